@@ -1,8 +1,7 @@
-package main
+package blockstorage
 
 import (
 	"context"
-	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -19,43 +18,50 @@ import (
 	l "gitlab.com/cyclops-utilities/logging"
 )
 
-var (
-	collector       = "Blockstorage"
-	objects         = "volume"
-	remotelist      []*eeModels.MinimalState
-	collectionStart int64
-)
-
 // collect handles the process of retrieving the information from the system.
-func collect() {
+// Parameters:
+// - ctx: context of the collection.
+// Returns:
+// - e: error raised in case of something goes wrong.
+func (s *Source) collect(ctx context.Context) (e error) {
 
 	l.Trace.Printf("[COLLECTION] The collection process has been started.\n")
 
-	collectionStart = time.Now().UnixNano()
-
-	metricTime.With(prometheus.Labels{"type": "Collection Start Time"}).Set(float64(collectionStart))
+	s.collectionStart = time.Now().UnixNano()
 
 	// Here comes the logic to retrieve the information from the system
 	opts := gophercloud.AuthOptions{
-		DomainName:       cfg.OpenStack.Domain,
-		IdentityEndpoint: cfg.OpenStack.Keystone,
-		Password:         cfg.OpenStack.Password,
-		Username:         cfg.OpenStack.User,
+		DomainName:       s.cfg.OpenStack.Domain,
+		IdentityEndpoint: s.cfg.OpenStack.Keystone,
+		Password:         s.cfg.OpenStack.Password,
+		Username:         s.cfg.OpenStack.User,
 	}
 
-	if len(cfg.OpenStack.Project) > 0 {
+	if len(s.cfg.OpenStack.Project) > 0 {
 
-		opts.TenantName = cfg.OpenStack.Project
+		opts.TenantName = s.cfg.OpenStack.Project
 
 	}
 
-	provider, e := openstack.AuthenticatedClient(opts)
+	// The provider uses the HTTP client of the runtime so its requests can be
+	// recorded or replayed.
+	provider, e := openstack.NewClient(opts.IdentityEndpoint)
 
 	if e != nil {
 
+		l.Error.Printf("[COLLECTION] Error creating the OpenStack client. Error: %v\n", e)
+
+		return
+
+	}
+
+	provider.HTTPClient = s.httpClient
+
+	if e = openstack.Authenticate(provider, opts); e != nil {
+
 		l.Error.Printf("[COLLECTION] Error authenticating against OpenStack. Error: %v\n", e)
 
-		os.Exit(1)
+		return
 
 	}
 
@@ -69,7 +75,7 @@ func collect() {
 
 		l.Error.Printf("[COLLECTION] Error creating the collector client. Error: %v\n", e)
 
-		os.Exit(1)
+		return
 
 	}
 
@@ -79,7 +85,7 @@ func collect() {
 
 		l.Error.Printf("[COLLECTION] Error listing all the pages. Error: %v\n", e)
 
-		os.Exit(1)
+		return
 
 	}
 
@@ -89,20 +95,22 @@ func collect() {
 
 		l.Error.Printf("[COLLECTION] Error listing all the projects. Error: %v\n", e)
 
-		os.Exit(1)
+		return
 
 	}
 
 	l.Trace.Printf("[COLLECTION] Querying events engine service for list of known and not terminated volumes")
 
 	resourceType := "blockstorage"
-	eeParams := eeEvent.NewListStatesParams().WithResource(&resourceType).WithRegion(&cfg.OpenStack.Region)
+	eeParams := eeEvent.NewListStatesParams().WithResource(&resourceType).WithRegion(&s.cfg.OpenStack.Region)
+
+	eeCtx, cancel := context.WithTimeout(ctx, 300*time.Second)
+	defer cancel()
 
-	ctx, _ := context.WithTimeout(context.Background(), 300*time.Second)
-	r, e := reportClient.EventManagement.ListStates(ctx, eeParams)
+	r, e := s.reportClient.EventManagement.ListStates(eeCtx, eeParams)
 
 	// Clears the remotelist between runs
-	remotelist = nil
+	s.remotelist = nil
 
 	if e != nil {
 
@@ -110,15 +118,14 @@ func collect() {
 
 	} else {
 
-		remotelist = r.Payload
+		s.remotelist = r.Payload
 
 	}
 
 	resourceType = "blockstorage_ssd"
-	eeParams = eeEvent.NewListStatesParams().WithResource(&resourceType).WithRegion(&cfg.OpenStack.Region)
+	eeParams = eeEvent.NewListStatesParams().WithResource(&resourceType).WithRegion(&s.cfg.OpenStack.Region)
 
-	ctx, _ = context.WithTimeout(context.Background(), 300*time.Second)
-	r, e = reportClient.EventManagement.ListStates(ctx, eeParams)
+	r, e = s.reportClient.EventManagement.ListStates(eeCtx, eeParams)
 
 	if e != nil {
 
@@ -126,15 +133,16 @@ func collect() {
 
 	} else {
 
-		remotelist = append(remotelist, r.Payload...)
+		s.remotelist = append(s.remotelist, r.Payload...)
 
 	}
 
-	eeCount := len(remotelist)
+	eeCount := len(s.remotelist)
 	apiCount := 0
 	ssdCount := 0
+	incomplete := false
 
-	l.Trace.Printf("[COLLECTION] (BEFORE) Existing count of blockstorage elements at remote [ %v ].\n", len(remotelist))
+	l.Trace.Printf("[COLLECTION] (BEFORE) Existing count of blockstorage elements at remote [ %v ].\n", len(s.remotelist))
 
 allProjectsLoop:
 	for _, project := range allProjects {
@@ -142,19 +150,21 @@ allProjectsLoop:
 		l.Trace.Printf("[COLLECTION] Found project [ %v ] with ID [ %v ]. Proceeding to get list of volumes.\n", project.Name, project.ID)
 
 		volumeClient, e := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{
-			Region: cfg.OpenStack.Region,
+			Region: s.cfg.OpenStack.Region,
 		})
 
 		if e != nil {
 
 			l.Error.Printf("[COLLECTION] Error creating block storage client. Error: %v\n", e)
 
+			incomplete = true
+
 			continue
 
 		}
 
 		// Filter by project id:
-		for _, filter := range cfg.ProjectFilters {
+		for _, filter := range s.cfg.ProjectFilters {
 
 			if strings.Contains(project.ID, filter) && filter != "" {
 
@@ -167,7 +177,7 @@ allProjectsLoop:
 		}
 
 		// Filter by project name:
-		for _, filter := range cfg.NameFilters {
+		for _, filter := range s.cfg.NameFilters {
 
 			if strings.Contains(strings.ToLower(project.Name), strings.ToLower(filter)) && filter != "" {
 
@@ -212,9 +222,15 @@ allProjectsLoop:
 				md["availabilityzone"] = v.AvailabilityZone
 				md["replication"] = v.ReplicationStatus
 				md["volumetype"] = v.VolumeType
-				md["region"] = cfg.OpenStack.Region
+				md["region"] = s.cfg.OpenStack.Region
+
+				if tags := s.getTags(v.Metadata); len(tags) > 0 {
+
+					md["tags"] = tags
 
-				evTime := int64(time.Now().Unix())
+				}
+
+				evTime := int64(s.fixtures.Now().Unix())
 				evLast := getStatus(v.Status)
 
 				objectType := "blockstorage"
@@ -232,16 +248,16 @@ allProjectsLoop:
 					EventTime:    &evTime,
 					LastEvent:    &evLast,
 					MetaData:     md,
-					Region:       cfg.OpenStack.Region,
+					Region:       s.cfg.OpenStack.Region,
 					ResourceID:   v.ID,
 					ResourceName: strings.TrimSpace(v.Name),
 					ResourceType: objectType,
 				}
 
-				report(event)
+				s.report(event)
 
 				//if this object exists in remote list then lets remove it
-				for i, object := range remotelist {
+				for i, object := range s.remotelist {
 
 					metadata := object.MetaData
 
@@ -252,7 +268,7 @@ allProjectsLoop:
 
 						l.Debug.Printf("[COLLECTION] Event send cleaned from the processing list..\n")
 
-						remotelist = append(remotelist[:i], remotelist[i+1:]...)
+						s.remotelist = append(s.remotelist[:i], s.remotelist[i+1:]...)
 
 						break
 
@@ -266,15 +282,29 @@ allProjectsLoop:
 
 		})
 
+		if e != nil {
+
+			l.Error.Printf("[COLLECTION] Error listing the volumes of project [ %v ]. Error: %v\n", project.ID, e)
+
+			incomplete = true
+
+		}
+
 	}
 
-	l.Trace.Printf("[COLLECTION] (AFTER) Remaining count of blockstorage elements at remote which were left unprocessed [ %v ].\n", len(remotelist))
+	l.Trace.Printf("[COLLECTION] (AFTER) Remaining count of blockstorage elements at remote which were left unprocessed [ %v ].\n", len(s.remotelist))
+
+	// The remaining volumes are sent a terminated status, unless the safeguards
+	// against mass termination hold them back
+	terminations := s.safeguard.Guard(s.remotelist, eeCount, incomplete)
+
+	for _, t := range terminations {
 
-	for _, object := range remotelist {
+		object := t.Object
 
-		l.Debug.Printf("[COLLECTION] Sending termination for zombie data in the system..\n")
+		l.Info.Printf("[COLLECTION] Sending termination for zombie data in the system, volume [ %v ] of project [ %v ]. Reason: %v.\n", object.ResourceID, object.Account, t.Reason)
 
-		evTime := int64(time.Now().Unix())
+		evTime := int64(s.fixtures.Now().Unix())
 		evLast := getStatus("terminated")
 
 		objectType := "blockstorage"
@@ -291,30 +321,52 @@ allProjectsLoop:
 			EventTime:    &evTime,
 			LastEvent:    &evLast,
 			MetaData:     object.MetaData,
-			Region:       cfg.OpenStack.Region,
+			Region:       s.cfg.OpenStack.Region,
 			ResourceID:   object.ResourceID,
 			ResourceName: object.ResourceName,
 			ResourceType: objectType,
 		}
 
-		report(event)
+		s.report(event)
 
 	}
 
-	metricCount.With(prometheus.Labels{"type": "Total Storage Blocks reported by OS API"}).Set(float64(apiCount))
+	s.metricCount.With(prometheus.Labels{"type": "Total Storage Blocks reported by OS API"}).Set(float64(apiCount))
 
-	metricCount.With(prometheus.Labels{"type": "Total Storage Blocks (only SSD) reported by OS API"}).Set(float64(ssdCount))
+	s.metricCount.With(prometheus.Labels{"type": "Total Storage Blocks (only SSD) reported by OS API"}).Set(float64(ssdCount))
 
-	metricCount.With(prometheus.Labels{"type": "Total Storage Blocks from EventEngine"}).Set(float64(eeCount))
+	s.metricCount.With(prometheus.Labels{"type": "Total Storage Blocks from EventEngine"}).Set(float64(eeCount))
 
-	metricCount.With(prometheus.Labels{"type": "Total Storage Blocks forcefully TERMINATED"}).Set(float64(len(remotelist)))
+	s.metricCount.With(prometheus.Labels{"type": "Total Storage Blocks forcefully TERMINATED"}).Set(float64(len(terminations)))
 
-	metricTime.With(prometheus.Labels{"type": "Collection Processing Time"}).Set(float64(time.Now().UnixNano()-collectionStart) / float64(time.Millisecond))
-
-	l.Warning.Printf("[COLLECTION] Completed.\n - OS Report: %v\n - EE Report: %v\n - Forced Termination: %v\n - Processing Time: %v[ms]\n", apiCount, eeCount, len(remotelist), float64(time.Now().UnixNano()-collectionStart)/float64(time.Millisecond))
+	l.Warning.Printf("[COLLECTION] Completed.\n - OS Report: %v\n - EE Report: %v\n - Forced Termination: %v\n - Processing Time: %v[ms]\n", apiCount, eeCount, len(terminations), float64(time.Now().UnixNano()-s.collectionStart)/float64(time.Millisecond))
 
 	l.Trace.Printf("[COLLECTION] The collection process has been finished.\n")
 
+	return nil
+
+}
+
+// getTags job is to filter the tags of the resource against the configured
+// allowlist, so only the ones meant for cost allocation travel with the event.
+// Parameters:
+// - source: map with the tags/metadata of the resource in the system.
+// Returns:
+// - tags: JSONdb containing only the allowlisted tags.
+func (s *Source) getTags(source map[string]string) (tags datamodels.JSONdb) {
+
+	tags = make(datamodels.JSONdb)
+
+	for _, key := range s.cfg.TagAllowlist {
+
+		if value, exists := source[key]; exists && value != "" {
+
+			tags[key] = value
+
+		}
+
+	}
+
 	return
 
 }
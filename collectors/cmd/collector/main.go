@@ -0,0 +1,29 @@
+package main
+
+import (
+	"github.com/GoDieNow/TFT_Code/collectors/blockstorage"
+	"github.com/GoDieNow/TFT_Code/collectors/collector"
+	"github.com/GoDieNow/TFT_Code/collectors/network"
+	"github.com/GoDieNow/TFT_Code/collectors/notifications"
+	"github.com/GoDieNow/TFT_Code/collectors/objects"
+	"github.com/GoDieNow/TFT_Code/collectors/servers"
+)
+
+var (
+	version string
+	service string
+)
+
+func main() {
+
+	collector.RegisterSource("blockstorage", blockstorage.New)
+	collector.RegisterSource("network", network.New)
+	collector.RegisterSource("objects", objects.New)
+	collector.RegisterSource("servers", servers.New)
+	collector.RegisterListener("notifications", notifications.New)
+
+	// The service set at build time is the source run when the configuration
+	// doesn't enable any in its [SOURCES] section.
+	collector.Run(version, service)
+
+}
@@ -0,0 +1,610 @@
+// Package collector is the runtime shared by the collectors: it loads the
+// configuration, schedules the registered sources, retries the failed
+// collections, reports the events and usages through kafka, http or to a file,
+// and serves the metrics and the health of the sources.
+package collector
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/viper"
+	cusClient "github.com/GoDieNow/TFT_Code/services/customerdb/client"
+	eeClient "github.com/GoDieNow/TFT_Code/services/eventsengine/client"
+	udrClient "github.com/GoDieNow/TFT_Code/services/udr/client"
+	l "gitlab.com/cyclops-utilities/logging"
+)
+
+// Source is implemented by the collectors polling a system: every period the
+// resources are listed and returned as events (eeModels.Event) or usages
+// (udrModels.Usage) to be reported. When the collection fails nothing of it is
+// reported and it's retried.
+type Source interface {
+	Collect(ctx context.Context) (reports []interface{}, e error)
+}
+
+// Listener is implemented by the collectors driven by the notifications of a
+// system: Listen reports the events as they arrive, report failing when they
+// couldn't be delivered, and only returns when the connection is lost, being
+// restarted by the runtime, or the context is done on shutdown.
+type Listener interface {
+	Listen(ctx context.Context, report func(object interface{}) error) (e error)
+}
+
+// Env gathers what the runtime shares with a source. The clients, and the
+// HTTPClient to be used by the source with the APIs it collects from, record
+// or replay their responses when the fixtures are enabled.
+type Env struct {
+	Config       *Configuration
+	CustomerDB   *cusClient.CustomerDatabaseManagement
+	EventsEngine *eeClient.EventEngineManagementAPI
+	Fixtures     *Fixtures
+	HTTPClient   http.Client
+	MetricCount  *prometheus.GaugeVec
+	MetricTime   *prometheus.GaugeVec
+	Name         string
+}
+
+var (
+	cfg       Configuration
+	listeners = make(map[string]func(env *Env) (Listener, error))
+	sources   = make(map[string]func(env *Env) (Source, error))
+)
+
+// RegisterSource makes a polling source available to the runtime.
+// Parameters:
+// - name: string with the name of the source, as used in [SOURCES.<name>].
+// - factory: function creating the source from its environment.
+func RegisterSource(name string, factory func(env *Env) (Source, error)) {
+
+	sources[strings.ToLower(name)] = factory
+
+}
+
+// RegisterListener makes a notification driven source available to the
+// runtime.
+// Parameters:
+// - name: string with the name of the source, as used in [SOURCES.<name>].
+// - factory: function creating the listener from its environment.
+func RegisterListener(name string, factory func(env *Env) (Listener, error)) {
+
+	listeners[strings.ToLower(name)] = factory
+
+}
+
+// Run loads the configuration and runs the sources enabled in it, each one
+// with its own periodicity, for the lifetime of the collector. When the
+// configuration doesn't have a [SOURCES] section, the source the binary has
+// been built for is run alone.
+// With [FIXTURES] in record or replay mode every poll source is collected only
+// once, against the live APIs saving their responses or against the saved
+// ones, and the reports are written to a file.
+// Parameters:
+// - version: string with the version of the collector.
+// - service: string with the name of the default source.
+func Run(version, service string) {
+
+	confFile := flag.String("conf", "./config", "configuration file path (without toml extension)")
+
+	flag.Parse()
+
+	//placeholder code as the default value will ensure this situation will never arise
+	if len(*confFile) == 0 {
+
+		fmt.Printf("Usage: Collector -conf=/path/to/configuration/file\n")
+
+		os.Exit(0)
+
+	}
+
+	viper.SetConfigName(*confFile) // name of config file (without extension)
+	viper.SetConfigType("toml")
+	viper.AddConfigPath(".") // path to look for the config file in
+
+	err := viper.ReadInConfig() // Find and read the config file
+
+	if err != nil {
+
+		fmt.Printf("[MAIN] Failed to parse configuration data: %s\nCorrect usage: Collector -conf=/path/to/configuration/file\n", err)
+
+		os.Exit(1)
+
+	}
+
+	cfg = parseConfig()
+
+	e := l.InitLogger(cfg.General.LogFile, cfg.General.LogLevel, cfg.General.LogToConsole)
+
+	if e != nil {
+
+		fmt.Printf("[MAIN] Initialization of the logger failed. Error: %v\n", e)
+
+	}
+
+	l.Info.Printf("Cyclops Labs Collector version %v initialized\n", version)
+
+	if len(cfg.Sources) == 0 && service != "" {
+
+		cfg.Sources[service] = SourceConfig{
+			Periodicity: cfg.General.Periodicity,
+		}
+
+	}
+
+	dumpConfig(cfg)
+
+	if cfg.Fixtures.Mode != "" {
+
+		if cfg.Fixtures.Mode != "record" && cfg.Fixtures.Mode != "replay" {
+
+			l.Error.Printf("[MAIN] The fixtures mode [ %v ] is not supported, it has to be either record or replay.\n", cfg.Fixtures.Mode)
+
+			os.Exit(1)
+
+		}
+
+		l.Info.Printf("[MAIN] Running the sources once in [ %v ] mode with the fixtures in [ %v ], the reports are written to [ %v ].\n", cfg.Fixtures.Mode, cfg.Fixtures.Path, cfg.Report.File)
+
+		cfg.Report.Mode = "file"
+		cfg.General.Retries = 0
+
+	}
+
+	if cfg.Report.Mode != "kafka" && cfg.Report.Mode != "http" && cfg.Report.Mode != "file" {
+
+		l.Error.Printf("[MAIN] The report mode [ %v ] is not supported, it has to be either kafka, http or file.\n", cfg.Report.Mode)
+
+		os.Exit(1)
+
+	}
+
+	h := newHealth()
+
+	// Let's start the HTTP Server and Gauges for Prometheus
+	prometheusStart(h)
+
+	auth := httptransport.APIKeyAuth(cfg.APIKey.Key, cfg.APIKey.Place, cfg.APIKey.Token)
+
+	// Here we start the client instantiation to send reports to the EventsEngine
+	// and to look up the resources it already knows.
+	ee := eeClient.New(eeClient.Config{
+		URL: &url.URL{
+			Host:   cfg.Services["eventsengine"],
+			Path:   eeClient.DefaultBasePath,
+			Scheme: "http",
+		},
+		AuthInfo: auth,
+	})
+
+	// Here we start the client instantiation to get the canceled customers to check for zombies.
+	cus := cusClient.New(cusClient.Config{
+		URL: &url.URL{
+			Host:   cfg.Services["customerdb"],
+			Path:   cusClient.DefaultBasePath,
+			Scheme: "http",
+		},
+		AuthInfo: auth,
+	})
+
+	// Here we start the client instantiation to ingest the usages in the UDR.
+	udr := udrClient.New(udrClient.Config{
+		URL: &url.URL{
+			Host:   cfg.Services["udr"],
+			Path:   udrClient.DefaultBasePath,
+			Scheme: "http",
+		},
+		AuthInfo: auth,
+	})
+
+	r, e := newReporter(ee, udr)
+
+	if e != nil {
+
+		l.Error.Printf("[MAIN] The reporter couldn't be started. Error: %v\n", e)
+
+		os.Exit(1)
+
+	}
+
+	var names []string
+
+	for name := range cfg.Sources {
+
+		names = append(names, name)
+
+	}
+
+	sort.Strings(names)
+
+	if len(names) == 0 {
+
+		l.Error.Printf("[MAIN] There are no sources to run, enable them in the [SOURCES] section of the configuration.\n")
+
+		os.Exit(1)
+
+	}
+
+	var failed int
+	var listening sync.WaitGroup
+
+	// The listeners are stopped on shutdown, so their sessions are closed
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	for _, name := range names {
+
+		f := newFixtures(cfg.Fixtures.Mode, filepath.Join(cfg.Fixtures.Path, name))
+
+		env := &Env{
+			Config:       &cfg,
+			CustomerDB:   cus,
+			EventsEngine: ee,
+			Fixtures:     f,
+			HTTPClient:   http.Client{Transport: f.Transport(http.DefaultTransport)},
+			MetricCount:  metricCount.MustCurryWith(prometheus.Labels{"source": name}),
+			MetricTime:   metricTime.MustCurryWith(prometheus.Labels{"source": name}),
+			Name:         name,
+		}
+
+		// The lookups in the EventsEngine and the CustomerDB are part of what the
+		// collection depends on, so they are recorded with the rest of the APIs.
+		if cfg.Fixtures.Mode != "" {
+
+			env.EventsEngine = eeClient.New(eeClient.Config{
+				URL: &url.URL{
+					Host:   cfg.Services["eventsengine"],
+					Path:   eeClient.DefaultBasePath,
+					Scheme: "http",
+				},
+				AuthInfo:  auth,
+				Transport: env.HTTPClient.Transport,
+			})
+
+			env.CustomerDB = cusClient.New(cusClient.Config{
+				URL: &url.URL{
+					Host:   cfg.Services["customerdb"],
+					Path:   cusClient.DefaultBasePath,
+					Scheme: "http",
+				},
+				AuthInfo:  auth,
+				Transport: env.HTTPClient.Transport,
+			})
+
+		}
+
+		if factory, exists := sources[name]; exists {
+
+			s, e := factory(env)
+
+			if e != nil {
+
+				l.Error.Printf("[MAIN] The source [ %v ] couldn't be started. Error: %v\n", name, e)
+
+				os.Exit(1)
+
+			}
+
+			periodicity := cfg.Sources[name].Periodicity
+
+			if periodicity < 1 {
+
+				l.Error.Printf("[MAIN] The source [ %v ] needs a periodicity of at least one minute.\n", name)
+
+				os.Exit(1)
+
+			}
+
+			h.register(name, "poll", periodicity)
+
+			if cfg.Fixtures.Mode != "" {
+
+				if e := f.start(); e != nil {
+
+					l.Error.Printf("[MAIN] The fixtures of source [ %v ] couldn't be prepared. Error: %v\n", name, e)
+
+					failed++
+
+					continue
+
+				}
+
+				if e := collect(name, s, time.Duration(periodicity)*time.Minute, r, h); e != nil {
+
+					failed++
+
+				}
+
+				continue
+
+			}
+
+			go schedule(name, s, periodicity, r, h)
+
+			continue
+
+		}
+
+		if factory, exists := listeners[name]; exists {
+
+			if cfg.Fixtures.Mode != "" {
+
+				l.Warning.Printf("[MAIN] The source [ %v ] is driven by notifications, it can't be run against the fixtures and is skipped.\n", name)
+
+				continue
+
+			}
+
+			s, e := factory(env)
+
+			if e != nil {
+
+				l.Error.Printf("[MAIN] The source [ %v ] couldn't be started. Error: %v\n", name, e)
+
+				os.Exit(1)
+
+			}
+
+			h.register(name, "listen", 0)
+
+			listening.Add(1)
+
+			go func(name string, s Listener) {
+
+				defer listening.Done()
+
+				listen(ctx, name, s, r, h)
+
+			}(name, s)
+
+			continue
+
+		}
+
+		l.Error.Printf("[MAIN] The source [ %v ] is unknown to this collector.\n", name)
+
+		os.Exit(1)
+
+	}
+
+	if cfg.Fixtures.Mode != "" {
+
+		if e := r.close(); e != nil {
+
+			l.Error.Printf("[MAIN] The reports couldn't be written to [ %v ]. Error: %v\n", cfg.Report.File, e)
+
+			failed++
+
+		}
+
+		if failed > 0 {
+
+			l.Error.Printf("[MAIN] [ %v ] of the sources failed in [ %v ] mode.\n", failed, cfg.Fixtures.Mode)
+
+			os.Exit(1)
+
+		}
+
+		l.Info.Printf("[MAIN] The sources have been run in [ %v ] mode, the reports are in [ %v ].\n", cfg.Fixtures.Mode, cfg.Report.File)
+
+		os.Exit(0)
+
+	}
+
+	l.Info.Printf("[MAIN] Running the sources %v.\n", names)
+
+	<-ctx.Done()
+
+	l.Info.Printf("[MAIN] Stopping the sources.\n")
+
+	listening.Wait()
+
+}
+
+// schedule runs the collections of a source every period, a collection
+// still running when the next one is due makes the latter to be skipped.
+// Parameters:
+// - name: string with the name of the source.
+// - s: Source to be collected.
+// - periodicity: int with the minutes between collections.
+// - r: reporter reference to send the reports.
+// - h: health reference to record the result of the collections.
+func schedule(name string, s Source, periodicity int, r *reporter, h *health) {
+
+	var running sync.Mutex
+
+	period := time.Duration(periodicity) * time.Minute
+
+	run := func() {
+
+		if !running.TryLock() {
+
+			l.Warning.Printf("[SCHEDULE] The previous collection of source [ %v ] is still running, skipping this one.\n", name)
+
+			metricCount.With(prometheus.Labels{"source": name, "type": "Collections SKIPPED due to overlapping"}).Inc()
+
+			return
+
+		}
+
+		defer running.Unlock()
+
+		collect(name, s, period, r, h)
+
+	}
+
+	// Let's lunch the first collection process..
+	go run()
+
+	for range time.NewTicker(period).C {
+
+		go run()
+
+	}
+
+}
+
+// collect runs a collection of a source, retrying it when it fails, and sends
+// its reports once it succeeds.
+// Parameters:
+// - name: string with the name of the source.
+// - s: Source to be collected.
+// - period: Duration with the time available for the collection.
+// - r: reporter reference to send the reports.
+// - h: health reference to record the result of the collection.
+// Returns:
+// - e: error raised by the collection when all its attempts failed.
+func collect(name string, s Source, period time.Duration, r *reporter, h *health) (e error) {
+
+	l.Trace.Printf("[SCHEDULE] The collection of source [ %v ] has been started.\n", name)
+
+	h.started(name)
+
+	collectionStart := time.Now().UnixNano()
+
+	metricTime.With(prometheus.Labels{"source": name, "type": "Collection Start Time"}).Set(float64(collectionStart))
+
+	ctx, cancel := context.WithTimeout(context.Background(), period)
+	defer cancel()
+
+	var reports []interface{}
+
+	e = retry("SCHEDULE", func() (e error) {
+
+		reports, e = s.Collect(ctx)
+
+		return
+
+	})
+
+	metricTime.With(prometheus.Labels{"source": name, "type": "Collection Processing Time"}).Set(float64(time.Now().UnixNano()-collectionStart) / float64(time.Millisecond))
+
+	h.finished(name, len(reports), e)
+
+	if e != nil {
+
+		l.Error.Printf("[SCHEDULE] The collection of source [ %v ] failed, nothing will be reported for this period. Error: %v\n", name, e)
+
+		metricCount.With(prometheus.Labels{"source": name, "type": "Collections FAILED"}).Inc()
+
+		return
+
+	}
+
+	metricCount.With(prometheus.Labels{"source": name, "type": "Total reports generated"}).Set(float64(len(reports)))
+
+	if err := r.report(name, reports); err != nil {
+
+		l.Warning.Printf("[SCHEDULE] Some of the reports of source [ %v ] couldn't be delivered. Error: %v\n", name, err)
+
+	}
+
+	l.Trace.Printf("[SCHEDULE] The collection of source [ %v ] has been finished.\n", name)
+
+	return
+
+}
+
+// listen runs a listener for the lifetime of the collector, restarting it
+// with an increasing backoff whenever it stops.
+// Parameters:
+// - ctx: context done when the collector is stopped.
+// - name: string with the name of the source.
+// - s: Listener to be run.
+// - r: reporter reference to send the reports.
+// - h: health reference to record the state of the listener.
+func listen(ctx context.Context, name string, s Listener, r *reporter, h *health) {
+
+	backoff := 5 * time.Second
+
+	for {
+
+		h.started(name)
+
+		start := time.Now()
+
+		e := s.Listen(ctx, func(object interface{}) error {
+
+			h.reported(name)
+
+			return r.report(name, []interface{}{object})
+
+		})
+
+		h.finished(name, 0, e)
+
+		if ctx.Err() != nil {
+
+			l.Info.Printf("[SCHEDULE] The listener of source [ %v ] has been stopped.\n", name)
+
+			return
+
+		}
+
+		metricCount.With(prometheus.Labels{"source": name, "type": "Total listener restarts"}).Inc()
+
+		// A session that lasted resets the backoff
+		if time.Since(start) > 5*time.Minute {
+
+			backoff = 5 * time.Second
+
+		}
+
+		l.Warning.Printf("[SCHEDULE] The listener of source [ %v ] stopped, restarting it in [ %v ]. Error: %v\n", name, backoff, e)
+
+		select {
+
+		case <-ctx.Done():
+
+			return
+
+		case <-time.After(backoff):
+
+		}
+
+		if backoff *= 2; backoff > 5*time.Minute {
+
+			backoff = 5 * time.Minute
+
+		}
+
+	}
+
+}
+
+// retry runs a function until it succeeds or General.Retries retries have
+// been made, waiting General.RetryWait seconds between attempts.
+// Parameters:
+// - tag: string with the prefix of the log messages.
+// - f: function to be run.
+// Returns:
+// - e: error raised by the last attempt, nil when one succeeded.
+func retry(tag string, f func() error) (e error) {
+
+	for attempt := 0; ; attempt++ {
+
+		if e = f(); e == nil || attempt >= cfg.General.Retries {
+
+			return
+
+		}
+
+		l.Warning.Printf("[%v] Attempt [ %v/%v ] failed, retrying in [ %v ] seconds. Error: %v\n", tag, attempt+1, cfg.General.Retries+1, cfg.General.RetryWait, e)
+
+		time.Sleep(time.Duration(cfg.General.RetryWait) * time.Second)
+
+	}
+
+}
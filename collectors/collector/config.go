@@ -1,7 +1,8 @@
-package main
+package collector
 
 import (
 	"encoding/json"
+	"net/url"
 	"strings"
 
 	"github.com/spf13/viper"
@@ -10,46 +11,48 @@ import (
 
 // The following structs are part of the configuration struct which
 // acts as the main reference for configuration parameters in the system.
-type apiKey struct {
+type APIKeyConfig struct {
 	Enabled bool
 	Key     string
 	Place   string
 	Token   string
 }
 
-type configuration struct {
-	APIKey         apiKey
-	General        generalConfig
-	Heappe         heappeConfig
-	Kafka          kafkaConfig
-	Keycloak       keycloakConfig
-	Lieutenant     lieutenantConfig
-	OpenStack      openStackConfig
-	Prometheus     prometheusConfig
-	RGW            rgwConfig
+type Configuration struct {
+	APIKey         APIKeyConfig
+	Fixtures       FixturesConfig
+	General        GeneralConfig
+	Kafka          KafkaConfig
+	Notifications  NotificationsConfig
+	OpenStack      OpenStackConfig
+	Prometheus     PrometheusConfig
+	Report         ReportConfig
+	RGW            RGWConfig
+	Sources        map[string]SourceConfig
+	Termination    TerminationConfig
 	NameFilters    []string
 	ProjectFilters []string
 	Services       map[string]string
+	TagAllowlist   []string
 }
 
-type generalConfig struct {
+type FixturesConfig struct {
+	Mode string
+	Path string
+}
+
+type GeneralConfig struct {
 	InsecureSkipVerify    bool
 	LogFile               string
 	LogLevel              string
 	LogToConsole          bool
-	ObjectsPeriodicity    int
 	Periodicity           int
 	PrometheusPeriodicity int
+	Retries               int
+	RetryWait             int
 }
 
-type heappeConfig struct {
-	Username                    string
-	Password                    string
-	GroupResourceUsageReportURI string
-	AuthenticateUserPasswordURI string
-}
-
-type kafkaConfig struct {
+type KafkaConfig struct {
 	Brokers      []string
 	MaxBytes     int
 	MinBytes     int
@@ -60,23 +63,16 @@ type kafkaConfig struct {
 	TopicEEngine string
 }
 
-type keycloakConfig struct {
-	ClientID     string `json:"client_id"`
-	ClientSecret string `json:"client_secret"`
-	Enabled      bool   `json:"enabled"`
-	Host         string `json:"host"`
-	Port         int    `json:"port"`
-	Realm        string `json:"realm"`
-	RedirectURL  string `json:"redirect_url"`
-	UseHTTP      bool   `json:"use_http"`
+type NotificationsConfig struct {
+	Durable   bool
+	Exchanges []string
+	Prefetch  int
+	Queue     string
+	Topic     string
+	URL       string
 }
 
-type lieutenantConfig struct {
-	Host  string
-	Token string
-}
-
-type openStackConfig struct {
+type OpenStackConfig struct {
 	Domain   string
 	Keystone string
 	Password string
@@ -85,14 +81,20 @@ type openStackConfig struct {
 	User     string
 }
 
-type prometheusConfig struct {
+type PrometheusConfig struct {
 	Host          string
+	HealthRoute   string
 	MetricsExport bool
 	MetricsPort   string
 	MetricsRoute  string
 }
 
-type rgwConfig struct {
+type ReportConfig struct {
+	File string
+	Mode string
+}
+
+type RGWConfig struct {
 	AccessKeyID     string
 	AdminPath       string
 	Region          string
@@ -100,23 +102,40 @@ type rgwConfig struct {
 	ServerURL       string
 }
 
+type SourceConfig struct {
+	Periodicity int
+}
+
+type TerminationConfig struct {
+	DryRun      bool
+	GracePeriod int
+	MaxCount    int
+	MaxPercent  float64
+}
+
 // dumpConfig 's job is to dumps the configuration in JSON format to the log
 // system. It makes use of the masking function to keep some secrecy in the log.
 // Parameters:
 // - c: configuration type containing the config present in the system.
-func dumpConfig(c configuration) {
+func dumpConfig(c Configuration) {
 	cfgCopy := c
 
 	// deal with configuration params that should be masked
 	cfgCopy.APIKey.Token = masked(c.APIKey.Token, 4)
-	cfgCopy.Heappe.Username = masked(c.Heappe.Username, 4)
-	cfgCopy.Heappe.Password = masked(c.Heappe.Password, 4)
-	cfgCopy.Keycloak.ClientSecret = masked(c.Keycloak.ClientSecret, 4)
-	cfgCopy.Lieutenant.Token = masked(c.Lieutenant.Token, 4)
 	cfgCopy.OpenStack.Password = masked(c.OpenStack.Password, 4)
 	cfgCopy.RGW.AccessKeyID = masked(c.RGW.AccessKeyID, 4)
 	cfgCopy.RGW.SecretAccessKey = masked(c.RGW.SecretAccessKey, 4)
 
+	if u, e := url.Parse(c.Notifications.URL); e == nil {
+
+		cfgCopy.Notifications.URL = u.Redacted()
+
+	} else {
+
+		cfgCopy.Notifications.URL = masked(c.Notifications.URL, 4)
+
+	}
+
 	// mmrshalindent creates a string containing newlines; each line starts with
 	// two spaces and two spaces are added for each indent...
 	configJSON, _ := json.MarshalIndent(cfgCopy, "  ", "  ")
@@ -155,37 +174,36 @@ func masked(s string, unmaskedChars int) (returnString string) {
 // from the configuration file.
 // Returns:
 // - c: the configuration struct filled with the relevant parsed configuration.
-func parseConfig() (c configuration) {
+func parseConfig() (c Configuration) {
 
 	l.Trace.Printf("[CONFIG] Retrieving configuration.\n")
 
-	c = configuration{
+	c = Configuration{
 
-		APIKey: apiKey{
+		APIKey: APIKeyConfig{
 			Enabled: viper.GetBool("apikey.enabled"),
 			Key:     viper.GetString("apikey.key"),
 			Place:   viper.GetString("apikey.place"),
 			Token:   viper.GetString("apikey.token"),
 		},
 
-		General: generalConfig{
+		Fixtures: FixturesConfig{
+			Mode: strings.ToLower(viper.GetString("fixtures.mode")),
+			Path: viper.GetString("fixtures.path"),
+		},
+
+		General: GeneralConfig{
 			InsecureSkipVerify:    viper.GetBool("general.insecureskipverify"),
 			LogFile:               viper.GetString("general.logfile"),
 			LogLevel:              viper.GetString("general.loglevel"),
 			LogToConsole:          viper.GetBool("general.logtoconsole"),
-			ObjectsPeriodicity:    viper.GetInt("general.objectsperiodicity"),
 			Periodicity:           viper.GetInt("general.periodicity"),
 			PrometheusPeriodicity: viper.GetInt("general.prometheusperiodicity"),
+			Retries:               viper.GetInt("general.retries"),
+			RetryWait:             viper.GetInt("general.retrywait"),
 		},
 
-		Heappe: heappeConfig{
-			Username:                    viper.GetString("heappe.username"),
-			Password:                    viper.GetString("heappe.password"),
-			GroupResourceUsageReportURI: viper.GetString("heappe.groupResourceUsageReportUri"),
-			AuthenticateUserPasswordURI: viper.GetString("heappe.authenticateUserPasswordUri"),
-		},
-
-		Kafka: kafkaConfig{
+		Kafka: KafkaConfig{
 			Brokers:      viper.GetStringSlice("kafka.brokers"),
 			MaxBytes:     viper.GetInt("kafka.sizemax"),
 			MinBytes:     viper.GetInt("kafka.sizemin"),
@@ -196,23 +214,16 @@ func parseConfig() (c configuration) {
 			TopicEEngine: viper.GetString("kafka.topiceengine"),
 		},
 
-		Keycloak: keycloakConfig{
-			ClientID:     viper.GetString("keycloak.clientid"),
-			ClientSecret: viper.GetString("keycloak.clientsecret"),
-			Enabled:      viper.GetBool("keycloak.enabled"),
-			Host:         viper.GetString("keycloak.host"),
-			Port:         viper.GetInt("keycloak.port"),
-			Realm:        viper.GetString("keycloak.realm"),
-			RedirectURL:  viper.GetString("keycloak.redirecturl"),
-			UseHTTP:      viper.GetBool("keycloak.usehttp"),
-		},
-
-		Lieutenant: lieutenantConfig{
-			Host:  viper.GetString("lieutenant.host"),
-			Token: viper.GetString("lieutenant.token"),
+		Notifications: NotificationsConfig{
+			Durable:   viper.GetBool("notifications.durable"),
+			Exchanges: viper.GetStringSlice("notifications.exchanges"),
+			Prefetch:  viper.GetInt("notifications.prefetch"),
+			Queue:     viper.GetString("notifications.queue"),
+			Topic:     viper.GetString("notifications.topic"),
+			URL:       viper.GetString("notifications.url"),
 		},
 
-		OpenStack: openStackConfig{
+		OpenStack: OpenStackConfig{
 			Domain:   viper.GetString("openstack.domain"),
 			Keystone: viper.GetString("openstack.keystone"),
 			Password: viper.GetString("openstack.password"),
@@ -221,14 +232,20 @@ func parseConfig() (c configuration) {
 			User:     viper.GetString("openstack.user"),
 		},
 
-		Prometheus: prometheusConfig{
+		Prometheus: PrometheusConfig{
 			Host:          viper.GetString("prometheus.host"),
+			HealthRoute:   viper.GetString("prometheus.healthroute"),
 			MetricsExport: viper.GetBool("prometheus.metricsexport"),
 			MetricsPort:   viper.GetString("prometheus.metricsport"),
 			MetricsRoute:  viper.GetString("prometheus.metricsroute"),
 		},
 
-		RGW: rgwConfig{
+		Report: ReportConfig{
+			File: viper.GetString("report.file"),
+			Mode: strings.ToLower(viper.GetString("report.mode")),
+		},
+
+		RGW: RGWConfig{
 			AccessKeyID:     viper.GetString("rgw.accesskey"),
 			AdminPath:       viper.GetString("rgw.adminpath"),
 			Region:          viper.GetString("rgw.region"),
@@ -236,9 +253,61 @@ func parseConfig() (c configuration) {
 			ServerURL:       viper.GetString("rgw.serverurl"),
 		},
 
+		Sources: make(map[string]SourceConfig),
+
+		Termination: TerminationConfig{
+			DryRun:      viper.GetBool("termination.dryrun"),
+			GracePeriod: viper.GetInt("termination.graceperiod"),
+			MaxCount:    viper.GetInt("termination.maxcount"),
+			MaxPercent:  viper.GetFloat64("termination.maxpercent"),
+		},
+
 		NameFilters:    viper.GetStringSlice("events.namefilters"),
 		ProjectFilters: viper.GetStringSlice("events.projectfilters"),
 		Services:       viper.GetStringMapString("services"),
+		TagAllowlist:   viper.GetStringSlice("events.tagallowlist"),
+	}
+
+	// Every [SOURCES.<name>] table enables the source with that name, when a
+	// periodicity isn't given the general one is used.
+	for name := range viper.GetStringMap("sources") {
+
+		periodicity := viper.GetInt("sources." + name + ".periodicity")
+
+		if periodicity < 1 {
+
+			periodicity = c.General.Periodicity
+
+		}
+
+		c.Sources[strings.ToLower(name)] = SourceConfig{
+			Periodicity: periodicity,
+		}
+
+	}
+
+	if c.Report.Mode == "" {
+
+		c.Report.Mode = "kafka"
+
+	}
+
+	if c.Fixtures.Path == "" {
+
+		c.Fixtures.Path = "./fixtures"
+
+	}
+
+	if c.Report.File == "" {
+
+		c.Report.File = "./reports.ndjson"
+
+	}
+
+	if c.Prometheus.HealthRoute == "" {
+
+		c.Prometheus.HealthRoute = "/health"
+
 	}
 
 	return
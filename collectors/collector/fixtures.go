@@ -0,0 +1,387 @@
+package collector
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	l "gitlab.com/cyclops-utilities/logging"
+)
+
+// Fixtures records the responses of the APIs queried by a source while it
+// collects, or replays them so the source can be run offline against them.
+// The responses of the HTTP APIs are recorded raw through Transport, the ones
+// of the clients that don't let their transport be replaced through Call.
+type Fixtures struct {
+	clock time.Time
+	mode  string
+	path  string
+}
+
+// fixture is the format of the recorded HTTP responses, the body is kept as
+// JSON when it is so to ease their edition.
+type fixture struct {
+	Method string          `json:"method"`
+	URL    string          `json:"url"`
+	Status int             `json:"status"`
+	Header http.Header     `json:"header,omitempty"`
+	Body   json.RawMessage `json:"body,omitempty"`
+	Text   string          `json:"text,omitempty"`
+}
+
+// fixtureTransport is the RoundTripper recording or replaying the responses.
+type fixtureTransport struct {
+	fixtures *Fixtures
+	next     http.RoundTripper
+}
+
+// newFixtures creates the fixtures of a source.
+// Parameters:
+// - mode: string with the mode, either record, replay or empty to disable them.
+// - path: string with the folder of the fixtures of the source.
+// Returns:
+// - f: Fixtures reference of the source.
+func newFixtures(mode, path string) (f *Fixtures) {
+
+	f = &Fixtures{
+		mode: mode,
+		path: path,
+	}
+
+	return
+
+}
+
+// start prepares the fixtures for a collection: when recording the folder is
+// created and the time of the collection saved, when replaying that time is
+// loaded so the reports carry the same one.
+// Returns:
+// - e: error raised in case of something goes wrong.
+func (f *Fixtures) start() (e error) {
+
+	file := filepath.Join(f.path, "clock.json")
+
+	switch f.mode {
+
+	case "record":
+
+		if e = os.MkdirAll(f.path, 0700); e != nil {
+
+			return
+
+		}
+
+		f.clock = time.Now()
+
+		e = f.save(file, map[string]time.Time{"time": f.clock})
+
+	case "replay":
+
+		var clock map[string]time.Time
+
+		if e = f.load(file, &clock); e != nil {
+
+			return
+
+		}
+
+		f.clock = clock["time"]
+
+	}
+
+	return
+
+}
+
+// Replaying tells whether the source runs against the fixtures.
+// Returns:
+// - a bool set when replaying.
+func (f *Fixtures) Replaying() bool {
+
+	return f.mode == "replay"
+
+}
+
+// Now returns the time to be used in the reports: the current one, or the
+// one of the recorded collection when replaying so the reports can be diffed.
+// Returns:
+// - t: Time to be used.
+func (f *Fixtures) Now() (t time.Time) {
+
+	if f.mode == "replay" {
+
+		t = f.clock
+
+		return
+
+	}
+
+	t = time.Now()
+
+	return
+
+}
+
+// Transport wraps the transport of an HTTP client to record its responses or
+// to replay them.
+// Parameters:
+// - next: RoundTripper doing the actual requests.
+// Returns:
+// - t: RoundTripper to be used by the client.
+func (f *Fixtures) Transport(next http.RoundTripper) (t http.RoundTripper) {
+
+	if f.mode == "" {
+
+		t = next
+
+		return
+
+	}
+
+	t = &fixtureTransport{
+		fixtures: f,
+		next:     next,
+	}
+
+	return
+
+}
+
+// Call records or replays the response of a call to an API, for the clients
+// whose transport can't be replaced.
+// Parameters:
+// - key: string identifying the call, with its arguments.
+// - out: reference to the variable holding the response.
+// - fetch: function doing the call and filling out, not run when replaying.
+// Returns:
+// - e: error raised by the call or by the fixtures.
+func (f *Fixtures) Call(key string, out interface{}, fetch func() error) (e error) {
+
+	file := f.file("call " + key)
+
+	if f.mode == "replay" {
+
+		e = f.load(file, out)
+
+		return
+
+	}
+
+	if e = fetch(); e != nil || f.mode != "record" {
+
+		return
+
+	}
+
+	e = f.save(file, out)
+
+	return
+
+}
+
+// RoundTrip records the response of the request, or replays it.
+// Parameters:
+// - req: Request to be done.
+// Returns:
+// - resp: Response to the request.
+// - e: error raised in case of something goes wrong.
+func (t *fixtureTransport) RoundTrip(req *http.Request) (resp *http.Response, e error) {
+
+	key := req.Method + " " + req.URL.String()
+	file := t.fixtures.file(key)
+
+	if t.fixtures.mode == "replay" {
+
+		if req.Body != nil {
+
+			req.Body.Close()
+
+		}
+
+		var fx fixture
+
+		if e = t.fixtures.load(file, &fx); e != nil {
+
+			return
+
+		}
+
+		body := []byte(fx.Body)
+
+		if len(body) == 0 {
+
+			body = []byte(fx.Text)
+
+		}
+
+		resp = &http.Response{
+			Status:        fmt.Sprintf("%v %v", fx.Status, http.StatusText(fx.Status)),
+			StatusCode:    fx.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        fx.Header,
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}
+
+		if resp.Header == nil {
+
+			resp.Header = make(http.Header)
+
+		}
+
+		return
+
+	}
+
+	if resp, e = t.next.RoundTrip(req); e != nil {
+
+		return
+
+	}
+
+	body, e := io.ReadAll(resp.Body)
+
+	resp.Body.Close()
+
+	if e != nil {
+
+		return
+
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	fx := fixture{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Status: resp.StatusCode,
+		Header: resp.Header.Clone(),
+	}
+
+	// The tokens aren't kept, the replayed clients only need them to exist
+	for _, h := range []string{"X-Subject-Token", "X-Auth-Token"} {
+
+		if fx.Header.Get(h) != "" {
+
+			fx.Header.Set(h, "fixture")
+
+		}
+
+	}
+
+	fx.Header.Del("Set-Cookie")
+
+	if json.Valid(body) {
+
+		fx.Body = body
+
+	} else {
+
+		fx.Text = string(body)
+
+	}
+
+	if err := t.fixtures.save(file, fx); err != nil {
+
+		l.Warning.Printf("[FIXTURES] The response to [ %v ] couldn't be recorded. Error: %v\n", key, err)
+
+	}
+
+	return
+
+}
+
+// file returns the file of the fixture of a request or call, named after it
+// with a hash to keep it unique.
+// Parameters:
+// - key: string identifying the request or call.
+// Returns:
+// - name: string with the path of the file.
+func (f *Fixtures) file(key string) (name string) {
+
+	sum := sha1.Sum([]byte(key))
+
+	clean := strings.Map(func(r rune) rune {
+
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '-' || r == '.' {
+
+			return r
+
+		}
+
+		return '_'
+
+	}, key)
+
+	if len(clean) > 120 {
+
+		clean = clean[:120]
+
+	}
+
+	name = filepath.Join(f.path, clean+"-"+hex.EncodeToString(sum[:])[:10]+".json")
+
+	return
+
+}
+
+// load reads a fixture from its file.
+// Parameters:
+// - file: string with the path of the file.
+// - out: reference to the variable to be filled.
+// Returns:
+// - e: error raised when the fixture is missing or malformed.
+func (f *Fixtures) load(file string, out interface{}) (e error) {
+
+	data, e := os.ReadFile(file)
+
+	if e != nil {
+
+		e = fmt.Errorf("no fixture recorded in [ %v ]: %v", file, e)
+
+		return
+
+	}
+
+	if e = json.Unmarshal(data, out); e != nil {
+
+		e = fmt.Errorf("the fixture [ %v ] is malformed: %v", file, e)
+
+	}
+
+	return
+
+}
+
+// save writes a fixture to its file.
+// Parameters:
+// - file: string with the path of the file.
+// - in: the fixture to be saved.
+// Returns:
+// - e: error raised in case of something goes wrong.
+func (f *Fixtures) save(file string, in interface{}) (e error) {
+
+	data, e := json.MarshalIndent(in, "", "  ")
+
+	if e != nil {
+
+		return
+
+	}
+
+	e = os.WriteFile(file, data, 0600)
+
+	return
+
+}
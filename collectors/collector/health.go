@@ -0,0 +1,201 @@
+package collector
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	l "gitlab.com/cyclops-utilities/logging"
+)
+
+// sourceHealth holds the state of a source as served by the health endpoint.
+type sourceHealth struct {
+	Kind        string    `json:"kind"`
+	Periodicity int       `json:"periodicity,omitempty"`
+	Running     bool      `json:"running"`
+	LastRun     time.Time `json:"lastRun"`
+	LastSuccess time.Time `json:"lastSuccess"`
+	LastError   string    `json:"lastError,omitempty"`
+	Reports     int       `json:"reports"`
+	Healthy     bool      `json:"healthy"`
+}
+
+// health keeps track of the state of the sources run by the collector.
+type health struct {
+	mutex   sync.Mutex
+	start   time.Time
+	sources map[string]*sourceHealth
+}
+
+// newHealth creates the tracker of the state of the sources.
+// Returns:
+// - h: health reference ready to track the sources.
+func newHealth() (h *health) {
+
+	h = &health{
+		start:   time.Now(),
+		sources: make(map[string]*sourceHealth),
+	}
+
+	return
+
+}
+
+// register adds a source to be tracked by the health endpoint.
+// Parameters:
+// - name: string with the name of the source.
+// - kind: string with the kind of source, either poll or listen.
+// - periodicity: int with the minutes between collections of the poll sources.
+func (h *health) register(name, kind string, periodicity int) {
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.sources[name] = &sourceHealth{
+		Kind:        kind,
+		Periodicity: periodicity,
+	}
+
+}
+
+// started records the start of a collection or of a listening session.
+// Parameters:
+// - name: string with the name of the source.
+func (h *health) started(name string) {
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	s := h.sources[name]
+
+	s.Running = true
+	s.LastRun = time.Now()
+
+}
+
+// finished records the result of a collection or of a listening session.
+// Parameters:
+// - name: string with the name of the source.
+// - reports: int with the number of reports generated.
+// - e: error raised by the source, nil when it succeeded.
+func (h *health) finished(name string, reports int, e error) {
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	s := h.sources[name]
+
+	s.Running = false
+	s.Reports = reports
+
+	if e != nil {
+
+		s.LastError = e.Error()
+
+		return
+
+	}
+
+	s.LastError = ""
+	s.LastSuccess = time.Now()
+
+}
+
+// reported records the reports generated by a listening source.
+// Parameters:
+// - name: string with the name of the source.
+func (h *health) reported(name string) {
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	s := h.sources[name]
+
+	s.Reports++
+	s.LastSuccess = time.Now()
+
+}
+
+// healthy evaluates the state of a source: a listener has to be running and a
+// polling source needs its last collection to have succeeded and to have had
+// a successful one within the last two periods.
+// Parameters:
+// - s: sourceHealth reference with the state of the source.
+// Returns:
+// - ok: bool with the result of the evaluation.
+func (h *health) healthy(s *sourceHealth) (ok bool) {
+
+	if s.Kind == "listen" {
+
+		ok = s.Running
+
+		return
+
+	}
+
+	if s.LastError != "" {
+
+		return
+
+	}
+
+	since := h.start
+
+	if !s.LastSuccess.IsZero() {
+
+		since = s.LastSuccess
+
+	}
+
+	ok = time.Since(since) < 2*time.Duration(s.Periodicity)*time.Minute
+
+	return
+
+}
+
+// ServeHTTP serves the state of the sources in JSON format, with a 503 status
+// when any of them isn't healthy.
+// Parameters:
+// - w: ResponseWriter to write the state of the sources to.
+// - r: Request received.
+func (h *health) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	h.mutex.Lock()
+
+	status := "ok"
+	code := http.StatusOK
+	sources := make(map[string]sourceHealth)
+
+	for name, s := range h.sources {
+
+		s.Healthy = h.healthy(s)
+
+		if !s.Healthy {
+
+			status = "degraded"
+			code = http.StatusServiceUnavailable
+
+		}
+
+		sources[name] = *s
+
+	}
+
+	h.mutex.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	e := json.NewEncoder(w).Encode(map[string]interface{}{
+		"status":  status,
+		"sources": sources,
+	})
+
+	if e != nil {
+
+		l.Warning.Printf("[HEALTH] There was a problem writing the health of the sources. Error: %v\n", e)
+
+	}
+
+}
@@ -1,4 +1,4 @@
-package main
+package collector
 
 import (
 	"context"
@@ -19,7 +19,29 @@ type kafkaHandlerConf struct {
 type kafkaPackage struct {
 	topic     string
 	partition int
-	channel   chan interface{}
+	channel   chan message
+}
+
+// message is the unit sent through the kafka channels, it carries the name of
+// the source that generated the object to label the metrics and, optionally,
+// the channel where the result of the sending is reported.
+type message struct {
+	done   chan error
+	object interface{}
+	source string
+}
+
+// finish reports the result of the sending of the message, if requested.
+// Parameters:
+// - e: error raised while sending the message, nil if it was sent.
+func (m message) finish(e error) {
+
+	if m.done != nil {
+
+		m.done <- e
+
+	}
+
 }
 
 // kafkaHandler job is to check the config that it receives and initialize the
@@ -43,13 +65,14 @@ func kafkaHandler(kH kafkaHandlerConf) {
 }
 
 // kafkaSender is the abstracted interface handling the sending of data through
-// kafka topics.
+// kafka topics, every message is retried General.Retries times before being
+// given up.
 // Paramenters:
 // - t: string containing the kafka-topic in use.
 // - p: int containing the kafka-topic partition.
-// - c: interface{} channel to receive the data that will be marshalled into
+// - c: message channel to receive the data that will be marshalled into
 // JSON and then transmitted via kafka.
-func kafkaSender(t string, p int, c chan interface{}) {
+func kafkaSender(t string, p int, c chan message) {
 
 	l.Trace.Printf("[KAFKA] Initializing kafka sender for topic: %v.\n", t)
 
@@ -85,7 +108,7 @@ func kafkaSender(t string, p int, c chan interface{}) {
 
 		if !ok {
 
-			metricReporting.With(prometheus.Labels{"topic": t, "state": "FAIL", "reason": "Go Channel Problems"}).Inc()
+			metricReporting.With(prometheus.Labels{"source": "", "topic": t, "state": "FAIL", "reason": "Go Channel Problems"}).Inc()
 
 			break
 
@@ -93,42 +116,50 @@ func kafkaSender(t string, p int, c chan interface{}) {
 
 		go func() {
 
-			m, e := json.Marshal(&v)
+			m, e := json.Marshal(&v.object)
+
+			if e != nil {
+
+				l.Warning.Printf("[KAFKA] The information to be sent into the stream cannot be marshalled, please check with the administrator. Error: %v\n", e)
+
+				metricReporting.With(prometheus.Labels{"source": v.source, "topic": t, "state": "FAIL", "reason": "JSON Marshalling"}).Inc()
+
+				v.finish(e)
+
+				return
+
+			}
 
-			if e == nil {
+			l.Info.Printf("[KAFKA] Object received through the channel. Starting its processing.\n")
 
-				l.Info.Printf("[KAFKA] Object received through the channel. Starting its processing.\n")
+			e = retry("KAFKA", func() error {
 
-				err := w.WriteMessages(context.Background(),
+				return w.WriteMessages(context.Background(),
 					kafka.Message{
 						Key:   []byte(t + "-" + strconv.Itoa(p)),
 						Value: m,
 					},
 				)
 
-				if err != nil {
+			})
 
-					l.Warning.Printf("[KAFKA] There was a problem when sending the record through the stream. Error: %v\n", err)
+			if e != nil {
 
-					metricReporting.With(prometheus.Labels{"topic": t, "state": "FAIL", "reason": "Kafka Stream Problems"}).Inc()
+				l.Warning.Printf("[KAFKA] There was a problem when sending the record through the stream. Error: %v\n", e)
 
-				} else {
+				metricReporting.With(prometheus.Labels{"source": v.source, "topic": t, "state": "FAIL", "reason": "Kafka Stream Problems"}).Inc()
 
-					l.Info.Printf("[KAFKA] Object added to the stream succesfully. Topic: %v.\n", t)
+				v.finish(e)
 
-					metricReporting.With(prometheus.Labels{"topic": t, "state": "OK", "reason": "Object sent"}).Inc()
+				return
 
-				}
-
-			} else {
-
-				l.Warning.Printf("[KAFKA] The information to be sent into the stream cannot be marshalled, please check with the administrator. Error: %v\n", e)
+			}
 
-				metricReporting.With(prometheus.Labels{"topic": t, "state": "FAIL", "reason": "JSON Marshalling"}).Inc()
+			l.Info.Printf("[KAFKA] Object added to the stream succesfully. Topic: %v.\n", t)
 
-			}
+			metricReporting.With(prometheus.Labels{"source": v.source, "topic": t, "state": "OK", "reason": "Object sent"}).Inc()
 
-			return
+			v.finish(nil)
 
 		}()
 
@@ -0,0 +1,95 @@
+package collector
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	l "gitlab.com/cyclops-utilities/logging"
+)
+
+var (
+	metricReporting *prometheus.GaugeVec
+	metricTime      *prometheus.GaugeVec
+	metricCount     *prometheus.GaugeVec
+)
+
+// prometheusStart initializes the gauges shared by the sources, labelled with
+// the source they belong to, and starts the HTTP server with the metrics and
+// the health endpoint.
+// Parameters:
+// - h: health reference with the state of the sources to be served.
+func prometheusStart(h *health) {
+
+	reg := prometheus.NewPedanticRegistry()
+
+	metricReporting = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "CYCLOPS",
+			Subsystem: "Collector",
+			Name:      "report_state",
+			Help:      "Reporting information and Kafka topics/HTTP endpoints usage",
+		},
+		[]string{
+			"reason",
+			"source",
+			"state",
+			"topic",
+		},
+	)
+
+	metricTime = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "CYCLOPS",
+			Subsystem: "Collector",
+			Name:      "collection_time",
+			Help:      "Different timing metrics",
+		},
+		[]string{
+			"source",
+			"type",
+		},
+	)
+
+	metricCount = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "CYCLOPS",
+			Subsystem: "Collector",
+			Name:      "count",
+			Help:      "Different resources counts",
+		},
+		[]string{
+			"source",
+			"type",
+		},
+	)
+
+	reg.MustRegister(metricReporting, metricTime, metricCount)
+
+	// The runs against the fixtures are one-shot, there is nothing to serve
+	if cfg.Fixtures.Mode != "" {
+
+		return
+
+	}
+
+	l.Trace.Printf("[Prometheus] Starting to serve the metrics and the health of the sources.\n")
+
+	mux := http.NewServeMux()
+
+	mux.Handle(cfg.Prometheus.HealthRoute, h)
+
+	if cfg.Prometheus.MetricsExport {
+
+		mux.Handle(cfg.Prometheus.MetricsRoute, promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
+
+	}
+
+	go func() {
+
+		log.Fatal(http.ListenAndServe(":"+cfg.Prometheus.MetricsPort, mux))
+
+	}()
+
+}
@@ -0,0 +1,386 @@
+package collector
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	eeClient "github.com/GoDieNow/TFT_Code/services/eventsengine/client"
+	eeEvent "github.com/GoDieNow/TFT_Code/services/eventsengine/client/event_management"
+	eeModels "github.com/GoDieNow/TFT_Code/services/eventsengine/models"
+	udrClient "github.com/GoDieNow/TFT_Code/services/udr/client"
+	udrUsage "github.com/GoDieNow/TFT_Code/services/udr/client/usage_management"
+	udrModels "github.com/GoDieNow/TFT_Code/services/udr/models"
+	l "gitlab.com/cyclops-utilities/logging"
+)
+
+// reporter sends the events and usages generated by the sources to the
+// EventsEngine and the UDR, either through kafka or directly to their APIs,
+// or writes them to a file.
+type reporter struct {
+	eeClient  *eeClient.EventEngineManagementAPI
+	pipeE     chan message
+	pipeU     chan message
+	sink      *os.File
+	sinkMutex sync.Mutex
+	udrClient *udrClient.UDRManagementAPI
+}
+
+// record is the format of the lines of the file the reports are written to.
+type record struct {
+	Object interface{} `json:"object"`
+	Source string      `json:"source"`
+	Type   string      `json:"type"`
+}
+
+// newReporter creates the reporter for the configured mode, starting the kafka
+// senders or creating the file when needed.
+// Parameters:
+// - ee: EventsEngine client used in the http mode.
+// - udr: UDR client used in the http mode.
+// Returns:
+// - r: reporter reference ready to send the reports.
+// - e: error raised in case of something goes wrong.
+func newReporter(ee *eeClient.EventEngineManagementAPI, udr *udrClient.UDRManagementAPI) (r *reporter, e error) {
+
+	r = &reporter{
+		eeClient:  ee,
+		udrClient: udr,
+	}
+
+	if cfg.Report.Mode == "file" {
+
+		l.Trace.Printf("[REPORT] Writing the reports to [ %v ]\n", cfg.Report.File)
+
+		r.sink, e = os.Create(cfg.Report.File)
+
+		return
+
+	}
+
+	if cfg.Report.Mode != "kafka" {
+
+		return
+
+	}
+
+	l.Trace.Printf("[REPORT] Intializing Kafka\n")
+
+	r.pipeU = make(chan message, 1000)
+	r.pipeE = make(chan message, 1000)
+
+	handler := kafkaHandlerConf{
+		out: []kafkaPackage{
+			{
+				topic:   cfg.Kafka.TopicUDR,
+				channel: r.pipeU,
+			},
+			{
+				topic:   cfg.Kafka.TopicEEngine,
+				channel: r.pipeE,
+			},
+		},
+	}
+
+	kafkaHandler(handler)
+
+	return
+
+}
+
+// report handles the process of sending the events or usages generated by a
+// source to the respective service, waiting for them to be delivered.
+// Parameters:
+// - source: string with the name of the source that generated the objects.
+// - objects: slice with the events/usages to be sent.
+// Returns:
+// - e: error raised when any of the objects couldn't be delivered.
+func (r *reporter) report(source string, objects []interface{}) (e error) {
+
+	l.Trace.Printf("[REPORT] The reporting process has been started for [ %v ] objects of source [ %v ].\n", len(objects), source)
+
+	var events []eeModels.Event
+	var usages []udrModels.Usage
+
+	for _, object := range objects {
+
+		switch reflect.TypeOf(object) {
+
+		case reflect.TypeOf(udrModels.Usage{}):
+
+			usages = append(usages, object.(udrModels.Usage))
+
+		case reflect.TypeOf(eeModels.Event{}):
+
+			events = append(events, object.(eeModels.Event))
+
+		default:
+
+			fail := "the provided object doesn't belong to UDR or EE models"
+
+			l.Warning.Printf("[REPORT] Something went wrong while processing the object, check with the administrator. Error: %v.\n", fail)
+
+		}
+
+	}
+
+	if cfg.Report.Mode == "kafka" {
+
+		l.Trace.Printf("[REPORT] Sending [ %v ] events and [ %v ] usages through kafka.\n", len(events), len(usages))
+
+		done := make(chan error, len(events)+len(usages))
+
+		for _, event := range events {
+
+			r.pipeE <- message{done: done, object: event, source: source}
+
+		}
+
+		for _, usage := range usages {
+
+			r.pipeU <- message{done: done, object: usage, source: source}
+
+		}
+
+		for i := 0; i < len(events)+len(usages); i++ {
+
+			if err := <-done; err != nil {
+
+				e = err
+
+			}
+
+		}
+
+		return
+
+	}
+
+	if cfg.Report.Mode == "file" {
+
+		e = r.write(source, events, usages)
+
+		return
+
+	}
+
+	l.Trace.Printf("[REPORT] Sending [ %v ] events and [ %v ] usages through http.\n", len(events), len(usages))
+
+	for i := range events {
+
+		if err := r.sendEvent(source, &events[i]); err != nil {
+
+			e = err
+
+		}
+
+	}
+
+	if len(usages) > 0 {
+
+		if err := r.sendUsages(source, usages); err != nil {
+
+			e = err
+
+		}
+
+	}
+
+	return
+
+}
+
+// sendEvent adds an event to the EventsEngine through its API.
+// Parameters:
+// - source: string with the name of the source that generated the event.
+// - event: Event reference to be sent.
+// Returns:
+// - e: error raised when the event couldn't be sent.
+func (r *reporter) sendEvent(source string, event *eeModels.Event) (e error) {
+
+	params := eeEvent.NewAddEventParams().WithEvent(event)
+
+	e = retry("REPORT", func() (e error) {
+
+		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+		defer cancel()
+
+		_, e = r.eeClient.EventManagement.AddEvent(ctx, params)
+
+		return
+
+	})
+
+	if e != nil {
+
+		l.Warning.Printf("[REPORT] There was a problem when sending the event of [ %v ] to the EventsEngine. Error: %v\n", event.ResourceID, e)
+
+		metricReporting.With(prometheus.Labels{"source": source, "topic": "eventsengine", "state": "FAIL", "reason": "HTTP Problems"}).Inc()
+
+		return
+
+	}
+
+	metricReporting.With(prometheus.Labels{"source": source, "topic": "eventsengine", "state": "OK", "reason": "Object sent"}).Inc()
+
+	return
+
+}
+
+// sendUsages ingests the usages into the UDR through its API as a single
+// JSON batch.
+// Parameters:
+// - source: string with the name of the source that generated the usages.
+// - usages: slice with the Usages to be sent.
+// Returns:
+// - e: error raised when the usages couldn't be ingested.
+func (r *reporter) sendUsages(source string, usages []udrModels.Usage) (e error) {
+
+	batch, e := json.Marshal(usages)
+
+	if e != nil {
+
+		l.Warning.Printf("[REPORT] The usages to be sent cannot be marshalled, please check with the administrator. Error: %v\n", e)
+
+		metricReporting.With(prometheus.Labels{"source": source, "topic": "udr", "state": "FAIL", "reason": "JSON Marshalling"}).Add(float64(len(usages)))
+
+		return
+
+	}
+
+	format := "json"
+
+	e = retry("REPORT", func() (e error) {
+
+		ctx, cancel := context.WithTimeout(context.Background(), 300*time.Second)
+		defer cancel()
+
+		params := udrUsage.NewIngestUsageParams().WithFormat(&format).WithRecords(io.NopCloser(bytes.NewReader(batch)))
+
+		_, e = r.udrClient.UsageManagement.IngestUsage(ctx, params)
+
+		// Big batches are accepted to be processed in the background
+		if _, accepted := e.(*udrUsage.IngestUsageAccepted); accepted {
+
+			e = nil
+
+		}
+
+		return
+
+	})
+
+	if e != nil {
+
+		l.Warning.Printf("[REPORT] There was a problem when ingesting the usages in the UDR. Error: %v\n", e)
+
+		metricReporting.With(prometheus.Labels{"source": source, "topic": "udr", "state": "FAIL", "reason": "HTTP Problems"}).Add(float64(len(usages)))
+
+		return
+
+	}
+
+	metricReporting.With(prometheus.Labels{"source": source, "topic": "udr", "state": "OK", "reason": "Object sent"}).Add(float64(len(usages)))
+
+	return
+
+}
+
+// write appends the reports to the file as JSON lines, sorted so the files of
+// different runs can be diffed.
+// Parameters:
+// - source: string with the name of the source that generated the reports.
+// - events: slice with the Events to be written.
+// - usages: slice with the Usages to be written.
+// Returns:
+// - e: error raised when any of the reports couldn't be written.
+func (r *reporter) write(source string, events []eeModels.Event, usages []udrModels.Usage) (e error) {
+
+	var records []record
+	var lines []string
+
+	for _, event := range events {
+
+		records = append(records, record{Object: event, Source: source, Type: "event"})
+
+	}
+
+	for _, usage := range usages {
+
+		records = append(records, record{Object: usage, Source: source, Type: "usage"})
+
+	}
+
+	for _, rec := range records {
+
+		line, err := json.Marshal(rec)
+
+		if err != nil {
+
+			l.Warning.Printf("[REPORT] The report cannot be marshalled, please check with the administrator. Error: %v\n", err)
+
+			metricReporting.With(prometheus.Labels{"source": source, "topic": "file", "state": "FAIL", "reason": "JSON Marshalling"}).Inc()
+
+			e = err
+
+			continue
+
+		}
+
+		lines = append(lines, string(line))
+
+	}
+
+	sort.Strings(lines)
+
+	r.sinkMutex.Lock()
+	defer r.sinkMutex.Unlock()
+
+	for _, line := range lines {
+
+		if _, err := r.sink.WriteString(line + "\n"); err != nil {
+
+			l.Warning.Printf("[REPORT] There was a problem when writing the report to the file. Error: %v\n", err)
+
+			metricReporting.With(prometheus.Labels{"source": source, "topic": "file", "state": "FAIL", "reason": "File Problems"}).Inc()
+
+			e = err
+
+			continue
+
+		}
+
+		metricReporting.With(prometheus.Labels{"source": source, "topic": "file", "state": "OK", "reason": "Object sent"}).Inc()
+
+	}
+
+	return
+
+}
+
+// close flushes the file the reports are written to.
+// Returns:
+// - e: error raised in case of something goes wrong.
+func (r *reporter) close() (e error) {
+
+	if r.sink == nil {
+
+		return
+
+	}
+
+	r.sinkMutex.Lock()
+	defer r.sinkMutex.Unlock()
+
+	e = r.sink.Close()
+
+	return
+
+}
@@ -0,0 +1,168 @@
+package collector
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	eeModels "github.com/GoDieNow/TFT_Code/services/eventsengine/models"
+	l "gitlab.com/cyclops-utilities/logging"
+)
+
+// Safeguard keeps track of the resources of a source missing from its
+// collections, to guard them against mass forced termination.
+type Safeguard struct {
+	metricCount  *prometheus.GaugeVec
+	missing      map[string]int
+	missingMutex sync.Mutex
+}
+
+// Termination holds a resource known by the EventsEngine to be forced to the
+// terminated state, together with the reason to do so, which is only logged as
+// the metadata of the event has to match the one of the resource.
+type Termination struct {
+	Object *eeModels.MinimalState
+	Reason string
+}
+
+// NewSafeguard creates the safeguard of the forced terminations of a source.
+// Parameters:
+// - env: Env reference of the source.
+// Returns:
+// - s: Safeguard reference ready to guard the terminations.
+func NewSafeguard(env *Env) (s *Safeguard) {
+
+	s = &Safeguard{
+		metricCount: env.MetricCount,
+		missing:     make(map[string]int),
+	}
+
+	return
+
+}
+
+// Guard applies the safeguards against mass forced termination to
+// the resources known by the EventsEngine left unprocessed by a collection:
+// - they have to be missing in GracePeriod consecutive collections,
+// - a run can't terminate more than MaxCount resources nor more than a
+// MaxPercent of the known ones, otherwise the circuit breaker trips and none
+// is terminated,
+// - in dry-run mode the terminations are only reported.
+// No resource is terminated either when the listing was incomplete.
+// Parameters:
+// - remote: slice with the resources left unprocessed by the collection.
+// - total: int with the number of resources known by the EventsEngine.
+// - incomplete: bool set when the listing of the resources failed partially.
+// Returns:
+// - terminations: slice with the resources to be terminated and the reason.
+func (s *Safeguard) Guard(remote []*eeModels.MinimalState, total int, incomplete bool) (terminations []Termination) {
+
+	s.missingMutex.Lock()
+	defer s.missingMutex.Unlock()
+
+	if incomplete {
+
+		l.Warning.Printf("[SAFEGUARD] The listing of the resources was incomplete, the [ %v ] resources missing won't be terminated in this run.\n", len(remote))
+
+		s.metricCount.With(prometheus.Labels{"type": "Forced terminations SKIPPED due to an incomplete listing"}).Set(float64(len(remote)))
+
+		return
+
+	}
+
+	grace := cfg.Termination.GracePeriod
+
+	if grace < 1 {
+
+		grace = 1
+
+	}
+
+	var candidates []Termination
+
+	deferred := 0
+	current := make(map[string]int)
+
+	for _, object := range remote {
+
+		key := object.Account + "/" + object.ResourceID
+
+		current[key] = s.missing[key] + 1
+
+		if current[key] < grace {
+
+			l.Debug.Printf("[SAFEGUARD] The resource [ %v ] of project [ %v ] has been missing in [ %v/%v ] collections, its termination is deferred.\n", object.ResourceID, object.Account, current[key], grace)
+
+			deferred++
+
+			continue
+
+		}
+
+		candidates = append(candidates, Termination{
+			Object: object,
+			Reason: fmt.Sprintf("missing from the OpenStack listing in %v consecutive collections", current[key]),
+		})
+
+	}
+
+	// The resources found again by this collection restart their count
+	s.missing = current
+
+	breaker := ""
+
+	if cfg.Termination.MaxCount > 0 && len(candidates) > cfg.Termination.MaxCount {
+
+		breaker = fmt.Sprintf("%v terminations exceed the maximum of %v per run", len(candidates), cfg.Termination.MaxCount)
+
+	}
+
+	if cfg.Termination.MaxPercent > 0 && total > 0 && float64(len(candidates))*100/float64(total) > cfg.Termination.MaxPercent {
+
+		breaker = fmt.Sprintf("%v terminations exceed the %v%% of the %v resources known", len(candidates), cfg.Termination.MaxPercent, total)
+
+	}
+
+	state := "not tripped"
+
+	if breaker != "" {
+
+		state = "tripped"
+
+	}
+
+	l.Warning.Printf("[SAFEGUARD] Forced termination report.\n - Missing: %v\n - Deferred by the grace period: %v\n - To terminate: %v\n - Circuit breaker: %v\n - Dry-run: %v\n", len(remote), deferred, len(candidates), state, cfg.Termination.DryRun)
+
+	s.metricCount.With(prometheus.Labels{"type": "Forced terminations SKIPPED due to an incomplete listing"}).Set(0)
+
+	s.metricCount.With(prometheus.Labels{"type": "Forced terminations DEFERRED by the grace period"}).Set(float64(deferred))
+
+	if breaker != "" {
+
+		l.Error.Printf("[SAFEGUARD] The circuit breaker tripped, no resource will be terminated in this run: %v.\n", breaker)
+
+		s.metricCount.With(prometheus.Labels{"type": "Forced terminations BLOCKED by the circuit breaker"}).Set(float64(len(candidates)))
+
+	} else {
+
+		s.metricCount.With(prometheus.Labels{"type": "Forced terminations BLOCKED by the circuit breaker"}).Set(0)
+
+	}
+
+	if breaker != "" || cfg.Termination.DryRun {
+
+		for _, t := range candidates {
+
+			l.Warning.Printf("[SAFEGUARD] The resource [ %v ] with ID [ %v ] of project [ %v ] would have been terminated, %v.\n", t.Object.ResourceName, t.Object.ResourceID, t.Object.Account, t.Reason)
+
+		}
+
+		return
+
+	}
+
+	terminations = candidates
+
+	return
+
+}
@@ -1,4 +1,4 @@
-module github.com/GoDieNow/TFT_Code/collectors/objects
+module github.com/GoDieNow/TFT_Code/collectors
 
 go 1.23.9
 
@@ -7,9 +7,11 @@ require (
 	github.com/GoDieNow/TFT_Code/services/eventsengine v0.0.0-20250624195138-25504b9603b7
 	github.com/GoDieNow/TFT_Code/services/udr v0.0.0-20250624195138-25504b9603b7
 	github.com/go-openapi/runtime v0.28.0
+	github.com/gophercloud/gophercloud v1.14.1
 	github.com/myENA/radosgwadmin v0.3.0
 	github.com/myENA/restclient v1.2.1
 	github.com/prometheus/client_golang v1.22.0
+	github.com/rabbitmq/amqp091-go v1.10.0
 	github.com/remeh/sizedwaitgroup v1.0.0
 	github.com/segmentio/kafka-go v0.4.48
 	github.com/spf13/viper v1.20.1
@@ -42,6 +44,7 @@ require (
 	github.com/google/uuid v1.6.0 // indirect
 	github.com/josharian/intern v1.0.0 // indirect
 	github.com/klauspost/compress v1.18.0 // indirect
+	github.com/kylelemons/godebug v1.1.0 // indirect
 	github.com/leodido/go-urn v1.4.0 // indirect
 	github.com/mailru/easyjson v0.9.0 // indirect
 	github.com/mitchellh/mapstructure v1.5.0 // indirect
@@ -75,3 +78,11 @@ require (
 	google.golang.org/protobuf v1.36.5 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace (
+	github.com/GoDieNow/TFT_Code/services/cdr => ../services/cdr
+	github.com/GoDieNow/TFT_Code/services/customerdb => ../services/customerdb
+	github.com/GoDieNow/TFT_Code/services/eventsengine => ../services/eventsengine
+	github.com/GoDieNow/TFT_Code/services/planmanager => ../services/planmanager
+	github.com/GoDieNow/TFT_Code/services/udr => ../services/udr
+)
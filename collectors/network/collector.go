@@ -1,8 +1,7 @@
-package main
+package network
 
 import (
 	"context"
-	"os"
 	"strings"
 	"time"
 
@@ -18,42 +17,49 @@ import (
 	l "gitlab.com/cyclops-utilities/logging"
 )
 
-var (
-	collector       = "Network"
-	objects         = "ip"
-	remotelist      []*eeModels.MinimalState
-	collectionStart int64
-)
-
 // collect handles the process of retrieving the information from the system.
-func collect() {
+// Parameters:
+// - ctx: context of the collection.
+// Returns:
+// - e: error raised in case of something goes wrong.
+func (s *Source) collect(ctx context.Context) (e error) {
 
 	l.Trace.Printf("[COLLECTION] The collection process has been started.\n")
 
-	collectionStart = time.Now().UnixNano()
-
-	metricTime.With(prometheus.Labels{"type": "Collection Start Time"}).Set(float64(collectionStart))
+	s.collectionStart = time.Now().UnixNano()
 
 	opts := gophercloud.AuthOptions{
-		IdentityEndpoint: cfg.OpenStack.Keystone,
-		Username:         cfg.OpenStack.User,
-		Password:         cfg.OpenStack.Password,
-		DomainName:       cfg.OpenStack.Domain,
+		IdentityEndpoint: s.cfg.OpenStack.Keystone,
+		Username:         s.cfg.OpenStack.User,
+		Password:         s.cfg.OpenStack.Password,
+		DomainName:       s.cfg.OpenStack.Domain,
 	}
 
-	if len(cfg.OpenStack.Project) > 0 {
+	if len(s.cfg.OpenStack.Project) > 0 {
 
-		opts.TenantName = cfg.OpenStack.Project
+		opts.TenantName = s.cfg.OpenStack.Project
 
 	}
 
-	provider, e := openstack.AuthenticatedClient(opts)
+	// The provider uses the HTTP client of the runtime so its requests can be
+	// recorded or replayed.
+	provider, e := openstack.NewClient(opts.IdentityEndpoint)
 
 	if e != nil {
 
+		l.Error.Printf("[COLLECTION] Error creating the OpenStack client. Error: %v\n", e)
+
+		return
+
+	}
+
+	provider.HTTPClient = s.httpClient
+
+	if e = openstack.Authenticate(provider, opts); e != nil {
+
 		l.Error.Printf("[COLLECTION] Error authenticating against OpenStack. Error: %v\n", e)
 
-		os.Exit(1)
+		return
 
 	}
 
@@ -67,7 +73,7 @@ func collect() {
 
 		l.Error.Printf("[COLLECTION] Error creating the collector client. Error: %v\n", e)
 
-		os.Exit(1)
+		return
 
 	}
 
@@ -77,7 +83,7 @@ func collect() {
 
 		l.Error.Printf("[COLLECTION] Error listing all the pages. Error: %v\n", e)
 
-		os.Exit(1)
+		return
 
 	}
 
@@ -87,7 +93,7 @@ func collect() {
 
 		l.Error.Printf("[COLLECTION] Error listing all the projects. Error: %v\n", e)
 
-		os.Exit(1)
+		return
 
 	}
 
@@ -95,13 +101,15 @@ func collect() {
 
 	resourceType := "floatingip"
 
-	eeParams := eeEvent.NewListStatesParams().WithResource(&resourceType).WithRegion(&cfg.OpenStack.Region)
+	eeParams := eeEvent.NewListStatesParams().WithResource(&resourceType).WithRegion(&s.cfg.OpenStack.Region)
+
+	eeCtx, cancel := context.WithTimeout(ctx, 300*time.Second)
+	defer cancel()
 
-	ctx, _ := context.WithTimeout(context.Background(), 300*time.Second)
-	r, e := reportClient.EventManagement.ListStates(ctx, eeParams)
+	r, e := s.reportClient.EventManagement.ListStates(eeCtx, eeParams)
 
 	// Clears the remotelist between runs
-	remotelist = nil
+	s.remotelist = nil
 
 	if e != nil {
 
@@ -109,14 +117,15 @@ func collect() {
 
 	} else {
 
-		remotelist = r.Payload
+		s.remotelist = r.Payload
 
 	}
 
-	eeCount := len(remotelist)
+	eeCount := len(s.remotelist)
 	apiCount := 0
+	incomplete := false
 
-	l.Trace.Printf("[COLLECTION] (BEFORE) Existing count of floating IPs at remote [ %v ].\n", len(remotelist))
+	l.Trace.Printf("[COLLECTION] (BEFORE) Existing count of floating IPs at remote [ %v ].\n", len(s.remotelist))
 
 allProjectsLoop:
 	for _, project := range allProjects {
@@ -124,13 +133,15 @@ allProjectsLoop:
 		l.Trace.Printf("[COLLECTION] Found project [ %v ] with ID [ %v ]. Proceeding to get list of floating IPs.\n", project.Name, project.ID)
 
 		networkClient, e := openstack.NewNetworkV2(provider, gophercloud.EndpointOpts{
-			Region: cfg.OpenStack.Region,
+			Region: s.cfg.OpenStack.Region,
 		})
 
 		if e != nil {
 
 			l.Error.Printf("[COLLECTION] Error creating network client. Error: %v\n", e)
 
+			incomplete = true
+
 			continue
 
 		}
@@ -140,7 +151,7 @@ allProjectsLoop:
 		}
 
 		// Filter by project id:
-		for _, filter := range cfg.ProjectFilters {
+		for _, filter := range s.cfg.ProjectFilters {
 
 			if strings.Contains(strings.ToLower(project.ID), strings.ToLower(filter)) && filter != "" {
 
@@ -153,7 +164,7 @@ allProjectsLoop:
 		}
 
 		// Filter by project name:
-		for _, filter := range cfg.NameFilters {
+		for _, filter := range s.cfg.NameFilters {
 
 			if strings.Contains(strings.ToLower(project.Name), strings.ToLower(filter)) && filter != "" {
 
@@ -189,10 +200,16 @@ allProjectsLoop:
 
 				// Here comes the transformation of the information retrieved into either
 				md := make(datamodels.JSONdb)
-				md["region"] = cfg.OpenStack.Region
+				md["region"] = s.cfg.OpenStack.Region
 				md["floatingnetworkid"] = ip.FloatingNetworkID
 
-				evTime := int64(time.Now().Unix())
+				if tags := s.getTags(ip.Tags); len(tags) > 0 {
+
+					md["tags"] = tags
+
+				}
+
+				evTime := int64(s.fixtures.Now().Unix())
 				evLast := getStatus(ip.Status)
 
 				// events or usage reports to be sent.
@@ -201,16 +218,16 @@ allProjectsLoop:
 					EventTime:    &evTime,
 					LastEvent:    &evLast,
 					MetaData:     md,
-					Region:       cfg.OpenStack.Region,
+					Region:       s.cfg.OpenStack.Region,
 					ResourceID:   ip.ID,
 					ResourceName: ip.FloatingIP,
 					ResourceType: "floatingip",
 				}
 
-				report(event)
+				s.report(event)
 
 				//if this object exists in remote list then lets remove it
-				for i, object := range remotelist {
+				for i, object := range s.remotelist {
 
 					if strings.Compare(object.Account, project.ID) == 0 &&
 						strings.Compare(object.ResourceID, ip.ID) == 0 &&
@@ -218,7 +235,7 @@ allProjectsLoop:
 
 						l.Debug.Printf("[COLLECTION] Event send cleaned from the processing list..\n")
 
-						remotelist = append(remotelist[:i], remotelist[i+1:]...)
+						s.remotelist = append(s.remotelist[:i], s.remotelist[i+1:]...)
 
 						break
 
@@ -232,16 +249,29 @@ allProjectsLoop:
 
 		})
 
+		if e != nil {
+
+			l.Error.Printf("[COLLECTION] Error listing the floating IPs of project [ %v ]. Error: %v\n", project.ID, e)
+
+			incomplete = true
+
+		}
+
 	}
 
-	l.Trace.Printf("[COLLECTION] (AFTER) Remaining count of floating IPs at remote which were left unprocessed [ %v ].\n", len(remotelist))
+	l.Trace.Printf("[COLLECTION] (AFTER) Remaining count of floating IPs at remote which were left unprocessed [ %v ].\n", len(s.remotelist))
+
+	// The remaining floating IPs are sent a terminated status, unless the
+	// safeguards against mass termination hold them back
+	terminations := s.safeguard.Guard(s.remotelist, eeCount, incomplete)
+
+	for _, t := range terminations {
 
-	//now for all remaining servers send terminated status
-	for _, object := range remotelist {
+		object := t.Object
 
-		l.Debug.Printf("[COLLECTION] Sending termination for zombie data in the system..\n")
+		l.Info.Printf("[COLLECTION] Sending termination for zombie data in the system, floating IP [ %v ] of project [ %v ]. Reason: %v.\n", object.ResourceID, object.Account, t.Reason)
 
-		evTime := int64(time.Now().Unix())
+		evTime := int64(s.fixtures.Now().Unix())
 		evLast := getStatus("terminated")
 
 		// events or usage reports to be sent.
@@ -250,28 +280,63 @@ allProjectsLoop:
 			EventTime:    &evTime,
 			LastEvent:    &evLast,
 			MetaData:     object.MetaData,
-			Region:       cfg.OpenStack.Region,
+			Region:       s.cfg.OpenStack.Region,
 			ResourceID:   object.ResourceID,
 			ResourceName: object.ResourceName,
 			ResourceType: "floatingip",
 		}
 
-		report(event)
+		s.report(event)
 
 	}
 
-	metricCount.With(prometheus.Labels{"type": "Total IPs reported by OS API"}).Set(float64(apiCount))
+	s.metricCount.With(prometheus.Labels{"type": "Total IPs reported by OS API"}).Set(float64(apiCount))
 
-	metricCount.With(prometheus.Labels{"type": "Total IPs from EventEngine"}).Set(float64(eeCount))
+	s.metricCount.With(prometheus.Labels{"type": "Total IPs from EventEngine"}).Set(float64(eeCount))
 
-	metricCount.With(prometheus.Labels{"type": "Total IPs forcefully TERMINATED"}).Set(float64(len(remotelist)))
+	s.metricCount.With(prometheus.Labels{"type": "Total IPs forcefully TERMINATED"}).Set(float64(len(terminations)))
 
-	metricTime.With(prometheus.Labels{"type": "Collection Processing Time"}).Set(float64(time.Now().UnixNano()-collectionStart) / float64(time.Millisecond))
-
-	l.Warning.Printf("[COLLECTION] Completed.\n - OS Report: %v\n - EE Report: %v\n - Forced Termination: %v\n - Processing Time: %v[ms]\n", apiCount, eeCount, len(remotelist), float64(time.Now().UnixNano()-collectionStart)/float64(time.Millisecond))
+	l.Warning.Printf("[COLLECTION] Completed.\n - OS Report: %v\n - EE Report: %v\n - Forced Termination: %v\n - Processing Time: %v[ms]\n", apiCount, eeCount, len(terminations), float64(time.Now().UnixNano()-s.collectionStart)/float64(time.Millisecond))
 
 	l.Trace.Printf("[COLLECTION] The collection process has been finished.\n")
 
+	return nil
+
+}
+
+// getTags job is to filter the tags of the resource against the configured
+// allowlist, so only the ones meant for cost allocation travel with the event.
+// Neutron tags are plain strings, so the "key=value" convention is expected.
+// Parameters:
+// - source: slice with the tags of the resource in the system.
+// Returns:
+// - tags: JSONdb containing only the allowlisted tags.
+func (s *Source) getTags(source []string) (tags datamodels.JSONdb) {
+
+	tags = make(datamodels.JSONdb)
+
+	for _, tag := range source {
+
+		kv := strings.SplitN(tag, "=", 2)
+
+		if len(kv) != 2 || kv[1] == "" {
+
+			continue
+
+		}
+
+		for _, key := range s.cfg.TagAllowlist {
+
+			if kv[0] == key {
+
+				tags[key] = kv[1]
+
+			}
+
+		}
+
+	}
+
 	return
 
 }
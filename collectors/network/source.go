@@ -0,0 +1,80 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/GoDieNow/TFT_Code/collectors/collector"
+	eeClient "github.com/GoDieNow/TFT_Code/services/eventsengine/client"
+	eeModels "github.com/GoDieNow/TFT_Code/services/eventsengine/models"
+)
+
+// Source collects the floating IPs of OpenStack as events for the EventsEngine.
+// Every source keeps its own clients and collection state, so it can be
+// registered more than once in the same collector.
+type Source struct {
+	cfg          *collector.Configuration
+	fixtures     *collector.Fixtures
+	httpClient   http.Client
+	metricCount  *prometheus.GaugeVec
+	reportClient *eeClient.EventEngineManagementAPI
+	reports      []interface{}
+	reportsMutex sync.Mutex
+	safeguard    *collector.Safeguard
+
+	collectionStart int64
+	remotelist      []*eeModels.MinimalState
+}
+
+// New creates the network source to be run by the collector runtime.
+// Parameters:
+// - env: Env reference with the configuration and clients of the runtime.
+// Returns:
+// - s: the network Source.
+// - e: error raised in case of something goes wrong.
+func New(env *collector.Env) (s collector.Source, e error) {
+
+	s = &Source{
+		cfg:          env.Config,
+		fixtures:     env.Fixtures,
+		httpClient:   env.HTTPClient,
+		metricCount:  env.MetricCount,
+		reportClient: env.EventsEngine,
+		safeguard:    collector.NewSafeguard(env),
+	}
+
+	return
+
+}
+
+// Collect retrieves the floating IPs from OpenStack.
+// Parameters:
+// - ctx: context of the collection.
+// Returns:
+// - r: slice with the events to be reported.
+// - e: error raised in case of something goes wrong.
+func (s *Source) Collect(ctx context.Context) (r []interface{}, e error) {
+
+	s.reports = nil
+
+	e = s.collect(ctx)
+
+	r = s.reports
+
+	return
+
+}
+
+// report queues the event to be sent once the collection has finished.
+// Parameters:
+// - object: an interface{} reference with the event to be sent.
+func (s *Source) report(object interface{}) {
+
+	s.reportsMutex.Lock()
+	defer s.reportsMutex.Unlock()
+
+	s.reports = append(s.reports, object)
+
+}
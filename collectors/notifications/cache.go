@@ -0,0 +1,288 @@
+package notifications
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumetypes"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/images"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+	l "gitlab.com/cyclops-utilities/logging"
+)
+
+type FlavorIDCacheData struct {
+	FlavorName    string
+	LastRefreshed int64
+}
+
+type ImageIDCacheData struct {
+	ImageName     string
+	OSFlavor      string
+	LastRefreshed int64
+}
+
+type ProjectIDCacheData struct {
+	ProjectName   string
+	LastRefreshed int64
+}
+
+type VolumeTypeIDCacheData struct {
+	VolumeTypeName string
+	LastRefreshed  int64
+}
+
+func (s *Listener) getFromFlavorCache(client *gophercloud.ServiceClient, flavorid string) (flavorname string, e error) {
+
+	var flavor *flavors.Flavor
+
+	if s.flavorCache[flavorid].LastRefreshed != 0 {
+
+		//the cache entry exists
+		l.Trace.Printf("[CACHE] Flavor cache entry found for key: %v.\n", flavorid)
+
+		if time.Now().Unix()-s.flavorCache[flavorid].LastRefreshed > 86400 {
+
+			//invalidate the entry and get a fresh one
+			l.Trace.Printf("[CACHE] Cache invalidation for key [ %v ], refreshing entry now.\n", flavorid)
+
+			flavor, e = flavors.Get(client, flavorid).Extract()
+
+			if e != nil {
+
+				l.Warning.Printf("[CACHE] Error reading remote API response for flavor-id data. Error: %v\n", e)
+
+				return
+
+			}
+
+			flavorname = flavor.Name
+
+			s.flavorCache[flavorid] = FlavorIDCacheData{
+				flavor.Name,
+				time.Now().Unix(),
+			}
+
+			l.Trace.Printf("[CACHE] Cache entry updated for key [ %v ]. New value returned.\n", flavorid)
+
+			return
+
+		}
+
+		l.Trace.Printf("[CACHE] Cache entry value for key [ %v ] returned without refreshing.\n", flavorid)
+
+		flavorname = s.flavorCache[flavorid].FlavorName
+
+		return
+
+	}
+
+	l.Trace.Printf("[CACHE] Cache entry miss for key: %v\n.", flavorid)
+
+	flavor, e = flavors.Get(client, flavorid).Extract()
+
+	if e != nil {
+
+		l.Warning.Printf("[CACHE] Error reading remote API response for flavor-id data. Error: %v ", e)
+
+		return
+
+	}
+
+	flavorname = flavor.Name
+
+	l.Trace.Printf("[CACHE] Going to add a new cache entry added for key: %v\n", flavorid)
+
+	s.flavorCache[flavorid] = FlavorIDCacheData{
+		flavor.Name,
+		time.Now().Unix(),
+	}
+
+	l.Trace.Printf("[CACHE] Cache entry added for key [ %v ]. New value returned.\n", flavorid)
+
+	return
+
+}
+
+func (s *Listener) getFromImageCache(client *gophercloud.ServiceClient, imageid string) (imagename string, osflavor string, e error) {
+
+	var image *images.Image
+
+	if s.imageCache[imageid].LastRefreshed != 0 {
+
+		//the cache entry exists
+		l.Trace.Printf("[CACHE] Image cache entry found for image key: %v.\n", imageid)
+
+		if time.Now().Unix()-s.imageCache[imageid].LastRefreshed > 86400 {
+
+			//invalidate the entry and get a fresh one
+			l.Trace.Printf("[CACHE] Cache invalidation for image key [ %v ], refreshing entry now.\n", imageid)
+
+			image, e = images.Get(client, imageid).Extract()
+
+			if e != nil {
+
+				l.Warning.Printf("[CACHE] Error reading remote API response for image data. Error: %v.\n", e)
+
+				return
+
+			}
+
+			imagename = image.Name
+
+			osflavor_test, exists := image.Metadata["os_flavor"] //image operating system flavor
+
+			if exists {
+
+				osflavor = osflavor_test.(string)
+
+			}
+
+			s.imageCache[imageid] = ImageIDCacheData{
+				image.Name,
+				osflavor,
+				time.Now().Unix(),
+			}
+
+			l.Trace.Printf("[CACHE] Cache entry updated for image key [ %v ]. New value returned.\n", imageid)
+
+			return
+
+		}
+
+		l.Trace.Printf("[CACHE] Cache entry value for image key [ %v ] returned without refreshing.\n", imageid)
+
+		imagename = s.imageCache[imageid].ImageName
+		osflavor = s.imageCache[imageid].OSFlavor
+
+		return
+
+	}
+
+	l.Trace.Printf("[CACHE] Cache entry miss for image key: %v.\n", imageid)
+
+	image, e = images.Get(client, imageid).Extract()
+
+	if e != nil {
+
+		l.Warning.Printf("[CACHE] Error reading remote API response for image data. Error: %v.\n", e)
+
+		return
+
+	}
+
+	imagename = image.Name
+
+	osflavor_test, exists := image.Metadata["os_flavor"] //image operating system flavor
+
+	if exists {
+
+		osflavor = osflavor_test.(string)
+
+	}
+
+	l.Trace.Printf("[CACHE] Going to add a new cache entry added for image key: " + imageid)
+
+	s.imageCache[imageid] = ImageIDCacheData{
+		image.Name,
+		osflavor,
+		time.Now().Unix(),
+	}
+
+	l.Trace.Printf("[CACHE] Cache entry added for image key: " + imageid + ". New value returned.")
+
+	return
+
+}
+
+// getFromProjectCache returns the name of the project, needed to apply the
+// name filters to the volumes and floating IPs the same way their collectors
+// do, refreshing the entry once a day.
+// Parameters:
+// - client: identity ServiceClient reference.
+// - projectid: string with the ID of the project.
+// Returns:
+// - projectname: string with the name of the project.
+// - e: error raised in case of problems with the remote API.
+func (s *Listener) getFromProjectCache(client *gophercloud.ServiceClient, projectid string) (projectname string, e error) {
+
+	if entry := s.projectCache[projectid]; entry.LastRefreshed != 0 && time.Now().Unix()-entry.LastRefreshed <= 86400 {
+
+		l.Trace.Printf("[CACHE] Cache entry value for project key [ %v ] returned without refreshing.\n", projectid)
+
+		projectname = entry.ProjectName
+
+		return
+
+	}
+
+	l.Trace.Printf("[CACHE] Cache entry miss or invalidation for project key [ %v ], refreshing entry now.\n", projectid)
+
+	project, e := projects.Get(client, projectid).Extract()
+
+	if e != nil {
+
+		l.Warning.Printf("[CACHE] Error reading remote API response for project data. Error: %v.\n", e)
+
+		return
+
+	}
+
+	projectname = project.Name
+
+	s.projectCache[projectid] = ProjectIDCacheData{
+		project.Name,
+		time.Now().Unix(),
+	}
+
+	l.Trace.Printf("[CACHE] Cache entry added for project key [ %v ]. New value returned.\n", projectid)
+
+	return
+
+}
+
+// getFromVolumeTypeCache returns the name of the volume type, as the volume
+// notifications carry only its ID while the blockstorage collector reports
+// the name, refreshing the entry once a day.
+// Parameters:
+// - client: blockstorage ServiceClient reference.
+// - typeid: string with the ID of the volume type.
+// Returns:
+// - typename: string with the name of the volume type.
+// - e: error raised in case of problems with the remote API.
+func (s *Listener) getFromVolumeTypeCache(client *gophercloud.ServiceClient, typeid string) (typename string, e error) {
+
+	if entry := s.volumeTypeCache[typeid]; entry.LastRefreshed != 0 && time.Now().Unix()-entry.LastRefreshed <= 86400 {
+
+		l.Trace.Printf("[CACHE] Cache entry value for volume type key [ %v ] returned without refreshing.\n", typeid)
+
+		typename = entry.VolumeTypeName
+
+		return
+
+	}
+
+	l.Trace.Printf("[CACHE] Cache entry miss or invalidation for volume type key [ %v ], refreshing entry now.\n", typeid)
+
+	volumeType, e := volumetypes.Get(client, typeid).Extract()
+
+	if e != nil {
+
+		l.Warning.Printf("[CACHE] Error reading remote API response for volume type data. Error: %v.\n", e)
+
+		return
+
+	}
+
+	typename = volumeType.Name
+
+	s.volumeTypeCache[typeid] = VolumeTypeIDCacheData{
+		volumeType.Name,
+		time.Now().Unix(),
+	}
+
+	l.Trace.Printf("[CACHE] Cache entry added for volume type key [ %v ]. New value returned.\n", typeid)
+
+	return
+
+}
@@ -0,0 +1,1164 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/prometheus/client_golang/prometheus"
+	amqp "github.com/rabbitmq/amqp091-go"
+	datamodels "gitlab.com/cyclops-utilities/datamodels"
+	eeEvent "github.com/GoDieNow/TFT_Code/services/eventsengine/client/event_management"
+	eeModels "github.com/GoDieNow/TFT_Code/services/eventsengine/models"
+	l "gitlab.com/cyclops-utilities/logging"
+)
+
+const (
+	osloTimeFormat = "2006-01-02 15:04:05.999999"
+)
+
+var errMalformed = errors.New("malformed notification")
+
+// envelope is the wrapper used by oslo.messaging since its version 2 of the
+// message format, which carries the actual message serialized as a string.
+type envelope struct {
+	Message *string `json:"oslo.message"`
+	Version string  `json:"oslo.version"`
+}
+
+// notification holds the fields of the oslo.messaging notifications used by
+// the collector, the payload is decoded by the handler of each service.
+type notification struct {
+	EventType   string          `json:"event_type"`
+	Payload     json.RawMessage `json:"payload"`
+	ProjectID   string          `json:"_context_project_id"`
+	PublisherID string          `json:"publisher_id"`
+	Timestamp   string          `json:"timestamp"`
+}
+
+type instancePayload struct {
+	DisplayName string                 `json:"display_name"`
+	FlavorID    string                 `json:"instance_flavor_id"`
+	FlavorName  string                 `json:"instance_type"`
+	ImageMeta   map[string]interface{} `json:"image_meta"`
+	ImageRefURL string                 `json:"image_ref_url"`
+	InstanceID  string                 `json:"instance_id"`
+	Metadata    map[string]string      `json:"metadata"`
+	OldState    string                 `json:"old_state"`
+	State       string                 `json:"state"`
+	TenantID    string                 `json:"tenant_id"`
+}
+
+type volumePayload struct {
+	AvailabilityZone  string          `json:"availability_zone"`
+	DisplayName       string          `json:"display_name"`
+	Metadata          json.RawMessage `json:"metadata"`
+	ReplicationStatus string          `json:"replication_status"`
+	Size              int             `json:"size"`
+	Status            string          `json:"status"`
+	TenantID          string          `json:"tenant_id"`
+	VolumeID          string          `json:"volume_id"`
+	VolumeType        string          `json:"volume_type"`
+}
+
+type floatingIPPayload struct {
+	FloatingIP   *floatingIP `json:"floatingip"`
+	FloatingIPID string      `json:"floatingip_id"`
+}
+
+type floatingIP struct {
+	FloatingIPAddress string   `json:"floating_ip_address"`
+	FloatingNetworkID string   `json:"floating_network_id"`
+	ID                string   `json:"id"`
+	ProjectID         string   `json:"project_id"`
+	Status            string   `json:"status"`
+	Tags              []string `json:"tags"`
+	TenantID          string   `json:"tenant_id"`
+}
+
+// openstackStart handles the authentication against OpenStack and the creation
+// of the clients used to complete the details missing in the notifications.
+// The token is renewed automatically as the collector is long-lived.
+// Returns:
+// - e: error raised in case of something goes wrong.
+func (s *Listener) openstackStart() (e error) {
+
+	opts := gophercloud.AuthOptions{
+		AllowReauth:      true,
+		DomainName:       s.cfg.OpenStack.Domain,
+		IdentityEndpoint: s.cfg.OpenStack.Keystone,
+		Password:         s.cfg.OpenStack.Password,
+		Username:         s.cfg.OpenStack.User,
+	}
+
+	if len(s.cfg.OpenStack.Project) > 0 {
+
+		opts.TenantName = s.cfg.OpenStack.Project
+
+	}
+
+	provider, e := openstack.AuthenticatedClient(opts)
+
+	if e != nil {
+
+		l.Error.Printf("[LISTENER] Error authenticating against OpenStack. Error: %v\n", e)
+
+		return
+
+	}
+
+	endpoint := gophercloud.EndpointOpts{
+		Region: s.cfg.OpenStack.Region,
+	}
+
+	if s.client, e = openstack.NewComputeV2(provider, endpoint); e != nil {
+
+		l.Error.Printf("[LISTENER] Error creating compute client. Error: %v\n", e)
+
+		return
+
+	}
+
+	if s.volumeClient, e = openstack.NewBlockStorageV3(provider, endpoint); e != nil {
+
+		l.Error.Printf("[LISTENER] Error creating block storage client. Error: %v\n", e)
+
+		return
+
+	}
+
+	if s.identityClient, e = openstack.NewIdentityV3(provider, gophercloud.EndpointOpts{}); e != nil {
+
+		l.Error.Printf("[LISTENER] Error creating identity client. Error: %v\n", e)
+
+		return
+
+	}
+
+	return
+
+}
+
+// consume connects to the broker, binds the queue of the collector to the
+// notifications topic of every configured exchange and processes the
+// notifications until the connection is lost or the context is done.
+// Every notification is acknowledged once processed, unprocessable ones being
+// dropped, so a failure of the collector redelivers only the pending ones.
+// The ones whose event couldn't be reported are requeued instead and the
+// session ended, so they're retried after the backoff of the runtime.
+// Parameters:
+// - ctx: context of the listening session.
+// - report: function sending the events to the EventsEngine.
+// Returns:
+// - e: error raised when the connection is lost or can't be established, or
+// an event couldn't be reported.
+func (s *Listener) consume(ctx context.Context, report func(object interface{}) error) (e error) {
+
+	conn, e := amqp.Dial(s.cfg.Notifications.URL)
+
+	if e != nil {
+
+		return
+
+	}
+
+	defer conn.Close()
+
+	ch, e := conn.Channel()
+
+	if e != nil {
+
+		return
+
+	}
+
+	defer ch.Close()
+
+	closed := ch.NotifyClose(make(chan *amqp.Error, 1))
+
+	if e = ch.Qos(s.cfg.Notifications.Prefetch, 0, false); e != nil {
+
+		return
+
+	}
+
+	q, e := ch.QueueDeclare(s.cfg.Notifications.Queue, s.cfg.Notifications.Durable, false, false, false, nil)
+
+	if e != nil {
+
+		return
+
+	}
+
+	for _, exchange := range s.cfg.Notifications.Exchanges {
+
+		// oslo.messaging declares its exchanges as topic ones, durable only if
+		// amqp_durable_queues is set, so the declaration must match it.
+		if e = ch.ExchangeDeclare(exchange, "topic", s.cfg.Notifications.Durable, false, false, false, nil); e != nil {
+
+			return
+
+		}
+
+		// The routing key of the notifications is the topic followed by their
+		// priority (info, error, ...).
+		if e = ch.QueueBind(q.Name, s.cfg.Notifications.Topic+".*", exchange, false, nil); e != nil {
+
+			return
+
+		}
+
+	}
+
+	deliveries, e := ch.Consume(q.Name, "cyclops-notifications", false, false, false, false, nil)
+
+	if e != nil {
+
+		return
+
+	}
+
+	l.Info.Printf("[LISTENER] Listening for notifications in queue [ %v ] bound to the exchanges %v.\n", q.Name, s.cfg.Notifications.Exchanges)
+
+	for {
+
+		var d amqp.Delivery
+		var ok bool
+
+		select {
+
+		case <-ctx.Done():
+
+			l.Info.Printf("[LISTENER] Closing the connection to the broker.\n")
+
+			e = ctx.Err()
+
+			return
+
+		case d, ok = <-deliveries:
+
+		}
+
+		if !ok {
+
+			break
+
+		}
+
+		if e = s.process(ctx, d.Body, report); e != nil {
+
+			if err := d.Nack(false, true); err != nil {
+
+				l.Warning.Printf("[LISTENER] The notification couldn't be requeued. Error: %v\n", err)
+
+			}
+
+			return
+
+		}
+
+		if err := d.Ack(false); err != nil {
+
+			l.Warning.Printf("[LISTENER] The notification couldn't be acknowledged. Error: %v\n", err)
+
+		}
+
+	}
+
+	select {
+
+	case err := <-closed:
+
+		if err != nil {
+
+			e = err
+
+			return
+
+		}
+
+	case <-time.After(time.Second):
+
+	}
+
+	e = errors.New("the deliveries channel was closed by the broker")
+
+	return
+
+}
+
+// process handles a notification received from the broker, reporting the
+// event of the resource it refers to when it carries a lifecycle change.
+// Parameters:
+// - ctx: context of the listening session.
+// - body: slice of bytes with the message received from the broker.
+// - report: function sending the events to the EventsEngine.
+// Returns:
+// - e: error raised when the event couldn't be reported, the notifications
+// dropped or ignored don't raise any.
+func (s *Listener) process(ctx context.Context, body []byte, report func(object interface{}) error) (e error) {
+
+	s.metricCount.With(prometheus.Labels{"type": "Total notifications received"}).Inc()
+
+	n, e := parseNotification(body)
+
+	if e != nil {
+
+		l.Warning.Printf("[LISTENER] The notification received couldn't be parsed and will be dropped. Error: %v\n", e)
+
+		s.metricCount.With(prometheus.Labels{"type": "Total notifications DROPPED due to errors"}).Inc()
+
+		return nil
+
+	}
+
+	l.Trace.Printf("[LISTENER] Notification [ %v ] received from [ %v ].\n", n.EventType, n.PublisherID)
+
+	var event *eeModels.Event
+
+	switch {
+
+	case strings.HasPrefix(n.EventType, "compute.instance."):
+
+		event, e = s.serverEvent(n)
+
+	case strings.HasPrefix(n.EventType, "volume."):
+
+		event, e = s.volumeEvent(n)
+
+	case strings.HasPrefix(n.EventType, "floatingip."):
+
+		event, e = s.floatingIPEvent(ctx, n)
+
+	}
+
+	if e != nil {
+
+		l.Warning.Printf("[LISTENER] The notification [ %v ] couldn't be processed and will be dropped. Error: %v\n", n.EventType, e)
+
+		s.metricCount.With(prometheus.Labels{"type": "Total notifications DROPPED due to errors"}).Inc()
+
+		return nil
+
+	}
+
+	if event == nil {
+
+		l.Trace.Printf("[LISTENER] The notification [ %v ] carries no lifecycle change and will be ignored.\n", n.EventType)
+
+		s.metricCount.With(prometheus.Labels{"type": "Total notifications IGNORED"}).Inc()
+
+		return
+
+	}
+
+	if s.filtered(*event) {
+
+		s.metricCount.With(prometheus.Labels{"type": "Total notifications FILTERED"}).Inc()
+
+		return
+
+	}
+
+	l.Info.Printf("[LISTENER] Reporting [ %v ] for %v [ %v ] of project [ %v ] at [ %v ] after notification [ %v ].\n",
+		*event.LastEvent, event.ResourceType, event.ResourceID, event.Account, *event.EventTime, n.EventType)
+
+	if e = report(*event); e != nil {
+
+		l.Warning.Printf("[LISTENER] The event of notification [ %v ] couldn't be reported, it will be requeued. Error: %v\n", n.EventType, e)
+
+		s.metricCount.With(prometheus.Labels{"type": "Total notifications REQUEUED due to errors"}).Inc()
+
+		return
+
+	}
+
+	s.metricCount.With(prometheus.Labels{"type": "Total events reported"}).Inc()
+
+	s.metricTime.With(prometheus.Labels{"type": "Last event time reported"}).Set(float64(*event.EventTime))
+
+	if *event.LastEvent == "terminated" {
+
+		delete(s.known, event.ResourceID)
+
+	} else {
+
+		s.known[event.ResourceID] = *event
+
+	}
+
+	return
+
+}
+
+// parseNotification decodes the message received from the broker, both with
+// and without the oslo.messaging envelope.
+// Parameters:
+// - body: slice of bytes with the message received from the broker.
+// Returns:
+// - n: notification decoded.
+// - e: error raised in case the message isn't a notification.
+func parseNotification(body []byte) (n notification, e error) {
+
+	var env envelope
+
+	if e = json.Unmarshal(body, &env); e != nil {
+
+		e = fmt.Errorf("%w: %v", errMalformed, e)
+
+		return
+
+	}
+
+	raw := body
+
+	if env.Message != nil {
+
+		raw = []byte(*env.Message)
+
+	}
+
+	if e = json.Unmarshal(raw, &n); e != nil {
+
+		e = fmt.Errorf("%w: %v", errMalformed, e)
+
+		return
+
+	}
+
+	if n.EventType == "" {
+
+		e = fmt.Errorf("%w: the event type is missing", errMalformed)
+
+	}
+
+	return
+
+}
+
+// serverEvent builds the event of a Nova instance notification, with the same
+// metadata the servers collector reports.
+// Only the end of the actions and the updates changing the state of the
+// instance are relevant, the rest are ignored returning no event.
+// Parameters:
+// - n: notification received.
+// Returns:
+// - event: reference to the event to be reported, nil if not relevant.
+// - e: error raised in case of problems with the notification.
+func (s *Listener) serverEvent(n notification) (event *eeModels.Event, e error) {
+
+	action := strings.TrimPrefix(n.EventType, "compute.instance.")
+
+	var p instancePayload
+
+	if e = json.Unmarshal(n.Payload, &p); e != nil {
+
+		e = fmt.Errorf("%w: %v", errMalformed, e)
+
+		return
+
+	}
+
+	state := p.State
+
+	switch {
+
+	case action == "delete.end":
+
+		state = "terminated"
+
+	case action == "update":
+
+		if p.State == p.OldState {
+
+			return
+
+		}
+
+	case !strings.HasSuffix(action, ".end"):
+
+		return
+
+	}
+
+	if p.InstanceID == "" || p.TenantID == "" {
+
+		e = fmt.Errorf("%w: the instance or project ID is missing", errMalformed)
+
+		return
+
+	}
+
+	var imageid, imagename, imageosflavor string
+
+	if ref, exists := p.ImageMeta["base_image_ref"].(string); exists {
+
+		imageid = ref
+
+	} else {
+
+		imageid = p.ImageRefURL[strings.LastIndex(p.ImageRefURL, "/")+1:]
+
+	}
+
+	var err error
+
+	if imageid != "" {
+
+		if imagename, imageosflavor, err = s.getFromImageCache(s.client, imageid); err != nil {
+
+			l.Error.Printf("[LISTENER] Error while getting the image id [ %+v ]. Error: %v\n", imageid, err)
+
+		}
+
+	}
+
+	flavorname := p.FlavorName
+
+	if flavorname == "" && p.FlavorID != "" {
+
+		if flavorname, err = s.getFromFlavorCache(s.client, p.FlavorID); err != nil {
+
+			l.Error.Printf("[LISTENER] Error while getting the flavor id [ %+v ]. Error: %v\n", p.FlavorID, err)
+
+		}
+
+	}
+
+	if len(flavorname) == 0 {
+
+		e = fmt.Errorf("the VM [ %v ] has no flavor name", p.InstanceID)
+
+		return
+
+	}
+
+	metadata := make(datamodels.JSONdb)
+	metadata["imageid"] = imageid
+	metadata["imagename"] = imagename
+	metadata["imageosflavor"] = imageosflavor
+	metadata["flavorid"] = p.FlavorID
+	metadata["flavorname"] = flavorname
+	metadata["region"] = s.cfg.OpenStack.Region
+
+	if tags := s.getTags(p.Metadata); len(tags) > 0 {
+
+		metadata["tags"] = tags
+
+	}
+
+	if value, exists := p.Metadata["schedule_frequency"]; exists && value == "never" {
+
+		metadata["PlanOverride"] = true
+
+	}
+
+	evTime := eventTime(n)
+	evLast := getStatus(state)
+
+	event = &eeModels.Event{
+		Account:      p.TenantID,
+		EventTime:    &evTime,
+		LastEvent:    &evLast,
+		MetaData:     metadata,
+		Region:       s.cfg.OpenStack.Region,
+		ResourceID:   p.InstanceID,
+		ResourceName: p.DisplayName,
+		ResourceType: "server",
+	}
+
+	return
+
+}
+
+// volumeEvent builds the event of a Cinder volume notification, with the same
+// metadata the blockstorage collector reports.
+// Only the end of the actions and the retypes are relevant, the rest are
+// ignored returning no event.
+// Parameters:
+// - n: notification received.
+// Returns:
+// - event: reference to the event to be reported, nil if not relevant.
+// - e: error raised in case of problems with the notification.
+func (s *Listener) volumeEvent(n notification) (event *eeModels.Event, e error) {
+
+	action := strings.TrimPrefix(n.EventType, "volume.")
+
+	var p volumePayload
+
+	if e = json.Unmarshal(n.Payload, &p); e != nil {
+
+		e = fmt.Errorf("%w: %v", errMalformed, e)
+
+		return
+
+	}
+
+	state := p.Status
+
+	switch {
+
+	case action == "delete.end":
+
+		state = "terminated"
+
+	case action != "retype" && !strings.HasSuffix(action, ".end"):
+
+		return
+
+	}
+
+	if p.VolumeID == "" || p.TenantID == "" {
+
+		e = fmt.Errorf("%w: the volume or project ID is missing", errMalformed)
+
+		return
+
+	}
+
+	// The notifications carry the ID of the volume type instead of its name
+	volumeType := p.VolumeType
+
+	if volumeType != "" {
+
+		var err error
+
+		if volumeType, err = s.getFromVolumeTypeCache(s.volumeClient, p.VolumeType); err != nil {
+
+			l.Error.Printf("[LISTENER] Error while getting the volume type id [ %+v ]. Error: %v\n", p.VolumeType, err)
+
+			volumeType = p.VolumeType
+
+		}
+
+	}
+
+	md := make(datamodels.JSONdb)
+	md["size"] = strconv.Itoa(p.Size)
+	md["availabilityzone"] = p.AvailabilityZone
+	md["replication"] = p.ReplicationStatus
+	md["volumetype"] = volumeType
+	md["region"] = s.cfg.OpenStack.Region
+
+	if tags := s.getTags(volumeMetadata(p.Metadata)); len(tags) > 0 {
+
+		md["tags"] = tags
+
+	}
+
+	objectType := "blockstorage"
+
+	if strings.Contains(volumeType, "ssd") {
+
+		objectType = "blockstorage_ssd"
+
+	}
+
+	evTime := eventTime(n)
+	evLast := getStatus(state)
+
+	event = &eeModels.Event{
+		Account:      p.TenantID,
+		EventTime:    &evTime,
+		LastEvent:    &evLast,
+		MetaData:     md,
+		Region:       s.cfg.OpenStack.Region,
+		ResourceID:   p.VolumeID,
+		ResourceName: strings.TrimSpace(p.DisplayName),
+		ResourceType: objectType,
+	}
+
+	return
+
+}
+
+// floatingIPEvent builds the event of a Neutron floating IP notification, with
+// the same metadata the network collector reports.
+// The deletions only carry the ID of the floating IP, so the rest of its
+// details are taken from the last event known for it.
+// Parameters:
+// - ctx: context of the listening session.
+// - n: notification received.
+// Returns:
+// - event: reference to the event to be reported, nil if not relevant.
+// - e: error raised in case of problems with the notification.
+func (s *Listener) floatingIPEvent(ctx context.Context, n notification) (event *eeModels.Event, e error) {
+
+	action := strings.TrimPrefix(n.EventType, "floatingip.")
+
+	if !strings.HasSuffix(action, ".end") {
+
+		return
+
+	}
+
+	var p floatingIPPayload
+
+	if e = json.Unmarshal(n.Payload, &p); e != nil {
+
+		e = fmt.Errorf("%w: %v", errMalformed, e)
+
+		return
+
+	}
+
+	if p.FloatingIP == nil {
+
+		if action != "delete.end" || p.FloatingIPID == "" {
+
+			e = fmt.Errorf("%w: the floating IP is missing", errMalformed)
+
+			return
+
+		}
+
+		if event, e = s.lookupEvent(ctx, p.FloatingIPID, "floatingip"); event == nil || e != nil {
+
+			return
+
+		}
+
+		evTime := eventTime(n)
+		evLast := getStatus("terminated")
+
+		event.EventTime = &evTime
+		event.LastEvent = &evLast
+
+		return
+
+	}
+
+	ip := p.FloatingIP
+	account := ip.ProjectID
+
+	if account == "" {
+
+		account = ip.TenantID
+
+	}
+
+	if ip.ID == "" || account == "" {
+
+		e = fmt.Errorf("%w: the floating IP or project ID is missing", errMalformed)
+
+		return
+
+	}
+
+	state := ip.Status
+
+	if action == "delete.end" {
+
+		state = "terminated"
+
+	}
+
+	md := make(datamodels.JSONdb)
+	md["region"] = s.cfg.OpenStack.Region
+	md["floatingnetworkid"] = ip.FloatingNetworkID
+
+	if tags := s.getTagList(ip.Tags); len(tags) > 0 {
+
+		md["tags"] = tags
+
+	}
+
+	evTime := eventTime(n)
+	evLast := getStatus(state)
+
+	event = &eeModels.Event{
+		Account:      account,
+		EventTime:    &evTime,
+		LastEvent:    &evLast,
+		MetaData:     md,
+		Region:       s.cfg.OpenStack.Region,
+		ResourceID:   ip.ID,
+		ResourceName: ip.FloatingIPAddress,
+		ResourceType: "floatingip",
+	}
+
+	return
+
+}
+
+// lookupEvent returns the details of a resource from the last event reported
+// by the collector or, when unknown to it, from the states in the
+// EventsEngine.
+// Parameters:
+// - ctx: context of the listening session.
+// - id: string with the ID of the resource.
+// - types: strings with the resource types the resource may belong to.
+// Returns:
+// - event: reference to the event with the details of the resource, nil if
+// the resource is unknown.
+// - e: error raised in case of problems with the EventsEngine.
+func (s *Listener) lookupEvent(ctx context.Context, id string, types ...string) (event *eeModels.Event, e error) {
+
+	if last, exists := s.known[id]; exists {
+
+		event = &last
+
+		return
+
+	}
+
+	for _, resourceType := range types {
+
+		params := eeEvent.NewListStatesParams().WithResource(&resourceType).WithRegion(&s.cfg.OpenStack.Region)
+
+		eeCtx, cancel := context.WithTimeout(ctx, 300*time.Second)
+		r, err := s.reportClient.EventManagement.ListStates(eeCtx, params)
+		cancel()
+
+		if err != nil {
+
+			e = fmt.Errorf("the states of [ %v ] couldn't be retrieved from the EventsEngine: %v", resourceType, err)
+
+			return
+
+		}
+
+		for _, state := range r.Payload {
+
+			if state.ResourceID == id {
+
+				event = &eeModels.Event{
+					Account:      state.Account,
+					MetaData:     state.MetaData,
+					Region:       s.cfg.OpenStack.Region,
+					ResourceID:   state.ResourceID,
+					ResourceName: state.ResourceName,
+					ResourceType: resourceType,
+				}
+
+				return
+
+			}
+
+		}
+
+	}
+
+	l.Debug.Printf("[LISTENER] The resource [ %v ] is unknown, no event will be reported.\n", id)
+
+	return
+
+}
+
+// filtered checks the event against the project and name filters, applied
+// the same way the polling collectors do: the name filters are matched
+// against the name of the VMs for the servers and against the name of the
+// project for the rest of resources.
+// Parameters:
+// - event: event to be checked.
+// Returns:
+// - a bool set when the event shouldn't be reported.
+func (s *Listener) filtered(event eeModels.Event) bool {
+
+	for _, filter := range s.cfg.ProjectFilters {
+
+		if strings.Contains(event.Account, filter) && filter != "" {
+
+			l.Debug.Printf("[LISTENER] The Project [ %v ] matches filter [ %v ] and won't be further processed.", event.Account, filter)
+
+			return true
+
+		}
+
+	}
+
+	if len(s.cfg.NameFilters) == 0 {
+
+		return false
+
+	}
+
+	name := event.ResourceName
+
+	if event.ResourceType != "server" {
+
+		var e error
+
+		if name, e = s.getFromProjectCache(s.identityClient, event.Account); e != nil {
+
+			l.Error.Printf("[LISTENER] Error while getting the project id [ %+v ]. Error: %v\n", event.Account, e)
+
+		}
+
+	}
+
+	for _, filter := range s.cfg.NameFilters {
+
+		if strings.Contains(strings.ToLower(name), strings.ToLower(filter)) && filter != "" {
+
+			l.Debug.Printf("[LISTENER] The name [ %v ] matches filter [ %v ] and won't be further processed.", name, filter)
+
+			return true
+
+		}
+
+	}
+
+	return false
+
+}
+
+// eventTime returns the time of the notification, when the change actually
+// happened, falling back to the current time if it can't be parsed.
+// Parameters:
+// - n: notification received.
+// Returns:
+// - an int64 with the time of the notification in seconds since the epoch.
+func eventTime(n notification) int64 {
+
+	for _, layout := range []string{osloTimeFormat, "2006-01-02T15:04:05.999999", time.RFC3339Nano} {
+
+		if t, e := time.ParseInLocation(layout, n.Timestamp, time.UTC); e == nil {
+
+			return t.Unix()
+
+		}
+
+	}
+
+	l.Warning.Printf("[LISTENER] The timestamp [ %v ] of the notification [ %v ] couldn't be parsed, using the current time.\n", n.Timestamp, n.EventType)
+
+	return time.Now().Unix()
+
+}
+
+// volumeMetadata decodes the metadata of the volume notifications, which
+// depending on the Cinder release is either a map or a list of key/values.
+// Parameters:
+// - raw: RawMessage with the metadata in the notification.
+// Returns:
+// - md: map with the metadata of the volume.
+func volumeMetadata(raw json.RawMessage) (md map[string]string) {
+
+	if json.Unmarshal(raw, &md) == nil {
+
+		return
+
+	}
+
+	var list []struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	}
+
+	md = make(map[string]string)
+
+	if json.Unmarshal(raw, &list) == nil {
+
+		for _, item := range list {
+
+			md[item.Key] = item.Value
+
+		}
+
+	}
+
+	return
+
+}
+
+// getTags job is to filter the tags of the resource against the configured
+// allowlist, so only the ones meant for cost allocation travel with the event.
+// Parameters:
+// - source: map with the tags/metadata of the resource in the system.
+// Returns:
+// - tags: JSONdb containing only the allowlisted tags.
+func (s *Listener) getTags(source map[string]string) (tags datamodels.JSONdb) {
+
+	tags = make(datamodels.JSONdb)
+
+	for _, key := range s.cfg.TagAllowlist {
+
+		if value, exists := source[key]; exists && value != "" {
+
+			tags[key] = value
+
+		}
+
+	}
+
+	return
+
+}
+
+// getTagList job is the same as getTags for the Neutron tags, which are plain
+// strings, so the "key=value" convention is expected.
+// Parameters:
+// - source: slice with the tags of the resource in the system.
+// Returns:
+// - tags: JSONdb containing only the allowlisted tags.
+func (s *Listener) getTagList(source []string) (tags datamodels.JSONdb) {
+
+	tags = make(datamodels.JSONdb)
+
+	for _, tag := range source {
+
+		kv := strings.SplitN(tag, "=", 2)
+
+		if len(kv) != 2 || kv[1] == "" {
+
+			continue
+
+		}
+
+		for _, key := range s.cfg.TagAllowlist {
+
+			if kv[0] == key {
+
+				tags[key] = kv[1]
+
+			}
+
+		}
+
+	}
+
+	return
+
+}
+
+// getStatus job is to normalize the event state returned by the collectors.
+// Besides the states of the APIs it covers the ones of the notifications,
+// like the vm_state of Nova.
+// Parameters:
+// - state: string returned by the system.
+// Returns:
+// - status: normalized state to be returned.
+func getStatus(state string) (status string) {
+
+	switch strings.ToUpper(state) {
+
+	case "ACTIVE":
+
+		status = "active"
+
+	case "ATTACHING":
+
+		status = "active"
+
+	case "AVAILABLE":
+
+		status = "active"
+
+	case "BUILD", "BUILDING":
+
+		status = "inactive"
+
+	case "CREATING":
+
+		status = "active"
+
+	case "DELETED":
+
+		status = "terminated"
+
+	case "DELETING":
+
+		status = "terminated"
+
+	case "DETACHING":
+
+		status = "active"
+
+	case "DOWN":
+
+		status = "inactive"
+
+	case "ERROR":
+
+		status = "error"
+
+	case "ERROR_DELETING":
+
+		status = "error"
+
+	case "EXTENDING":
+
+		status = "inactive"
+
+	case "HARD_DELETED":
+
+		status = "terminated"
+
+	case "IN-USE":
+
+		status = "active"
+
+	case "MAINTENANCE":
+
+		status = "active"
+
+	case "PAUSED":
+
+		status = "inactive"
+
+	case "RESCUED":
+
+		status = "active"
+
+	case "RESIZE":
+
+		status = "active"
+
+	case "RESIZED":
+
+		status = "active"
+
+	case "RESERVED":
+
+		status = "active"
+
+	case "RETYPING":
+
+		status = "inactive"
+
+	case "SHELVED":
+
+		status = "inactive"
+
+	case "SHELVED_OFFLOADED":
+
+		status = "terminated"
+
+	case "SHUTOFF":
+
+		status = "inactive"
+
+	case "SOFT_DELETED", "SOFT-DELETE":
+
+		status = "terminated"
+
+	case "STOPPED":
+
+		status = "inactive"
+
+	case "SUSPENDED":
+
+		status = "inactive"
+
+	case "TERMINATED":
+
+		status = "terminated"
+
+	case "VERIFY_RESIZE":
+
+		status = "active"
+
+	}
+
+	l.Trace.Printf("[REPORT] State received from the system [ %v ] normalized to [ %v ]", state, status)
+
+	return status
+
+}
@@ -0,0 +1,82 @@
+package notifications
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/GoDieNow/TFT_Code/collectors/collector"
+	eeClient "github.com/GoDieNow/TFT_Code/services/eventsengine/client"
+	eeModels "github.com/GoDieNow/TFT_Code/services/eventsengine/models"
+)
+
+// Listener reports the events of the servers, volumes and floating IPs of
+// OpenStack as its notifications arrive.
+// Every listener keeps its own clients, caches and known resources, so it can
+// be registered more than once in the same collector.
+type Listener struct {
+	cfg          *collector.Configuration
+	metricCount  *prometheus.GaugeVec
+	metricTime   *prometheus.GaugeVec
+	reportClient *eeClient.EventEngineManagementAPI
+
+	flavorCache     map[string]FlavorIDCacheData
+	imageCache      map[string]ImageIDCacheData
+	projectCache    map[string]ProjectIDCacheData
+	volumeTypeCache map[string]VolumeTypeIDCacheData
+	known           map[string]eeModels.Event
+
+	client         *gophercloud.ServiceClient
+	identityClient *gophercloud.ServiceClient
+	volumeClient   *gophercloud.ServiceClient
+}
+
+// New creates the notifications listener to be run by the collector runtime.
+// Parameters:
+// - env: Env reference with the configuration and clients of the runtime.
+// Returns:
+// - s: the notifications Listener.
+// - e: error raised in case of something goes wrong.
+func New(env *collector.Env) (s collector.Listener, e error) {
+
+	s = &Listener{
+		cfg:             env.Config,
+		metricCount:     env.MetricCount,
+		metricTime:      env.MetricTime,
+		reportClient:    env.EventsEngine,
+		flavorCache:     make(map[string]FlavorIDCacheData),
+		imageCache:      make(map[string]ImageIDCacheData),
+		projectCache:    make(map[string]ProjectIDCacheData),
+		volumeTypeCache: make(map[string]VolumeTypeIDCacheData),
+		known:           make(map[string]eeModels.Event),
+	}
+
+	return
+
+}
+
+// Listen consumes the notifications from the broker until the connection is
+// lost or the context is done, authenticating against OpenStack first if it
+// wasn't yet.
+// Parameters:
+// - ctx: context of the listening session.
+// - report: function sending the events to the EventsEngine.
+// Returns:
+// - e: error raised when the connection is lost or can't be established.
+func (s *Listener) Listen(ctx context.Context, report func(object interface{}) error) (e error) {
+
+	if s.client == nil || s.volumeClient == nil || s.identityClient == nil {
+
+		if e = s.openstackStart(); e != nil {
+
+			return
+
+		}
+
+	}
+
+	e = s.consume(ctx, report)
+
+	return
+
+}
@@ -1,4 +1,4 @@
-package main
+package objects
 
 import (
 	"context"
@@ -10,48 +10,61 @@ import (
 	rcl "github.com/myENA/restclient"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/remeh/sizedwaitgroup"
+	"github.com/GoDieNow/TFT_Code/collectors/collector"
 	datamodels "gitlab.com/cyclops-utilities/datamodels"
 	udrModels "github.com/GoDieNow/TFT_Code/services/udr/models"
 	l "gitlab.com/cyclops-utilities/logging"
 )
 
-var (
-	collector       = "Objects"
-	objects         = "object"
-	collectionStart int64
-)
-
 // collect handles the process of retrieving the information from the system.
-func collect() {
+// Parameters:
+// - ctx: context of the collection.
+// Returns:
+// - e: error raised in case of something goes wrong.
+func (s *Source) collect(ctx context.Context) (e error) {
 
 	l.Trace.Printf("[COLLECTION] The collection process has been started.\n")
 
-	collectionStart = time.Now().UnixNano()
-
-	metricTime.With(prometheus.Labels{"type": "Collection Start Time"}).Set(float64(collectionStart))
+	s.collectionStart = time.Now().UnixNano()
 
 	// Here comes the logic to retrieve the information from the system.
 	rcfg := &rgw.Config{
-		AdminPath:   cfg.RGW.AdminPath,
-		AccessKeyID: cfg.RGW.AccessKeyID,
+		AdminPath:   s.cfg.RGW.AdminPath,
+		AccessKeyID: s.cfg.RGW.AccessKeyID,
 		ClientConfig: rcl.ClientConfig{
 			ClientTimeout: rcl.Duration(time.Second * 30),
 		},
-		SecretAccessKey: cfg.RGW.SecretAccessKey,
-		ServerURL:       cfg.RGW.ServerURL,
+		SecretAccessKey: s.cfg.RGW.SecretAccessKey,
+		ServerURL:       s.cfg.RGW.ServerURL,
 	}
 
-	a, e := rgw.NewAdminAPI(rcfg)
+	// The responses of RadosGW are recorded per call as its client doesn't let
+	// its transport be replaced, so there is no need for it when replaying.
+	a := new(rgw.AdminAPI)
 
-	if e != nil {
+	if !s.fixtures.Replaying() {
 
-		l.Warning.Printf("[COLLECTION] Could not authenticate with RadosGW. Not obtaining data for this period. Error: %v\n", e.Error())
+		a, e = rgw.NewAdminAPI(rcfg)
 
-		return
+		if e != nil {
+
+			l.Warning.Printf("[COLLECTION] Could not authenticate with RadosGW. Not obtaining data for this period. Error: %v\n", e.Error())
+
+			return
+
+		}
 
 	}
 
-	users, e := a.MListUsers(context.Background())
+	var users []string
+
+	e = s.fixtures.Call("users", &users, func() (e error) {
+
+		users, e = a.MListUsers(ctx)
+
+		return
+
+	})
 
 	if e != nil {
 
@@ -79,7 +92,15 @@ func collect() {
 			if filterOpenstackProject(u) {
 
 				// get all buckets for given user
-				bucketList, e := a.BucketList(context.Background(), u)
+				var bucketList []string
+
+				e := s.fixtures.Call("buckets "+u, &bucketList, func() (e error) {
+
+					bucketList, e = a.BucketList(ctx, u)
+
+					return
+
+				})
 
 				if e != nil {
 
@@ -96,7 +117,7 @@ func collect() {
 
 				for _, b := range bucketList {
 
-					bucketStats, e := a.BucketStats(context.Background(), u, b)
+					bucketStats, e := bucketStats(ctx, s.fixtures, a.BucketStats, u, b)
 
 					if e != nil {
 
@@ -111,7 +132,7 @@ func collect() {
 					} else {
 
 						d := datamodels.JSONdb{
-							"region": cfg.RGW.Region,
+							"region": s.cfg.RGW.Region,
 							"bucket": b,
 						}
 
@@ -133,12 +154,12 @@ func collect() {
 									Account:      u,
 									Metadata:     d,
 									ResourceType: "objectstorage",
-									Time:         time.Now().Unix(),
+									Time:         s.fixtures.Now().Unix(),
 									Unit:         "GB",
 									Usage:        float64((usage / float64(1024)) / float64(1024)),
 								}
 
-								report(usageReport)
+								s.report(usageReport)
 
 								aggregateBucketSize += usageReport.Usage
 
@@ -164,17 +185,15 @@ func collect() {
 
 	swg.Wait()
 
-	metricCount.With(prometheus.Labels{"type": "Total Objects reported by OS API"}).Set(float64(apiCount))
+	s.metricCount.With(prometheus.Labels{"type": "Total Objects reported by OS API"}).Set(float64(apiCount))
 
-	metricCount.With(prometheus.Labels{"type": "Total Objects DROPPED due to missing information"}).Set(float64(dropCount))
+	s.metricCount.With(prometheus.Labels{"type": "Total Objects DROPPED due to missing information"}).Set(float64(dropCount))
 
-	metricTime.With(prometheus.Labels{"type": "Collection Processing Time"}).Set(float64(time.Now().UnixNano()-collectionStart) / float64(time.Millisecond))
-
-	l.Warning.Printf("[COLLECTION] Completed.\n OS Report: %v\n, Dropped: %v\n, Processing Time: %v[ms]\n", apiCount, dropCount, float64(time.Now().UnixNano()-collectionStart)/float64(time.Millisecond))
+	l.Warning.Printf("[COLLECTION] Completed.\n OS Report: %v\n, Dropped: %v\n, Processing Time: %v[ms]\n", apiCount, dropCount, float64(time.Now().UnixNano()-s.collectionStart)/float64(time.Millisecond))
 
 	l.Trace.Printf("[COLLECTION] The collection process has been finished.\n")
 
-	return
+	return nil
 
 }
 
@@ -315,3 +334,28 @@ func getStatus(state string) (status string) {
 	return status
 
 }
+
+// bucketStats retrieves the stats of a bucket through the fixtures, generic
+// over the stats so they are kept as returned by the RadosGW client.
+// Parameters:
+// - ctx: context of the collection.
+// - fixtures: Fixtures reference recording or replaying the call.
+// - fetch: the method of the RadosGW client retrieving the stats.
+// - u: string with the user owning the bucket.
+// - b: string with the name of the bucket.
+// Returns:
+// - stats: the stats of the bucket.
+// - e: error raised in case of something goes wrong.
+func bucketStats[T any](ctx context.Context, fixtures *collector.Fixtures, fetch func(context.Context, string, string) (T, error), u, b string) (stats T, e error) {
+
+	e = fixtures.Call("stats "+u+" "+b, &stats, func() (e error) {
+
+		stats, e = fetch(ctx, u, b)
+
+		return
+
+	})
+
+	return
+
+}
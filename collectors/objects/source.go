@@ -0,0 +1,70 @@
+package objects
+
+import (
+	"context"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/GoDieNow/TFT_Code/collectors/collector"
+)
+
+// Source collects the size of the buckets of RadosGW as usages for the UDR.
+// Every source keeps its own configuration and collection state, so it can be
+// registered more than once in the same collector.
+type Source struct {
+	cfg          *collector.Configuration
+	fixtures     *collector.Fixtures
+	metricCount  *prometheus.GaugeVec
+	reports      []interface{}
+	reportsMutex sync.Mutex
+
+	collectionStart int64
+}
+
+// New creates the objects source to be run by the collector runtime.
+// Parameters:
+// - env: Env reference with the configuration and clients of the runtime.
+// Returns:
+// - s: the objects Source.
+// - e: error raised in case of something goes wrong.
+func New(env *collector.Env) (s collector.Source, e error) {
+
+	s = &Source{
+		cfg:         env.Config,
+		fixtures:    env.Fixtures,
+		metricCount: env.MetricCount,
+	}
+
+	return
+
+}
+
+// Collect retrieves the size of the buckets from RadosGW.
+// Parameters:
+// - ctx: context of the collection.
+// Returns:
+// - r: slice with the usages to be reported.
+// - e: error raised in case of something goes wrong.
+func (s *Source) Collect(ctx context.Context) (r []interface{}, e error) {
+
+	s.reports = nil
+
+	e = s.collect(ctx)
+
+	r = s.reports
+
+	return
+
+}
+
+// report queues the usage to be sent once the collection has finished.
+// Parameters:
+// - object: an interface{} reference with the usage to be sent.
+func (s *Source) report(object interface{}) {
+
+	s.reportsMutex.Lock()
+	defer s.reportsMutex.Unlock()
+
+	s.reports = append(s.reports, object)
+
+}
@@ -1,4 +1,6 @@
 #!/bin/sh
+# The collectors share a single module, so the image is built from its root
+cd ..
 sed -i '4,$d' go.mod
-docker build -t tft/servers:latest .
+docker build -t tft/servers:latest -f servers/Dockerfile .
 git restore go.mod
\ No newline at end of file
@@ -1,4 +1,4 @@
-package main
+package servers
 
 import (
 	"time"
@@ -20,21 +20,16 @@ type ImageIDCacheData struct {
 	LastRefreshed int64
 }
 
-var (
-	FlavorCache map[string]FlavorIDCacheData
-	ImageCache  map[string]ImageIDCacheData
-)
-
-func getFromFlavorCache(client *gophercloud.ServiceClient, flavorid string) (flavorname string, e error) {
+func (s *Source) getFromFlavorCache(client *gophercloud.ServiceClient, flavorid string) (flavorname string, e error) {
 
 	var flavor *flavors.Flavor
 
-	if FlavorCache[flavorid].LastRefreshed != 0 {
+	if s.flavorCache[flavorid].LastRefreshed != 0 {
 
 		//the cache entry exists
 		l.Trace.Printf("[CACHE] Flavor cache entry found for key: %v.\n", flavorid)
 
-		if time.Now().Unix()-FlavorCache[flavorid].LastRefreshed > 86400 {
+		if time.Now().Unix()-s.flavorCache[flavorid].LastRefreshed > 86400 {
 
 			//invalidate the entry and get a fresh one
 			l.Trace.Printf("[CACHE] Cache invalidation for key [ %v ], refreshing entry now.\n", flavorid)
@@ -51,7 +46,7 @@ func getFromFlavorCache(client *gophercloud.ServiceClient, flavorid string) (fla
 
 			flavorname = flavor.Name
 
-			FlavorCache[flavorid] = FlavorIDCacheData{
+			s.flavorCache[flavorid] = FlavorIDCacheData{
 				flavor.Name,
 				time.Now().Unix(),
 			}
@@ -64,7 +59,7 @@ func getFromFlavorCache(client *gophercloud.ServiceClient, flavorid string) (fla
 
 		l.Trace.Printf("[CACHE] Cache entry value for key [ %v ] returned without refreshing.\n", flavorid)
 
-		flavorname = FlavorCache[flavorid].FlavorName
+		flavorname = s.flavorCache[flavorid].FlavorName
 
 		return
 
@@ -86,7 +81,7 @@ func getFromFlavorCache(client *gophercloud.ServiceClient, flavorid string) (fla
 
 	l.Trace.Printf("[CACHE] Going to add a new cache entry added for key: %v\n", flavorid)
 
-	FlavorCache[flavorid] = FlavorIDCacheData{
+	s.flavorCache[flavorid] = FlavorIDCacheData{
 		flavor.Name,
 		time.Now().Unix(),
 	}
@@ -97,16 +92,16 @@ func getFromFlavorCache(client *gophercloud.ServiceClient, flavorid string) (fla
 
 }
 
-func getFromImageCache(client *gophercloud.ServiceClient, imageid string) (imagename string, osflavor string, e error) {
+func (s *Source) getFromImageCache(client *gophercloud.ServiceClient, imageid string) (imagename string, osflavor string, e error) {
 
 	var image *images.Image
 
-	if ImageCache[imageid].LastRefreshed != 0 {
+	if s.imageCache[imageid].LastRefreshed != 0 {
 
 		//the cache entry exists
 		l.Trace.Printf("[CACHE] Image cache entry found for image key: %v.\n", imageid)
 
-		if time.Now().Unix()-ImageCache[imageid].LastRefreshed > 86400 {
+		if time.Now().Unix()-s.imageCache[imageid].LastRefreshed > 86400 {
 
 			//invalidate the entry and get a fresh one
 			l.Trace.Printf("[CACHE] Cache invalidation for image key [ %v ], refreshing entry now.\n", imageid)
@@ -131,7 +126,7 @@ func getFromImageCache(client *gophercloud.ServiceClient, imageid string) (image
 
 			}
 
-			ImageCache[imageid] = ImageIDCacheData{
+			s.imageCache[imageid] = ImageIDCacheData{
 				image.Name,
 				osflavor,
 				time.Now().Unix(),
@@ -145,8 +140,8 @@ func getFromImageCache(client *gophercloud.ServiceClient, imageid string) (image
 
 		l.Trace.Printf("[CACHE] Cache entry value for image key [ %v ] returned without refreshing.\n", imageid)
 
-		imagename = ImageCache[imageid].ImageName
-		osflavor = ImageCache[imageid].OSFlavor
+		imagename = s.imageCache[imageid].ImageName
+		osflavor = s.imageCache[imageid].OSFlavor
 
 		return
 
@@ -176,7 +171,7 @@ func getFromImageCache(client *gophercloud.ServiceClient, imageid string) (image
 
 	l.Trace.Printf("[CACHE] Going to add a new cache entry added for image key: " + imageid)
 
-	ImageCache[imageid] = ImageIDCacheData{
+	s.imageCache[imageid] = ImageIDCacheData{
 		image.Name,
 		osflavor,
 		time.Now().Unix(),
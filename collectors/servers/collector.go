@@ -1,8 +1,7 @@
-package main
+package servers
 
 import (
 	"context"
-	"os"
 	"strings"
 	"time"
 
@@ -17,59 +16,62 @@ import (
 	l "gitlab.com/cyclops-utilities/logging"
 )
 
-var (
-	collector       = "Servers"
-	objects         = "vm"
-	collectionStart int64
-	vmCount         int
-	terminatedCount int
-	dropCount       int
-	remotelist      []*eeModels.MinimalState
-	client          *gophercloud.ServiceClient
-)
-
 // collect handles the process of retrieving the information from the system.
-func collect() {
+// Parameters:
+// - ctx: context of the collection.
+// Returns:
+// - e: error raised in case of something goes wrong.
+func (s *Source) collect(ctx context.Context) (e error) {
 
 	l.Trace.Printf("[COLLECTION] The collection process has been started.\n")
 
-	collectionStart = time.Now().UnixNano()
-
-	metricTime.With(prometheus.Labels{"type": "Collection Start Time"}).Set(float64(collectionStart))
+	s.collectionStart = time.Now().UnixNano()
 
 	// Here comes the logic to retrieve the information from the system.
 	opts := gophercloud.AuthOptions{
-		DomainName:       cfg.OpenStack.Domain,
-		IdentityEndpoint: cfg.OpenStack.Keystone,
-		Password:         cfg.OpenStack.Password,
-		Username:         cfg.OpenStack.User,
+		DomainName:       s.cfg.OpenStack.Domain,
+		IdentityEndpoint: s.cfg.OpenStack.Keystone,
+		Password:         s.cfg.OpenStack.Password,
+		Username:         s.cfg.OpenStack.User,
 	}
 
-	if len(cfg.OpenStack.Project) > 0 {
+	if len(s.cfg.OpenStack.Project) > 0 {
 
-		opts.TenantName = cfg.OpenStack.Project
+		opts.TenantName = s.cfg.OpenStack.Project
 
 	}
 
-	provider, e := openstack.AuthenticatedClient(opts)
+	// The provider uses the HTTP client of the runtime so its requests can be
+	// recorded or replayed.
+	provider, e := openstack.NewClient(opts.IdentityEndpoint)
 
 	if e != nil {
 
+		l.Error.Printf("[COLLECTION] Error creating the OpenStack client. Error: %v\n", e)
+
+		return
+
+	}
+
+	provider.HTTPClient = s.httpClient
+
+	if e = openstack.Authenticate(provider, opts); e != nil {
+
 		l.Error.Printf("[COLLECTION] Error authenticating against OpenStack. Error: %v", e)
 
-		os.Exit(1)
+		return
 
 	}
 
-	client, e = openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
-		Region: cfg.OpenStack.Region,
+	s.client, e = openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: s.cfg.OpenStack.Region,
 	})
 
 	if e != nil {
 
 		l.Error.Printf("[COLLECTION] Error creating compute collector client. Error: %v", e)
 
-		os.Exit(1)
+		return
 
 	}
 
@@ -80,13 +82,15 @@ func collect() {
 	l.Trace.Printf("[COLLECTION] Querying events engine service for list of known and not terminated servers")
 
 	resourceType := "server"
-	eeParams := eeEvent.NewListStatesParams().WithResource(&resourceType).WithRegion(&cfg.OpenStack.Region)
+	eeParams := eeEvent.NewListStatesParams().WithResource(&resourceType).WithRegion(&s.cfg.OpenStack.Region)
+
+	eeCtx, cancel := context.WithTimeout(ctx, 300*time.Second)
+	defer cancel()
 
-	ctx, _ := context.WithTimeout(context.Background(), 300*time.Second)
-	r, e := reportClient.EventManagement.ListStates(ctx, eeParams)
+	r, e := s.reportClient.EventManagement.ListStates(eeCtx, eeParams)
 
-	// Clears the remotelist between runs
-	remotelist = nil
+	// Clears the s.remotelist between runs
+	s.remotelist = nil
 
 	if e != nil {
 
@@ -94,44 +98,51 @@ func collect() {
 
 	} else {
 
-		remotelist = r.Payload
+		s.remotelist = r.Payload
 
 	}
 
-	metricCount.With(prometheus.Labels{"type": "Total VMs from EventEngine"}).Set(float64(len(remotelist)))
+	s.metricCount.With(prometheus.Labels{"type": "Total VMs from EventEngine"}).Set(float64(len(s.remotelist)))
 
-	l.Trace.Printf("[COLLECTION] (BEFORE) Existing count of servers at remote [ %v ].\n", len(remotelist))
+	l.Trace.Printf("[COLLECTION] (BEFORE) Existing count of servers at remote [ %v ].\n", len(s.remotelist))
 
-	eeCount := len(remotelist)
+	eeCount := len(s.remotelist)
 
-	pager := servers.List(client, serveropts)
+	pager := servers.List(s.client, serveropts)
 
-	vmCount = 0
+	s.vmCount = 0
+	s.terminatedCount = 0
+	s.dropCount = 0
 
-	e = pager.EachPage(extractPage)
+	e = pager.EachPage(s.extractPage)
 
 	if e != nil {
 
 		l.Error.Printf("[COLLECTION] Error processing the lists of active resources. Error: %v\n", e)
 
-		os.Exit(1)
+		return
 
 	}
 
-	metricCount.With(prometheus.Labels{"type": "Total VMs reported by OS API"}).Set(float64(vmCount))
+	s.metricCount.With(prometheus.Labels{"type": "Total VMs reported by OS API"}).Set(float64(s.vmCount))
+
+	s.metricCount.With(prometheus.Labels{"type": "Total VMs reported by OS API (to terminated state)"}).Set(float64(s.terminatedCount))
+
+	s.metricCount.With(prometheus.Labels{"type": "Total VMs DROPPED due to unknown flavor"}).Set(float64(s.dropCount))
 
-	metricCount.With(prometheus.Labels{"type": "Total VMs reported by OS API (to terminated state)"}).Set(float64(terminatedCount))
+	l.Trace.Printf("[COLLECTION] (AFTER) Remaining count of servers at remote which were left unprocessed [ %v ].\n", len(s.remotelist))
 
-	metricCount.With(prometheus.Labels{"type": "Total VMs DROPPED due to unknown flavor"}).Set(float64(dropCount))
+	// The remaining servers are sent a terminated status, unless the safeguards
+	// against mass termination hold them back
+	terminations := s.safeguard.Guard(s.remotelist, eeCount, false)
 
-	l.Trace.Printf("[COLLECTION] (AFTER) Remaining count of servers at remote which were left unprocessed [ %v ].\n", len(remotelist))
+	for _, t := range terminations {
 
-	//now for all remaining servers send terminated status
-	for _, object := range remotelist {
+		object := t.Object
 
-		l.Trace.Printf("[COLLECTION] Sending terminated event for server [ %v ] for project [ %v ] with ID [ %v ].\n", object.ResourceID, object.ResourceName, object.Account)
+		l.Info.Printf("[COLLECTION] Sending terminated event for server [ %v ] for project [ %v ] with ID [ %v ]. Reason: %v.\n", object.ResourceID, object.ResourceName, object.Account, t.Reason)
 
-		evTime := int64(time.Now().Unix())
+		evTime := int64(s.fixtures.Now().Unix())
 		evLast := getStatus("terminated")
 
 		// events reports to be sent.
@@ -140,25 +151,23 @@ func collect() {
 			EventTime:    &evTime,
 			LastEvent:    &evLast,
 			MetaData:     object.MetaData,
-			Region:       cfg.OpenStack.Region,
+			Region:       s.cfg.OpenStack.Region,
 			ResourceID:   object.ResourceID,
 			ResourceName: object.ResourceName,
 			ResourceType: "server",
 		}
 
-		report(event)
+		s.report(event)
 
 	}
 
-	metricCount.With(prometheus.Labels{"type": "Total VMs forcefully TERMINATED"}).Set(float64(len(remotelist)))
+	s.metricCount.With(prometheus.Labels{"type": "Total VMs forcefully TERMINATED"}).Set(float64(len(terminations)))
 
-	metricTime.With(prometheus.Labels{"type": "Collection Processing Time"}).Set(float64(time.Now().UnixNano()-collectionStart) / float64(time.Millisecond))
-
-	l.Warning.Printf("[COLLECTION] Completed.\n - OS Report: %v\n - EE Report: %v\n - Droped: %v\n - OS Terminated: %v\n - Forced Termination: %v\n - Processing Time: %v[ms]\n", vmCount, eeCount, dropCount, terminatedCount, len(remotelist), float64(time.Now().UnixNano()-collectionStart)/float64(time.Millisecond))
+	l.Warning.Printf("[COLLECTION] Completed.\n - OS Report: %v\n - EE Report: %v\n - Droped: %v\n - OS Terminated: %v\n - Forced Termination: %v\n - Processing Time: %v[ms]\n", s.vmCount, eeCount, s.dropCount, s.terminatedCount, len(terminations), float64(time.Now().UnixNano()-s.collectionStart)/float64(time.Millisecond))
 
 	l.Trace.Printf("[COLLECTION] The collection process has been finished.\n")
 
-	return
+	return nil
 
 }
 
@@ -169,7 +178,7 @@ func collect() {
 // Returns:
 // - ok: a bool to mark the state of the processing.
 // - e: an error reference raised in case of something goes wrong.
-func extractPage(page pagination.Page) (ok bool, e error) {
+func (s *Source) extractPage(page pagination.Page) (ok bool, e error) {
 
 	var serverList []servers.Server
 
@@ -182,14 +191,14 @@ func extractPage(page pagination.Page) (ok bool, e error) {
 	}
 
 allProjectsLoop:
-	for _, s := range serverList {
+	for _, vm := range serverList {
 
 		// Filter by project id:
-		for _, filter := range cfg.ProjectFilters {
+		for _, filter := range s.cfg.ProjectFilters {
 
-			if strings.Contains(s.TenantID, filter) && filter != "" {
+			if strings.Contains(vm.TenantID, filter) && filter != "" {
 
-				l.Debug.Printf("[COLLECTION] The Project [ %v ] matches filter [ %v ] and won't be further processed.", s.TenantID, filter)
+				l.Debug.Printf("[COLLECTION] The Project [ %v ] matches filter [ %v ] and won't be further processed.", vm.TenantID, filter)
 
 				continue allProjectsLoop
 
@@ -198,11 +207,11 @@ allProjectsLoop:
 		}
 
 		// Filter by project name:
-		for _, filter := range cfg.NameFilters {
+		for _, filter := range s.cfg.NameFilters {
 
-			if strings.Contains(strings.ToLower(s.Name), strings.ToLower(filter)) && filter != "" {
+			if strings.Contains(strings.ToLower(vm.Name), strings.ToLower(filter)) && filter != "" {
 
-				l.Debug.Printf("[COLLECTION] The Project [ %v ] matches filter [ %v ] and won't be further processed.", s.Name, filter)
+				l.Debug.Printf("[COLLECTION] The Project [ %v ] matches filter [ %v ] and won't be further processed.", vm.Name, filter)
 
 				continue allProjectsLoop
 
@@ -210,12 +219,12 @@ allProjectsLoop:
 
 		}
 
-		vmCount++
+		s.vmCount++
 
 		// "s" will be a servers.Server
 		var imageid, flavorid, imagename, imageosflavor, flavorname string
 
-		for k, val := range s.Image {
+		for k, val := range vm.Image {
 
 			switch v := val.(type) {
 
@@ -231,7 +240,7 @@ allProjectsLoop:
 
 		}
 
-		for k, val := range s.Flavor {
+		for k, val := range vm.Flavor {
 
 			switch v := val.(type) {
 
@@ -247,9 +256,9 @@ allProjectsLoop:
 
 		}
 
-		l.Trace.Printf("%+v, %v", client, imageid)
+		l.Trace.Printf("%+v, %v", s.client, imageid)
 
-		imagename, imageosflavor, e := getFromImageCache(client, imageid)
+		imagename, imageosflavor, e := s.getFromImageCache(s.client, imageid)
 
 		if e != nil {
 
@@ -257,7 +266,7 @@ allProjectsLoop:
 
 		}
 
-		flavorname, e = getFromFlavorCache(client, flavorid)
+		flavorname, e = s.getFromFlavorCache(s.client, flavorid)
 
 		if e != nil {
 
@@ -268,16 +277,16 @@ allProjectsLoop:
 		if len(flavorname) == 0 {
 
 			l.Warning.Printf("[COLLECTION] Found VM - Name:[%s], TenantID:[%s], Status:[%s], ID:[%s], ImageID:[%s], ImageName:[%s], ImageOSFlavor:[%s], FlavorId:[%s], FlavorName:[%s] :: with missing FlavorName, skipping record!",
-				s.Name, s.TenantID, s.Status, s.ID, imageid, imagename, imageosflavor, flavorid, flavorname)
+				vm.Name, vm.TenantID, vm.Status, vm.ID, imageid, imagename, imageosflavor, flavorid, flavorname)
 
-			dropCount++
+			s.dropCount++
 
 			continue
 
 		}
 
 		l.Info.Printf("[COLLECTION] Found VM - Name:[%s], TenantID:[%s], Status:[%s], ID:[%s], ImageID:[%s], ImageName:[%s], ImageOSFlavor:[%s], FlavorId:[%s], FlavorName:[%s]",
-			s.Name, s.TenantID, s.Status, s.ID, imageid, imagename, imageosflavor, flavorid, flavorname)
+			vm.Name, vm.TenantID, vm.Status, vm.ID, imageid, imagename, imageosflavor, flavorid, flavorname)
 
 		// Potential problem with these filters are if clients create their
 		// VMs with the filter strings those will not be billed.
@@ -293,48 +302,54 @@ allProjectsLoop:
 		metadata["imageosflavor"] = imageosflavor
 		metadata["flavorid"] = flavorid
 		metadata["flavorname"] = flavorname
-		metadata["region"] = cfg.OpenStack.Region
+		metadata["region"] = s.cfg.OpenStack.Region
+
+		if tags := s.getTags(vm.Metadata); len(tags) > 0 {
+
+			metadata["tags"] = tags
+
+		}
 
 		// TODO: MAke more generic and customizable via config file
-		if value, exists := s.Metadata["schedule_frequency"]; exists && value == "never" {
+		if value, exists := vm.Metadata["schedule_frequency"]; exists && value == "never" {
 
 			metadata["PlanOverride"] = true
 
 		}
 
-		evTime := int64(time.Now().Unix())
-		evLast := getStatus(s.Status)
+		evTime := int64(s.fixtures.Now().Unix())
+		evLast := getStatus(vm.Status)
 
 		if evLast == "terminated" {
 
-			terminatedCount++
+			s.terminatedCount++
 
 		}
 
 		// events reports to be sent.
 		event := eeModels.Event{
-			Account:      s.TenantID,
+			Account:      vm.TenantID,
 			EventTime:    &evTime,
 			LastEvent:    &evLast,
 			MetaData:     metadata,
-			Region:       cfg.OpenStack.Region,
-			ResourceID:   s.ID,
-			ResourceName: s.Name,
+			Region:       s.cfg.OpenStack.Region,
+			ResourceID:   vm.ID,
+			ResourceName: vm.Name,
 			ResourceType: "server",
 		}
 
-		report(event)
+		s.report(event)
 
 		//if this object exists in remote list then lets remove it
-		for i, object := range remotelist {
+		for i, object := range s.remotelist {
 
-			if strings.Compare(object.Account, s.TenantID) == 0 &&
-				strings.Compare(object.ResourceID, s.ID) == 0 &&
-				strings.Compare(object.ResourceName, s.Name) == 0 {
+			if strings.Compare(object.Account, vm.TenantID) == 0 &&
+				strings.Compare(object.ResourceID, vm.ID) == 0 &&
+				strings.Compare(object.ResourceName, vm.Name) == 0 {
 
 				l.Debug.Printf("[COLLECTION] Event send cleaned from the processing list..\n")
 
-				remotelist = append(remotelist[:i], remotelist[i+1:]...)
+				s.remotelist = append(s.remotelist[:i], s.remotelist[i+1:]...)
 
 				break
 
@@ -350,6 +365,30 @@ allProjectsLoop:
 
 }
 
+// getTags job is to filter the tags of the resource against the configured
+// allowlist, so only the ones meant for cost allocation travel with the event.
+// Parameters:
+// - source: map with the tags/metadata of the resource in the system.
+// Returns:
+// - tags: JSONdb containing only the allowlisted tags.
+func (s *Source) getTags(source map[string]string) (tags datamodels.JSONdb) {
+
+	tags = make(datamodels.JSONdb)
+
+	for _, key := range s.cfg.TagAllowlist {
+
+		if value, exists := source[key]; exists && value != "" {
+
+			tags[key] = value
+
+		}
+
+	}
+
+	return
+
+}
+
 // getStatus job is to normalize the event state returned by the collectors.
 // Parameters:
 // - state: string returned by the system.
@@ -0,0 +1,90 @@
+package servers
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/GoDieNow/TFT_Code/collectors/collector"
+	eeClient "github.com/GoDieNow/TFT_Code/services/eventsengine/client"
+	eeModels "github.com/GoDieNow/TFT_Code/services/eventsengine/models"
+)
+
+// Source collects the servers of OpenStack as events for the EventsEngine.
+// Every source keeps its own clients, caches and collection state, so it can
+// be registered more than once in the same collector.
+type Source struct {
+	cfg          *collector.Configuration
+	fixtures     *collector.Fixtures
+	httpClient   http.Client
+	metricCount  *prometheus.GaugeVec
+	reportClient *eeClient.EventEngineManagementAPI
+	reports      []interface{}
+	reportsMutex sync.Mutex
+	safeguard    *collector.Safeguard
+
+	flavorCache map[string]FlavorIDCacheData
+	imageCache  map[string]ImageIDCacheData
+
+	client          *gophercloud.ServiceClient
+	collectionStart int64
+	dropCount       int
+	remotelist      []*eeModels.MinimalState
+	terminatedCount int
+	vmCount         int
+}
+
+// New creates the servers source to be run by the collector runtime.
+// Parameters:
+// - env: Env reference with the configuration and clients of the runtime.
+// Returns:
+// - s: the servers Source.
+// - e: error raised in case of something goes wrong.
+func New(env *collector.Env) (s collector.Source, e error) {
+
+	s = &Source{
+		cfg:          env.Config,
+		fixtures:     env.Fixtures,
+		httpClient:   env.HTTPClient,
+		metricCount:  env.MetricCount,
+		reportClient: env.EventsEngine,
+		safeguard:    collector.NewSafeguard(env),
+		flavorCache:  make(map[string]FlavorIDCacheData),
+		imageCache:   make(map[string]ImageIDCacheData),
+	}
+
+	return
+
+}
+
+// Collect retrieves the servers from OpenStack.
+// Parameters:
+// - ctx: context of the collection.
+// Returns:
+// - r: slice with the events to be reported.
+// - e: error raised in case of something goes wrong.
+func (s *Source) Collect(ctx context.Context) (r []interface{}, e error) {
+
+	s.reports = nil
+
+	e = s.collect(ctx)
+
+	r = s.reports
+
+	return
+
+}
+
+// report queues the event to be sent once the collection has finished.
+// Parameters:
+// - object: an interface{} reference with the event to be sent.
+func (s *Source) report(object interface{}) {
+
+	s.reportsMutex.Lock()
+	defer s.reportsMutex.Unlock()
+
+	s.reports = append(s.reports, object)
+
+}
@@ -13,6 +13,7 @@ import (
 	rtclient "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 
+	"github.com/GoDieNow/TFT_Code/services/billing/client/allocation_management"
 	"github.com/GoDieNow/TFT_Code/services/billing/client/bulk_management"
 	"github.com/GoDieNow/TFT_Code/services/billing/client/invoice_management"
 	"github.com/GoDieNow/TFT_Code/services/billing/client/status_management"
@@ -61,6 +62,7 @@ func New(c Config) *BillingManagementAPI {
 
 	cli := new(BillingManagementAPI)
 	cli.Transport = transport
+	cli.AllocationManagement = allocation_management.New(transport, strfmt.Default, c.AuthInfo)
 	cli.BulkManagement = bulk_management.New(transport, strfmt.Default, c.AuthInfo)
 	cli.InvoiceManagement = invoice_management.New(transport, strfmt.Default, c.AuthInfo)
 	cli.StatusManagement = status_management.New(transport, strfmt.Default, c.AuthInfo)
@@ -70,9 +72,10 @@ func New(c Config) *BillingManagementAPI {
 
 // BillingManagementAPI is a client for billing management API
 type BillingManagementAPI struct {
-	BulkManagement    *bulk_management.Client
-	InvoiceManagement *invoice_management.Client
-	StatusManagement  *status_management.Client
-	TriggerManagement *trigger_management.Client
-	Transport         runtime.ClientTransport
+	AllocationManagement *allocation_management.Client
+	BulkManagement       *bulk_management.Client
+	InvoiceManagement    *invoice_management.Client
+	StatusManagement     *status_management.Client
+	TriggerManagement    *trigger_management.Client
+	Transport            runtime.ClientTransport
 }
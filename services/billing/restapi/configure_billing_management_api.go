@@ -15,6 +15,7 @@ import (
 	"github.com/go-openapi/runtime/security"
 
 	"github.com/GoDieNow/TFT_Code/services/billing/restapi/operations"
+	"github.com/GoDieNow/TFT_Code/services/billing/restapi/operations/allocation_management"
 	"github.com/GoDieNow/TFT_Code/services/billing/restapi/operations/bulk_management"
 	"github.com/GoDieNow/TFT_Code/services/billing/restapi/operations/invoice_management"
 	"github.com/GoDieNow/TFT_Code/services/billing/restapi/operations/status_management"
@@ -25,6 +26,29 @@ type contextKey string
 
 const AuthKey contextKey = "Auth"
 
+//go:generate mockery -name AllocationManagementAPI -inpkg
+
+/* AllocationManagementAPI  */
+type AllocationManagementAPI interface {
+	/* CreateAllocationRule Creates a new allocation rule in the system */
+	CreateAllocationRule(ctx context.Context, params allocation_management.CreateAllocationRuleParams) middleware.Responder
+
+	/* GetAllocationRule Retrieve the allocation rule associated with the provided id */
+	GetAllocationRule(ctx context.Context, params allocation_management.GetAllocationRuleParams) middleware.Responder
+
+	/* GetInvoiceAllocation Allocation of the invoice lines to the cost centers defined by the rule */
+	GetInvoiceAllocation(ctx context.Context, params allocation_management.GetInvoiceAllocationParams) middleware.Responder
+
+	/* GetOrganizationAllocation Allocation of the CDRs of the organization to the cost centers defined by the rule */
+	GetOrganizationAllocation(ctx context.Context, params allocation_management.GetOrganizationAllocationParams) middleware.Responder
+
+	/* ListAllocationRules List of the allocation rules in the system */
+	ListAllocationRules(ctx context.Context, params allocation_management.ListAllocationRulesParams) middleware.Responder
+
+	/* UpdateAllocationRule Updates the allocation rule associated with the provided id */
+	UpdateAllocationRule(ctx context.Context, params allocation_management.UpdateAllocationRuleParams) middleware.Responder
+}
+
 //go:generate mockery -name BulkManagementAPI -inpkg
 
 /* BulkManagementAPI  */
@@ -95,6 +119,7 @@ type TriggerManagementAPI interface {
 
 // Config is configuration for Handler
 type Config struct {
+	AllocationManagementAPI
 	BulkManagementAPI
 	InvoiceManagementAPI
 	StatusManagementAPI
@@ -176,6 +201,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.BillingManagementAPIAPI, er
 		return c.AuthKeycloak(token, scopes)
 	}
 	api.APIAuthorizer = authorizer(c.Authorizer)
+	api.AllocationManagementCreateAllocationRuleHandler = allocation_management.CreateAllocationRuleHandlerFunc(func(params allocation_management.CreateAllocationRuleParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.AllocationManagementAPI.CreateAllocationRule(ctx, params)
+	})
 	api.InvoiceManagementGenerateInvoiceForCustomerHandler = invoice_management.GenerateInvoiceForCustomerHandlerFunc(func(params invoice_management.GenerateInvoiceForCustomerParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -186,6 +216,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.BillingManagementAPIAPI, er
 		ctx = storeAuth(ctx, principal)
 		return c.InvoiceManagementAPI.GenerateInvoiceForReseller(ctx, params)
 	})
+	api.AllocationManagementGetAllocationRuleHandler = allocation_management.GetAllocationRuleHandlerFunc(func(params allocation_management.GetAllocationRuleParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.AllocationManagementAPI.GetAllocationRule(ctx, params)
+	})
 	api.BulkManagementGetBillRunHandler = bulk_management.GetBillRunHandlerFunc(func(params bulk_management.GetBillRunParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -196,6 +231,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.BillingManagementAPIAPI, er
 		ctx = storeAuth(ctx, principal)
 		return c.InvoiceManagementAPI.GetInvoice(ctx, params)
 	})
+	api.AllocationManagementGetInvoiceAllocationHandler = allocation_management.GetInvoiceAllocationHandlerFunc(func(params allocation_management.GetInvoiceAllocationParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.AllocationManagementAPI.GetInvoiceAllocation(ctx, params)
+	})
 	api.InvoiceManagementGetInvoicesByCustomerHandler = invoice_management.GetInvoicesByCustomerHandlerFunc(func(params invoice_management.GetInvoicesByCustomerParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -206,6 +246,21 @@ func HandlerAPI(c Config) (http.Handler, *operations.BillingManagementAPIAPI, er
 		ctx = storeAuth(ctx, principal)
 		return c.InvoiceManagementAPI.GetInvoicesByReseller(ctx, params)
 	})
+	api.AllocationManagementGetOrganizationAllocationHandler = allocation_management.GetOrganizationAllocationHandlerFunc(func(params allocation_management.GetOrganizationAllocationParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.AllocationManagementAPI.GetOrganizationAllocation(ctx, params)
+	})
+	api.StatusManagementGetStatusHandler = status_management.GetStatusHandlerFunc(func(params status_management.GetStatusParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.StatusManagementAPI.GetStatus(ctx, params)
+	})
+	api.AllocationManagementListAllocationRulesHandler = allocation_management.ListAllocationRulesHandlerFunc(func(params allocation_management.ListAllocationRulesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.AllocationManagementAPI.ListAllocationRules(ctx, params)
+	})
 	api.BulkManagementListBillRunsHandler = bulk_management.ListBillRunsHandlerFunc(func(params bulk_management.ListBillRunsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -231,6 +286,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.BillingManagementAPIAPI, er
 		ctx = storeAuth(ctx, principal)
 		return c.InvoiceManagementAPI.ListResellerInvoices(ctx, params)
 	})
+	api.TriggerManagementPeriodicRunHandler = trigger_management.PeriodicRunHandlerFunc(func(params trigger_management.PeriodicRunParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.TriggerManagementAPI.PeriodicRun(ctx, params)
+	})
 	api.BulkManagementReRunAllBillRunsHandler = bulk_management.ReRunAllBillRunsHandlerFunc(func(params bulk_management.ReRunAllBillRunsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -241,20 +301,15 @@ func HandlerAPI(c Config) (http.Handler, *operations.BillingManagementAPIAPI, er
 		ctx = storeAuth(ctx, principal)
 		return c.BulkManagementAPI.ReRunBillRun(ctx, params)
 	})
-	api.StatusManagementGetStatusHandler = status_management.GetStatusHandlerFunc(func(params status_management.GetStatusParams, principal interface{}) middleware.Responder {
-		ctx := params.HTTPRequest.Context()
-		ctx = storeAuth(ctx, principal)
-		return c.StatusManagementAPI.GetStatus(ctx, params)
-	})
-	api.TriggerManagementPeriodicRunHandler = trigger_management.PeriodicRunHandlerFunc(func(params trigger_management.PeriodicRunParams, principal interface{}) middleware.Responder {
+	api.StatusManagementShowStatusHandler = status_management.ShowStatusHandlerFunc(func(params status_management.ShowStatusParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
-		return c.TriggerManagementAPI.PeriodicRun(ctx, params)
+		return c.StatusManagementAPI.ShowStatus(ctx, params)
 	})
-	api.StatusManagementShowStatusHandler = status_management.ShowStatusHandlerFunc(func(params status_management.ShowStatusParams, principal interface{}) middleware.Responder {
+	api.AllocationManagementUpdateAllocationRuleHandler = allocation_management.UpdateAllocationRuleHandlerFunc(func(params allocation_management.UpdateAllocationRuleParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
-		return c.StatusManagementAPI.ShowStatus(ctx, params)
+		return c.AllocationManagementAPI.UpdateAllocationRule(ctx, params)
 	})
 	api.ServerShutdown = func() {}
 	return api.Serve(c.InnerMiddleware), api, nil
@@ -38,7 +38,7 @@ func init() {
   "host": "localhost:8000",
   "basePath": "/api/v1.0",
   "paths": {
-    "/billrun": {
+    "/allocation/invoice/{id}": {
       "get": {
         "security": [
           {
@@ -57,26 +57,39 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "bulkManagement"
+          "allocationManagement"
         ],
-        "summary": "Show the status report of the billruns present in the system",
-        "operationId": "ListBillRuns",
+        "summary": "Allocation of the invoice lines to the cost centers defined by the rule",
+        "operationId": "GetInvoiceAllocation",
         "parameters": [
           {
-            "type": "integer",
-            "description": "Amount of months to have in the report",
-            "name": "months",
-            "in": "query"
+            "type": "string",
+            "format": "uuid",
+            "description": "Id of the invoice to be allocated",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Id of the allocation rule to be applied",
+            "name": "rule",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Description of a successfully operation",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BillRunList"
-              }
+              "$ref": "#/definitions/AllocationReport"
+            }
+          },
+          "404": {
+            "description": "The invoice or allocation rule id provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
@@ -86,8 +99,10 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/allocation/organization/{id}": {
+      "get": {
         "security": [
           {
             "Keycloak": [
@@ -101,34 +116,54 @@ func init() {
             "APIKeyParam": []
           }
         ],
-        "consumes": [
-          "application/json"
-        ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "bulkManagement"
+          "allocationManagement"
         ],
-        "summary": "Try to re-run the failed invoices in all the billruns.",
-        "operationId": "ReRunAllBillRuns",
+        "summary": "Allocation of the CDRs of the organization to the cost centers defined by the rule",
+        "operationId": "GetOrganizationAllocation",
         "parameters": [
           {
-            "type": "integer",
-            "description": "Amount of months to check for failed invoices.",
-            "name": "months",
+            "type": "string",
+            "description": "Id of the organization (reseller or customer) to be allocated",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Id of the allocation rule to be applied",
+            "name": "rule",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime from which to get the CDRs",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime until which to get the CDRs",
+            "name": "to",
             "in": "query"
           }
         ],
         "responses": {
-          "202": {
-            "description": "The request for processing had been added to the queue",
+          "200": {
+            "description": "Description of a successfully operation",
             "schema": {
-              "$ref": "#/definitions/ItemCreatedResponse"
+              "$ref": "#/definitions/AllocationReport"
             }
           },
           "404": {
-            "description": "The invoice id provided doesn't exist",
+            "description": "The allocation rule id provided doesn't exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -142,7 +177,7 @@ func init() {
         }
       }
     },
-    "/billrun/organization/{id}": {
+    "/allocation/rule": {
       "get": {
         "security": [
           {
@@ -161,32 +196,17 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "bulkManagement"
-        ],
-        "summary": "Show the status report of the billruns present in the system",
-        "operationId": "ListBillRunsByOrganization",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "Id of the billrun to be re-run.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "integer",
-            "description": "Amount of months to have in the report",
-            "name": "months",
-            "in": "query"
-          }
+          "allocationManagement"
         ],
+        "summary": "List of the allocation rules in the system",
+        "operationId": "ListAllocationRules",
         "responses": {
           "200": {
             "description": "Description of a successfully operation",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/BillRunList"
+                "$ref": "#/definitions/AllocationRule"
               }
             }
           },
@@ -197,9 +217,72 @@ func init() {
             }
           }
         }
+      },
+      "post": {
+        "security": [
+          {
+            "Keycloak": [
+              "admin"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "allocationManagement"
+        ],
+        "summary": "Creates a new allocation rule in the system",
+        "operationId": "CreateAllocationRule",
+        "parameters": [
+          {
+            "description": "Allocation rule to be added",
+            "name": "rule",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/AllocationRule"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "New allocation rule was added successfully",
+            "schema": {
+              "$ref": "#/definitions/ItemCreatedResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The allocation rule already exists in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
       }
     },
-    "/billrun/{id}": {
+    "/allocation/rule/{id}": {
       "get": {
         "security": [
           {
@@ -218,15 +301,15 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "bulkManagement"
+          "allocationManagement"
         ],
-        "summary": "Get the status report of the billrun requested",
-        "operationId": "GetBillRun",
+        "summary": "Retrieve the allocation rule associated with the provided id",
+        "operationId": "GetAllocationRule",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "Id of the invoice to be checked",
+            "description": "Id of the allocation rule to be retrieved",
             "name": "id",
             "in": "path",
             "required": true
@@ -236,11 +319,11 @@ func init() {
           "200": {
             "description": "Description of a successfully operation",
             "schema": {
-              "$ref": "#/definitions/BillRunReport"
+              "$ref": "#/definitions/AllocationRule"
             }
           },
           "404": {
-            "description": "The invoice id provided doesn't exist",
+            "description": "The allocation rule id provided doesn't exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -257,7 +340,7 @@ func init() {
         "security": [
           {
             "Keycloak": [
-              "user"
+              "admin"
             ]
           },
           {
@@ -274,29 +357,38 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "bulkManagement"
+          "allocationManagement"
         ],
-        "summary": "Try to re-run the failed invoices in the billrun.",
-        "operationId": "ReRunBillRun",
+        "summary": "Updates the allocation rule associated with the provided id",
+        "operationId": "UpdateAllocationRule",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "Id of the billrun to be re-run.",
+            "description": "Id of the allocation rule to be updated",
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "Allocation rule to be updated",
+            "name": "rule",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/AllocationRule"
+            }
           }
         ],
         "responses": {
-          "202": {
-            "description": "The request for processing had been added to the queue",
+          "200": {
+            "description": "Allocation rule with the id provided updated successfully",
             "schema": {
-              "$ref": "#/definitions/ItemCreatedResponse"
+              "$ref": "#/definitions/AllocationRule"
             }
           },
           "404": {
-            "description": "The invoice id provided doesn't exist",
+            "description": "The allocation rule id provided doesn't exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -310,7 +402,7 @@ func init() {
         }
       }
     },
-    "/invoice": {
+    "/billrun": {
       "get": {
         "security": [
           {
@@ -329,18 +421,16 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "invoiceManagement"
+          "bulkManagement"
         ],
-        "summary": "Summary for this endpoint",
-        "operationId": "ListInvoices",
+        "summary": "Show the status report of the billruns present in the system",
+        "operationId": "ListBillRuns",
         "parameters": [
           {
-            "description": "Invoice model partially filled to use for the filtering of the invoices",
-            "name": "model",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/Invoice"
-            }
+            "type": "integer",
+            "description": "Amount of months to have in the report",
+            "name": "months",
+            "in": "query"
           }
         ],
         "responses": {
@@ -349,7 +439,7 @@ func init() {
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/Invoice"
+                "$ref": "#/definitions/BillRunList"
               }
             }
           },
@@ -360,10 +450,8 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/invoice/customer": {
-      "get": {
+      },
+      "put": {
         "security": [
           {
             "Keycloak": [
@@ -377,22 +465,36 @@ func init() {
             "APIKeyParam": []
           }
         ],
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "invoiceManagement"
+          "bulkManagement"
+        ],
+        "summary": "Try to re-run the failed invoices in all the billruns.",
+        "operationId": "ReRunAllBillRuns",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "Amount of months to check for failed invoices.",
+            "name": "months",
+            "in": "query"
+          }
         ],
-        "summary": "Retrieve customers' invoices",
-        "operationId": "ListCustomerInvoices",
         "responses": {
-          "200": {
-            "description": "Description of a successfully operation",
+          "202": {
+            "description": "The request for processing had been added to the queue",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Invoice"
-              }
+              "$ref": "#/definitions/ItemCreatedResponse"
+            }
+          },
+          "404": {
+            "description": "The invoice id provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
@@ -404,7 +506,7 @@ func init() {
         }
       }
     },
-    "/invoice/customer/{id}": {
+    "/billrun/organization/{id}": {
       "get": {
         "security": [
           {
@@ -423,14 +525,14 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "invoiceManagement"
+          "bulkManagement"
         ],
-        "summary": "Retrieve invoices by customer id",
-        "operationId": "GetInvoicesByCustomer",
+        "summary": "Show the status report of the billruns present in the system",
+        "operationId": "ListBillRunsByOrganization",
         "parameters": [
           {
             "type": "string",
-            "description": "Id of the account to be checked",
+            "description": "Id of the billrun to be re-run.",
             "name": "id",
             "in": "path",
             "required": true
@@ -448,16 +550,10 @@ func init() {
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/Invoice"
+                "$ref": "#/definitions/BillRunList"
               }
             }
           },
-          "404": {
-            "description": "The invoice id provided doesn't exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "Something unexpected happend, error raised",
             "schema": {
@@ -465,12 +561,14 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/billrun/{id}": {
+      "get": {
         "security": [
           {
             "Keycloak": [
-              "admin"
+              "user"
             ]
           },
           {
@@ -480,38 +578,78 @@ func init() {
             "APIKeyParam": []
           }
         ],
-        "consumes": [
-          "application/json"
-        ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "invoiceManagement"
+          "bulkManagement"
         ],
-        "summary": "Generate invoice for the provided customer for the provided time window or last period",
-        "operationId": "GenerateInvoiceForCustomer",
+        "summary": "Get the status report of the billrun requested",
+        "operationId": "GetBillRun",
         "parameters": [
           {
             "type": "string",
-            "description": "Id of the account to be checked",
+            "format": "uuid",
+            "description": "Id of the invoice to be checked",
             "name": "id",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/BillRunReport"
+            }
+          },
+          "404": {
+            "description": "The invoice id provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
           {
-            "type": "string",
-            "format": "datetime",
-            "description": "Datetime from which to generate the invoice",
-            "name": "from",
-            "in": "query"
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
           },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "bulkManagement"
+        ],
+        "summary": "Try to re-run the failed invoices in the billrun.",
+        "operationId": "ReRunBillRun",
+        "parameters": [
           {
             "type": "string",
-            "format": "datetime",
-            "description": "Datetime until which to generate the invoice",
-            "name": "to",
-            "in": "query"
+            "format": "uuid",
+            "description": "Id of the billrun to be re-run.",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
@@ -521,8 +659,8 @@ func init() {
               "$ref": "#/definitions/ItemCreatedResponse"
             }
           },
-          "400": {
-            "description": "Invalid input, object invalid",
+          "404": {
+            "description": "The invoice id provided doesn't exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -536,7 +674,7 @@ func init() {
         }
       }
     },
-    "/invoice/reseller": {
+    "/invoice": {
       "get": {
         "security": [
           {
@@ -557,8 +695,18 @@ func init() {
         "tags": [
           "invoiceManagement"
         ],
-        "summary": "Retrieve resellers' invoices",
-        "operationId": "ListResellerInvoices",
+        "summary": "Summary for this endpoint",
+        "operationId": "ListInvoices",
+        "parameters": [
+          {
+            "description": "Invoice model partially filled to use for the filtering of the invoices",
+            "name": "model",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Invoice"
+            }
+          }
+        ],
         "responses": {
           "200": {
             "description": "Description of a successfully operation",
@@ -578,7 +726,7 @@ func init() {
         }
       }
     },
-    "/invoice/reseller/{id}": {
+    "/invoice/customer": {
       "get": {
         "security": [
           {
@@ -599,8 +747,50 @@ func init() {
         "tags": [
           "invoiceManagement"
         ],
-        "summary": "Retrieve invoices by reseller id",
-        "operationId": "GetInvoicesByReseller",
+        "summary": "Retrieve customers' invoices",
+        "operationId": "ListCustomerInvoices",
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Invoice"
+              }
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/invoice/customer/{id}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "invoiceManagement"
+        ],
+        "summary": "Retrieve invoices by customer id",
+        "operationId": "GetInvoicesByCustomer",
         "parameters": [
           {
             "type": "string",
@@ -663,8 +853,8 @@ func init() {
         "tags": [
           "invoiceManagement"
         ],
-        "summary": "Generate invoice for the provided reseller for the provided time window or last period",
-        "operationId": "GenerateInvoiceForReseller",
+        "summary": "Generate invoice for the provided customer for the provided time window or last period",
+        "operationId": "GenerateInvoiceForCustomer",
         "parameters": [
           {
             "type": "string",
@@ -710,7 +900,7 @@ func init() {
         }
       }
     },
-    "/invoice/{id}": {
+    "/invoice/reseller": {
       "get": {
         "security": [
           {
@@ -731,29 +921,16 @@ func init() {
         "tags": [
           "invoiceManagement"
         ],
-        "summary": "Summary for this endpoint",
-        "operationId": "GetInvoice",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Id of the invoice to be checked",
-            "name": "id",
-            "in": "path",
-            "required": true
-          }
-        ],
+        "summary": "Retrieve resellers' invoices",
+        "operationId": "ListResellerInvoices",
         "responses": {
           "200": {
             "description": "Description of a successfully operation",
             "schema": {
-              "$ref": "#/definitions/Invoice"
-            }
-          },
-          "404": {
-            "description": "The invoice id provided doesn't exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Invoice"
+              }
             }
           },
           "500": {
@@ -765,40 +942,7 @@ func init() {
         }
       }
     },
-    "/status": {
-      "get": {
-        "security": [
-          {
-            "Keycloak": [
-              "user"
-            ]
-          },
-          {
-            "APIKeyHeader": []
-          },
-          {
-            "APIKeyParam": []
-          }
-        ],
-        "produces": [
-          "application/json"
-        ],
-        "tags": [
-          "statusManagement"
-        ],
-        "summary": "Basic status of the system",
-        "operationId": "showStatus",
-        "responses": {
-          "200": {
-            "description": "Status information of the system",
-            "schema": {
-              "$ref": "#/definitions/Status"
-            }
-          }
-        }
-      }
-    },
-    "/status/{id}": {
+    "/invoice/reseller/{id}": {
       "get": {
         "security": [
           {
@@ -817,49 +961,54 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "statusManagement"
+          "invoiceManagement"
         ],
-        "summary": "Basic status of the system",
-        "operationId": "getStatus",
+        "summary": "Retrieve invoices by reseller id",
+        "operationId": "GetInvoicesByReseller",
         "parameters": [
           {
-            "enum": [
-              "kafka-receiver",
-              "kafka-sender",
-              "status",
-              "trigger",
-              "bulk",
-              "invoice"
-            ],
             "type": "string",
-            "description": "Id of the endpoint to be checked",
+            "description": "Id of the account to be checked",
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "integer",
+            "description": "Amount of months to have in the report",
+            "name": "months",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Status information of the system",
+            "description": "Description of a successfully operation",
             "schema": {
-              "$ref": "#/definitions/Status"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Invoice"
+              }
             }
           },
           "404": {
-            "description": "The endpoint provided doesn't exist",
+            "description": "The invoice id provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
         }
-      }
-    },
-    "/trigger/periodicrun": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
             "Keycloak": [
-              "user"
+              "admin"
             ]
           },
           {
@@ -869,23 +1018,238 @@ func init() {
             "APIKeyParam": []
           }
         ],
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "triggerManagement"
+          "invoiceManagement"
         ],
-        "summary": "Periodic run of the bulk generation of invoices",
-        "operationId": "periodicRun",
+        "summary": "Generate invoice for the provided reseller for the provided time window or last period",
+        "operationId": "GenerateInvoiceForReseller",
         "parameters": [
           {
             "type": "string",
-            "format": "datetime",
-            "description": "Datetime to override the time.now() to simulate other days",
-            "name": "today",
-            "in": "query"
-          }
-        ],
+            "description": "Id of the account to be checked",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime from which to generate the invoice",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime until which to generate the invoice",
+            "name": "to",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "The request for processing had been added to the queue",
+            "schema": {
+              "$ref": "#/definitions/ItemCreatedResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/invoice/{id}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "invoiceManagement"
+        ],
+        "summary": "Summary for this endpoint",
+        "operationId": "GetInvoice",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Id of the invoice to be checked",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/Invoice"
+            }
+          },
+          "404": {
+            "description": "The invoice id provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/status": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "statusManagement"
+        ],
+        "summary": "Basic status of the system",
+        "operationId": "showStatus",
+        "responses": {
+          "200": {
+            "description": "Status information of the system",
+            "schema": {
+              "$ref": "#/definitions/Status"
+            }
+          }
+        }
+      }
+    },
+    "/status/{id}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "statusManagement"
+        ],
+        "summary": "Basic status of the system",
+        "operationId": "getStatus",
+        "parameters": [
+          {
+            "enum": [
+              "kafka-receiver",
+              "kafka-sender",
+              "status",
+              "trigger",
+              "bulk",
+              "invoice"
+            ],
+            "type": "string",
+            "description": "Id of the endpoint to be checked",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Status information of the system",
+            "schema": {
+              "$ref": "#/definitions/Status"
+            }
+          },
+          "404": {
+            "description": "The endpoint provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/trigger/periodicrun": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "triggerManagement"
+        ],
+        "summary": "Periodic run of the bulk generation of invoices",
+        "operationId": "periodicRun",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime to override the time.now() to simulate other days",
+            "name": "today",
+            "in": "query"
+          }
+        ],
         "responses": {
           "202": {
             "description": "The request for processing the periodic run had been added to the queue",
@@ -898,46 +1262,145 @@ func init() {
     }
   },
   "definitions": {
-    "BillRun": {
+    "AllocationBucket": {
       "type": "object",
       "properties": {
-        "AmountInvoiced": {
+        "Cost": {
           "type": "number",
           "format": "double",
-          "default": 0,
-          "x-go-custom-tag": "gorm:\"type:numeric(23,13)\""
-        },
-        "CreationTimestamp": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+          "default": 0
         },
-        "ExecutionType": {
+        "Name": {
           "type": "string"
         },
-        "ID": {
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"type:uuid;primary_key;unique;default:md5(random()::text || clock_timestamp()::text)::uuid\""
-        },
-        "InvoicesCount": {
-          "type": "integer"
-        },
-        "InvoicesErrorCount": {
+        "ResourceCount": {
           "type": "integer"
         },
-        "InvoicesErrorList": {
-          "x-go-custom-tag": "gorm:\"type:text[]\"",
-          "$ref": "#/definitions/StringArray"
-        },
-        "InvoicesList": {
+        "Resources": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/InvoiceMetadata"
-          },
-          "x-go-custom-tag": "gorm:\"-\""
+            "type": "string"
+          }
+        }
+      }
+    },
+    "AllocationReport": {
+      "type": "object",
+      "properties": {
+        "Buckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AllocationBucket"
+          }
         },
-        "InvoicesProcessedCount": {
+        "Currency": {
+          "type": "string"
+        },
+        "OrganizationID": {
+          "type": "string"
+        },
+        "RuleID": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "Source": {
+          "type": "string",
+          "enum": [
+            "cdr",
+            "invoice"
+          ]
+        },
+        "TagKey": {
+          "type": "string"
+        },
+        "TimeFrom": {
+          "type": "string",
+          "format": "datetime"
+        },
+        "TimeTo": {
+          "type": "string",
+          "format": "datetime"
+        },
+        "Total": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        }
+      }
+    },
+    "AllocationRule": {
+      "type": "object",
+      "required": [
+        "Name",
+        "TagKey"
+      ],
+      "properties": {
+        "Description": {
+          "type": "string"
+        },
+        "ID": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"type:uuid;primary_key;unique;default:md5(random()::text || clock_timestamp()::text)::uuid\""
+        },
+        "Mapping": {
+          "x-go-custom-tag": "gorm:\"type:jsonb\"",
+          "$ref": "#/definitions/Metadata"
+        },
+        "Name": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"unique\""
+        },
+        "TagKey": {
+          "type": "string"
+        },
+        "UntaggedBucket": {
+          "type": "string",
+          "default": "untagged",
+          "x-go-custom-tag": "gorm:\"default:untagged\""
+        }
+      }
+    },
+    "BillRun": {
+      "type": "object",
+      "properties": {
+        "AmountInvoiced": {
+          "type": "number",
+          "format": "double",
+          "default": 0,
+          "x-go-custom-tag": "gorm:\"type:numeric(23,13)\""
+        },
+        "CreationTimestamp": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+        },
+        "ExecutionType": {
+          "type": "string"
+        },
+        "ID": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"type:uuid;primary_key;unique;default:md5(random()::text || clock_timestamp()::text)::uuid\""
+        },
+        "InvoicesCount": {
+          "type": "integer"
+        },
+        "InvoicesErrorCount": {
+          "type": "integer"
+        },
+        "InvoicesErrorList": {
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
+          "$ref": "#/definitions/StringArray"
+        },
+        "InvoicesList": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/InvoiceMetadata"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "InvoicesProcessedCount": {
           "type": "integer"
         },
         "OrganizationsInvolved": {
@@ -1255,91 +1718,459 @@ func init() {
         }
       }
     },
-    "StringArray": {
-      "x-go-type": {
-        "import": {
-          "package": "github.com/lib/pq"
-        },
-        "type": "StringArray"
-      }
-    }
-  },
-  "securityDefinitions": {
-    "APIKeyHeader": {
-      "type": "apiKey",
-      "name": "X-API-KEY",
-      "in": "header"
-    },
-    "APIKeyParam": {
-      "type": "apiKey",
-      "name": "api_key",
-      "in": "query"
-    },
-    "Keycloak": {
-      "type": "oauth2",
-      "flow": "accessCode",
-      "authorizationUrl": "http://localhost:8080/auth/realms/Dev/protocol/openid-connect/auth",
-      "tokenUrl": "http://localhost:8080/auth/realms/Dev/protocol/openid-connect/token",
-      "scopes": {
-        "admin": "Admin scope",
-        "user": "User scope"
+    "StringArray": {
+      "x-go-type": {
+        "import": {
+          "package": "github.com/lib/pq"
+        },
+        "type": "StringArray"
+      }
+    }
+  },
+  "securityDefinitions": {
+    "APIKeyHeader": {
+      "type": "apiKey",
+      "name": "X-API-KEY",
+      "in": "header"
+    },
+    "APIKeyParam": {
+      "type": "apiKey",
+      "name": "api_key",
+      "in": "query"
+    },
+    "Keycloak": {
+      "type": "oauth2",
+      "flow": "accessCode",
+      "authorizationUrl": "http://localhost:8080/auth/realms/Dev/protocol/openid-connect/auth",
+      "tokenUrl": "http://localhost:8080/auth/realms/Dev/protocol/openid-connect/token",
+      "scopes": {
+        "admin": "Admin scope",
+        "user": "User scope"
+      }
+    }
+  },
+  "security": [
+    {
+      "Keycloak": [
+        "user",
+        "admin"
+      ]
+    },
+    {
+      "APIKeyHeader": []
+    },
+    {
+      "APIKeyParam": []
+    }
+  ],
+  "tags": [
+    {
+      "description": "Actions relating to the reporting of the state of the service",
+      "name": "statusManagement"
+    },
+    {
+      "description": "Actions relating to the periodics actions to be triggered in the system",
+      "name": "triggerManagement"
+    },
+    {
+      "description": "Actions relating to the bulk generations/retrieval of invoices.",
+      "name": "bulkManagement"
+    },
+    {
+      "description": "Actions relating to the generation and retrieval of invoices.",
+      "name": "invoiceManagement"
+    },
+    {
+      "description": "Actions relating to the allocation of costs to cost centers based on the resources tags.",
+      "name": "allocationManagement"
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "An API which supports creation, deletion, listing etc of Billing",
+    "title": "Billing Management API",
+    "contact": {
+      "email": "diego@cyclops-labs.io"
+    },
+    "license": {
+      "name": "Apache 2.0",
+      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+    },
+    "version": "1.0.0"
+  },
+  "host": "localhost:8000",
+  "basePath": "/api/v1.0",
+  "paths": {
+    "/allocation/invoice/{id}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "allocationManagement"
+        ],
+        "summary": "Allocation of the invoice lines to the cost centers defined by the rule",
+        "operationId": "GetInvoiceAllocation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Id of the invoice to be allocated",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Id of the allocation rule to be applied",
+            "name": "rule",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/AllocationReport"
+            }
+          },
+          "404": {
+            "description": "The invoice or allocation rule id provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/allocation/organization/{id}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "allocationManagement"
+        ],
+        "summary": "Allocation of the CDRs of the organization to the cost centers defined by the rule",
+        "operationId": "GetOrganizationAllocation",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Id of the organization (reseller or customer) to be allocated",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Id of the allocation rule to be applied",
+            "name": "rule",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime from which to get the CDRs",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime until which to get the CDRs",
+            "name": "to",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/AllocationReport"
+            }
+          },
+          "404": {
+            "description": "The allocation rule id provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/allocation/rule": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "allocationManagement"
+        ],
+        "summary": "List of the allocation rules in the system",
+        "operationId": "ListAllocationRules",
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/AllocationRule"
+              }
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "Keycloak": [
+              "admin"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "allocationManagement"
+        ],
+        "summary": "Creates a new allocation rule in the system",
+        "operationId": "CreateAllocationRule",
+        "parameters": [
+          {
+            "description": "Allocation rule to be added",
+            "name": "rule",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/AllocationRule"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "New allocation rule was added successfully",
+            "schema": {
+              "$ref": "#/definitions/ItemCreatedResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The allocation rule already exists in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/allocation/rule/{id}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "allocationManagement"
+        ],
+        "summary": "Retrieve the allocation rule associated with the provided id",
+        "operationId": "GetAllocationRule",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Id of the allocation rule to be retrieved",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/AllocationRule"
+            }
+          },
+          "404": {
+            "description": "The allocation rule id provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "Keycloak": [
+              "admin"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "allocationManagement"
+        ],
+        "summary": "Updates the allocation rule associated with the provided id",
+        "operationId": "UpdateAllocationRule",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Id of the allocation rule to be updated",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Allocation rule to be updated",
+            "name": "rule",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/AllocationRule"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Allocation rule with the id provided updated successfully",
+            "schema": {
+              "$ref": "#/definitions/AllocationRule"
+            }
+          },
+          "404": {
+            "description": "The allocation rule id provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
       }
-    }
-  },
-  "security": [
-    {
-      "Keycloak": [
-        "user",
-        "admin"
-      ]
-    },
-    {
-      "APIKeyHeader": []
-    },
-    {
-      "APIKeyParam": []
-    }
-  ],
-  "tags": [
-    {
-      "description": "Actions relating to the reporting of the state of the service",
-      "name": "statusManagement"
-    },
-    {
-      "description": "Actions relating to the periodics actions to be triggered in the system",
-      "name": "triggerManagement"
-    },
-    {
-      "description": "Actions relating to the bulk generations/retrieval of invoices.",
-      "name": "bulkManagement"
-    },
-    {
-      "description": "Actions relating to the generation and retrieval of invoices.",
-      "name": "invoiceManagement"
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "schemes": [
-    "http",
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "An API which supports creation, deletion, listing etc of Billing",
-    "title": "Billing Management API",
-    "contact": {
-      "email": "diego@cyclops-labs.io"
     },
-    "license": {
-      "name": "Apache 2.0",
-      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
-    },
-    "version": "1.0.0"
-  },
-  "host": "localhost:8000",
-  "basePath": "/api/v1.0",
-  "paths": {
     "/billrun": {
       "get": {
         "security": [
@@ -2200,6 +3031,105 @@ func init() {
     }
   },
   "definitions": {
+    "AllocationBucket": {
+      "type": "object",
+      "properties": {
+        "Cost": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        },
+        "Name": {
+          "type": "string"
+        },
+        "ResourceCount": {
+          "type": "integer"
+        },
+        "Resources": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "AllocationReport": {
+      "type": "object",
+      "properties": {
+        "Buckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AllocationBucket"
+          }
+        },
+        "Currency": {
+          "type": "string"
+        },
+        "OrganizationID": {
+          "type": "string"
+        },
+        "RuleID": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "Source": {
+          "type": "string",
+          "enum": [
+            "cdr",
+            "invoice"
+          ]
+        },
+        "TagKey": {
+          "type": "string"
+        },
+        "TimeFrom": {
+          "type": "string",
+          "format": "datetime"
+        },
+        "TimeTo": {
+          "type": "string",
+          "format": "datetime"
+        },
+        "Total": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        }
+      }
+    },
+    "AllocationRule": {
+      "type": "object",
+      "required": [
+        "Name",
+        "TagKey"
+      ],
+      "properties": {
+        "Description": {
+          "type": "string"
+        },
+        "ID": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"type:uuid;primary_key;unique;default:md5(random()::text || clock_timestamp()::text)::uuid\""
+        },
+        "Mapping": {
+          "x-go-custom-tag": "gorm:\"type:jsonb\"",
+          "$ref": "#/definitions/Metadata"
+        },
+        "Name": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"unique\""
+        },
+        "TagKey": {
+          "type": "string"
+        },
+        "UntaggedBucket": {
+          "type": "string",
+          "default": "untagged",
+          "x-go-custom-tag": "gorm:\"default:untagged\""
+        }
+      }
+    },
     "BillRun": {
       "type": "object",
       "properties": {
@@ -2618,6 +3548,10 @@ func init() {
     {
       "description": "Actions relating to the generation and retrieval of invoices.",
       "name": "invoiceManagement"
+    },
+    {
+      "description": "Actions relating to the allocation of costs to cost centers based on the resources tags.",
+      "name": "allocationManagement"
     }
   ]
 }`))
@@ -19,6 +19,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/GoDieNow/TFT_Code/services/billing/restapi/operations/allocation_management"
 	"github.com/GoDieNow/TFT_Code/services/billing/restapi/operations/bulk_management"
 	"github.com/GoDieNow/TFT_Code/services/billing/restapi/operations/invoice_management"
 	"github.com/GoDieNow/TFT_Code/services/billing/restapi/operations/status_management"
@@ -47,24 +48,42 @@ func NewBillingManagementAPIAPI(spec *loads.Document) *BillingManagementAPIAPI {
 
 		JSONProducer: runtime.JSONProducer(),
 
+		AllocationManagementCreateAllocationRuleHandler: allocation_management.CreateAllocationRuleHandlerFunc(func(params allocation_management.CreateAllocationRuleParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation allocation_management.CreateAllocationRule has not yet been implemented")
+		}),
 		InvoiceManagementGenerateInvoiceForCustomerHandler: invoice_management.GenerateInvoiceForCustomerHandlerFunc(func(params invoice_management.GenerateInvoiceForCustomerParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation invoice_management.GenerateInvoiceForCustomer has not yet been implemented")
 		}),
 		InvoiceManagementGenerateInvoiceForResellerHandler: invoice_management.GenerateInvoiceForResellerHandlerFunc(func(params invoice_management.GenerateInvoiceForResellerParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation invoice_management.GenerateInvoiceForReseller has not yet been implemented")
 		}),
+		AllocationManagementGetAllocationRuleHandler: allocation_management.GetAllocationRuleHandlerFunc(func(params allocation_management.GetAllocationRuleParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation allocation_management.GetAllocationRule has not yet been implemented")
+		}),
 		BulkManagementGetBillRunHandler: bulk_management.GetBillRunHandlerFunc(func(params bulk_management.GetBillRunParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation bulk_management.GetBillRun has not yet been implemented")
 		}),
 		InvoiceManagementGetInvoiceHandler: invoice_management.GetInvoiceHandlerFunc(func(params invoice_management.GetInvoiceParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation invoice_management.GetInvoice has not yet been implemented")
 		}),
+		AllocationManagementGetInvoiceAllocationHandler: allocation_management.GetInvoiceAllocationHandlerFunc(func(params allocation_management.GetInvoiceAllocationParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation allocation_management.GetInvoiceAllocation has not yet been implemented")
+		}),
 		InvoiceManagementGetInvoicesByCustomerHandler: invoice_management.GetInvoicesByCustomerHandlerFunc(func(params invoice_management.GetInvoicesByCustomerParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation invoice_management.GetInvoicesByCustomer has not yet been implemented")
 		}),
 		InvoiceManagementGetInvoicesByResellerHandler: invoice_management.GetInvoicesByResellerHandlerFunc(func(params invoice_management.GetInvoicesByResellerParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation invoice_management.GetInvoicesByReseller has not yet been implemented")
 		}),
+		AllocationManagementGetOrganizationAllocationHandler: allocation_management.GetOrganizationAllocationHandlerFunc(func(params allocation_management.GetOrganizationAllocationParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation allocation_management.GetOrganizationAllocation has not yet been implemented")
+		}),
+		StatusManagementGetStatusHandler: status_management.GetStatusHandlerFunc(func(params status_management.GetStatusParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation status_management.GetStatus has not yet been implemented")
+		}),
+		AllocationManagementListAllocationRulesHandler: allocation_management.ListAllocationRulesHandlerFunc(func(params allocation_management.ListAllocationRulesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation allocation_management.ListAllocationRules has not yet been implemented")
+		}),
 		BulkManagementListBillRunsHandler: bulk_management.ListBillRunsHandlerFunc(func(params bulk_management.ListBillRunsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation bulk_management.ListBillRuns has not yet been implemented")
 		}),
@@ -80,21 +99,21 @@ func NewBillingManagementAPIAPI(spec *loads.Document) *BillingManagementAPIAPI {
 		InvoiceManagementListResellerInvoicesHandler: invoice_management.ListResellerInvoicesHandlerFunc(func(params invoice_management.ListResellerInvoicesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation invoice_management.ListResellerInvoices has not yet been implemented")
 		}),
+		TriggerManagementPeriodicRunHandler: trigger_management.PeriodicRunHandlerFunc(func(params trigger_management.PeriodicRunParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation trigger_management.PeriodicRun has not yet been implemented")
+		}),
 		BulkManagementReRunAllBillRunsHandler: bulk_management.ReRunAllBillRunsHandlerFunc(func(params bulk_management.ReRunAllBillRunsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation bulk_management.ReRunAllBillRuns has not yet been implemented")
 		}),
 		BulkManagementReRunBillRunHandler: bulk_management.ReRunBillRunHandlerFunc(func(params bulk_management.ReRunBillRunParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation bulk_management.ReRunBillRun has not yet been implemented")
 		}),
-		StatusManagementGetStatusHandler: status_management.GetStatusHandlerFunc(func(params status_management.GetStatusParams, principal interface{}) middleware.Responder {
-			return middleware.NotImplemented("operation status_management.GetStatus has not yet been implemented")
-		}),
-		TriggerManagementPeriodicRunHandler: trigger_management.PeriodicRunHandlerFunc(func(params trigger_management.PeriodicRunParams, principal interface{}) middleware.Responder {
-			return middleware.NotImplemented("operation trigger_management.PeriodicRun has not yet been implemented")
-		}),
 		StatusManagementShowStatusHandler: status_management.ShowStatusHandlerFunc(func(params status_management.ShowStatusParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation status_management.ShowStatus has not yet been implemented")
 		}),
+		AllocationManagementUpdateAllocationRuleHandler: allocation_management.UpdateAllocationRuleHandlerFunc(func(params allocation_management.UpdateAllocationRuleParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation allocation_management.UpdateAllocationRule has not yet been implemented")
+		}),
 
 		// Applies when the "X-API-KEY" header is set
 		APIKeyHeaderAuth: func(token string) (interface{}, error) {
@@ -158,18 +177,30 @@ type BillingManagementAPIAPI struct {
 	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
 	APIAuthorizer runtime.Authorizer
 
+	// AllocationManagementCreateAllocationRuleHandler sets the operation handler for the create allocation rule operation
+	AllocationManagementCreateAllocationRuleHandler allocation_management.CreateAllocationRuleHandler
 	// InvoiceManagementGenerateInvoiceForCustomerHandler sets the operation handler for the generate invoice for customer operation
 	InvoiceManagementGenerateInvoiceForCustomerHandler invoice_management.GenerateInvoiceForCustomerHandler
 	// InvoiceManagementGenerateInvoiceForResellerHandler sets the operation handler for the generate invoice for reseller operation
 	InvoiceManagementGenerateInvoiceForResellerHandler invoice_management.GenerateInvoiceForResellerHandler
+	// AllocationManagementGetAllocationRuleHandler sets the operation handler for the get allocation rule operation
+	AllocationManagementGetAllocationRuleHandler allocation_management.GetAllocationRuleHandler
 	// BulkManagementGetBillRunHandler sets the operation handler for the get bill run operation
 	BulkManagementGetBillRunHandler bulk_management.GetBillRunHandler
 	// InvoiceManagementGetInvoiceHandler sets the operation handler for the get invoice operation
 	InvoiceManagementGetInvoiceHandler invoice_management.GetInvoiceHandler
+	// AllocationManagementGetInvoiceAllocationHandler sets the operation handler for the get invoice allocation operation
+	AllocationManagementGetInvoiceAllocationHandler allocation_management.GetInvoiceAllocationHandler
 	// InvoiceManagementGetInvoicesByCustomerHandler sets the operation handler for the get invoices by customer operation
 	InvoiceManagementGetInvoicesByCustomerHandler invoice_management.GetInvoicesByCustomerHandler
 	// InvoiceManagementGetInvoicesByResellerHandler sets the operation handler for the get invoices by reseller operation
 	InvoiceManagementGetInvoicesByResellerHandler invoice_management.GetInvoicesByResellerHandler
+	// AllocationManagementGetOrganizationAllocationHandler sets the operation handler for the get organization allocation operation
+	AllocationManagementGetOrganizationAllocationHandler allocation_management.GetOrganizationAllocationHandler
+	// StatusManagementGetStatusHandler sets the operation handler for the get status operation
+	StatusManagementGetStatusHandler status_management.GetStatusHandler
+	// AllocationManagementListAllocationRulesHandler sets the operation handler for the list allocation rules operation
+	AllocationManagementListAllocationRulesHandler allocation_management.ListAllocationRulesHandler
 	// BulkManagementListBillRunsHandler sets the operation handler for the list bill runs operation
 	BulkManagementListBillRunsHandler bulk_management.ListBillRunsHandler
 	// BulkManagementListBillRunsByOrganizationHandler sets the operation handler for the list bill runs by organization operation
@@ -180,16 +211,16 @@ type BillingManagementAPIAPI struct {
 	InvoiceManagementListInvoicesHandler invoice_management.ListInvoicesHandler
 	// InvoiceManagementListResellerInvoicesHandler sets the operation handler for the list reseller invoices operation
 	InvoiceManagementListResellerInvoicesHandler invoice_management.ListResellerInvoicesHandler
+	// TriggerManagementPeriodicRunHandler sets the operation handler for the periodic run operation
+	TriggerManagementPeriodicRunHandler trigger_management.PeriodicRunHandler
 	// BulkManagementReRunAllBillRunsHandler sets the operation handler for the re run all bill runs operation
 	BulkManagementReRunAllBillRunsHandler bulk_management.ReRunAllBillRunsHandler
 	// BulkManagementReRunBillRunHandler sets the operation handler for the re run bill run operation
 	BulkManagementReRunBillRunHandler bulk_management.ReRunBillRunHandler
-	// StatusManagementGetStatusHandler sets the operation handler for the get status operation
-	StatusManagementGetStatusHandler status_management.GetStatusHandler
-	// TriggerManagementPeriodicRunHandler sets the operation handler for the periodic run operation
-	TriggerManagementPeriodicRunHandler trigger_management.PeriodicRunHandler
 	// StatusManagementShowStatusHandler sets the operation handler for the show status operation
 	StatusManagementShowStatusHandler status_management.ShowStatusHandler
+	// AllocationManagementUpdateAllocationRuleHandler sets the operation handler for the update allocation rule operation
+	AllocationManagementUpdateAllocationRuleHandler allocation_management.UpdateAllocationRuleHandler
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
 	ServeError func(http.ResponseWriter, *http.Request, error)
@@ -276,24 +307,42 @@ func (o *BillingManagementAPIAPI) Validate() error {
 		unregistered = append(unregistered, "KeycloakAuth")
 	}
 
+	if o.AllocationManagementCreateAllocationRuleHandler == nil {
+		unregistered = append(unregistered, "allocation_management.CreateAllocationRuleHandler")
+	}
 	if o.InvoiceManagementGenerateInvoiceForCustomerHandler == nil {
 		unregistered = append(unregistered, "invoice_management.GenerateInvoiceForCustomerHandler")
 	}
 	if o.InvoiceManagementGenerateInvoiceForResellerHandler == nil {
 		unregistered = append(unregistered, "invoice_management.GenerateInvoiceForResellerHandler")
 	}
+	if o.AllocationManagementGetAllocationRuleHandler == nil {
+		unregistered = append(unregistered, "allocation_management.GetAllocationRuleHandler")
+	}
 	if o.BulkManagementGetBillRunHandler == nil {
 		unregistered = append(unregistered, "bulk_management.GetBillRunHandler")
 	}
 	if o.InvoiceManagementGetInvoiceHandler == nil {
 		unregistered = append(unregistered, "invoice_management.GetInvoiceHandler")
 	}
+	if o.AllocationManagementGetInvoiceAllocationHandler == nil {
+		unregistered = append(unregistered, "allocation_management.GetInvoiceAllocationHandler")
+	}
 	if o.InvoiceManagementGetInvoicesByCustomerHandler == nil {
 		unregistered = append(unregistered, "invoice_management.GetInvoicesByCustomerHandler")
 	}
 	if o.InvoiceManagementGetInvoicesByResellerHandler == nil {
 		unregistered = append(unregistered, "invoice_management.GetInvoicesByResellerHandler")
 	}
+	if o.AllocationManagementGetOrganizationAllocationHandler == nil {
+		unregistered = append(unregistered, "allocation_management.GetOrganizationAllocationHandler")
+	}
+	if o.StatusManagementGetStatusHandler == nil {
+		unregistered = append(unregistered, "status_management.GetStatusHandler")
+	}
+	if o.AllocationManagementListAllocationRulesHandler == nil {
+		unregistered = append(unregistered, "allocation_management.ListAllocationRulesHandler")
+	}
 	if o.BulkManagementListBillRunsHandler == nil {
 		unregistered = append(unregistered, "bulk_management.ListBillRunsHandler")
 	}
@@ -309,21 +358,21 @@ func (o *BillingManagementAPIAPI) Validate() error {
 	if o.InvoiceManagementListResellerInvoicesHandler == nil {
 		unregistered = append(unregistered, "invoice_management.ListResellerInvoicesHandler")
 	}
+	if o.TriggerManagementPeriodicRunHandler == nil {
+		unregistered = append(unregistered, "trigger_management.PeriodicRunHandler")
+	}
 	if o.BulkManagementReRunAllBillRunsHandler == nil {
 		unregistered = append(unregistered, "bulk_management.ReRunAllBillRunsHandler")
 	}
 	if o.BulkManagementReRunBillRunHandler == nil {
 		unregistered = append(unregistered, "bulk_management.ReRunBillRunHandler")
 	}
-	if o.StatusManagementGetStatusHandler == nil {
-		unregistered = append(unregistered, "status_management.GetStatusHandler")
-	}
-	if o.TriggerManagementPeriodicRunHandler == nil {
-		unregistered = append(unregistered, "trigger_management.PeriodicRunHandler")
-	}
 	if o.StatusManagementShowStatusHandler == nil {
 		unregistered = append(unregistered, "status_management.ShowStatusHandler")
 	}
+	if o.AllocationManagementUpdateAllocationRuleHandler == nil {
+		unregistered = append(unregistered, "allocation_management.UpdateAllocationRuleHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -428,6 +477,10 @@ func (o *BillingManagementAPIAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/allocation/rule"] = allocation_management.NewCreateAllocationRule(o.context, o.AllocationManagementCreateAllocationRuleHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -439,6 +492,10 @@ func (o *BillingManagementAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/allocation/rule/{id}"] = allocation_management.NewGetAllocationRule(o.context, o.AllocationManagementGetAllocationRuleHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/billrun/{id}"] = bulk_management.NewGetBillRun(o.context, o.BulkManagementGetBillRunHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -447,6 +504,10 @@ func (o *BillingManagementAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/allocation/invoice/{id}"] = allocation_management.NewGetInvoiceAllocation(o.context, o.AllocationManagementGetInvoiceAllocationHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/invoice/customer/{id}"] = invoice_management.NewGetInvoicesByCustomer(o.context, o.InvoiceManagementGetInvoicesByCustomerHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -455,6 +516,18 @@ func (o *BillingManagementAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/allocation/organization/{id}"] = allocation_management.NewGetOrganizationAllocation(o.context, o.AllocationManagementGetOrganizationAllocationHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/status/{id}"] = status_management.NewGetStatus(o.context, o.StatusManagementGetStatusHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/allocation/rule"] = allocation_management.NewListAllocationRules(o.context, o.AllocationManagementListAllocationRulesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/billrun"] = bulk_management.NewListBillRuns(o.context, o.BulkManagementListBillRunsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -472,6 +545,10 @@ func (o *BillingManagementAPIAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/invoice/reseller"] = invoice_management.NewListResellerInvoices(o.context, o.InvoiceManagementListResellerInvoicesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/trigger/periodicrun"] = trigger_management.NewPeriodicRun(o.context, o.TriggerManagementPeriodicRunHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -483,15 +560,11 @@ func (o *BillingManagementAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
-	o.handlers["GET"]["/status/{id}"] = status_management.NewGetStatus(o.context, o.StatusManagementGetStatusHandler)
-	if o.handlers["GET"] == nil {
-		o.handlers["GET"] = make(map[string]http.Handler)
-	}
-	o.handlers["GET"]["/trigger/periodicrun"] = trigger_management.NewPeriodicRun(o.context, o.TriggerManagementPeriodicRunHandler)
-	if o.handlers["GET"] == nil {
-		o.handlers["GET"] = make(map[string]http.Handler)
-	}
 	o.handlers["GET"]["/status"] = status_management.NewShowStatus(o.context, o.StatusManagementShowStatusHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/allocation/rule/{id}"] = allocation_management.NewUpdateAllocationRule(o.context, o.AllocationManagementUpdateAllocationRuleHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -0,0 +1,374 @@
+package allocationManager
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/GoDieNow/TFT_Code/services/billing/models"
+	"github.com/GoDieNow/TFT_Code/services/billing/restapi/operations/allocation_management"
+	"github.com/GoDieNow/TFT_Code/services/billing/server/dbManager"
+	"github.com/GoDieNow/TFT_Code/services/billing/server/statusManager"
+	l "gitlab.com/cyclops-utilities/logging"
+)
+
+// AllocationManager is the struct defined to group and contain all the methods
+// that interact with the cost allocation subsystem.
+// Parameters:
+// - db: a DbParameter reference to be able to use the DBManager methods.
+// - monit: a StatusManager reference to be able to use the status subsystem methods.
+// - BasePath: a string with the base path of the system.
+type AllocationManager struct {
+	db       *dbManager.DbParameter
+	monit    *statusManager.StatusManager
+	BasePath string
+}
+
+// New is the function to create the struct AllocationManager.
+// Parameters:
+// - DbParameter: reference pointing to the DbParameter that allows the interaction
+// with the DBManager methods.
+// - StatusParameter: reference poining to the StatusManager that allows the
+// interaction with the StatusManager methods.
+// - bp: a string containing the base path of the service.
+// Returns:
+// - AllocationManager: struct to interact with AllocationManager subsystem functionalities.
+func New(db *dbManager.DbParameter, monit *statusManager.StatusManager, bp string) *AllocationManager {
+
+	l.Trace.Printf("[AllocationManager] Generating new allocationManager.\n")
+
+	monit.InitEndpoint("allocation")
+
+	return &AllocationManager{
+		db:       db,
+		monit:    monit,
+		BasePath: bp,
+	}
+
+}
+
+func (m *AllocationManager) getToken(param *http.Request) (token string) {
+
+	if len(param.Header.Get("Authorization")) > 0 {
+
+		token = strings.Fields(param.Header.Get("Authorization"))[1]
+
+	}
+
+	return
+
+}
+
+// CreateAllocationRule (Swagger func) is the function behind the (POST) endpoint
+// /allocation/rule
+// Its job is to add a new cost allocation rule to the system.
+func (m *AllocationManager) CreateAllocationRule(ctx context.Context, params allocation_management.CreateAllocationRuleParams) middleware.Responder {
+
+	l.Trace.Printf("[AllocationManager] CreateAllocationRule endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("allocation", callTime)
+
+	id, state, e := m.db.AddAllocationRule(params.Rule)
+
+	if state == dbManager.StatusDuplicated {
+
+		s := "The allocation rule already exists in the system."
+		conflictReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "409", "method": "POST", "route": "/allocation/rule"}).Inc()
+
+		m.monit.APIHitDone("allocation", callTime)
+
+		return allocation_management.NewCreateAllocationRuleConflict().WithPayload(&conflictReturn)
+
+	}
+
+	if e != nil {
+
+		s := "Problem creating the new allocation rule: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "POST", "route": "/allocation/rule"}).Inc()
+
+		m.monit.APIHitDone("allocation", callTime)
+
+		return allocation_management.NewCreateAllocationRuleInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	createdReturn := models.ItemCreatedResponse{
+		APILink: m.BasePath + "/allocation/rule/" + string(id),
+		Message: "The allocation rule has been added to the system",
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "201", "method": "POST", "route": "/allocation/rule"}).Inc()
+
+	m.monit.APIHitDone("allocation", callTime)
+
+	return allocation_management.NewCreateAllocationRuleCreated().WithPayload(&createdReturn)
+
+}
+
+// GetAllocationRule (Swagger func) is the function behind the (GET) endpoint
+// /allocation/rule/{id}
+// Its job is to retrieve the allocation rule associated with the provided ID.
+func (m *AllocationManager) GetAllocationRule(ctx context.Context, params allocation_management.GetAllocationRuleParams) middleware.Responder {
+
+	l.Trace.Printf("[AllocationManager] GetAllocationRule endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("allocation", callTime)
+
+	rule, e := m.db.GetAllocationRule(params.ID)
+
+	if e != nil {
+
+		s := "Problem while retrieving the allocation rule from the db: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/allocation/rule/" + string(params.ID)}).Inc()
+
+		m.monit.APIHitDone("allocation", callTime)
+
+		return allocation_management.NewGetAllocationRuleInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	if rule == nil {
+
+		s := "The allocation rule doesn't exists in the system."
+		missingReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "404", "method": "GET", "route": "/allocation/rule/" + string(params.ID)}).Inc()
+
+		m.monit.APIHitDone("allocation", callTime)
+
+		return allocation_management.NewGetAllocationRuleNotFound().WithPayload(&missingReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/allocation/rule/" + string(params.ID)}).Inc()
+
+	m.monit.APIHitDone("allocation", callTime)
+
+	return allocation_management.NewGetAllocationRuleOK().WithPayload(rule)
+
+}
+
+// GetInvoiceAllocation (Swagger func) is the function behind the (GET) endpoint
+// /allocation/invoice/{id}
+// Its job is to split the costs of the invoice associated with the provided ID
+// following the requested allocation rule.
+func (m *AllocationManager) GetInvoiceAllocation(ctx context.Context, params allocation_management.GetInvoiceAllocationParams) middleware.Responder {
+
+	l.Trace.Printf("[AllocationManager] GetInvoiceAllocation endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("allocation", callTime)
+
+	report, state, e := m.db.AllocateInvoice(params.ID, params.Rule)
+
+	if state == dbManager.StatusMissing {
+
+		s := "The invoice or the allocation rule doesn't exists in the system."
+		missingReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "404", "method": "GET", "route": "/allocation/invoice/" + string(params.ID)}).Inc()
+
+		m.monit.APIHitDone("allocation", callTime)
+
+		return allocation_management.NewGetInvoiceAllocationNotFound().WithPayload(&missingReturn)
+
+	}
+
+	if e != nil {
+
+		s := "Problem while allocating the invoice: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/allocation/invoice/" + string(params.ID)}).Inc()
+
+		m.monit.APIHitDone("allocation", callTime)
+
+		return allocation_management.NewGetInvoiceAllocationInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/allocation/invoice/" + string(params.ID)}).Inc()
+
+	m.monit.APIHitDone("allocation", callTime)
+
+	return allocation_management.NewGetInvoiceAllocationOK().WithPayload(report)
+
+}
+
+// GetOrganizationAllocation (Swagger func) is the function behind the (GET)
+// endpoint /allocation/organization/{id}
+// Its job is to split the costs present in the CDRs of the organization within
+// the optional declared time-window following the requested allocation rule.
+func (m *AllocationManager) GetOrganizationAllocation(ctx context.Context, params allocation_management.GetOrganizationAllocationParams) middleware.Responder {
+
+	l.Trace.Printf("[AllocationManager] GetOrganizationAllocation endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("allocation", callTime)
+
+	token := m.getToken(params.HTTPRequest)
+
+	report, state, e := m.db.AllocateOrganization(params.ID, params.Rule, token, params.From, params.To)
+
+	if state == dbManager.StatusMissing {
+
+		s := "The organization or the allocation rule doesn't exists in the system."
+		missingReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "404", "method": "GET", "route": "/allocation/organization/" + params.ID}).Inc()
+
+		m.monit.APIHitDone("allocation", callTime)
+
+		return allocation_management.NewGetOrganizationAllocationNotFound().WithPayload(&missingReturn)
+
+	}
+
+	if e != nil {
+
+		s := "Problem while allocating the costs of the organization: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/allocation/organization/" + params.ID}).Inc()
+
+		m.monit.APIHitDone("allocation", callTime)
+
+		return allocation_management.NewGetOrganizationAllocationInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/allocation/organization/" + params.ID}).Inc()
+
+	m.monit.APIHitDone("allocation", callTime)
+
+	return allocation_management.NewGetOrganizationAllocationOK().WithPayload(report)
+
+}
+
+// ListAllocationRules (Swagger func) is the function behind the (GET) endpoint
+// /allocation/rule
+// Its job is to list all the allocation rules in the system.
+func (m *AllocationManager) ListAllocationRules(ctx context.Context, params allocation_management.ListAllocationRulesParams) middleware.Responder {
+
+	l.Trace.Printf("[AllocationManager] ListAllocationRules endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("allocation", callTime)
+
+	rules, e := m.db.ListAllocationRules()
+
+	if e != nil {
+
+		s := "Problem while retrieving the allocation rules from the db: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/allocation/rule"}).Inc()
+
+		m.monit.APIHitDone("allocation", callTime)
+
+		return allocation_management.NewListAllocationRulesInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/allocation/rule"}).Inc()
+
+	m.monit.APIHitDone("allocation", callTime)
+
+	return allocation_management.NewListAllocationRulesOK().WithPayload(rules)
+
+}
+
+// UpdateAllocationRule (Swagger func) is the function behind the (PUT) endpoint
+// /allocation/rule/{id}
+// Its job is to update the allocation rule associated with the provided ID.
+func (m *AllocationManager) UpdateAllocationRule(ctx context.Context, params allocation_management.UpdateAllocationRuleParams) middleware.Responder {
+
+	l.Trace.Printf("[AllocationManager] UpdateAllocationRule endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("allocation", callTime)
+
+	state, e := m.db.UpdateAllocationRule(params.ID, params.Rule)
+
+	if state == dbManager.StatusMissing {
+
+		s := "The allocation rule doesn't exists in the system."
+		missingReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "404", "method": "PUT", "route": "/allocation/rule/" + string(params.ID)}).Inc()
+
+		m.monit.APIHitDone("allocation", callTime)
+
+		return allocation_management.NewUpdateAllocationRuleNotFound().WithPayload(&missingReturn)
+
+	}
+
+	if e != nil {
+
+		s := "Problem while updating the allocation rule: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "PUT", "route": "/allocation/rule/" + string(params.ID)}).Inc()
+
+		m.monit.APIHitDone("allocation", callTime)
+
+		return allocation_management.NewUpdateAllocationRuleInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	rule, e := m.db.GetAllocationRule(params.ID)
+
+	if e != nil {
+
+		s := "Problem while retrieving the updated allocation rule from the db: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "PUT", "route": "/allocation/rule/" + string(params.ID)}).Inc()
+
+		m.monit.APIHitDone("allocation", callTime)
+
+		return allocation_management.NewUpdateAllocationRuleInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "PUT", "route": "/allocation/rule/" + string(params.ID)}).Inc()
+
+	m.monit.APIHitDone("allocation", callTime)
+
+	return allocation_management.NewUpdateAllocationRuleOK().WithPayload(rule)
+
+}
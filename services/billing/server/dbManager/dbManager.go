@@ -7,6 +7,7 @@ import (
 	"math"
 	"reflect"
 	"runtime"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -2032,7 +2033,7 @@ func (d *DbParameter) getCDRS(orgID, token string) (ty, cdrs string, err error)
 
 		for j := range customer.Products {
 
-			if cdrs != "" {
+			if cdrs == "" {
 
 				cdrs = string(customer.Products[j].ProductID)
 
@@ -2155,3 +2156,553 @@ func (d *DbParameter) ReRunBillRun(id strfmt.UUID, months *int64, token string)
 	return
 
 }
+
+// AddAllocationRule job is to register a new cost allocation rule in the
+// system.
+// Parameters:
+// - r: a reference to the AllocationRule model containing the new rule.
+// Returns:
+// - id: a UUID string with the ID of the rule just added to the db.
+// - status: an int for informing about the status of the operation.
+// - e: an error raised in case of problems with the operation.
+func (d *DbParameter) AddAllocationRule(r *models.AllocationRule) (id strfmt.UUID, status int, e error) {
+
+	l.Trace.Printf("[DB] Attempting to add the allocation rule [ %v ] now.\n", *r.Name)
+
+	var r0 models.AllocationRule
+
+	if e = d.Db.Where(&models.AllocationRule{Name: r.Name}).First(&r0).Error; errors.Is(e, gorm.ErrRecordNotFound) {
+
+		if e = d.Db.Create(r).Error; e == nil {
+
+			l.Info.Printf("[DB] Inserted new record for allocation rule [ %v ] successfully.\n", *r.Name)
+
+			d.Metrics["count"].With(prometheus.Labels{"type": "Allocation rules added"}).Inc()
+
+			status = StatusOK
+			id = r.ID
+
+		} else {
+
+			l.Warning.Printf("[DB] Unable to insert the record for allocation rule [ %v ], check with administrator. Error: %v\n", *r.Name, e)
+
+			status = StatusFail
+
+		}
+
+	} else {
+
+		l.Warning.Printf("[DB] Record for allocation rule [ %v ] already exists, check with administrator.\n", *r.Name)
+
+		e = nil
+		status = StatusDuplicated
+
+	}
+
+	return
+
+}
+
+// GetAllocationRule job is to retrieve from the system the allocation rule
+// associated with the provided ID.
+// Parameters:
+// - id: a UUID string with the associated ID to the rule requested.
+// Returns:
+// - the allocation rule associated to the provided ID, nil if it doesn't exist.
+// - e in case of any error happening.
+func (d *DbParameter) GetAllocationRule(id strfmt.UUID) (*models.AllocationRule, error) {
+
+	l.Trace.Printf("[DB] Attempting to retrieve the allocation rule [ %v ].\n", id)
+
+	var o models.AllocationRule
+	var e error
+
+	e = d.Db.Where(&models.AllocationRule{ID: id}).First(&o).Error
+
+	if errors.Is(e, gorm.ErrRecordNotFound) {
+
+		l.Warning.Printf("[DB] The allocation rule [ %v ] doesn't exist in the system.\n", id)
+
+		return nil, nil
+
+	}
+
+	if e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while retrieving the allocation rule [ %v ]. Error: %v\n", id, e)
+
+	} else {
+
+		l.Debug.Printf("[DB] Allocation rule [ %v ] retrieved from the system.\n", id)
+
+	}
+
+	return &o, e
+
+}
+
+// ListAllocationRules job is to retrieve from the system all the allocation
+// rules registered.
+// Returns:
+// - o: slice of AllocationRule containing the rules in the system.
+// - e in case of any error happening.
+func (d *DbParameter) ListAllocationRules() (o []*models.AllocationRule, e error) {
+
+	l.Trace.Printf("[DB] Attempting to retrieve the allocation rules in the system.\n")
+
+	if e = d.Db.Find(&o).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while retrieving the allocation rules. Error: %v\n", e)
+
+	} else {
+
+		l.Debug.Printf("[DB] [ %v ] allocation rules retrieved from the system.\n", len(o))
+
+	}
+
+	return
+
+}
+
+// UpdateAllocationRule job is to update the allocation rule linked to the
+// provided ID with the provided new data.
+// Parameters:
+// - id: a UUID string with the associated ID to the rule to be updated.
+// - r: a reference to the AllocationRule model containing the new data.
+// Returns:
+// - status: an int for informing about the status of the operation.
+// - e: an error raised in case of problems with the operation.
+func (d *DbParameter) UpdateAllocationRule(id strfmt.UUID, r *models.AllocationRule) (status int, e error) {
+
+	l.Trace.Printf("[DB] Attempting to update the allocation rule [ %v ] now.\n", id)
+
+	var r0 models.AllocationRule
+
+	if e = d.Db.Where(&models.AllocationRule{ID: id}).First(&r0).Error; !errors.Is(e, gorm.ErrRecordNotFound) {
+
+		r.ID = id
+
+		if e = d.Db.Model(&r0).Updates(r).Error; e == nil {
+
+			l.Info.Printf("[DB] Updated record for allocation rule [ %v ] successfully.\n", id)
+
+			d.Metrics["count"].With(prometheus.Labels{"type": "Allocation rules updated"}).Inc()
+
+			status = StatusOK
+
+		} else {
+
+			l.Warning.Printf("[DB] Unable to update record for allocation rule [ %v ], check with administrator. Error: %v\n", id, e)
+
+			status = StatusFail
+
+		}
+
+	} else {
+
+		l.Warning.Printf("[DB] Record for allocation rule [ %v ] not found, check with administrator.\n", id)
+
+		e = nil
+		status = StatusMissing
+
+	}
+
+	return
+
+}
+
+// AllocateInvoice job is to split the cost of an already generated invoice
+// into buckets (cost centers) following the provided allocation rule.
+// Every resource in the invoice is assigned to the bucket matching the value
+// of its rule's tag, with the invoice discount already applied.
+// Parameters:
+// - id: a UUID string with the associated ID to the invoice to be allocated.
+// - ruleID: a UUID string with the associated ID to the rule to be applied.
+// Returns:
+// - o: a reference to the AllocationReport with the split of the costs.
+// - status: an int for informing about the status of the operation.
+// - e: an error raised in case of problems with the operation.
+func (d *DbParameter) AllocateInvoice(id, ruleID strfmt.UUID) (o *models.AllocationReport, status int, e error) {
+
+	l.Trace.Printf("[DB] Attempting to allocate the invoice [ %v ] with rule [ %v ].\n", id, ruleID)
+
+	rule, e := d.GetAllocationRule(ruleID)
+
+	if e != nil {
+
+		status = StatusFail
+
+		return
+
+	}
+
+	if rule == nil {
+
+		status = StatusMissing
+
+		return
+
+	}
+
+	invoice, e := d.GetInvoice(id)
+
+	if e != nil {
+
+		status = StatusFail
+
+		if errors.Is(e, gorm.ErrRecordNotFound) {
+
+			status = StatusMissing
+
+		}
+
+		return
+
+	}
+
+	buckets := make(map[string]*models.AllocationBucket)
+
+	accounts, _ := invoice.Items["accounts"].([]interface{})
+
+	for _, a := range accounts {
+
+		acc, ok := a.(map[string]interface{})
+
+		if !ok {
+
+			continue
+
+		}
+
+		discount := d.getFloat(acc["discountRate"])
+		costBreakup, _ := acc["costBreakup"].([]interface{})
+
+		for _, c := range costBreakup {
+
+			cost, ok := c.(map[string]interface{})
+
+			if !ok {
+
+				continue
+
+			}
+
+			resourceList, _ := cost["resourceList"].([]interface{})
+
+			for _, r := range resourceList {
+
+				resource, ok := r.(map[string]interface{})
+
+				if !ok {
+
+					continue
+
+				}
+
+				net := float64(0)
+
+				if states, ok := resource["costBreakup"].(map[string]interface{}); ok {
+
+					for _, v := range states {
+
+						net = net + d.getFloat(v)
+
+					}
+
+				}
+
+				bucket := d.getAllocationBucket(rule, resource["metadata"])
+
+				d.addToAllocationBucket(buckets, bucket, fmt.Sprintf("%v", resource["resourceID"]), net-(net*discount))
+
+			}
+
+		}
+
+	}
+
+	o = d.allocationReport(rule, buckets)
+
+	o.Source = models.AllocationReportSourceInvoice
+	o.OrganizationID = invoice.OrganizationID
+	o.TimeFrom = (strfmt.DateTime)(time.Time(invoice.PeriodStartDate))
+	o.TimeTo = (strfmt.DateTime)(time.Time(invoice.PeriodEndDate))
+
+	if invoice.Currency != nil {
+
+		o.Currency = *invoice.Currency
+
+	}
+
+	status = StatusOK
+
+	return
+
+}
+
+// AllocateOrganization job is to split the costs present in the CDRs of the
+// provided organization in the provided time window into buckets (cost
+// centers) following the provided allocation rule.
+// Parameters:
+// - org: a string with the ID of the organization (reseller or customer).
+// - ruleID: a UUID string with the associated ID to the rule to be applied.
+// - token: a string with an optional keycloak bearer token.
+// - from: datetime from which to get the CDRs, defaults to the beginning of
+// the current month.
+// - to: datetime until which to get the CDRs, defaults to now.
+// Returns:
+// - o: a reference to the AllocationReport with the split of the costs.
+// - status: an int for informing about the status of the operation.
+// - e: an error raised in case of problems with the operation.
+func (d *DbParameter) AllocateOrganization(org string, ruleID strfmt.UUID, token string, from, to *strfmt.DateTime) (o *models.AllocationReport, status int, e error) {
+
+	l.Trace.Printf("[DB] Attempting to allocate the costs of organization [ %v ] with rule [ %v ].\n", org, ruleID)
+
+	rule, e := d.GetAllocationRule(ruleID)
+
+	if e != nil {
+
+		status = StatusFail
+
+		return
+
+	}
+
+	if rule == nil {
+
+		status = StatusMissing
+
+		return
+
+	}
+
+	now := time.Now().UTC()
+	f := (strfmt.DateTime)(time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC))
+	t := (strfmt.DateTime)(now)
+
+	if from != nil {
+
+		f = *from
+
+	}
+
+	if to != nil {
+
+		t = *to
+
+	}
+
+	ty, products, e := d.getCDRS(org, token)
+
+	if e != nil {
+
+		status = StatusMissing
+
+		return
+
+	}
+
+	buckets := make(map[string]*models.AllocationBucket)
+
+	for _, product := range strings.Split(products, ",") {
+
+		if product == "" {
+
+			continue
+
+		}
+
+		cdr, e := d.Cache.Get(fmt.Sprintf("%v?%v?%v", product, f, t), "cdr", token)
+
+		if e != nil {
+
+			l.Warning.Printf("[DB] Something went wrong while retrieving the CDRs for product [ %v ]. Error: %v\n", product, e)
+
+			status = StatusFail
+
+			return nil, status, e
+
+		}
+
+		for _, report := range cdr.([]*cdrModels.CReport) {
+
+			for _, usage := range report.Usage {
+
+				bucket := d.getAllocationBucket(rule, usage.Metadata)
+
+				d.addToAllocationBucket(buckets, bucket, usage.ResourceID, d.getFloat(usage.Cost["netTotal"]))
+
+			}
+
+		}
+
+	}
+
+	o = d.allocationReport(rule, buckets)
+
+	o.Source = models.AllocationReportSourceCdr
+	o.OrganizationID = org
+	o.TimeFrom = f
+	o.TimeTo = t
+
+	if x, e := d.Cache.Get(org, ty, token); e == nil {
+
+		switch c := x.(type) {
+
+		case cusModels.Reseller:
+
+			if c.BillCurrency != nil {
+
+				o.Currency = *c.BillCurrency
+
+			}
+
+		case cusModels.Customer:
+
+			if c.BillCurrency != nil {
+
+				o.Currency = *c.BillCurrency
+
+			}
+
+		}
+
+	}
+
+	status = StatusOK
+
+	return
+
+}
+
+// getAllocationBucket job is to resolve the bucket where a resource has to be
+// allocated, based on the tags present in its metadata.
+// Parameters:
+// - rule: a reference to the AllocationRule being applied.
+// - metadata: interface with the metadata of the resource.
+// Returns:
+// - bucket: a string with the name of the bucket.
+func (d *DbParameter) getAllocationBucket(rule *models.AllocationRule, metadata interface{}) (bucket string) {
+
+	bucket = "untagged"
+
+	if rule.UntaggedBucket != nil && *rule.UntaggedBucket != "" {
+
+		bucket = *rule.UntaggedBucket
+
+	}
+
+	var tags interface{}
+
+	switch md := metadata.(type) {
+
+	case datamodels.JSONdb:
+
+		tags = md["tags"]
+
+	case map[string]interface{}:
+
+		tags = md["tags"]
+
+	}
+
+	var value interface{}
+
+	switch t := tags.(type) {
+
+	case datamodels.JSONdb:
+
+		value = t[*rule.TagKey]
+
+	case map[string]interface{}:
+
+		value = t[*rule.TagKey]
+
+	}
+
+	if value == nil || fmt.Sprintf("%v", value) == "" {
+
+		return
+
+	}
+
+	bucket = fmt.Sprintf("%v", value)
+
+	if mapped, exists := rule.Mapping[bucket]; exists {
+
+		bucket = fmt.Sprintf("%v", mapped)
+
+	}
+
+	return
+
+}
+
+// addToAllocationBucket job is to add the cost of a resource to the provided
+// bucket, creating it if needed.
+// Parameters:
+// - buckets: map with the buckets of the allocation being processed.
+// - name: a string with the name of the bucket.
+// - resource: a string with the ID of the resource.
+// - cost: a float64 with the cost to be added to the bucket.
+func (d *DbParameter) addToAllocationBucket(buckets map[string]*models.AllocationBucket, name, resource string, cost float64) {
+
+	b, exists := buckets[name]
+
+	if !exists {
+
+		b = &models.AllocationBucket{
+			Name:      name,
+			Resources: []string{},
+		}
+
+		buckets[name] = b
+
+	}
+
+	b.Cost = b.Cost + cost
+
+	if !d.contains(b.Resources, resource) {
+
+		b.Resources = append(b.Resources, resource)
+		b.ResourceCount++
+
+	}
+
+}
+
+// allocationReport job is to build the allocation report out of the buckets
+// computed while processing the allocation.
+// Parameters:
+// - rule: a reference to the AllocationRule applied.
+// - buckets: map with the buckets of the allocation processed.
+// Returns:
+// - o: a reference to the AllocationReport with the buckets sorted by name.
+func (d *DbParameter) allocationReport(rule *models.AllocationRule, buckets map[string]*models.AllocationBucket) (o *models.AllocationReport) {
+
+	o = &models.AllocationReport{
+		Buckets: []*models.AllocationBucket{},
+		RuleID:  rule.ID,
+		TagKey:  *rule.TagKey,
+	}
+
+	for _, b := range buckets {
+
+		b.Cost = d.getNiceFloat(b.Cost)
+
+		o.Total = o.Total + b.Cost
+		o.Buckets = append(o.Buckets, b)
+
+	}
+
+	sort.Slice(o.Buckets, func(i, j int) bool {
+
+		return o.Buckets[i].Name < o.Buckets[j].Name
+
+	})
+
+	o.Total = d.getNiceFloat(o.Total)
+
+	return
+
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/GoDieNow/TFT_Code/services/billing/models"
 	"github.com/GoDieNow/TFT_Code/services/billing/restapi"
+	"github.com/GoDieNow/TFT_Code/services/billing/server/allocationManager"
 	"github.com/GoDieNow/TFT_Code/services/billing/server/bulkManager"
 	"github.com/GoDieNow/TFT_Code/services/billing/server/invoiceManager"
 	"github.com/GoDieNow/TFT_Code/services/billing/server/statusManager"
@@ -26,7 +27,7 @@ func getService() (handler http.Handler, register *prometheus.Registry, e error)
 	l.Trace.Printf("[MAIN] Intializing service [ %v ] handler\n", strings.Title(service))
 
 	// TABLE0,...,N have to be customized
-	db := dbStart(&models.Invoice{}, &models.BillRun{})
+	db := dbStart(&models.Invoice{}, &models.BillRun{}, &models.AllocationRule{})
 	mon := statusManager.New(db)
 
 	// Prometheus Metrics linked to dbParameter
@@ -38,20 +39,22 @@ func getService() (handler http.Handler, register *prometheus.Registry, e error)
 	bp := getBasePath()
 
 	// Parts of the service HERE
+	a := allocationManager.New(db, mon, bp)
 	b := bulkManager.New(db, mon)
 	i := invoiceManager.New(db, mon, bp)
 	t := triggerManager.New(db, mon, bp)
 
 	// Initiate the http handler, with the objects that are implementing the business logic.
 	h, e := restapi.Handler(restapi.Config{
-		StatusManagementAPI:  mon,
-		TriggerManagementAPI: t,
-		BulkManagementAPI:    b,
-		InvoiceManagementAPI: i,
-		Logger:               l.Info.Printf,
-		AuthKeycloak:         AuthKeycloak,
-		AuthAPIKeyHeader:     AuthAPIKey,
-		AuthAPIKeyParam:      AuthAPIKey,
+		StatusManagementAPI:     mon,
+		TriggerManagementAPI:    t,
+		AllocationManagementAPI: a,
+		BulkManagementAPI:       b,
+		InvoiceManagementAPI:    i,
+		Logger:                  l.Info.Printf,
+		AuthKeycloak:            AuthKeycloak,
+		AuthAPIKeyHeader:        AuthAPIKey,
+		AuthAPIKeyParam:         AuthAPIKey,
 	})
 
 	if e != nil {
@@ -13,6 +13,7 @@ import (
 	rtclient "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 
+	"github.com/GoDieNow/TFT_Code/services/cdr/client/anomaly_management"
 	"github.com/GoDieNow/TFT_Code/services/cdr/client/status_management"
 	"github.com/GoDieNow/TFT_Code/services/cdr/client/trigger_management"
 	"github.com/GoDieNow/TFT_Code/services/cdr/client/usage_management"
@@ -60,6 +61,7 @@ func New(c Config) *CDRManagementAPI {
 
 	cli := new(CDRManagementAPI)
 	cli.Transport = transport
+	cli.AnomalyManagement = anomaly_management.New(transport, strfmt.Default, c.AuthInfo)
 	cli.StatusManagement = status_management.New(transport, strfmt.Default, c.AuthInfo)
 	cli.TriggerManagement = trigger_management.New(transport, strfmt.Default, c.AuthInfo)
 	cli.UsageManagement = usage_management.New(transport, strfmt.Default, c.AuthInfo)
@@ -68,6 +70,7 @@ func New(c Config) *CDRManagementAPI {
 
 // CDRManagementAPI is a client for c d r management API
 type CDRManagementAPI struct {
+	AnomalyManagement *anomaly_management.Client
 	StatusManagement  *status_management.Client
 	TriggerManagement *trigger_management.Client
 	UsageManagement   *usage_management.Client
@@ -17,6 +17,9 @@ import (
 
 // API is the interface of the usage management client
 type API interface {
+	/*
+	   ForecastSpend ends of period spend projection per customer and sku with confidence bands*/
+	ForecastSpend(ctx context.Context, params *ForecastSpendParams) (*ForecastSpendOK, error)
 	/*
 	   GetSystemUsage detaileds report covering all accounts within the specified time window*/
 	GetSystemUsage(ctx context.Context, params *GetSystemUsageParams) (*GetSystemUsageOK, error)
@@ -26,6 +29,9 @@ type API interface {
 	/*
 	   GetUsageSummary summaries report meant for the UI for the resources linked to the reseller ID provided within the specified time window*/
 	GetUsageSummary(ctx context.Context, params *GetUsageSummaryParams) (*GetUsageSummaryOK, error)
+	/*
+	   QueryUsage aggregateds usage and cost series grouped and filtered by the requested dimensions within the specified time window*/
+	QueryUsage(ctx context.Context, params *QueryUsageParams) (*QueryUsageOK, error)
 }
 
 // New creates a new usage management API client.
@@ -46,6 +52,31 @@ type Client struct {
 	authInfo  runtime.ClientAuthInfoWriter
 }
 
+/*
+ForecastSpend ends of period spend projection per customer and sku with confidence bands
+*/
+func (a *Client) ForecastSpend(ctx context.Context, params *ForecastSpendParams) (*ForecastSpendOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "forecastSpend",
+		Method:             "GET",
+		PathPattern:        "/usage/forecast",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ForecastSpendReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ForecastSpendOK), nil
+
+}
+
 /*
 GetSystemUsage detaileds report covering all accounts within the specified time window
 */
@@ -120,3 +151,28 @@ func (a *Client) GetUsageSummary(ctx context.Context, params *GetUsageSummaryPar
 	return result.(*GetUsageSummaryOK), nil
 
 }
+
+/*
+QueryUsage aggregateds usage and cost series grouped and filtered by the requested dimensions within the specified time window
+*/
+func (a *Client) QueryUsage(ctx context.Context, params *QueryUsageParams) (*QueryUsageOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "queryUsage",
+		Method:             "GET",
+		PathPattern:        "/usage/query",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &QueryUsageReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*QueryUsageOK), nil
+
+}
@@ -24,9 +24,15 @@ type CDRRecord struct {
 	// cost
 	Cost datamodels.JSONdb `json:"Cost,omitempty" gorm:"type:jsonb"`
 
+	// customer Id
+	CustomerID string `json:"CustomerId,omitempty" gorm:"index"`
+
 	// metadata
 	Metadata datamodels.JSONdb `json:"Metadata,omitempty" gorm:"type:jsonb"`
 
+	// reseller Id
+	ResellerID string `json:"ResellerId,omitempty" gorm:"index"`
+
 	// resource Id
 	ResourceID string `json:"ResourceId,omitempty"`
 
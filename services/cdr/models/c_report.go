@@ -22,6 +22,12 @@ type CReport struct {
 	// account Id
 	AccountID string `json:"AccountId,omitempty" gorm:"index"`
 
+	// customer Id
+	CustomerID string `json:"CustomerId,omitempty"`
+
+	// reseller Id
+	ResellerID string `json:"ResellerId,omitempty"`
+
 	// time from
 	// Format: datetime
 	TimeFrom strfmt.DateTime `json:"TimeFrom,omitempty" gorm:"index;type:timestamptz"`
@@ -15,6 +15,7 @@ import (
 	"github.com/go-openapi/runtime/security"
 
 	"github.com/GoDieNow/TFT_Code/services/cdr/restapi/operations"
+	"github.com/GoDieNow/TFT_Code/services/cdr/restapi/operations/anomaly_management"
 	"github.com/GoDieNow/TFT_Code/services/cdr/restapi/operations/status_management"
 	"github.com/GoDieNow/TFT_Code/services/cdr/restapi/operations/trigger_management"
 	"github.com/GoDieNow/TFT_Code/services/cdr/restapi/operations/usage_management"
@@ -24,6 +25,17 @@ type contextKey string
 
 const AuthKey contextKey = "Auth"
 
+//go:generate mockery -name AnomalyManagementAPI -inpkg
+
+/* AnomalyManagementAPI  */
+type AnomalyManagementAPI interface {
+	/* GetAnomaly Anomaly with the provided id along with the resources that contributed to it */
+	GetAnomaly(ctx context.Context, params anomaly_management.GetAnomalyParams) middleware.Responder
+
+	/* ListAnomalies List of the anomalies detected in the costs of the accounts within the specified time window */
+	ListAnomalies(ctx context.Context, params anomaly_management.ListAnomaliesParams) middleware.Responder
+}
+
 //go:generate mockery -name StatusManagementAPI -inpkg
 
 /* StatusManagementAPI  */
@@ -47,6 +59,9 @@ type TriggerManagementAPI interface {
 
 /* UsageManagementAPI  */
 type UsageManagementAPI interface {
+	/* ForecastSpend End-of-period spend projection per customer and sku with confidence bands */
+	ForecastSpend(ctx context.Context, params usage_management.ForecastSpendParams) middleware.Responder
+
 	/* GetSystemUsage Detailed report covering all accounts within the specified time window */
 	GetSystemUsage(ctx context.Context, params usage_management.GetSystemUsageParams) middleware.Responder
 
@@ -55,10 +70,14 @@ type UsageManagementAPI interface {
 
 	/* GetUsageSummary Summary report meant for the UI for the resources linked to the ResellerID provided within the specified time window */
 	GetUsageSummary(ctx context.Context, params usage_management.GetUsageSummaryParams) middleware.Responder
+
+	/* QueryUsage Aggregated usage and cost series grouped and filtered by the requested dimensions within the specified time window */
+	QueryUsage(ctx context.Context, params usage_management.QueryUsageParams) middleware.Responder
 }
 
 // Config is configuration for Handler
 type Config struct {
+	AnomalyManagementAPI
 	StatusManagementAPI
 	TriggerManagementAPI
 	UsageManagementAPI
@@ -144,6 +163,16 @@ func HandlerAPI(c Config) (http.Handler, *operations.CDRManagementAPIAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.TriggerManagementAPI.ExecTransformation(ctx, params)
 	})
+	api.UsageManagementForecastSpendHandler = usage_management.ForecastSpendHandlerFunc(func(params usage_management.ForecastSpendParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.UsageManagementAPI.ForecastSpend(ctx, params)
+	})
+	api.AnomalyManagementGetAnomalyHandler = anomaly_management.GetAnomalyHandlerFunc(func(params anomaly_management.GetAnomalyParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.AnomalyManagementAPI.GetAnomaly(ctx, params)
+	})
 	api.StatusManagementGetStatusHandler = status_management.GetStatusHandlerFunc(func(params status_management.GetStatusParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -164,6 +193,16 @@ func HandlerAPI(c Config) (http.Handler, *operations.CDRManagementAPIAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.UsageManagementAPI.GetUsageSummary(ctx, params)
 	})
+	api.AnomalyManagementListAnomaliesHandler = anomaly_management.ListAnomaliesHandlerFunc(func(params anomaly_management.ListAnomaliesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.AnomalyManagementAPI.ListAnomalies(ctx, params)
+	})
+	api.UsageManagementQueryUsageHandler = usage_management.QueryUsageHandlerFunc(func(params usage_management.QueryUsageParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.UsageManagementAPI.QueryUsage(ctx, params)
+	})
 	api.StatusManagementShowStatusHandler = status_management.ShowStatusHandlerFunc(func(params status_management.ShowStatusParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
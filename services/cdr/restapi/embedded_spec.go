@@ -38,6 +38,131 @@ func init() {
   "host": "localhost:8000",
   "basePath": "/api/v1.0",
   "paths": {
+    "/anomaly": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "anomalyManagement"
+        ],
+        "summary": "List of the anomalies detected in the costs of the accounts within the specified time window",
+        "operationId": "listAnomalies",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Id of the account to filter by",
+            "name": "account",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resource type to filter by",
+            "name": "resourceType",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime from which to get the anomalies",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime until which to get the anomalies",
+            "name": "to",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Anomaly"
+              }
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/anomaly/{id}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "anomalyManagement"
+        ],
+        "summary": "Anomaly with the provided id along with the resources that contributed to it",
+        "operationId": "getAnomaly",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Id of the anomaly to be retrieved",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/Anomaly"
+            }
+          },
+          "404": {
+            "description": "The anomaly with the id provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
     "/status": {
       "get": {
         "security": [
@@ -97,6 +222,7 @@ func init() {
         "parameters": [
           {
             "enum": [
+              "anomaly",
               "kafka-receiver",
               "kafka-sender",
               "status",
@@ -254,6 +380,189 @@ func init() {
         }
       }
     },
+    "/usage/forecast": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "usageManagement"
+        ],
+        "summary": "End-of-period spend projection per customer and sku with confidence bands",
+        "operationId": "forecastSpend",
+        "parameters": [
+          {
+            "maximum": 366,
+            "type": "integer",
+            "default": 28,
+            "description": "Number of days of history used to fit the model",
+            "name": "history",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime until which the spend is projected, at most 365 days ahead, by default the end of the current month",
+            "name": "periodEnd",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of accounts to filter by",
+            "name": "account",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of customers to filter by",
+            "name": "customer",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of resellers to filter by",
+            "name": "reseller",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/SpendForecastReport"
+            }
+          },
+          "400": {
+            "description": "Invalid parameters provided in the query",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/usage/query": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "usageManagement"
+        ],
+        "summary": "Aggregated usage and cost series grouped and filtered by the requested dimensions within the specified time window",
+        "operationId": "queryUsage",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime from which to get the usage report",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime until which to get the usage report",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of dimensions to group by (account, customer, reseller, resourceType, sku, region, day, week, month)",
+            "name": "groupby",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of accounts to filter by",
+            "name": "account",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of customers to filter by",
+            "name": "customer",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of resellers to filter by",
+            "name": "reseller",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of resource types to filter by",
+            "name": "resourceType",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of skus to filter by",
+            "name": "sku",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of regions to filter by",
+            "name": "region",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/UsageQueryReport"
+            }
+          },
+          "400": {
+            "description": "Invalid dimension provided in the query",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
     "/usage/summary/{id}": {
       "get": {
         "security": [
@@ -389,21 +698,111 @@ func init() {
     }
   },
   "definitions": {
-    "CDRRecord": {
+    "Anomaly": {
       "type": "object",
       "properties": {
         "AccountId": {
           "type": "string",
           "x-go-custom-tag": "gorm:\"index\""
         },
-        "Cost": {
-          "x-go-custom-tag": "gorm:\"type:jsonb\"",
-          "$ref": "#/definitions/Metadata"
+        "Baseline": {
+          "description": "Average cost of the previous windows of the account and resource type",
+          "type": "number",
+          "format": "double"
+        },
+        "Contributors": {
+          "description": "Resources with the highest cost in the window",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AnomalyContributor"
+          },
+          "x-go-custom-tag": "gorm:\"serializer:json\""
+        },
+        "Cost": {
+          "description": "Cost of the account and resource type in the window",
+          "type": "number",
+          "format": "double"
+        },
+        "CustomerId": {
+          "type": "string"
+        },
+        "DetectedAt": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+        },
+        "ID": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"type:uuid;primary_key;unique;default:md5(random()::text || clock_timestamp()::text)::uuid\""
+        },
+        "ResourceType": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "Samples": {
+          "description": "Number of previous windows used as baseline",
+          "type": "integer"
+        },
+        "Score": {
+          "description": "Standard deviations of the cost over the baseline",
+          "type": "number",
+          "format": "double"
+        },
+        "StdDev": {
+          "type": "number",
+          "format": "double"
+        },
+        "TimeFrom": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"index;type:timestamptz\""
+        },
+        "TimeTo": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+        }
+      }
+    },
+    "AnomalyContributor": {
+      "type": "object",
+      "properties": {
+        "Cost": {
+          "type": "number",
+          "format": "double"
+        },
+        "ResourceId": {
+          "type": "string"
+        },
+        "ResourceName": {
+          "type": "string"
+        }
+      }
+    },
+    "CDRRecord": {
+      "type": "object",
+      "properties": {
+        "AccountId": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "Cost": {
+          "x-go-custom-tag": "gorm:\"type:jsonb\"",
+          "$ref": "#/definitions/Metadata"
+        },
+        "CustomerId": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
         },
         "Metadata": {
           "x-go-custom-tag": "gorm:\"type:jsonb\"",
           "$ref": "#/definitions/Metadata"
         },
+        "ResellerId": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
         "ResourceId": {
           "type": "string"
         },
@@ -468,6 +867,12 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"index\""
         },
+        "CustomerId": {
+          "type": "string"
+        },
+        "ResellerId": {
+          "type": "string"
+        },
         "TimeFrom": {
           "type": "string",
           "format": "datetime",
@@ -517,6 +922,108 @@ func init() {
         "type": "JSONdb"
       }
     },
+    "SpendForecast": {
+      "type": "object",
+      "properties": {
+        "ActualToDate": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        },
+        "CustomerId": {
+          "type": "string"
+        },
+        "Intercept": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        },
+        "Lower": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        },
+        "Model": {
+          "type": "string"
+        },
+        "Projected": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        },
+        "SeasonalFactors": {
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "double"
+          }
+        },
+        "Sigma": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        },
+        "Sku": {
+          "type": "string"
+        },
+        "Slope": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        },
+        "Upper": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        }
+      }
+    },
+    "SpendForecastReport": {
+      "type": "object",
+      "properties": {
+        "ActualToDate": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        },
+        "Confidence": {
+          "type": "number",
+          "format": "double"
+        },
+        "Forecasts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SpendForecast"
+          }
+        },
+        "HistoryDays": {
+          "type": "integer"
+        },
+        "Lower": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        },
+        "PeriodEnd": {
+          "type": "string",
+          "format": "datetime"
+        },
+        "PeriodStart": {
+          "type": "string",
+          "format": "datetime"
+        },
+        "Projected": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        },
+        "Upper": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        }
+      }
+    },
     "Status": {
       "type": "object",
       "required": [
@@ -565,6 +1072,55 @@ func init() {
           "$ref": "#/definitions/Metadata"
         }
       }
+    },
+    "UsageQueryItem": {
+      "type": "object",
+      "properties": {
+        "Cost": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        },
+        "Dimensions": {
+          "$ref": "#/definitions/Metadata"
+        },
+        "Records": {
+          "type": "integer"
+        },
+        "Usage": {
+          "$ref": "#/definitions/Metadata"
+        }
+      }
+    },
+    "UsageQueryReport": {
+      "type": "object",
+      "properties": {
+        "GroupBy": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "Series": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/UsageQueryItem"
+          }
+        },
+        "TimeFrom": {
+          "type": "string",
+          "format": "datetime"
+        },
+        "TimeTo": {
+          "type": "string",
+          "format": "datetime"
+        },
+        "TotalCost": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        }
+      }
     }
   },
   "securityDefinitions": {
@@ -615,6 +1171,10 @@ func init() {
     {
       "description": "Actions relating to the usage reporting of the accounts of the system",
       "name": "usageManagement"
+    },
+    {
+      "description": "Actions relating to the anomalies detected in the usage of the accounts of the system",
+      "name": "anomalyManagement"
     }
   ]
 }`))
@@ -639,6 +1199,131 @@ func init() {
   "host": "localhost:8000",
   "basePath": "/api/v1.0",
   "paths": {
+    "/anomaly": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "anomalyManagement"
+        ],
+        "summary": "List of the anomalies detected in the costs of the accounts within the specified time window",
+        "operationId": "listAnomalies",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Id of the account to filter by",
+            "name": "account",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resource type to filter by",
+            "name": "resourceType",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime from which to get the anomalies",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime until which to get the anomalies",
+            "name": "to",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Anomaly"
+              }
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/anomaly/{id}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "anomalyManagement"
+        ],
+        "summary": "Anomaly with the provided id along with the resources that contributed to it",
+        "operationId": "getAnomaly",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Id of the anomaly to be retrieved",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/Anomaly"
+            }
+          },
+          "404": {
+            "description": "The anomaly with the id provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
     "/status": {
       "get": {
         "security": [
@@ -697,29 +1382,158 @@ func init() {
         "operationId": "getStatus",
         "parameters": [
           {
-            "enum": [
-              "kafka-receiver",
-              "kafka-sender",
-              "status",
-              "trigger",
-              "usage"
-            ],
+            "enum": [
+              "anomaly",
+              "kafka-receiver",
+              "kafka-sender",
+              "status",
+              "trigger",
+              "usage"
+            ],
+            "type": "string",
+            "description": "Id of the endpoint to be checked",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Status information of the system",
+            "schema": {
+              "$ref": "#/definitions/Status"
+            }
+          },
+          "404": {
+            "description": "The endpoint provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/trigger/transform": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "triggerManagement"
+        ],
+        "summary": "Transformation of UDR to CDR task trigger",
+        "operationId": "execTransformation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime from which to get the usage report",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime until which to get the usage report",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Switch for using 15m boundaries instead of 8h",
+            "name": "fast_mode",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Transformation task executed successfully."
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/usage": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "usageManagement"
+        ],
+        "summary": "Detailed report covering all accounts within the specified time window",
+        "operationId": "getSystemUsage",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime from which to get the usage report",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime until which to get the usage report",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Metric(s) to get the usage report",
+            "name": "metric",
+            "in": "query"
+          },
+          {
             "type": "string",
-            "description": "Id of the endpoint to be checked",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "description": "List of ids to be queried",
+            "name": "idlist",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Status information of the system",
+            "description": "Description of a successfully operation",
             "schema": {
-              "$ref": "#/definitions/Status"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/CReport"
+              }
             }
           },
-          "404": {
-            "description": "The endpoint provided doesn't exist",
+          "500": {
+            "description": "Something unexpected happend, error raised",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -727,7 +1541,7 @@ func init() {
         }
       }
     },
-    "/trigger/transform": {
+    "/usage/forecast": {
       "get": {
         "security": [
           {
@@ -746,35 +1560,57 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "triggerManagement"
+          "usageManagement"
         ],
-        "summary": "Transformation of UDR to CDR task trigger",
-        "operationId": "execTransformation",
+        "summary": "End-of-period spend projection per customer and sku with confidence bands",
+        "operationId": "forecastSpend",
         "parameters": [
+          {
+            "maximum": 366,
+            "type": "integer",
+            "default": 28,
+            "description": "Number of days of history used to fit the model",
+            "name": "history",
+            "in": "query"
+          },
           {
             "type": "string",
             "format": "datetime",
-            "description": "Datetime from which to get the usage report",
-            "name": "from",
+            "description": "Datetime until which the spend is projected, at most 365 days ahead, by default the end of the current month",
+            "name": "periodEnd",
             "in": "query"
           },
           {
             "type": "string",
-            "format": "datetime",
-            "description": "Datetime until which to get the usage report",
-            "name": "to",
+            "description": "Comma-separated list of accounts to filter by",
+            "name": "account",
             "in": "query"
           },
           {
-            "type": "boolean",
-            "description": "Switch for using 15m boundaries instead of 8h",
-            "name": "fast_mode",
+            "type": "string",
+            "description": "Comma-separated list of customers to filter by",
+            "name": "customer",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of resellers to filter by",
+            "name": "reseller",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Transformation task executed successfully."
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/SpendForecastReport"
+            }
+          },
+          "400": {
+            "description": "Invalid parameters provided in the query",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "Something unexpected happend, error raised",
@@ -785,7 +1621,7 @@ func init() {
         }
       }
     },
-    "/usage": {
+    "/usage/query": {
       "get": {
         "security": [
           {
@@ -806,8 +1642,8 @@ func init() {
         "tags": [
           "usageManagement"
         ],
-        "summary": "Detailed report covering all accounts within the specified time window",
-        "operationId": "getSystemUsage",
+        "summary": "Aggregated usage and cost series grouped and filtered by the requested dimensions within the specified time window",
+        "operationId": "queryUsage",
         "parameters": [
           {
             "type": "string",
@@ -825,14 +1661,44 @@ func init() {
           },
           {
             "type": "string",
-            "description": "Metric(s) to get the usage report",
-            "name": "metric",
+            "description": "Comma-separated list of dimensions to group by (account, customer, reseller, resourceType, sku, region, day, week, month)",
+            "name": "groupby",
             "in": "query"
           },
           {
             "type": "string",
-            "description": "List of ids to be queried",
-            "name": "idlist",
+            "description": "Comma-separated list of accounts to filter by",
+            "name": "account",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of customers to filter by",
+            "name": "customer",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of resellers to filter by",
+            "name": "reseller",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of resource types to filter by",
+            "name": "resourceType",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of skus to filter by",
+            "name": "sku",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of regions to filter by",
+            "name": "region",
             "in": "query"
           }
         ],
@@ -840,10 +1706,13 @@ func init() {
           "200": {
             "description": "Description of a successfully operation",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/CReport"
-              }
+              "$ref": "#/definitions/UsageQueryReport"
+            }
+          },
+          "400": {
+            "description": "Invalid dimension provided in the query",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
@@ -990,6 +1859,88 @@ func init() {
     }
   },
   "definitions": {
+    "Anomaly": {
+      "type": "object",
+      "properties": {
+        "AccountId": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "Baseline": {
+          "description": "Average cost of the previous windows of the account and resource type",
+          "type": "number",
+          "format": "double"
+        },
+        "Contributors": {
+          "description": "Resources with the highest cost in the window",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AnomalyContributor"
+          },
+          "x-go-custom-tag": "gorm:\"serializer:json\""
+        },
+        "Cost": {
+          "description": "Cost of the account and resource type in the window",
+          "type": "number",
+          "format": "double"
+        },
+        "CustomerId": {
+          "type": "string"
+        },
+        "DetectedAt": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+        },
+        "ID": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"type:uuid;primary_key;unique;default:md5(random()::text || clock_timestamp()::text)::uuid\""
+        },
+        "ResourceType": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "Samples": {
+          "description": "Number of previous windows used as baseline",
+          "type": "integer"
+        },
+        "Score": {
+          "description": "Standard deviations of the cost over the baseline",
+          "type": "number",
+          "format": "double"
+        },
+        "StdDev": {
+          "type": "number",
+          "format": "double"
+        },
+        "TimeFrom": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"index;type:timestamptz\""
+        },
+        "TimeTo": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+        }
+      }
+    },
+    "AnomalyContributor": {
+      "type": "object",
+      "properties": {
+        "Cost": {
+          "type": "number",
+          "format": "double"
+        },
+        "ResourceId": {
+          "type": "string"
+        },
+        "ResourceName": {
+          "type": "string"
+        }
+      }
+    },
     "CDRRecord": {
       "type": "object",
       "properties": {
@@ -1001,10 +1952,18 @@ func init() {
           "x-go-custom-tag": "gorm:\"type:jsonb\"",
           "$ref": "#/definitions/Metadata"
         },
+        "CustomerId": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
         "Metadata": {
           "x-go-custom-tag": "gorm:\"type:jsonb\"",
           "$ref": "#/definitions/Metadata"
         },
+        "ResellerId": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
         "ResourceId": {
           "type": "string"
         },
@@ -1069,6 +2028,12 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"index\""
         },
+        "CustomerId": {
+          "type": "string"
+        },
+        "ResellerId": {
+          "type": "string"
+        },
         "TimeFrom": {
           "type": "string",
           "format": "datetime",
@@ -1118,6 +2083,108 @@ func init() {
         "type": "JSONdb"
       }
     },
+    "SpendForecast": {
+      "type": "object",
+      "properties": {
+        "ActualToDate": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        },
+        "CustomerId": {
+          "type": "string"
+        },
+        "Intercept": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        },
+        "Lower": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        },
+        "Model": {
+          "type": "string"
+        },
+        "Projected": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        },
+        "SeasonalFactors": {
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "double"
+          }
+        },
+        "Sigma": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        },
+        "Sku": {
+          "type": "string"
+        },
+        "Slope": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        },
+        "Upper": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        }
+      }
+    },
+    "SpendForecastReport": {
+      "type": "object",
+      "properties": {
+        "ActualToDate": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        },
+        "Confidence": {
+          "type": "number",
+          "format": "double"
+        },
+        "Forecasts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SpendForecast"
+          }
+        },
+        "HistoryDays": {
+          "type": "integer"
+        },
+        "Lower": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        },
+        "PeriodEnd": {
+          "type": "string",
+          "format": "datetime"
+        },
+        "PeriodStart": {
+          "type": "string",
+          "format": "datetime"
+        },
+        "Projected": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        },
+        "Upper": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        }
+      }
+    },
     "Status": {
       "type": "object",
       "required": [
@@ -1166,6 +2233,55 @@ func init() {
           "$ref": "#/definitions/Metadata"
         }
       }
+    },
+    "UsageQueryItem": {
+      "type": "object",
+      "properties": {
+        "Cost": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        },
+        "Dimensions": {
+          "$ref": "#/definitions/Metadata"
+        },
+        "Records": {
+          "type": "integer"
+        },
+        "Usage": {
+          "$ref": "#/definitions/Metadata"
+        }
+      }
+    },
+    "UsageQueryReport": {
+      "type": "object",
+      "properties": {
+        "GroupBy": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "Series": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/UsageQueryItem"
+          }
+        },
+        "TimeFrom": {
+          "type": "string",
+          "format": "datetime"
+        },
+        "TimeTo": {
+          "type": "string",
+          "format": "datetime"
+        },
+        "TotalCost": {
+          "type": "number",
+          "format": "double",
+          "default": 0
+        }
+      }
     }
   },
   "securityDefinitions": {
@@ -1216,6 +2332,10 @@ func init() {
     {
       "description": "Actions relating to the usage reporting of the accounts of the system",
       "name": "usageManagement"
+    },
+    {
+      "description": "Actions relating to the anomalies detected in the usage of the accounts of the system",
+      "name": "anomalyManagement"
     }
   ]
 }`))
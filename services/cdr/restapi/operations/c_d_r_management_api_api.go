@@ -19,6 +19,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/GoDieNow/TFT_Code/services/cdr/restapi/operations/anomaly_management"
 	"github.com/GoDieNow/TFT_Code/services/cdr/restapi/operations/status_management"
 	"github.com/GoDieNow/TFT_Code/services/cdr/restapi/operations/trigger_management"
 	"github.com/GoDieNow/TFT_Code/services/cdr/restapi/operations/usage_management"
@@ -49,6 +50,12 @@ func NewCDRManagementAPIAPI(spec *loads.Document) *CDRManagementAPIAPI {
 		TriggerManagementExecTransformationHandler: trigger_management.ExecTransformationHandlerFunc(func(params trigger_management.ExecTransformationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation trigger_management.ExecTransformation has not yet been implemented")
 		}),
+		UsageManagementForecastSpendHandler: usage_management.ForecastSpendHandlerFunc(func(params usage_management.ForecastSpendParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation usage_management.ForecastSpend has not yet been implemented")
+		}),
+		AnomalyManagementGetAnomalyHandler: anomaly_management.GetAnomalyHandlerFunc(func(params anomaly_management.GetAnomalyParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation anomaly_management.GetAnomaly has not yet been implemented")
+		}),
 		StatusManagementGetStatusHandler: status_management.GetStatusHandlerFunc(func(params status_management.GetStatusParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation status_management.GetStatus has not yet been implemented")
 		}),
@@ -61,6 +68,12 @@ func NewCDRManagementAPIAPI(spec *loads.Document) *CDRManagementAPIAPI {
 		UsageManagementGetUsageSummaryHandler: usage_management.GetUsageSummaryHandlerFunc(func(params usage_management.GetUsageSummaryParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation usage_management.GetUsageSummary has not yet been implemented")
 		}),
+		AnomalyManagementListAnomaliesHandler: anomaly_management.ListAnomaliesHandlerFunc(func(params anomaly_management.ListAnomaliesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation anomaly_management.ListAnomalies has not yet been implemented")
+		}),
+		UsageManagementQueryUsageHandler: usage_management.QueryUsageHandlerFunc(func(params usage_management.QueryUsageParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation usage_management.QueryUsage has not yet been implemented")
+		}),
 		StatusManagementShowStatusHandler: status_management.ShowStatusHandlerFunc(func(params status_management.ShowStatusParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation status_management.ShowStatus has not yet been implemented")
 		}),
@@ -129,6 +142,10 @@ type CDRManagementAPIAPI struct {
 
 	// TriggerManagementExecTransformationHandler sets the operation handler for the exec transformation operation
 	TriggerManagementExecTransformationHandler trigger_management.ExecTransformationHandler
+	// UsageManagementForecastSpendHandler sets the operation handler for the forecast spend operation
+	UsageManagementForecastSpendHandler usage_management.ForecastSpendHandler
+	// AnomalyManagementGetAnomalyHandler sets the operation handler for the get anomaly operation
+	AnomalyManagementGetAnomalyHandler anomaly_management.GetAnomalyHandler
 	// StatusManagementGetStatusHandler sets the operation handler for the get status operation
 	StatusManagementGetStatusHandler status_management.GetStatusHandler
 	// UsageManagementGetSystemUsageHandler sets the operation handler for the get system usage operation
@@ -137,6 +154,10 @@ type CDRManagementAPIAPI struct {
 	UsageManagementGetUsageHandler usage_management.GetUsageHandler
 	// UsageManagementGetUsageSummaryHandler sets the operation handler for the get usage summary operation
 	UsageManagementGetUsageSummaryHandler usage_management.GetUsageSummaryHandler
+	// AnomalyManagementListAnomaliesHandler sets the operation handler for the list anomalies operation
+	AnomalyManagementListAnomaliesHandler anomaly_management.ListAnomaliesHandler
+	// UsageManagementQueryUsageHandler sets the operation handler for the query usage operation
+	UsageManagementQueryUsageHandler usage_management.QueryUsageHandler
 	// StatusManagementShowStatusHandler sets the operation handler for the show status operation
 	StatusManagementShowStatusHandler status_management.ShowStatusHandler
 	// ServeError is called when an error is received, there is a default handler
@@ -228,6 +249,12 @@ func (o *CDRManagementAPIAPI) Validate() error {
 	if o.TriggerManagementExecTransformationHandler == nil {
 		unregistered = append(unregistered, "trigger_management.ExecTransformationHandler")
 	}
+	if o.UsageManagementForecastSpendHandler == nil {
+		unregistered = append(unregistered, "usage_management.ForecastSpendHandler")
+	}
+	if o.AnomalyManagementGetAnomalyHandler == nil {
+		unregistered = append(unregistered, "anomaly_management.GetAnomalyHandler")
+	}
 	if o.StatusManagementGetStatusHandler == nil {
 		unregistered = append(unregistered, "status_management.GetStatusHandler")
 	}
@@ -240,6 +267,12 @@ func (o *CDRManagementAPIAPI) Validate() error {
 	if o.UsageManagementGetUsageSummaryHandler == nil {
 		unregistered = append(unregistered, "usage_management.GetUsageSummaryHandler")
 	}
+	if o.AnomalyManagementListAnomaliesHandler == nil {
+		unregistered = append(unregistered, "anomaly_management.ListAnomaliesHandler")
+	}
+	if o.UsageManagementQueryUsageHandler == nil {
+		unregistered = append(unregistered, "usage_management.QueryUsageHandler")
+	}
 	if o.StatusManagementShowStatusHandler == nil {
 		unregistered = append(unregistered, "status_management.ShowStatusHandler")
 	}
@@ -354,6 +387,14 @@ func (o *CDRManagementAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/usage/forecast"] = usage_management.NewForecastSpend(o.context, o.UsageManagementForecastSpendHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/anomaly/{id}"] = anomaly_management.NewGetAnomaly(o.context, o.AnomalyManagementGetAnomalyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/status/{id}"] = status_management.NewGetStatus(o.context, o.StatusManagementGetStatusHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -370,6 +411,14 @@ func (o *CDRManagementAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/anomaly"] = anomaly_management.NewListAnomalies(o.context, o.AnomalyManagementListAnomaliesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/usage/query"] = usage_management.NewQueryUsage(o.context, o.UsageManagementQueryUsageHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/status"] = status_management.NewShowStatus(o.context, o.StatusManagementShowStatusHandler)
 }
 
@@ -79,7 +79,7 @@ func (o *GetStatusParams) bindID(rawData []string, hasKey bool, formats strfmt.R
 // validateID carries on validations for parameter ID
 func (o *GetStatusParams) validateID(formats strfmt.Registry) error {
 
-	if err := validate.EnumCase("id", "path", o.ID, []interface{}{"kafka-receiver", "kafka-sender", "status", "trigger", "usage"}, true); err != nil {
+	if err := validate.EnumCase("id", "path", o.ID, []interface{}{"anomaly", "kafka-receiver", "kafka-sender", "status", "trigger", "usage"}, true); err != nil {
 		return err
 	}
 
@@ -0,0 +1,160 @@
+package anomalyManager
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/GoDieNow/TFT_Code/services/cdr/models"
+	"github.com/GoDieNow/TFT_Code/services/cdr/restapi/operations/anomaly_management"
+	"github.com/GoDieNow/TFT_Code/services/cdr/server/dbManager"
+	"github.com/GoDieNow/TFT_Code/services/cdr/server/statusManager"
+	l "gitlab.com/cyclops-utilities/logging"
+)
+
+// AnomalyManager is the struct defined to group and contain all the methods
+// that interact with the anomaly endpoint.
+// Parameters:
+// - db: a DbParameter reference to be able to use the DBManager methods.
+// - monit: a StatusManager reference to be able to use the status subsystem methods.
+type AnomalyManager struct {
+	db    *dbManager.DbParameter
+	monit *statusManager.StatusManager
+}
+
+// New is the function to create the struct AnomalyManager that grant
+// access to the methods to interact with the anomaly endpoint.
+// Parameters:
+// - db: a reference to the DbParameter to be able to interact with the db methods.
+// - monit: a reference to the StatusManager to be able to interact with the
+// status subsystem.
+// Returns:
+// - AnomalyManager: struct to interact with the anomaly endpoint functionalities.
+func New(db *dbManager.DbParameter, monit *statusManager.StatusManager) *AnomalyManager {
+
+	l.Trace.Printf("[AnomalyManager] Generating new AnomalyManager.\n")
+
+	monit.InitEndpoint("anomaly")
+
+	return &AnomalyManager{
+		db:    db,
+		monit: monit,
+	}
+
+}
+
+// GetAnomaly (Swagger func) is the function behind the (GET) endpoint
+// /anomaly/{id}
+// Its job is to retrieve the anomaly linked to the provided id along with the
+// resources that contributed to it.
+func (m *AnomalyManager) GetAnomaly(ctx context.Context, params anomaly_management.GetAnomalyParams) middleware.Responder {
+
+	l.Trace.Printf("[AnomalyManager] GetAnomaly endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("anomaly", callTime)
+
+	anomaly, e := m.db.GetAnomaly(params.ID)
+
+	if e != nil {
+
+		s := "There was an error in the DB operation: " + e.Error()
+		returnValueError := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/anomaly/" + string(params.ID)}).Inc()
+
+		m.monit.APIHitDone("anomaly", callTime)
+
+		return anomaly_management.NewGetAnomalyInternalServerError().WithPayload(&returnValueError)
+
+	}
+
+	if anomaly == nil {
+
+		s := "The anomaly doesn't exist in the system."
+		returnValueError := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "404", "method": "GET", "route": "/anomaly/" + string(params.ID)}).Inc()
+
+		m.monit.APIHitDone("anomaly", callTime)
+
+		return anomaly_management.NewGetAnomalyNotFound().WithPayload(&returnValueError)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/anomaly/" + string(params.ID)}).Inc()
+
+	m.monit.APIHitDone("anomaly", callTime)
+
+	return anomaly_management.NewGetAnomalyOK().WithPayload(anomaly)
+
+}
+
+// ListAnomalies (Swagger func) is the function behind the (GET) endpoint
+// /anomaly
+// Its job is to retrieve the anomalies detected in the system given a certain
+// time-window and with the posibility of filtering by account and resource type.
+func (m *AnomalyManager) ListAnomalies(ctx context.Context, params anomaly_management.ListAnomaliesParams) middleware.Responder {
+
+	l.Trace.Printf("[AnomalyManager] ListAnomalies endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("anomaly", callTime)
+
+	var account, resourceType string
+	var from, to strfmt.DateTime
+
+	if params.Account != nil {
+
+		account = *params.Account
+
+	}
+
+	if params.ResourceType != nil {
+
+		resourceType = *params.ResourceType
+
+	}
+
+	if params.From != nil {
+
+		from = *params.From
+
+	}
+
+	if params.To != nil {
+
+		to = *params.To
+
+	}
+
+	anomalies, e := m.db.ListAnomalies(account, resourceType, from, to)
+
+	if e != nil {
+
+		s := "There was an error in the DB operation: " + e.Error()
+		returnValueError := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/anomaly"}).Inc()
+
+		m.monit.APIHitDone("anomaly", callTime)
+
+		return anomaly_management.NewListAnomaliesInternalServerError().WithPayload(&returnValueError)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/anomaly"}).Inc()
+
+	m.monit.APIHitDone("anomaly", callTime)
+
+	return anomaly_management.NewListAnomaliesOK().WithPayload(anomalies)
+
+}
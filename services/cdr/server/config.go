@@ -8,9 +8,19 @@ import (
 	l "gitlab.com/cyclops-utilities/logging"
 )
 
-// The following structs: apikey, dbConfig, eventsConfig, generalConfig,
-// kafkaConfig, and keycloakConfig are part of the configuration struct which
+// The following structs: anomaliesConfig, apikey, dbConfig, eventsConfig,
+// generalConfig, kafkaConfig, keycloakConfig, and timescaleConfig are part of the configuration struct which
 // acts as the main reference for configuration parameters in the system.
+type anomaliesConfig struct {
+	Enabled        bool
+	History        int
+	MinDelta       float64
+	MinSamples     int
+	Threshold      float64
+	Webhook        string
+	WebhookTimeout string
+}
+
 type apiKey struct {
 	Enabled bool `json:"enabled"`
 	Key     string
@@ -19,6 +29,7 @@ type apiKey struct {
 }
 
 type configuration struct {
+	Anomalies    anomaliesConfig
 	APIKey       apiKey
 	DB           dbConfig
 	Events       eventsConfig
@@ -27,6 +38,7 @@ type configuration struct {
 	Keycloak     keycloakConfig `json:"keycloak"`
 	DefaultPlans map[string]string
 	Prometheus   prometheusConfig
+	Timescale    timescaleConfig
 }
 
 type dbConfig struct {
@@ -91,6 +103,13 @@ type prometheusConfig struct {
 	MetricsRoute  string
 }
 
+type timescaleConfig struct {
+	ChunkInterval string
+	Compression   map[string]string
+	Enabled       bool
+	Retention     map[string]string
+}
+
 // dumpConfig 's job is to dumps the configuration in JSON format to the log
 // system. It makes use of the masking function to keep some secrecy in the log.
 // Parameters:
@@ -149,6 +168,16 @@ func parseConfig() (c configuration) {
 
 	c = configuration{
 
+		Anomalies: anomaliesConfig{
+			Enabled:        viper.GetBool("anomalies.enabled"),
+			History:        viper.GetInt("anomalies.history"),
+			MinDelta:       viper.GetFloat64("anomalies.mindelta"),
+			MinSamples:     viper.GetInt("anomalies.minsamples"),
+			Threshold:      viper.GetFloat64("anomalies.threshold"),
+			Webhook:        viper.GetString("anomalies.webhook"),
+			WebhookTimeout: viper.GetString("anomalies.webhooktimeout"),
+		},
+
 		APIKey: apiKey{
 			Enabled: viper.GetBool("apikey.enabled"),
 			Key:     viper.GetString("apikey.key"),
@@ -216,6 +245,13 @@ func parseConfig() (c configuration) {
 			MetricsPort:   viper.GetString("prometheus.metricsport"),
 			MetricsRoute:  viper.GetString("prometheus.metricsroute"),
 		},
+
+		Timescale: timescaleConfig{
+			ChunkInterval: viper.GetString("timescale.chunkinterval"),
+			Compression:   viper.GetStringMapString("timescale.compression"),
+			Enabled:       viper.GetBool("timescale.enabled"),
+			Retention:     viper.GetStringMapString("timescale.retention"),
+		},
 	}
 
 	return
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-openapi/strfmt"
@@ -15,6 +17,7 @@ import (
 	datamodels "gitlab.com/cyclops-utilities/datamodels"
 	"github.com/GoDieNow/TFT_Code/services/cdr/models"
 	"github.com/GoDieNow/TFT_Code/services/cdr/server/cacheManager"
+	"github.com/GoDieNow/TFT_Code/services/cdr/server/notificationManager"
 	cusModels "github.com/GoDieNow/TFT_Code/services/customerdb/models"
 	pmModels "github.com/GoDieNow/TFT_Code/services/planmanager/models"
 	udrModels "github.com/GoDieNow/TFT_Code/services/udr/models"
@@ -29,25 +32,93 @@ const (
 	statusFail
 	statusMissing
 	statusOK
+
+	anomalyContributors = 5
+	forecastHistoryMax  = 366
+	forecastHorizon     = 365
 )
 
 var (
 	totalTime  float64
 	totalCount int64
+
+	// ErrUnknownDimension is raised when a usage query refers to a dimension
+	// that is not supported.
+	ErrUnknownDimension = errors.New("unknown dimension in the usage query")
+
+	// ErrInvalidForecast is raised when the parameters of a spend forecast
+	// don't allow to build a projection.
+	ErrInvalidForecast = errors.New("invalid spend forecast parameters")
+
+	queryDimensionNames = map[string]string{
+		"account":      "account",
+		"customer":     "customer",
+		"reseller":     "reseller",
+		"resourcetype": "resourceType",
+		"sku":          "sku",
+		"region":       "region",
+		"day":          "period",
+		"week":         "period",
+		"month":        "period",
+	}
 )
 
+// AnomalyConfig is the struct defined to group the parameters driving the
+// detection of anomalies in the costs of the accounts.
+// On it there is the following parameters:
+// - Enabled: bool to switch on the detection.
+// - History: int with the max number of previous windows in the baseline.
+// - MinDelta: float64 with the min increase over the baseline to be flagged.
+// - MinSamples: int with the min number of previous windows needed.
+// - Threshold: float64 with the standard deviations over the baseline needed.
+// - Webhook: string with the URL where the anomalies are posted, if any.
+type AnomalyConfig struct {
+	Enabled    bool
+	History    int
+	MinDelta   float64
+	MinSamples int
+	Threshold  float64
+	Webhook    string
+}
+
+// TimescaleConfig is the struct defined to group the parameters of the
+// optional TimescaleDB support.
+// On it there is the following parameters:
+// - ChunkInterval: time.Duration covered by each chunk of the hypertables.
+// - Compression: map with the table and the age from which its chunks are
+// compressed.
+// - Enabled: bool to use TimescaleDB when it's available in the database.
+// - Retention: map with the table and the age from which its chunks are
+// dropped.
+type TimescaleConfig struct {
+	ChunkInterval time.Duration
+	Compression   map[string]time.Duration
+	Enabled       bool
+	Retention     map[string]time.Duration
+}
+
 // DbParameter is the struct defined to group and contain all the methods
 // that interact with the database.
 // On it there is the following parameters:
+// - Anomalies: AnomalyConfig with the parameters of the anomaly detection.
+// - anomalous: map with the accounts whose last cost is anomalous per resource
+// type, to keep the count of them exported as metric.
 // - Cache: CacheManager pointer for the cache mechanism.
 // - connStr: strings with the connection information to the database.
 // - Db: a gorm.DB pointer to the db to invoke all the db methods.
+// - Notifier: NotificationManager pointer to deliver the anomalies.
+// - Timescale: bool reporting if the TimescaleDB support is active.
 type DbParameter struct {
-	Cache   *cacheManager.CacheManager
-	connStr string
-	Db      *gorm.DB
-	Metrics map[string]*prometheus.GaugeVec
-	Pipe    chan interface{}
+	Anomalies      AnomalyConfig
+	anomalous      map[string]map[string]bool
+	anomalousMutex sync.Mutex
+	Cache          *cacheManager.CacheManager
+	connStr        string
+	Db             *gorm.DB
+	Metrics        map[string]*prometheus.GaugeVec
+	Notifier       *notificationManager.NotificationManager
+	Pipe           chan interface{}
+	Timescale      bool
 }
 
 // New is the function to create the struct DbParameter.
@@ -81,12 +152,84 @@ func New(dbConn string, tables ...interface{}) *DbParameter {
 	//Database migration, it handles everything
 	dp.Db.AutoMigrate(tables...)
 
-	//l.Trace.Printf("[DB] Generating hypertables.\n")
+	// Hypertables are created by EnableTimescale when TimescaleDB is available
+	return &dp
 
-	// Hypertables creation for timescaledb in case of needed
-	//dp.Db.Exec("SELECT create_hypertable('" + dp.Db.NewScope(&models.TABLE).TableName() + "', 'TIMESCALE-ROW-INDEX');")
+}
 
-	return &dp
+// EnableTimescale job is to turn the CDR records table into a TimescaleDB
+// hypertable, with its compression and retention policies, as long as the
+// extension is available in the database. Otherwise the system keeps working
+// on plain PostgreSQL.
+// Parameters:
+// - c: TimescaleConfig with the configuration of the support.
+func (d *DbParameter) EnableTimescale(c TimescaleConfig) {
+
+	if !c.Enabled {
+
+		return
+
+	}
+
+	l.Trace.Printf("[DB] Checking the TimescaleDB capabilities of the database.\n")
+
+	if e := d.Db.Exec("CREATE EXTENSION IF NOT EXISTS timescaledb").Error; e != nil {
+
+		l.Warning.Printf("[DB] TimescaleDB is not available, running on plain PostgreSQL. Error: %v\n", e)
+
+		return
+
+	}
+
+	var version string
+
+	if e := d.Db.Raw("SELECT extversion FROM pg_extension WHERE extname = 'timescaledb'").Scan(&version).Error; e != nil || version == "" {
+
+		l.Warning.Printf("[DB] TimescaleDB is not available, running on plain PostgreSQL. Error: %v\n", e)
+
+		return
+
+	}
+
+	l.Info.Printf("[DB] TimescaleDB [ %v ] detected, generating hypertables.\n", version)
+
+	table := d.Db.NamingStrategy.TableName("CDRRecord")
+
+	if e := d.Db.Exec("SELECT create_hypertable(?::regclass, ?, chunk_time_interval => make_interval(secs => ?), if_not_exists => TRUE, migrate_data => TRUE)", table, d.Db.NamingStrategy.ColumnName("", "TimeFrom"), c.ChunkInterval.Seconds()).Error; e != nil {
+
+		l.Warning.Printf("[DB] The hypertable for [ %v ] couldn't be generated. Error: %v\n", table, e)
+
+		return
+
+	}
+
+	if after, exists := c.Compression[table]; exists && after > 0 {
+
+		segments := d.Db.NamingStrategy.ColumnName("", "ResourceType") + ", " + d.Db.NamingStrategy.ColumnName("", "AccountID")
+
+		if e := d.Db.Exec(fmt.Sprintf("ALTER TABLE %v SET (timescaledb.compress, timescaledb.compress_segmentby = '%v')", table, segments)).Error; e != nil {
+
+			l.Warning.Printf("[DB] The compression of [ %v ] couldn't be enabled. Error: %v\n", table, e)
+
+		} else if e := d.Db.Exec("SELECT add_compression_policy(?::regclass, make_interval(secs => ?), if_not_exists => TRUE)", table, after.Seconds()).Error; e != nil {
+
+			l.Warning.Printf("[DB] The compression policy of [ %v ] couldn't be added. Error: %v\n", table, e)
+
+		}
+
+	}
+
+	if after, exists := c.Retention[table]; exists && after > 0 {
+
+		if e := d.Db.Exec("SELECT add_retention_policy(?::regclass, make_interval(secs => ?), if_not_exists => TRUE)", table, after.Seconds()).Error; e != nil {
+
+			l.Warning.Printf("[DB] The retention policy of [ %v ] couldn't be added. Error: %v\n", table, e)
+
+		}
+
+	}
+
+	d.Timescale = true
 
 }
 
@@ -129,6 +272,8 @@ func (d *DbParameter) AddRecord(cdr models.CReport) (e error) {
 		var cdrrecord, cdrUpdate models.CDRRecord
 
 		cdrrecord.AccountID = cdr.AccountID
+		cdrrecord.CustomerID = cdr.CustomerID
+		cdrrecord.ResellerID = cdr.ResellerID
 		cdrrecord.Metadata = cdr.Usage[i].Metadata
 		cdrrecord.ResourceID = cdr.Usage[i].ResourceID
 		cdrrecord.ResourceName = cdr.Usage[i].ResourceName
@@ -181,6 +326,322 @@ func (d *DbParameter) AddRecord(cdr models.CReport) (e error) {
 
 }
 
+// DetectAnomalies job is to compare the cost of each resource type in the
+// provided CDR report against the baseline of the previous windows of the
+// account, saving and notifying the ones deviating significantly from it.
+// Parameters:
+// - cdr: CReport just processed and saved in the system.
+// Returns:
+// - e: error raised in case of problems.
+func (d *DbParameter) DetectAnomalies(cdr models.CReport) (e error) {
+
+	if !d.Anomalies.Enabled {
+
+		return
+
+	}
+
+	l.Trace.Printf("[DB] Attempting to detect anomalies in the CDR of account [ %v ] for period [ %v ] - [ %v ].\n", cdr.AccountID, cdr.TimeFrom, cdr.TimeTo)
+
+	// Reprocessed windows replace their records, so do their anomalies
+	if e = d.Db.Where(&models.Anomaly{AccountID: cdr.AccountID, TimeFrom: cdr.TimeFrom, TimeTo: cdr.TimeTo}).Delete(&models.Anomaly{}).Error; e != nil {
+
+		l.Warning.Printf("[DB] The clean up of previous anomalies failed. Error: %v\n", e)
+
+		return
+
+	}
+
+	costs := make(map[string]float64)
+	contributors := make(map[string][]*models.AnomalyContributor)
+
+	for _, use := range cdr.Usage {
+
+		c, _ := use.Cost["netTotal"].(float64)
+
+		costs[use.ResourceType] += c
+		contributors[use.ResourceType] = append(contributors[use.ResourceType], &models.AnomalyContributor{
+			Cost:         d.getNiceFloat(c),
+			ResourceID:   use.ResourceID,
+			ResourceName: use.ResourceName,
+		})
+
+	}
+
+	for resourceType, cost := range costs {
+
+		baseline, e := d.getAnomalyBaseline(cdr.AccountID, resourceType, cdr.TimeFrom)
+
+		if e != nil {
+
+			l.Warning.Printf("[DB] Something went wrong while retrieving the baseline of [ %v ] for account [ %v ]. Error: %v\n", resourceType, cdr.AccountID, e)
+
+			return e
+
+		}
+
+		if len(baseline) < d.Anomalies.MinSamples || len(baseline) < 2 {
+
+			continue
+
+		}
+
+		var mean, variance float64
+
+		for _, v := range baseline {
+
+			mean += v / float64(len(baseline))
+
+		}
+
+		for _, v := range baseline {
+
+			variance += (v - mean) * (v - mean) / float64(len(baseline)-1)
+
+		}
+
+		// A flat baseline would make any change infinitely significant, so the
+		// deviation is floored to a fraction of the baseline itself
+		deviation := math.Max(math.Sqrt(variance), math.Max(0.05*mean, 1/scaler))
+		score := (cost - mean) / deviation
+
+		anomalous := score >= d.Anomalies.Threshold && cost-mean >= d.Anomalies.MinDelta
+
+		d.trackAnomaly(cdr.AccountID, resourceType, anomalous)
+
+		if !anomalous {
+
+			continue
+
+		}
+
+		top := contributors[resourceType]
+
+		sort.Slice(top, func(i, j int) bool { return top[i].Cost > top[j].Cost })
+
+		if len(top) > anomalyContributors {
+
+			top = top[:anomalyContributors]
+
+		}
+
+		anomaly := models.Anomaly{
+			AccountID:    cdr.AccountID,
+			Baseline:     d.getNiceFloat(mean),
+			Contributors: top,
+			Cost:         d.getNiceFloat(cost),
+			CustomerID:   cdr.CustomerID,
+			DetectedAt:   strfmt.DateTime(time.Now()),
+			ResourceType: resourceType,
+			Samples:      int64(len(baseline)),
+			Score:        d.getNiceFloat(score),
+			StdDev:       d.getNiceFloat(math.Sqrt(variance)),
+			TimeFrom:     cdr.TimeFrom,
+			TimeTo:       cdr.TimeTo,
+		}
+
+		if e = d.Db.Create(&anomaly).Error; e != nil {
+
+			l.Warning.Printf("[DB] Something went wrong while saving the anomaly of [ %v ] for account [ %v ]. Error: %v\n", resourceType, cdr.AccountID, e)
+
+			return e
+
+		}
+
+		l.Info.Printf("[DB] Anomaly detected in [ %v ] for account [ %v ]: cost [ %v ] against a baseline of [ %v ].\n", resourceType, cdr.AccountID, anomaly.Cost, anomaly.Baseline)
+
+		d.Metrics["count"].With(prometheus.Labels{"type": "Usage anomalies detected"}).Inc()
+
+		if d.Anomalies.Webhook != "" && d.Notifier != nil {
+
+			if e := d.Notifier.SendWebhook(d.Anomalies.Webhook, anomaly); e != nil {
+
+				d.Metrics["count"].With(prometheus.Labels{"type": "Usage anomalies notification FAILED"}).Inc()
+
+			}
+
+		}
+
+	}
+
+	return nil
+
+}
+
+// trackAnomaly job is to keep the accounts whose last cost is anomalous per
+// resource type, exporting only their count as metric so its cardinality is
+// bounded by the resource types, the details being kept in the anomalies.
+// Parameters:
+// - account: string with the ID of the account.
+// - resourceType: string with the resource type evaluated.
+// - anomalous: bool reporting if the last cost of the account is anomalous.
+func (d *DbParameter) trackAnomaly(account, resourceType string, anomalous bool) {
+
+	d.anomalousMutex.Lock()
+	defer d.anomalousMutex.Unlock()
+
+	if d.anomalous == nil {
+
+		d.anomalous = make(map[string]map[string]bool)
+
+	}
+
+	if d.anomalous[resourceType] == nil {
+
+		d.anomalous[resourceType] = make(map[string]bool)
+
+	}
+
+	if anomalous {
+
+		d.anomalous[resourceType][account] = true
+
+	} else {
+
+		delete(d.anomalous[resourceType], account)
+
+	}
+
+	d.Metrics["anomaly"].With(prometheus.Labels{"resourcetype": resourceType}).Set(float64(len(d.anomalous[resourceType])))
+
+}
+
+// GetAnomaly job is to retrieve the anomaly linked to the provided ID.
+// Parameters:
+// - id: UUID containing the id of the anomaly to be retrieved.
+// Returns:
+// - reference to Anomaly containing the anomaly, nil if it doesn't exist.
+// - error raised in case of problems.
+func (d *DbParameter) GetAnomaly(id strfmt.UUID) (*models.Anomaly, error) {
+
+	l.Trace.Printf("[DB] Attempting to retrieve the anomaly [ %v ].\n", id)
+
+	var a models.Anomaly
+
+	if e := d.Db.Where(&models.Anomaly{ID: id}).First(&a).Error; e != nil {
+
+		if errors.Is(e, gorm.ErrRecordNotFound) {
+
+			l.Trace.Printf("[DB] Anomaly [ %v ] not found in the system.\n", id)
+
+			return nil, nil
+
+		}
+
+		l.Warning.Printf("[DB] Something went wrong while retrieving the anomaly [ %v ]. Error: %v\n", id, e)
+
+		return nil, e
+
+	}
+
+	return &a, nil
+
+}
+
+// ListAnomalies job is to retrieve the anomalies detected in the system,
+// optionally filtered by account, resource type and time-window.
+// Parameters:
+// - account: string with the id of the account to filter by.
+// - resourceType: string with the resource type to filter by.
+// - from: a datatime reference for the initial border of the time-window.
+// - to: a datatime reference for the final border of the time-window.
+// Returns:
+// - slice of Anomaly references with the anomalies found.
+// - error raised in case of problems.
+func (d *DbParameter) ListAnomalies(account, resourceType string, from, to strfmt.DateTime) ([]*models.Anomaly, error) {
+
+	l.Trace.Printf("[DB] Attempting to list the anomalies in the system.\n")
+
+	var a []*models.Anomaly
+
+	q := d.Db.Where(&models.Anomaly{AccountID: account, ResourceType: resourceType})
+
+	if window := d.getWindow(from, to); window != "" {
+
+		q = q.Where(window)
+
+	}
+
+	if e := q.Order(d.Db.NamingStrategy.ColumnName("", "TimeFrom") + " DESC").Find(&a).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while listing the anomalies. Error: %v\n", e)
+
+		return nil, e
+
+	}
+
+	l.Debug.Printf("[DB] [ %v ] anomalies found in the system.\n", len(a))
+
+	return a, nil
+
+}
+
+// getAnomalyBaseline job is to retrieve the cost of the previous windows of
+// the account for the provided resource type, as the reference to compare with.
+// The windows since the first record of the account without cost for the
+// resource type are part of the baseline with a zero cost.
+// Parameters:
+// - account: string with the id of the account.
+// - resourceType: string with the resource type.
+// - before: datetime of the window being analyzed.
+// Returns:
+// - baseline: slice with the cost of each previous window, newest first.
+// - e: error raised in case of problems.
+func (d *DbParameter) getAnomalyBaseline(account, resourceType string, before strfmt.DateTime) (baseline []float64, e error) {
+
+	var windows []time.Time
+	var rows []map[string]interface{}
+
+	timeFrom := d.Db.NamingStrategy.ColumnName("", "TimeFrom")
+
+	first := d.Db.Model(&models.CDRRecord{}).Select(fmt.Sprintf("MIN(%v)", timeFrom)).Where(&models.CDRRecord{AccountID: account})
+
+	e = d.Db.Model(&models.CDRRecord{}).Distinct().
+		Where(fmt.Sprintf("%v < ? AND %v >= (?)", timeFrom, timeFrom), before, first).
+		Order(timeFrom + " DESC").Limit(d.Anomalies.History).
+		Pluck(timeFrom, &windows).Error
+
+	if e != nil || len(windows) == 0 {
+
+		return
+
+	}
+
+	e = d.Db.Model(&models.CDRRecord{}).
+		Select(fmt.Sprintf("%v AS bucket, SUM(COALESCE((%v->>'netTotal')::float8, 0))::float8 AS cost", timeFrom, d.Db.NamingStrategy.ColumnName("", "Cost"))).
+		Where(&models.CDRRecord{AccountID: account, ResourceType: resourceType}).
+		Where(fmt.Sprintf("%v IN ?", timeFrom), windows).
+		Group(timeFrom).
+		Scan(&rows).Error
+
+	if e != nil {
+
+		return
+
+	}
+
+	costs := make(map[int64]float64)
+
+	for _, row := range rows {
+
+		if w, ok := row["bucket"].(time.Time); ok {
+
+			costs[w.Unix()], _ = row["cost"].(float64)
+
+		}
+
+	}
+
+	for _, w := range windows {
+
+		baseline = append(baseline, costs[w.Unix()])
+
+	}
+
+	return
+
+}
+
 // GetReport job is to retrieve the compacted usage records from the system for
 // the provided account in the requested time-window with the posibility of
 // filter by metric.
@@ -219,345 +680,896 @@ func (d *DbParameter) GetReport(id string, metric string, from strfmt.DateTime,
 
 	} else {
 
-		l.Debug.Printf("[DB] [ %v ] compacted usage records retrieved from the system.\n", len(r))
+		l.Debug.Printf("[DB] [ %v ] compacted usage records retrieved from the system.\n", len(r))
+
+	}
+
+	return r
+
+}
+
+// GetUsage job is to retrieve the usage report of the system or the specified
+// account within the requested time-window with the posibility to filter by
+// metric.
+// Parameters:
+// - id: string containing the id of the account requested.
+// - metric: a string with the metric to filter the records.
+// - from: a datatime reference for the initial border of the time-window.
+// - to: a datatime reference for the final border of the time-window.
+// Returns:
+// - a slice of references with the usage report contained in the system within
+// the requested time-window.
+// - error in case of duplication or failure in the task.
+func (d *DbParameter) GetUsage(id, metric string, from, to strfmt.DateTime) ([]*models.CReport, error) {
+
+	l.Trace.Printf("[DB] Attempting to retrieve the usage report from the system.\n")
+
+	var r []*models.CReport
+	var r0 models.CReport
+	var e error
+
+	window := d.getWindow(from, to)
+
+	if id != "" {
+
+		r0.AccountID = id
+
+	}
+
+	e = d.Db.Where(window).Where(&r0).Find(&r).Error
+
+	if e == nil {
+
+		for idx := range r {
+
+			r[idx].Usage = d.GetReport(r[idx].AccountID, metric, r[idx].TimeFrom, r[idx].TimeTo)
+
+		}
+
+		l.Debug.Printf("[DB] [ %v ] Usage reports retrieved from the system.\n", len(r))
+
+	} else {
+
+		l.Warning.Printf("[DB] Something went wrong while retrieving the usage reports from the system. Error: %v\n", e)
+
+	}
+
+	return r, e
+
+}
+
+// GetUsages job is to retrieve the usage report of the system or the specified
+// account accounts within the requested time-window with the posibility to
+// filter by metric.
+// Parameters:
+// - ids: string containing the list of ids of the accounts requested.
+// - metric: a string with the metric to filter the records.
+// - from: a datatime reference for the initial border of the time-window.
+// - to: a datatime reference for the final border of the time-window.
+// Returns:
+// - a slice of references with the usage report contained in the system within
+// the requested time-window.
+// - error in case of duplication or failure in the task.
+func (d *DbParameter) GetUsages(ids, metric string, from, to strfmt.DateTime) ([]*models.CReport, error) {
+
+	l.Trace.Printf("[DB] Attempting to retrieve the usage report from the system.\n")
+
+	var total, r []*models.CReport
+	var r0 models.CReport
+	var e error
+
+	window := d.getWindow(from, to)
+
+	list := strings.Split(ids, ",")
+
+	for _, acc := range list {
+
+		r0.AccountID = acc
+
+		if e = d.Db.Where(window).Where(&r0).Find(&r).Error; e == nil {
+
+			for idx := range r {
+
+				r[idx].Usage = d.GetReport(r[idx].AccountID, metric, r[idx].TimeFrom, r[idx].TimeTo)
+
+			}
+
+			l.Debug.Printf("[DB] [ %v ] Usage reports retrieved from the system.\n", len(r))
+
+		} else {
+
+			l.Warning.Printf("[DB] Something went wrong while retrieving the usage reports from the system. Error: %v\n", e)
+
+		}
+
+		total = append(total, r...)
+
+	}
+
+	return total, e
+
+}
+
+// GetUsageSummary job is to retrieve the usage report of the system of the specified
+// account within the requested time-window and process it into an easy to use
+// estructure for the UI.
+// Parameters:
+// - id: string containing the id of the account requested.
+// - from: a datatime reference for the initial border of the time-window.
+// - to: a datatime reference for the final border of the time-window.
+// Returns:
+// - a references with the usage report contained in the system within
+// the requested time-window.
+// - error in case of duplication or failure in the task.
+func (d *DbParameter) GetUsageSummary(id string, from, to strfmt.DateTime) (*models.UISummary, error) {
+
+	l.Trace.Printf("[DB] Attempting to retrieve the usage report from the system.\n")
+
+	var usages []*models.CDRReport
+	var report models.UISummary
+
+	interval := float64(28800) //UDR are created every 8h and the usage is provided in GB*s...
+	rounder := float64(((time.Time)(to)).Sub((time.Time)(from)).Seconds()) / interval
+
+	report.AccountID = id
+	report.TimeFrom = from
+	report.TimeTo = to
+
+	// Get the reseller data
+	r, err := d.Cache.Get(id, "reseller", "")
+
+	if err != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while retrieving the reseller info for id [ %v ]. Error: %v\n", id, err)
+
+		return nil, err
+
+	}
+
+	reseller := r.(cusModels.Reseller)
+
+	// Get all the usages linked to the reseller
+	for _, customer := range reseller.Customers {
+
+		for _, product := range customer.Products {
+
+			u := d.GetReport(product.ProductID, "", from, to)
+
+			usages = append(usages, u...)
+
+		}
+
+	}
+
+	// Scan the usages and make the numbers
+	resourcesMap := make(datamodels.JSONdb)
+
+	for i := range usages {
+
+		masterKey := strings.ToTitle(usages[i].ResourceType)
+
+		if _, exists := resourcesMap[masterKey]; !exists {
+
+			resourcesMap[masterKey] = make(datamodels.JSONdb)
+
+		}
+
+		// First the total
+		key := "TotalCount"
+		if _, exists := resourcesMap[masterKey].(datamodels.JSONdb)[key]; !exists {
+
+			resourcesMap[masterKey].(datamodels.JSONdb)[key] = float64(0)
+
+		}
+
+		resourcesMap[masterKey].(datamodels.JSONdb)[key] = resourcesMap[masterKey].(datamodels.JSONdb)[key].(float64) + float64(1/rounder)
+
+		// Second the regions
+		if region, exists := usages[i].Metadata["region"]; exists {
+
+			key := "Regions"
+
+			if _, exists := resourcesMap[masterKey].(datamodels.JSONdb)[key]; !exists {
+
+				resourcesMap[masterKey].(datamodels.JSONdb)[key] = make(datamodels.JSONdb)
+
+			}
+
+			regionKey := region.(string)
+			if _, exists := resourcesMap[masterKey].(datamodels.JSONdb)[key].(datamodels.JSONdb)[regionKey]; !exists {
+
+				resourcesMap[masterKey].(datamodels.JSONdb)[key].(datamodels.JSONdb)[regionKey] = float64(0)
+
+			}
+
+			resourcesMap[masterKey].(datamodels.JSONdb)[key].(datamodels.JSONdb)[regionKey] = resourcesMap[masterKey].(datamodels.JSONdb)[key].(datamodels.JSONdb)[regionKey].(float64) + float64(1/rounder)
+
+		}
+
+		// Third the flavors in case of
+		if flavor, exists := usages[i].Metadata["flavorname"]; exists {
+
+			key := "Flavors"
+
+			if _, exists := resourcesMap[masterKey].(datamodels.JSONdb)[key]; !exists {
+
+				resourcesMap[masterKey].(datamodels.JSONdb)[key] = make(datamodels.JSONdb)
+
+			}
+
+			flavorKey := flavor.(string)
+			if _, exists := resourcesMap[masterKey].(datamodels.JSONdb)[key].(datamodels.JSONdb)[flavorKey]; !exists {
+
+				resourcesMap[masterKey].(datamodels.JSONdb)[key].(datamodels.JSONdb)[flavorKey] = float64(0)
+
+			}
+
+			resourcesMap[masterKey].(datamodels.JSONdb)[key].(datamodels.JSONdb)[flavorKey] = resourcesMap[masterKey].(datamodels.JSONdb)[key].(datamodels.JSONdb)[flavorKey].(float64) + float64(1/rounder)
+
+		}
+
+		// Fourth the sizes in case of
+		if size, exists := usages[i].Metadata["size"]; exists {
+
+			sizeF, err := strconv.ParseFloat(size.(string), 64)
+
+			if err != nil {
+
+				l.Warning.Printf("[DB] Failed to turn the size [ %v ] into a float64 value. Error: %v\n", size, err)
+
+			} else {
+
+				key := "TotalAmount"
+
+				if _, exists := resourcesMap[key]; !exists {
+
+					resourcesMap[masterKey].(datamodels.JSONdb)[key] = float64(0)
+
+				}
+
+				resourcesMap[masterKey].(datamodels.JSONdb)[key] = resourcesMap[masterKey].(datamodels.JSONdb)[key].(float64) + float64(sizeF/rounder)
+
+			}
+
+		}
+
+		// UDR usages
+		if size, exists := usages[i].UsageBreakup["used"]; exists {
+
+			var sizeF float64
+			key := "TotalAmount"
+
+			// size to float64
+			if k := reflect.ValueOf(size); k.Kind() == reflect.Float64 {
+
+				sizeF = size.(float64) / interval
+
+			} else {
+
+				v, err := size.(json.Number).Float64()
+
+				if err != nil {
+
+					l.Warning.Printf("[DB] Failed to turn the size [ %v ] into a float64 value. Error: %v\n", size, err)
+
+				} else {
+
+					sizeF = float64(v) / interval
+
+				}
+
+			}
+
+			if _, exists := resourcesMap[masterKey].(datamodels.JSONdb)[key]; !exists {
+
+				resourcesMap[masterKey].(datamodels.JSONdb)[key] = float64(0)
+
+			}
+
+			resourcesMap[masterKey].(datamodels.JSONdb)[key] = resourcesMap[masterKey].(datamodels.JSONdb)[key].(float64) + float64(sizeF/rounder)
+
+		}
+
+	}
+
+	// Reshape of the JSON to easy showing in the front..
+	for key, value := range resourcesMap {
+
+		metricData := value.(datamodels.JSONdb)
+
+		if _, exists := metricData["Regions"]; exists {
+
+			var regions []datamodels.JSONdb
+
+			for k, v := range metricData["Regions"].(datamodels.JSONdb) {
+
+				region := make(datamodels.JSONdb)
+				region["name"] = k
+				region["amount"] = v
+
+				regions = append(regions, region)
+
+			}
+
+			resourcesMap[key].(datamodels.JSONdb)["Regions"] = regions
+
+		}
+
+		if _, exists := metricData["Flavors"]; exists {
+
+			var regions []datamodels.JSONdb
+
+			for k, v := range metricData["Flavors"].(datamodels.JSONdb) {
+
+				region := make(datamodels.JSONdb)
+				region["name"] = k
+				region["amount"] = v
+
+				regions = append(regions, region)
+
+			}
+
+			resourcesMap[key].(datamodels.JSONdb)["Flavors"] = regions
+
+		}
+
+	}
+
+	// Create the summarey report
+	report.UsageBreakup = resourcesMap
+
+	return &report, nil
+
+}
+
+// QueryUsage job is to aggregate the cost and usage records in the system
+// grouped by the requested dimensions and filtered by the provided values,
+// the aggregation is done in the db over the JSONB cost data.
+// Parameters:
+// - groupBy: a slice of strings with the dimensions to group the records by,
+// the allowed ones are account, customer, reseller, resourceType, sku, region
+// and one of the time granularities day, week or month.
+// - filters: a map with the dimensions to filter by and the list of values
+// allowed for each of them.
+// - from: a datatime reference for the initial border of the time-window.
+// - to: a datatime reference for the final border of the time-window.
+// Returns:
+// - a reference to the aggregated report with the series requested.
+// - error in case of an unknown dimension or failure in the task.
+func (d *DbParameter) QueryUsage(groupBy []string, filters map[string][]string, from, to strfmt.DateTime) (*models.UsageQueryReport, error) {
+
+	l.Trace.Printf("[DB] Attempting to aggregate the usage records grouped by [ %v ].\n", groupBy)
+
+	var rows []map[string]interface{}
+	var selects, groups, dimensions []string
+
+	report := models.UsageQueryReport{
+		GroupBy:  []string{},
+		Series:   []*models.UsageQueryItem{},
+		TimeFrom: from,
+		TimeTo:   to,
+	}
+
+	expressions := d.getQueryDimensions()
+	lateral := false
+	period := false
+
+	for _, g := range groupBy {
+
+		dim := strings.ToLower(strings.TrimSpace(g))
+
+		if dim == "" {
+
+			continue
+
+		}
+
+		expr, exists := expressions[dim]
+
+		if !exists {
+
+			return nil, fmt.Errorf("%w: [ %v ]", ErrUnknownDimension, g)
+
+		}
+
+		if dim == "day" || dim == "week" || dim == "month" {
+
+			if period {
+
+				return nil, fmt.Errorf("%w: only one time granularity is allowed", ErrUnknownDimension)
+
+			}
+
+			period = true
+
+		}
+
+		if dim == "sku" {
+
+			lateral = true
+
+		}
+
+		alias := fmt.Sprintf("d%v", len(dimensions))
+
+		selects = append(selects, fmt.Sprintf("%v AS %v", expr, alias))
+		groups = append(groups, alias)
+		dimensions = append(dimensions, dim)
+
+		report.GroupBy = append(report.GroupBy, queryDimensionNames[dim])
+
+	}
+
+	if _, exists := filters["sku"]; exists {
+
+		lateral = true
+
+	}
+
+	cost := fmt.Sprintf("COALESCE((%v->>'netTotal')::float8, 0)", d.Db.NamingStrategy.ColumnName("", "Cost"))
+	usage := fmt.Sprintf("(SELECT COALESCE(SUM((u.value#>>'{}')::float8), 0) FROM jsonb_each(%v) u WHERE jsonb_typeof(u.value) = 'number')", d.Db.NamingStrategy.ColumnName("", "UsageBreakup"))
+
+	// With the skus in play the net cost of each sku line carries its share
+	// of the plan discount applied to the whole record, while the records
+	// without a cost breakup keep their whole cost and usage
+	if lateral {
+
+		cost = fmt.Sprintf("CASE WHEN sku.item IS NULL THEN %[2]v WHEN COALESCE((%[1]v->>'totalFromSku')::float8, 0) = 0 THEN 0 ELSE COALESCE((sku.item->>'sku-net')::float8, 0) * (%[1]v->>'netTotal')::float8 / (%[1]v->>'totalFromSku')::float8 END", d.Db.NamingStrategy.ColumnName("", "Cost"), cost)
+		usage = fmt.Sprintf("CASE WHEN sku.item IS NULL THEN %[2]v ELSE COALESCE((%[1]v->>(sku.item->>'sku-state'))::float8, 0) END", d.Db.NamingStrategy.ColumnName("", "UsageBreakup"), usage)
+
+	}
+
+	unit := d.Db.NamingStrategy.ColumnName("", "Unit")
+
+	selects = append(selects, fmt.Sprintf("%v AS unit", unit), fmt.Sprintf("SUM(%v)::float8 AS cost", cost), fmt.Sprintf("SUM(%v)::float8 AS usage", usage), "COUNT(*) AS records")
+	groups = append(groups, "unit")
+
+	q := d.Db.Table(d.Db.NamingStrategy.TableName("CDRRecord")).Select(strings.Join(selects, ", "))
+
+	if lateral {
+
+		q = q.Joins(fmt.Sprintf("LEFT JOIN LATERAL jsonb_array_elements(COALESCE(%v->'costBreakup', '[]'::jsonb)) AS sku(item) ON true", d.Db.NamingStrategy.ColumnName("", "Cost")))
+
+	}
+
+	if window := d.getWindow(from, to); window != "" {
+
+		q = q.Where(window)
+
+	}
+
+	for dim, values := range filters {
+
+		expr, exists := expressions[dim]
+
+		if !exists || dim == "day" || dim == "week" || dim == "month" {
+
+			return nil, fmt.Errorf("%w: [ %v ]", ErrUnknownDimension, dim)
+
+		}
+
+		q = q.Where(fmt.Sprintf("%v IN ?", expr), values)
+
+	}
+
+	if e := q.Group(strings.Join(groups, ", ")).Order(strings.Join(groups, ", ")).Scan(&rows).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while aggregating the usage records. Error: %v\n", e)
+
+		return nil, e
+
+	}
+
+	// The rows come split by unit, so they are merged into a single item per
+	// combination of dimensions with the usage broken down by unit
+	items := make(map[string]*models.UsageQueryItem)
+
+	for _, row := range rows {
+
+		var keys []string
+
+		dims := make(datamodels.JSONdb)
+
+		for i, dim := range dimensions {
+
+			value := row[fmt.Sprintf("d%v", i)]
+
+			if t, ok := value.(time.Time); ok {
+
+				value = strfmt.DateTime(t)
+
+			}
+
+			if value == nil {
+
+				value = ""
+
+			}
+
+			dims[queryDimensionNames[dim]] = value
+			keys = append(keys, fmt.Sprintf("%v", value))
 
-	}
+		}
 
-	return r
+		key := strings.Join(keys, "|")
 
-}
+		item, exists := items[key]
 
-// GetUsage job is to retrieve the usage report of the system or the specified
-// account within the requested time-window with the posibility to filter by
-// metric.
-// Parameters:
-// - id: string containing the id of the account requested.
-// - metric: a string with the metric to filter the records.
-// - from: a datatime reference for the initial border of the time-window.
-// - to: a datatime reference for the final border of the time-window.
-// Returns:
-// - a slice of references with the usage report contained in the system within
-// the requested time-window.
-// - error in case of duplication or failure in the task.
-func (d *DbParameter) GetUsage(id, metric string, from, to strfmt.DateTime) ([]*models.CReport, error) {
+		if !exists {
 
-	l.Trace.Printf("[DB] Attempting to retrieve the usage report from the system.\n")
+			item = &models.UsageQueryItem{
+				Dimensions: dims,
+				Usage:      make(datamodels.JSONdb),
+			}
 
-	var r []*models.CReport
-	var r0 models.CReport
-	var e error
+			items[key] = item
+			report.Series = append(report.Series, item)
 
-	window := d.getWindow(from, to)
+		}
 
-	if id != "" {
+		u := "none"
 
-		r0.AccountID = id
+		if value, ok := row["unit"].(string); ok && value != "" {
 
-	}
+			u = value
 
-	e = d.Db.Where(window).Where(&r0).Find(&r).Error
+		}
 
-	if e == nil {
+		c, _ := row["cost"].(float64)
+		amount, _ := row["usage"].(float64)
+		records, _ := row["records"].(int64)
 
-		for idx := range r {
+		if previous, exists := item.Usage[u]; exists {
 
-			r[idx].Usage = d.GetReport(r[idx].AccountID, metric, r[idx].TimeFrom, r[idx].TimeTo)
+			amount = amount + previous.(float64)
 
 		}
 
-		l.Debug.Printf("[DB] [ %v ] Usage reports retrieved from the system.\n", len(r))
-
-	} else {
+		item.Usage[u] = d.getNiceFloat(amount)
+		item.Cost = d.getNiceFloat(item.Cost + c)
+		item.Records = item.Records + records
 
-		l.Warning.Printf("[DB] Something went wrong while retrieving the usage reports from the system. Error: %v\n", e)
+		report.TotalCost = report.TotalCost + c
 
 	}
 
-	return r, e
+	report.TotalCost = d.getNiceFloat(report.TotalCost)
+
+	l.Debug.Printf("[DB] [ %v ] series aggregated from the usage records in the system.\n", len(report.Series))
+
+	return &report, nil
 
 }
 
-// GetUsages job is to retrieve the usage report of the system or the specified
-// account accounts within the requested time-window with the posibility to
-// filter by metric.
+// ForecastSpend job is to project the spend of each customer and sku until the
+// end of the period. A linear trend is fitted over the daily costs of the
+// history window, adjusted with day-of-week factors when at least two weeks
+// of history are available, and the residuals of the fit provide the bands.
 // Parameters:
-// - ids: string containing the list of ids of the accounts requested.
-// - metric: a string with the metric to filter the records.
-// - from: a datatime reference for the initial border of the time-window.
-// - to: a datatime reference for the final border of the time-window.
+// - filters: a map with the lowercased dimension and the values to keep.
+// - history: number of days of history used to fit the model.
+// - periodEnd: datetime until which the spend is projected, at most
+// forecastHorizon days ahead, by default the end of the current month.
 // Returns:
-// - a slice of references with the usage report contained in the system within
-// the requested time-window.
-// - error in case of duplication or failure in the task.
-func (d *DbParameter) GetUsages(ids, metric string, from, to strfmt.DateTime) ([]*models.CReport, error) {
-
-	l.Trace.Printf("[DB] Attempting to retrieve the usage report from the system.\n")
-
-	var total, r []*models.CReport
-	var r0 models.CReport
-	var e error
+// - reference to the SpendForecastReport with the projections.
+// - error raised in case of problems.
+func (d *DbParameter) ForecastSpend(filters map[string][]string, history int64, periodEnd strfmt.DateTime) (*models.SpendForecastReport, error) {
 
-	window := d.getWindow(from, to)
+	l.Trace.Printf("[DB] Attempting to forecast the spend using [ %v ] days of history.\n", history)
 
-	list := strings.Split(ids, ",")
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	periodStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := (time.Time)(periodEnd)
 
-	for _, acc := range list {
+	if end.IsZero() {
 
-		r0.AccountID = acc
+		end = periodStart.AddDate(0, 1, 0)
 
-		if e = d.Db.Where(window).Where(&r0).Find(&r).Error; e == nil {
+	}
 
-			for idx := range r {
+	if history < 1 {
 
-				r[idx].Usage = d.GetReport(r[idx].AccountID, metric, r[idx].TimeFrom, r[idx].TimeTo)
+		return nil, fmt.Errorf("%w: the history must be at least one day", ErrInvalidForecast)
 
-			}
+	}
 
-			l.Debug.Printf("[DB] [ %v ] Usage reports retrieved from the system.\n", len(r))
+	if history > forecastHistoryMax {
 
-		} else {
+		history = forecastHistoryMax
 
-			l.Warning.Printf("[DB] Something went wrong while retrieving the usage reports from the system. Error: %v\n", e)
+	}
 
-		}
+	if !end.After(now) {
 
-		total = append(total, r...)
+		return nil, fmt.Errorf("%w: the end of the period must be in the future", ErrInvalidForecast)
 
 	}
 
-	return total, e
+	// The projection runs day by day, so its horizon is bounded
+	if end.After(today.AddDate(0, 0, forecastHorizon)) {
 
-}
+		return nil, fmt.Errorf("%w: the end of the period must be within %v days", ErrInvalidForecast, forecastHorizon)
 
-// GetUsageSummary job is to retrieve the usage report of the system of the specified
-// account within the requested time-window and process it into an easy to use
-// estructure for the UI.
-// Parameters:
-// - id: string containing the id of the account requested.
-// - from: a datatime reference for the initial border of the time-window.
-// - to: a datatime reference for the final border of the time-window.
-// Returns:
-// - a references with the usage report contained in the system within
-// the requested time-window.
-// - error in case of duplication or failure in the task.
-func (d *DbParameter) GetUsageSummary(id string, from, to strfmt.DateTime) (*models.UISummary, error) {
+	}
 
-	l.Trace.Printf("[DB] Attempting to retrieve the usage report from the system.\n")
+	from := today.AddDate(0, 0, -int(history))
 
-	var usages []*models.CDRReport
-	var report models.UISummary
+	past, e := d.QueryUsage([]string{"customer", "sku", "day"}, filters, strfmt.DateTime(from), strfmt.DateTime(today))
 
-	interval := float64(28800) //UDR are created every 8h and the usage is provided in GB*s...
-	rounder := float64(((time.Time)(to)).Sub((time.Time)(from)).Seconds()) / interval
+	if e != nil {
 
-	report.AccountID = id
-	report.TimeFrom = from
-	report.TimeTo = to
+		return nil, e
 
-	// Get the reseller data
-	r, err := d.Cache.Get(id, "reseller", "")
+	}
 
-	if err != nil {
+	current, e := d.QueryUsage([]string{"customer", "sku"}, filters, strfmt.DateTime(periodStart), strfmt.DateTime(now))
 
-		l.Warning.Printf("[DB] Something went wrong while retrieving the reseller info for id [ %v ]. Error: %v\n", id, err)
+	if e != nil {
 
-		return nil, err
+		return nil, e
 
 	}
 
-	reseller := r.(cusModels.Reseller)
+	report := models.SpendForecastReport{
+		Confidence:  0.95,
+		Forecasts:   []*models.SpendForecast{},
+		HistoryDays: history,
+		PeriodEnd:   strfmt.DateTime(end),
+		PeriodStart: strfmt.DateTime(periodStart),
+	}
 
-	// Get all the usages linked to the reseller
-	for _, customer := range reseller.Customers {
+	series := make(map[string][]float64)
+	forecasts := make(map[string]*models.SpendForecast)
 
-		for _, product := range customer.Products {
+	getForecast := func(dims datamodels.JSONdb) (string, *models.SpendForecast) {
 
-			u := d.GetReport(product.ProductID, "", from, to)
+		customer, sku := fmt.Sprintf("%v", dims["customer"]), fmt.Sprintf("%v", dims["sku"])
+		key := customer + "|" + sku
 
-			usages = append(usages, u...)
+		if _, exists := forecasts[key]; !exists {
+
+			forecasts[key] = &models.SpendForecast{
+				CustomerID: customer,
+				Sku:        sku,
+			}
+
+			series[key] = make([]float64, history)
+			report.Forecasts = append(report.Forecasts, forecasts[key])
 
 		}
 
+		return key, forecasts[key]
+
 	}
 
-	// Scan the usages and make the numbers
-	resourcesMap := make(datamodels.JSONdb)
+	for _, item := range past.Series {
 
-	for i := range usages {
+		key, _ := getForecast(item.Dimensions)
 
-		masterKey := strings.ToTitle(usages[i].ResourceType)
+		period, ok := item.Dimensions["period"].(strfmt.DateTime)
 
-		if _, exists := resourcesMap[masterKey]; !exists {
+		if !ok {
 
-			resourcesMap[masterKey] = make(datamodels.JSONdb)
+			continue
 
 		}
 
-		// First the total
-		key := "TotalCount"
-		if _, exists := resourcesMap[masterKey].(datamodels.JSONdb)[key]; !exists {
+		if i := int(math.Round((time.Time)(period).Sub(from).Hours() / 24)); i >= 0 && i < len(series[key]) {
 
-			resourcesMap[masterKey].(datamodels.JSONdb)[key] = float64(0)
+			series[key][i] += item.Cost
 
 		}
 
-		resourcesMap[masterKey].(datamodels.JSONdb)[key] = resourcesMap[masterKey].(datamodels.JSONdb)[key].(float64) + float64(1/rounder)
+	}
 
-		// Second the regions
-		if region, exists := usages[i].Metadata["region"]; exists {
+	for _, item := range current.Series {
 
-			key := "Regions"
+		_, forecast := getForecast(item.Dimensions)
 
-			if _, exists := resourcesMap[masterKey].(datamodels.JSONdb)[key]; !exists {
+		forecast.ActualToDate = item.Cost
 
-				resourcesMap[masterKey].(datamodels.JSONdb)[key] = make(datamodels.JSONdb)
+	}
 
-			}
+	var variance float64
 
-			regionKey := region.(string)
-			if _, exists := resourcesMap[masterKey].(datamodels.JSONdb)[key].(datamodels.JSONdb)[regionKey]; !exists {
+	for key, forecast := range forecasts {
 
-				resourcesMap[masterKey].(datamodels.JSONdb)[key].(datamodels.JSONdb)[regionKey] = float64(0)
+		intercept, slope, sigma, seasonal := d.fitSpendModel(series[key], from)
 
-			}
+		var remaining, projected float64
 
-			resourcesMap[masterKey].(datamodels.JSONdb)[key].(datamodels.JSONdb)[regionKey] = resourcesMap[masterKey].(datamodels.JSONdb)[key].(datamodels.JSONdb)[regionKey].(float64) + float64(1/rounder)
+		// Each day left in the period contributes with the part of it that
+		// still has to happen, so today only counts for its remaining hours
+		for day := today; day.Before(end); day = day.AddDate(0, 0, 1) {
 
-		}
+			start, stop := day, day.AddDate(0, 0, 1)
 
-		// Third the flavors in case of
-		if flavor, exists := usages[i].Metadata["flavorname"]; exists {
+			if start.Before(now) {
 
-			key := "Flavors"
+				start = now
 
-			if _, exists := resourcesMap[masterKey].(datamodels.JSONdb)[key]; !exists {
+			}
 
-				resourcesMap[masterKey].(datamodels.JSONdb)[key] = make(datamodels.JSONdb)
+			if stop.After(end) {
+
+				stop = end
 
 			}
 
-			flavorKey := flavor.(string)
-			if _, exists := resourcesMap[masterKey].(datamodels.JSONdb)[key].(datamodels.JSONdb)[flavorKey]; !exists {
+			weight := stop.Sub(start).Hours() / 24
 
-				resourcesMap[masterKey].(datamodels.JSONdb)[key].(datamodels.JSONdb)[flavorKey] = float64(0)
+			if weight <= 0 {
+
+				continue
 
 			}
 
-			resourcesMap[masterKey].(datamodels.JSONdb)[key].(datamodels.JSONdb)[flavorKey] = resourcesMap[masterKey].(datamodels.JSONdb)[key].(datamodels.JSONdb)[flavorKey].(float64) + float64(1/rounder)
+			t := math.Round(day.Sub(from).Hours() / 24)
 
-		}
+			projected += weight * math.Max(0, (intercept+slope*t)*seasonal[day.Weekday()])
+			remaining += weight
 
-		// Fourth the sizes in case of
-		if size, exists := usages[i].Metadata["size"]; exists {
+		}
 
-			sizeF, err := strconv.ParseFloat(size.(string), 64)
+		band := 1.96 * sigma * math.Sqrt(remaining)
 
-			if err != nil {
+		forecast.Intercept = d.getNiceFloat(intercept)
+		forecast.Slope = d.getNiceFloat(slope)
+		forecast.Sigma = d.getNiceFloat(sigma)
+		forecast.SeasonalFactors = seasonal
+		forecast.Model = "linear"
 
-				l.Warning.Printf("[DB] Failed to turn the size [ %v ] into a float64 value. Error: %v\n", size, err)
+		if len(series[key]) >= 14 {
 
-			} else {
+			forecast.Model = "linear+weekday"
 
-				key := "TotalAmount"
+		}
 
-				if _, exists := resourcesMap[key]; !exists {
+		forecast.Projected = d.getNiceFloat(forecast.ActualToDate + projected)
+		forecast.Lower = d.getNiceFloat(math.Max(forecast.ActualToDate, forecast.ActualToDate+projected-band))
+		forecast.Upper = d.getNiceFloat(forecast.ActualToDate + projected + band)
 
-					resourcesMap[masterKey].(datamodels.JSONdb)[key] = float64(0)
+		report.ActualToDate += forecast.ActualToDate
+		report.Projected += forecast.ActualToDate + projected
+		variance += band * band
 
-				}
+	}
 
-				resourcesMap[masterKey].(datamodels.JSONdb)[key] = resourcesMap[masterKey].(datamodels.JSONdb)[key].(float64) + float64(sizeF/rounder)
+	// The series are taken as independent so the bands of the totals are
+	// combined in quadrature
+	band := math.Sqrt(variance)
 
-			}
+	report.Lower = d.getNiceFloat(math.Max(report.ActualToDate, report.Projected-band))
+	report.Upper = d.getNiceFloat(report.Projected + band)
+	report.ActualToDate = d.getNiceFloat(report.ActualToDate)
+	report.Projected = d.getNiceFloat(report.Projected)
 
-		}
+	l.Debug.Printf("[DB] [ %v ] spend forecasts computed until [ %v ].\n", len(report.Forecasts), end)
 
-		// UDR usages
-		if size, exists := usages[i].UsageBreakup["used"]; exists {
+	return &report, nil
 
-			var sizeF float64
-			key := "TotalAmount"
+}
 
-			// size to float64
-			if k := reflect.ValueOf(size); k.Kind() == reflect.Float64 {
+// fitSpendModel job is to fit the least squares line over a daily cost series
+// and, with at least two weeks of data, the multiplicative day-of-week
+// factors over that line.
+// Parameters:
+// - y: slice with the daily costs, one per day starting at from.
+// - from: datetime of the first day of the series.
+// Returns:
+// - intercept: the cost of the line at the first day of the series.
+// - slope: the daily increment of the cost.
+// - sigma: the standard deviation of the residuals of the fit.
+// - seasonal: the day-of-week factors, starting on sunday.
+func (d *DbParameter) fitSpendModel(y []float64, from time.Time) (intercept, slope, sigma float64, seasonal []float64) {
 
-				sizeF = size.(float64) / interval
+	n := float64(len(y))
+	seasonal = []float64{1, 1, 1, 1, 1, 1, 1}
 
-			} else {
+	var meanT, meanY, sxx, sxy float64
 
-				v, err := size.(json.Number).Float64()
+	for i, v := range y {
 
-				if err != nil {
+		meanT += float64(i) / n
+		meanY += v / n
 
-					l.Warning.Printf("[DB] Failed to turn the size [ %v ] into a float64 value. Error: %v\n", size, err)
+	}
 
-				} else {
+	for i, v := range y {
 
-					sizeF = float64(v) / interval
+		sxx += (float64(i) - meanT) * (float64(i) - meanT)
+		sxy += (float64(i) - meanT) * (v - meanY)
 
-				}
+	}
 
-			}
+	if sxx > 0 {
 
-			if _, exists := resourcesMap[masterKey].(datamodels.JSONdb)[key]; !exists {
+		slope = sxy / sxx
 
-				resourcesMap[masterKey].(datamodels.JSONdb)[key] = float64(0)
+	}
 
-			}
+	intercept = meanY - slope*meanT
 
-			resourcesMap[masterKey].(datamodels.JSONdb)[key] = resourcesMap[masterKey].(datamodels.JSONdb)[key].(float64) + float64(sizeF/rounder)
+	if len(y) >= 14 {
 
-		}
+		var actual, fitted [7]float64
 
-	}
+		for i, v := range y {
 
-	// Reshape of the JSON to easy showing in the front..
-	for key, value := range resourcesMap {
+			w := from.AddDate(0, 0, i).Weekday()
 
-		metricData := value.(datamodels.JSONdb)
+			actual[w] += v
+			fitted[w] += math.Max(0, intercept+slope*float64(i))
 
-		if _, exists := metricData["Regions"]; exists {
+		}
 
-			var regions []datamodels.JSONdb
+		var total float64
 
-			for k, v := range metricData["Regions"].(datamodels.JSONdb) {
+		for w := range seasonal {
 
-				region := make(datamodels.JSONdb)
-				region["name"] = k
-				region["amount"] = v
+			if fitted[w] > 0 {
 
-				regions = append(regions, region)
+				seasonal[w] = actual[w] / fitted[w]
 
 			}
 
-			resourcesMap[key].(datamodels.JSONdb)["Regions"] = regions
+			total += seasonal[w]
 
 		}
 
-		if _, exists := metricData["Flavors"]; exists {
+		if total > 0 {
 
-			var regions []datamodels.JSONdb
+			for w := range seasonal {
 
-			for k, v := range metricData["Flavors"].(datamodels.JSONdb) {
+				seasonal[w] = d.getNiceFloat(seasonal[w] * 7 / total)
 
-				region := make(datamodels.JSONdb)
-				region["name"] = k
-				region["amount"] = v
+			}
 
-				regions = append(regions, region)
+		}
 
-			}
+	}
 
-			resourcesMap[key].(datamodels.JSONdb)["Flavors"] = regions
+	if len(y) > 2 {
+
+		var squares float64
+
+		for i, v := range y {
+
+			r := v - math.Max(0, (intercept+slope*float64(i))*seasonal[from.AddDate(0, 0, i).Weekday()])
+			squares += r * r
 
 		}
 
+		sigma = math.Sqrt(squares / (n - 2))
+
 	}
 
-	// Create the summarey report
-	report.UsageBreakup = resourcesMap
+	return
 
-	return &report, nil
+}
+
+// getQueryDimensions job is to provide the SQL expressions computing each one
+// of the dimensions allowed in the usage queries over the CDR records.
+// Returns:
+// - m: a map with the lowercased dimension and its SQL expression.
+func (d *DbParameter) getQueryDimensions() (m map[string]string) {
+
+	timeFrom := d.Db.NamingStrategy.ColumnName("", "TimeFrom")
+
+	m = map[string]string{
+		"account":      d.Db.NamingStrategy.ColumnName("", "AccountID"),
+		"customer":     d.Db.NamingStrategy.ColumnName("", "CustomerID"),
+		"reseller":     d.Db.NamingStrategy.ColumnName("", "ResellerID"),
+		"resourcetype": d.Db.NamingStrategy.ColumnName("", "ResourceType"),
+		"sku":          "sku.item->>'sku'",
+		"region":       d.Db.NamingStrategy.ColumnName("", "Metadata") + "->>'region'",
+		"day":          fmt.Sprintf("date_trunc('day', %v)", timeFrom),
+		"week":         fmt.Sprintf("date_trunc('week', %v)", timeFrom),
+		"month":        fmt.Sprintf("date_trunc('month', %v)", timeFrom),
+	}
+
+	return
 
 }
 
@@ -657,6 +1669,21 @@ func (d *DbParameter) ProcessUDR(report udrModels.UReport, token string) (e erro
 
 	product := x.(cusModels.Product)
 
+	// The customer is needed to link the records with the organization hierarchy
+	var customer cusModels.Customer
+
+	c, ce := d.Cache.Get(product.CustomerID, "customer", token)
+
+	if ce != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while retrieving the customer info for id [ %v ]. Error: %v\n", product.CustomerID, ce)
+
+	} else {
+
+		customer = c.(cusModels.Customer)
+
+	}
+
 	if product.PlanID != "" {
 
 		planID = product.PlanID
@@ -667,18 +1694,12 @@ func (d *DbParameter) ProcessUDR(report udrModels.UReport, token string) (e erro
 
 	} else {
 
-		c, e := d.Cache.Get(product.CustomerID, "customer", token)
-
-		if e != nil {
-
-			l.Warning.Printf("[DB] Something went wrong while retrieving the customer info for id [ %v ]. Error: %v\n", product.CustomerID, e)
+		if ce != nil {
 
-			return e
+			return ce
 
 		}
 
-		customer := c.(cusModels.Customer)
-
 		if customer.PlanID != "" {
 
 			planID = customer.PlanID
@@ -874,8 +1895,8 @@ PlanDefault:
 
 				} else {
 
-					v, _ := stateUse.(json.Number).Int64()
-					usage = float64(v)
+					// The count of discrete events might be fractional
+					usage, _ = stateUse.(json.Number).Float64()
 
 				}
 
@@ -1031,6 +2052,8 @@ PlanDefault:
 	}
 
 	cdr.AccountID = report.AccountID
+	cdr.CustomerID = product.CustomerID
+	cdr.ResellerID = customer.ResellerID
 	cdr.TimeFrom = report.TimeFrom
 	cdr.TimeTo = report.TimeTo
 	cdr.Usage = usages
@@ -1045,6 +2068,12 @@ PlanDefault:
 
 	l.Trace.Printf("[DB] UDR processed and transformed into CDR and saved in the system successfully .\n")
 
+	if e := d.DetectAnomalies(cdr); e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while looking for anomalies in the CDR. Error: %v\n", e)
+
+	}
+
 	d.Pipe <- cdr
 
 	l.Trace.Printf("[DB] CDR transmited to the Credit Manager successfully .\n")
@@ -19,6 +19,18 @@ func prometheusStart() (metricsMap map[string]*prometheus.GaugeVec, register *pr
 	metricsMap = make(map[string]*prometheus.GaugeVec)
 	register = prometheus.NewPedanticRegistry()
 
+	metricAnomaly := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "CYCLOPS",
+			Subsystem: strings.Split(service, "-")[0] + "_Service",
+			Name:      "anomalies_active",
+			Help:      "Accounts whose last cost is anomalous per resource type",
+		},
+		[]string{
+			"resourcetype",
+		},
+	)
+
 	metricCache := prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: "CYCLOPS",
@@ -97,9 +109,10 @@ func prometheusStart() (metricsMap map[string]*prometheus.GaugeVec, register *pr
 		},
 	)
 
-	register.MustRegister(metricCache, metricCount, metricEndpoint, metricKafka,
-		metricSecurity, metricTime)
+	register.MustRegister(metricAnomaly, metricCache, metricCount, metricEndpoint,
+		metricKafka, metricSecurity, metricTime)
 
+	metricsMap["anomaly"] = metricAnomaly
 	metricsMap["cache"] = metricCache
 	metricsMap["count"] = metricCount
 	metricsMap["api"] = metricEndpoint
@@ -0,0 +1,81 @@
+package notificationManager
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	l "gitlab.com/cyclops-utilities/logging"
+)
+
+// NotificationManager is the struct defined to group and contain all the
+// methods that deliver notifications outside of the system.
+// Parameters:
+// - client: http.Client used to post the webhooks.
+type NotificationManager struct {
+	client *http.Client
+}
+
+// New is the function to create the struct NotificationManager.
+// Parameters:
+// - timeout: time.Duration with the max time to wait for a webhook to answer.
+// Returns:
+// - NotificationManager: struct to interact with NotificationManager subsystem
+// functionalities.
+func New(timeout time.Duration) *NotificationManager {
+
+	l.Trace.Printf("[NOTIFICATION] Initializing the notification service.\n")
+
+	return &NotificationManager{
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+
+}
+
+// SendWebhook job is to post the provided payload as JSON to the provided URL.
+// Parameters:
+// - url: string with the URL of the webhook.
+// - payload: interface with the data to be posted.
+// Returns:
+// - e: error raised in case of problems.
+func (n *NotificationManager) SendWebhook(url string, payload interface{}) (e error) {
+
+	l.Trace.Printf("[NOTIFICATION] Posting notification to webhook [ %v ].\n", url)
+
+	body, e := json.Marshal(payload)
+
+	if e != nil {
+
+		l.Warning.Printf("[NOTIFICATION] Unable to marshal the payload for the webhook. Error: %v\n", e)
+
+		return
+
+	}
+
+	r, e := n.client.Post(url, "application/json", bytes.NewReader(body))
+
+	if e != nil {
+
+		l.Warning.Printf("[NOTIFICATION] Something went wrong while posting to the webhook [ %v ]. Error: %v\n", url, e)
+
+		return
+
+	}
+
+	defer r.Body.Close()
+
+	if r.StatusCode < 200 || r.StatusCode > 299 {
+
+		e = fmt.Errorf("webhook answered with status [ %v ]", r.StatusCode)
+
+		l.Warning.Printf("[NOTIFICATION] The webhook [ %v ] didn't accept the notification. Error: %v\n", url, e)
+
+	}
+
+	return
+
+}
@@ -4,13 +4,16 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/cors"
 	"github.com/GoDieNow/TFT_Code/services/cdr/models"
 	"github.com/GoDieNow/TFT_Code/services/cdr/restapi"
+	"github.com/GoDieNow/TFT_Code/services/cdr/server/anomalyManager"
 	"github.com/GoDieNow/TFT_Code/services/cdr/server/dbManager"
+	"github.com/GoDieNow/TFT_Code/services/cdr/server/notificationManager"
 	"github.com/GoDieNow/TFT_Code/services/cdr/server/statusManager"
 	"github.com/GoDieNow/TFT_Code/services/cdr/server/triggerManager"
 	"github.com/GoDieNow/TFT_Code/services/cdr/server/usageManager"
@@ -30,15 +33,20 @@ func getService() (handler http.Handler, register *prometheus.Registry, e error)
 	l.Trace.Printf("[MAIN] Intializing service [ %v ] handler\n", strings.Title(service))
 
 	// TABLE0,...,N have to be customized
-	db := dbStart(&models.CDRRecord{}, &models.CReport{})
+	db := dbStart(&models.Anomaly{}, &models.CDRRecord{}, &models.CReport{})
 	mon := statusManager.New(db)
 
+	db.EnableTimescale(timescaleStart())
+
 	// Prometheus Metrics linked to dbParameter
 	db.Metrics, register = prometheusStart()
 
 	// cache linked to the dbParameter
 	db.Cache = cacheStart(db.Metrics["cache"])
 
+	// anomaly detection linked to the dbParameter
+	db.Anomalies, db.Notifier = anomaliesStart()
+
 	// In case of needed, here is where kafka support is started
 	// The functions needs to be customized accordingly to the needs of the service
 	// And the parts of the service that might need to send messages need to get
@@ -56,11 +64,13 @@ func getService() (handler http.Handler, register *prometheus.Registry, e error)
 	}
 
 	// Parts of the service HERE
+	a := anomalyManager.New(db, mon)
 	t := triggerManager.New(db, mon, uc)
 	u := usageManager.New(db, mon)
 
 	// Initiate the http handler, with the objects that are implementing the business logic.
 	h, e := restapi.Handler(restapi.Config{
+		AnomalyManagementAPI: a,
 		StatusManagementAPI:  mon,
 		TriggerManagementAPI: t,
 		UsageManagementAPI:   u,
@@ -155,3 +165,105 @@ func kafkaStart(db *dbManager.DbParameter, mon *statusManager.StatusManager) (ch
 	return
 
 }
+
+// anomaliesStart handles the initialization of the anomaly detection, filling
+// the gaps in the configuration with sensible defaults.
+// Returns:
+// - c: the AnomalyConfig to be used by the dbManager.
+// - n: a notificationManager reference struct to deliver the anomalies.
+func anomaliesStart() (c dbManager.AnomalyConfig, n *notificationManager.NotificationManager) {
+
+	l.Trace.Printf("[MAIN] Intializing the anomaly detection\n")
+
+	c = dbManager.AnomalyConfig{
+		Enabled:    cfg.Anomalies.Enabled,
+		History:    cfg.Anomalies.History,
+		MinDelta:   cfg.Anomalies.MinDelta,
+		MinSamples: cfg.Anomalies.MinSamples,
+		Threshold:  cfg.Anomalies.Threshold,
+		Webhook:    cfg.Anomalies.Webhook,
+	}
+
+	if c.History <= 0 {
+
+		c.History = 14
+
+	}
+
+	if c.MinSamples <= 0 {
+
+		c.MinSamples = 7
+
+	}
+
+	if c.Threshold <= 0 {
+
+		c.Threshold = 3
+
+	}
+
+	timeout, e := time.ParseDuration(cfg.Anomalies.WebhookTimeout)
+
+	if e != nil {
+
+		timeout = 10 * time.Second
+
+	}
+
+	n = notificationManager.New(timeout)
+
+	return
+
+}
+
+// timescaleStart handles the configuration of the optional TimescaleDB
+// support, filling the gaps with daily chunks and no compression or retention.
+// Returns:
+// - c: the TimescaleConfig to be used by the dbManager.
+func timescaleStart() (c dbManager.TimescaleConfig) {
+
+	l.Trace.Printf("[MAIN] Intializing the TimescaleDB support\n")
+
+	c = dbManager.TimescaleConfig{
+		Compression: make(map[string]time.Duration),
+		Enabled:     cfg.Timescale.Enabled,
+		Retention:   make(map[string]time.Duration),
+	}
+
+	if c.ChunkInterval, _ = time.ParseDuration(cfg.Timescale.ChunkInterval); c.ChunkInterval <= 0 {
+
+		c.ChunkInterval = 24 * time.Hour
+
+	}
+
+	for table, after := range cfg.Timescale.Compression {
+
+		if d, e := time.ParseDuration(after); e == nil && d > 0 {
+
+			c.Compression[strings.ToLower(table)] = d
+
+		} else {
+
+			l.Warning.Printf("[MAIN] The compression age [ %v ] of table [ %v ] is not valid, skipping it.\n", after, table)
+
+		}
+
+	}
+
+	for table, after := range cfg.Timescale.Retention {
+
+		if d, e := time.ParseDuration(after); e == nil && d > 0 {
+
+			c.Retention[strings.ToLower(table)] = d
+
+		} else {
+
+			l.Warning.Printf("[MAIN] The retention age [ %v ] of table [ %v ] is not valid, skipping it.\n", after, table)
+
+		}
+
+	}
+
+	return
+
+}
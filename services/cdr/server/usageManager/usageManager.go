@@ -2,6 +2,8 @@ package usageManager
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/go-openapi/runtime/middleware"
@@ -45,6 +47,81 @@ func New(db *dbManager.DbParameter, monit *statusManager.StatusManager) *UsageMa
 
 }
 
+// ForecastSpend (Swagger func) is the function behind the (GET) endpoint
+// /usage/forecast
+// Its job is to project the spend of the customers and skus until the end of
+// the period along with the confidence bands of the projection.
+func (m *UsageManager) ForecastSpend(ctx context.Context, params usage_management.ForecastSpendParams) middleware.Responder {
+
+	l.Trace.Printf("[UsageManager] ForecastSpend endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("usage", callTime)
+
+	var periodEnd strfmt.DateTime
+
+	filters := make(map[string][]string)
+
+	if params.PeriodEnd != nil {
+
+		periodEnd = *params.PeriodEnd
+
+	}
+
+	for dim, values := range map[string]*string{
+		"account":  params.Account,
+		"customer": params.Customer,
+		"reseller": params.Reseller,
+	} {
+
+		if values != nil && *values != "" {
+
+			filters[dim] = strings.Split(*values, ",")
+
+		}
+
+	}
+
+	report, e := m.db.ForecastSpend(filters, *params.History, periodEnd)
+
+	if e == nil {
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/usage/forecast"}).Inc()
+
+		m.monit.APIHitDone("usage", callTime)
+
+		return usage_management.NewForecastSpendOK().WithPayload(report)
+
+	}
+
+	if errors.Is(e, dbManager.ErrInvalidForecast) {
+
+		s := "The forecast is not valid: " + e.Error()
+		returnValueError := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "400", "method": "GET", "route": "/usage/forecast"}).Inc()
+
+		m.monit.APIHitDone("usage", callTime)
+
+		return usage_management.NewForecastSpendBadRequest().WithPayload(&returnValueError)
+
+	}
+
+	s := "There was an error in the DB operation: " + e.Error()
+	returnValueError := models.ErrorResponse{
+		ErrorString: &s,
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/usage/forecast"}).Inc()
+
+	m.monit.APIHitDone("usage", callTime)
+
+	return usage_management.NewForecastSpendInternalServerError().WithPayload(&returnValueError)
+
+}
+
 // GetSystemUsage (Swagger func) is the function behind the (GET) endpoint
 // /usage
 // Its job is to retrieve the usage report given a certain time-window and with
@@ -235,3 +312,94 @@ func (m *UsageManager) GetUsageSummary(ctx context.Context, params usage_managem
 	return usage_management.NewGetUsageSummaryInternalServerError().WithPayload(&returnValueError)
 
 }
+
+// QueryUsage (Swagger func) is the function behind the (GET) endpoint
+// /usage/query
+// Its job is to retrieve the usage and cost series aggregated by the requested
+// dimensions, filtered by the provided values during the defined time-window.
+func (m *UsageManager) QueryUsage(ctx context.Context, params usage_management.QueryUsageParams) middleware.Responder {
+
+	l.Trace.Printf("[UsageManager] QueryUsage endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("usage", callTime)
+
+	var from, to strfmt.DateTime
+	var groupBy []string
+
+	filters := make(map[string][]string)
+
+	if params.From != nil {
+
+		from = *params.From
+
+	}
+
+	if params.To != nil {
+
+		to = *params.To
+
+	}
+
+	if params.Groupby != nil {
+
+		groupBy = strings.Split(*params.Groupby, ",")
+
+	}
+
+	for dim, values := range map[string]*string{
+		"account":      params.Account,
+		"customer":     params.Customer,
+		"reseller":     params.Reseller,
+		"resourcetype": params.ResourceType,
+		"sku":          params.Sku,
+		"region":       params.Region,
+	} {
+
+		if values != nil && *values != "" {
+
+			filters[dim] = strings.Split(*values, ",")
+
+		}
+
+	}
+
+	report, e := m.db.QueryUsage(groupBy, filters, from, to)
+
+	if e == nil {
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/usage/query"}).Inc()
+
+		m.monit.APIHitDone("usage", callTime)
+
+		return usage_management.NewQueryUsageOK().WithPayload(report)
+
+	}
+
+	if errors.Is(e, dbManager.ErrUnknownDimension) {
+
+		s := "The query is not valid: " + e.Error()
+		returnValueError := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "400", "method": "GET", "route": "/usage/query"}).Inc()
+
+		m.monit.APIHitDone("usage", callTime)
+
+		return usage_management.NewQueryUsageBadRequest().WithPayload(&returnValueError)
+
+	}
+
+	s := "There was an error in the DB operation: " + e.Error()
+	returnValueError := models.ErrorResponse{
+		ErrorString: &s,
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/usage/query"}).Inc()
+
+	m.monit.APIHitDone("usage", callTime)
+
+	return usage_management.NewQueryUsageInternalServerError().WithPayload(&returnValueError)
+
+}
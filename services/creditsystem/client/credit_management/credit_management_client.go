@@ -26,6 +26,9 @@ type API interface {
 	/*
 	   GetCredit credits status of the account with the provided id*/
 	GetCredit(ctx context.Context, params *GetCreditParams) (*GetCreditOK, error)
+	/*
+	   GetForecast predictions of the date when the credit and cash of the account with id will be exhausted*/
+	GetForecast(ctx context.Context, params *GetForecastParams) (*GetForecastOK, error)
 	/*
 	   GetHistory credits history of the customer with id*/
 	GetHistory(ctx context.Context, params *GetHistoryParams) (*GetHistoryOK, error)
@@ -127,6 +130,31 @@ func (a *Client) GetCredit(ctx context.Context, params *GetCreditParams) (*GetCr
 
 }
 
+/*
+GetForecast predictions of the date when the credit and cash of the account with id will be exhausted
+*/
+func (a *Client) GetForecast(ctx context.Context, params *GetForecastParams) (*GetForecastOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getForecast",
+		Method:             "GET",
+		PathPattern:        "/forecast/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetForecastReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetForecastOK), nil
+
+}
+
 /*
 GetHistory credits history of the customer with id
 */
@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/strfmt"
 
 	"github.com/GoDieNow/TFT_Code/services/creditsystem/client/account_management"
+	"github.com/GoDieNow/TFT_Code/services/creditsystem/client/budget_management"
 	"github.com/GoDieNow/TFT_Code/services/creditsystem/client/credit_management"
 	"github.com/GoDieNow/TFT_Code/services/creditsystem/client/status_management"
 	"github.com/GoDieNow/TFT_Code/services/creditsystem/client/trigger_management"
@@ -62,6 +63,7 @@ func New(c Config) *CreditManagerManagementAPI {
 	cli := new(CreditManagerManagementAPI)
 	cli.Transport = transport
 	cli.AccountManagement = account_management.New(transport, strfmt.Default, c.AuthInfo)
+	cli.BudgetManagement = budget_management.New(transport, strfmt.Default, c.AuthInfo)
 	cli.CreditManagement = credit_management.New(transport, strfmt.Default, c.AuthInfo)
 	cli.StatusManagement = status_management.New(transport, strfmt.Default, c.AuthInfo)
 	cli.TriggerManagement = trigger_management.New(transport, strfmt.Default, c.AuthInfo)
@@ -71,6 +73,7 @@ func New(c Config) *CreditManagerManagementAPI {
 // CreditManagerManagementAPI is a client for credit manager management API
 type CreditManagerManagementAPI struct {
 	AccountManagement *account_management.Client
+	BudgetManagement  *budget_management.Client
 	CreditManagement  *credit_management.Client
 	StatusManagement  *status_management.Client
 	TriggerManagement *trigger_management.Client
@@ -16,6 +16,7 @@ import (
 
 	"github.com/GoDieNow/TFT_Code/services/creditsystem/restapi/operations"
 	"github.com/GoDieNow/TFT_Code/services/creditsystem/restapi/operations/account_management"
+	"github.com/GoDieNow/TFT_Code/services/creditsystem/restapi/operations/budget_management"
 	"github.com/GoDieNow/TFT_Code/services/creditsystem/restapi/operations/credit_management"
 	"github.com/GoDieNow/TFT_Code/services/creditsystem/restapi/operations/status_management"
 	"github.com/GoDieNow/TFT_Code/services/creditsystem/restapi/operations/trigger_management"
@@ -45,6 +46,29 @@ type AccountManagementAPI interface {
 	ListAccounts(ctx context.Context, params account_management.ListAccountsParams) middleware.Responder
 }
 
+//go:generate mockery -name BudgetManagementAPI -inpkg
+
+/* BudgetManagementAPI  */
+type BudgetManagementAPI interface {
+	/* CreateBudget Creates a new budget in the system */
+	CreateBudget(ctx context.Context, params budget_management.CreateBudgetParams) middleware.Responder
+
+	/* GetBudget Budget with the provided id */
+	GetBudget(ctx context.Context, params budget_management.GetBudgetParams) middleware.Responder
+
+	/* GetBudgetConsumption Consumption of the budget with the provided id in a month */
+	GetBudgetConsumption(ctx context.Context, params budget_management.GetBudgetConsumptionParams) middleware.Responder
+
+	/* ListBudgetAlerts History of the alerts raised by the budget with the provided id */
+	ListBudgetAlerts(ctx context.Context, params budget_management.ListBudgetAlertsParams) middleware.Responder
+
+	/* ListBudgets List of the budgets in the system */
+	ListBudgets(ctx context.Context, params budget_management.ListBudgetsParams) middleware.Responder
+
+	/* UpdateBudget Updates the budget with the provided id */
+	UpdateBudget(ctx context.Context, params budget_management.UpdateBudgetParams) middleware.Responder
+}
+
 //go:generate mockery -name CreditManagementAPI -inpkg
 
 /* CreditManagementAPI  */
@@ -58,6 +82,9 @@ type CreditManagementAPI interface {
 	/* GetCredit Credit status of the account with the provided id */
 	GetCredit(ctx context.Context, params credit_management.GetCreditParams) middleware.Responder
 
+	/* GetForecast Prediction of the date when the credit and cash of the account with id will be exhausted */
+	GetForecast(ctx context.Context, params credit_management.GetForecastParams) middleware.Responder
+
 	/* GetHistory Credit history of the customer with id */
 	GetHistory(ctx context.Context, params credit_management.GetHistoryParams) middleware.Responder
 
@@ -87,6 +114,7 @@ type TriggerManagementAPI interface {
 // Config is configuration for Handler
 type Config struct {
 	AccountManagementAPI
+	BudgetManagementAPI
 	CreditManagementAPI
 	StatusManagementAPI
 	TriggerManagementAPI
@@ -177,6 +205,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.CreditManagerManagementAPIA
 		ctx = storeAuth(ctx, principal)
 		return c.AccountManagementAPI.CreateAccount(ctx, params)
 	})
+	api.BudgetManagementCreateBudgetHandler = budget_management.CreateBudgetHandlerFunc(func(params budget_management.CreateBudgetParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.BudgetManagementAPI.CreateBudget(ctx, params)
+	})
 	api.CreditManagementDecreaseCreditHandler = credit_management.DecreaseCreditHandlerFunc(func(params credit_management.DecreaseCreditParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -202,11 +235,26 @@ func HandlerAPI(c Config) (http.Handler, *operations.CreditManagerManagementAPIA
 		ctx = storeAuth(ctx, principal)
 		return c.AccountManagementAPI.GetAccountStatus(ctx, params)
 	})
+	api.BudgetManagementGetBudgetHandler = budget_management.GetBudgetHandlerFunc(func(params budget_management.GetBudgetParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.BudgetManagementAPI.GetBudget(ctx, params)
+	})
+	api.BudgetManagementGetBudgetConsumptionHandler = budget_management.GetBudgetConsumptionHandlerFunc(func(params budget_management.GetBudgetConsumptionParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.BudgetManagementAPI.GetBudgetConsumption(ctx, params)
+	})
 	api.CreditManagementGetCreditHandler = credit_management.GetCreditHandlerFunc(func(params credit_management.GetCreditParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.CreditManagementAPI.GetCredit(ctx, params)
 	})
+	api.CreditManagementGetForecastHandler = credit_management.GetForecastHandlerFunc(func(params credit_management.GetForecastParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.CreditManagementAPI.GetForecast(ctx, params)
+	})
 	api.CreditManagementGetHistoryHandler = credit_management.GetHistoryHandlerFunc(func(params credit_management.GetHistoryParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -227,11 +275,26 @@ func HandlerAPI(c Config) (http.Handler, *operations.CreditManagerManagementAPIA
 		ctx = storeAuth(ctx, principal)
 		return c.AccountManagementAPI.ListAccounts(ctx, params)
 	})
+	api.BudgetManagementListBudgetAlertsHandler = budget_management.ListBudgetAlertsHandlerFunc(func(params budget_management.ListBudgetAlertsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.BudgetManagementAPI.ListBudgetAlerts(ctx, params)
+	})
+	api.BudgetManagementListBudgetsHandler = budget_management.ListBudgetsHandlerFunc(func(params budget_management.ListBudgetsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.BudgetManagementAPI.ListBudgets(ctx, params)
+	})
 	api.StatusManagementShowStatusHandler = status_management.ShowStatusHandlerFunc(func(params status_management.ShowStatusParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.StatusManagementAPI.ShowStatus(ctx, params)
 	})
+	api.BudgetManagementUpdateBudgetHandler = budget_management.UpdateBudgetHandlerFunc(func(params budget_management.UpdateBudgetParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.BudgetManagementAPI.UpdateBudget(ctx, params)
+	})
 	api.ServerShutdown = func() {}
 	return api.Serve(c.InnerMiddleware), api, nil
 }
@@ -356,7 +356,7 @@ func init() {
         }
       }
     },
-    "/history/{id}": {
+    "/budget": {
       "get": {
         "security": [
           {
@@ -375,44 +375,92 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "creditManagement"
+          "budgetManagement"
         ],
-        "summary": "Credit history of the customer with id",
-        "operationId": "getHistory",
+        "summary": "List of the budgets in the system",
+        "operationId": "listBudgets",
         "parameters": [
           {
+            "enum": [
+              "product",
+              "customer",
+              "reseller"
+            ],
             "type": "string",
-            "description": "Id of the account to get the history",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "boolean",
-            "description": "Boolean variable to control if the system consumptions have to be listed or not",
-            "name": "filterSystem",
+            "description": "Scope of the budgets to be listed",
+            "name": "scope",
             "in": "query"
           },
           {
-            "enum": [
-              "credit",
-              "cash"
-            ],
             "type": "string",
-            "description": "Medium (cash/credit) to be used as filter",
-            "name": "medium",
+            "description": "Id of the product, customer or reseller linked to the budgets",
+            "name": "scopeId",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Credit status history of the account with the provided id",
+            "description": "List of budgets in the system",
             "schema": {
-              "$ref": "#/definitions/CreditHistory"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Budget"
+              }
             }
           },
-          "404": {
-            "description": "The endpoint provided doesn't exist",
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "budgetManagement"
+        ],
+        "summary": "Creates a new budget in the system",
+        "operationId": "createBudget",
+        "parameters": [
+          {
+            "description": "Budget to be added to the system",
+            "name": "budget",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Budget"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Budget created, provided information of the new item created",
+            "schema": {
+              "$ref": "#/definitions/Budget"
+            }
+          },
+          "409": {
+            "description": "A budget with the same name already exists for the scope provided",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -426,7 +474,7 @@ func init() {
         }
       }
     },
-    "/status": {
+    "/budget/{id}": {
       "get": {
         "security": [
           {
@@ -445,22 +493,42 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "statusManagement"
+          "budgetManagement"
+        ],
+        "summary": "Budget with the provided id",
+        "operationId": "getBudget",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Id of the budget to be retrieved",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Basic status of the system",
-        "operationId": "showStatus",
         "responses": {
           "200": {
-            "description": "Status information of the system",
+            "description": "Budget with the provided id",
             "schema": {
-              "$ref": "#/definitions/Status"
+              "$ref": "#/definitions/Budget"
+            }
+          },
+          "404": {
+            "description": "The budget with the id provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         }
-      }
-    },
-    "/status/{id}": {
-      "get": {
+      },
+      "put": {
         "security": [
           {
             "Keycloak": [
@@ -474,40 +542,51 @@ func init() {
             "APIKeyParam": []
           }
         ],
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "statusManagement"
+          "budgetManagement"
         ],
-        "summary": "Basic status of the system",
-        "operationId": "getStatus",
+        "summary": "Updates the budget with the provided id",
+        "operationId": "updateBudget",
         "parameters": [
           {
-            "enum": [
-              "kafka-receiver",
-              "kafka-sender",
-              "status",
-              "trigger",
-              "account",
-              "credit"
-            ],
             "type": "string",
-            "description": "Id of the endpoint to be checked",
+            "format": "uuid",
+            "description": "Id of the budget to be updated",
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "Updated budget",
+            "name": "budget",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Budget"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Status information of the system",
+            "description": "Budget updated",
             "schema": {
-              "$ref": "#/definitions/Status"
+              "$ref": "#/definitions/Budget"
             }
           },
           "404": {
-            "description": "The endpoint provided doesn't exist",
+            "description": "The budget with the id provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -515,7 +594,7 @@ func init() {
         }
       }
     },
-    "/trigger/sample": {
+    "/budget/{id}/alerts": {
       "get": {
         "security": [
           {
@@ -534,13 +613,41 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "triggerManagement"
+          "budgetManagement"
+        ],
+        "summary": "History of the alerts raised by the budget with the provided id",
+        "operationId": "listBudgetAlerts",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Id of the budget to get the alerts",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "Amount of months to go back in time for the alerts, default 3",
+            "name": "months",
+            "in": "query"
+          }
         ],
-        "summary": "Sample task trigger",
-        "operationId": "execSample",
         "responses": {
           "200": {
-            "description": "Sample task executed successfully"
+            "description": "Alerts raised by the budget with the provided id",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BudgetAlert"
+              }
+            }
+          },
+          "404": {
+            "description": "The budget with the id provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "Something unexpected happend, error raised",
@@ -551,12 +658,12 @@ func init() {
         }
       }
     },
-    "/{medium}/available/decrease/{id}": {
-      "post": {
+    "/budget/{id}/consumption": {
+      "get": {
         "security": [
           {
             "Keycloak": [
-              "admin"
+              "user"
             ]
           },
           {
@@ -566,54 +673,40 @@ func init() {
             "APIKeyParam": []
           }
         ],
-        "consumes": [
-          "application/json"
-        ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "creditManagement"
+          "budgetManagement"
         ],
-        "summary": "Insert a new reseller in the system.",
-        "operationId": "decreaseCredit",
+        "summary": "Consumption of the budget with the provided id in a month",
+        "operationId": "getBudgetConsumption",
         "parameters": [
           {
             "type": "string",
-            "description": "Id of the account to be checked",
+            "format": "uuid",
+            "description": "Id of the budget to get the consumption",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "type": "number",
-            "format": "double",
-            "description": "Amount to be decreased",
-            "name": "amount",
-            "in": "query",
-            "required": true
-          },
-          {
-            "enum": [
-              "credit",
-              "cash"
-            ],
             "type": "string",
-            "description": "Medium (cash/credit) to be used in the accounting",
-            "name": "medium",
-            "in": "path",
-            "required": true
+            "format": "datetime",
+            "description": "Datetime within the month to be checked, default the current one",
+            "name": "period",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Credit status of the account with the provided id",
+            "description": "Consumption of the budget with the provided id",
             "schema": {
-              "$ref": "#/definitions/CreditStatus"
+              "$ref": "#/definitions/BudgetConsumption"
             }
           },
           "404": {
-            "description": "The account with the id provided doesn't exist",
+            "description": "The budget with the id provided doesn't exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -627,12 +720,12 @@ func init() {
         }
       }
     },
-    "/{medium}/available/increase/{id}": {
-      "post": {
+    "/forecast/{id}": {
+      "get": {
         "security": [
           {
             "Keycloak": [
-              "admin"
+              "user"
             ]
           },
           {
@@ -642,54 +735,40 @@ func init() {
             "APIKeyParam": []
           }
         ],
-        "consumes": [
-          "application/json"
-        ],
         "produces": [
           "application/json"
         ],
         "tags": [
           "creditManagement"
         ],
-        "summary": "Insert a new reseller in the system.",
-        "operationId": "increaseCredit",
+        "summary": "Prediction of the date when the credit and cash of the account with id will be exhausted",
+        "operationId": "getForecast",
         "parameters": [
           {
             "type": "string",
-            "description": "Id of the account to be checked",
+            "description": "Id of the account to be forecasted",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "type": "number",
-            "format": "double",
-            "description": "Amount to be inccreased",
-            "name": "amount",
-            "in": "query",
-            "required": true
-          },
-          {
-            "enum": [
-              "credit",
-              "cash"
-            ],
-            "type": "string",
-            "description": "Medium (cash/credit) to be used in the accounting",
-            "name": "medium",
-            "in": "path",
-            "required": true
+            "maximum": 366,
+            "type": "integer",
+            "default": 28,
+            "description": "Number of days of consumption history used to fit the model",
+            "name": "history",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Credit status of the account with the provided id",
+            "description": "Exhaustion forecast of the account with the provided id",
             "schema": {
-              "$ref": "#/definitions/CreditStatus"
+              "$ref": "#/definitions/CreditForecast"
             }
           },
           "404": {
-            "description": "The account with the id provided doesn't exist",
+            "description": "The account provided doesn't exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -703,8 +782,8 @@ func init() {
         }
       }
     },
-    "/{medium}/consume/{id}": {
-      "post": {
+    "/history/{id}": {
+      "get": {
         "security": [
           {
             "Keycloak": [
@@ -718,32 +797,27 @@ func init() {
             "APIKeyParam": []
           }
         ],
-        "consumes": [
-          "application/json"
-        ],
         "produces": [
           "application/json"
         ],
         "tags": [
           "creditManagement"
         ],
-        "summary": "Adds a consumption to the system",
-        "operationId": "addConsumption",
+        "summary": "Credit history of the customer with id",
+        "operationId": "getHistory",
         "parameters": [
           {
             "type": "string",
-            "description": "Id of the account to be checked",
+            "description": "Id of the account to get the history",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "type": "number",
-            "format": "double",
-            "description": "Amount to be decreased",
-            "name": "amount",
-            "in": "query",
-            "required": true
+            "type": "boolean",
+            "description": "Boolean variable to control if the system consumptions have to be listed or not",
+            "name": "filterSystem",
+            "in": "query"
           },
           {
             "enum": [
@@ -751,21 +825,20 @@ func init() {
               "cash"
             ],
             "type": "string",
-            "description": "Medium (cash/credit) to be used in the accounting",
+            "description": "Medium (cash/credit) to be used as filter",
             "name": "medium",
-            "in": "path",
-            "required": true
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Credit status of the account with the provided id",
+            "description": "Credit status history of the account with the provided id",
             "schema": {
-              "$ref": "#/definitions/CreditStatus"
+              "$ref": "#/definitions/CreditHistory"
             }
           },
           "404": {
-            "description": "The account with the id provided doesn't exist",
+            "description": "The endpoint provided doesn't exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -778,275 +851,1150 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "AccountStatus": {
-      "type": "object",
-      "required": [
-        "Enabled"
-      ],
-      "properties": {
-        "AccountID": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"primary_key\""
-        },
-        "CreatedAt": {
-          "type": "string",
-          "format": "datetime",
-          "x-go-custom-tag": "gorm:\"type:timestamptz\""
-        },
-        "Enabled": {
-          "type": "boolean",
-          "default": true,
-          "x-go-custom-tag": "gorm:\"default:true\""
-        }
-      }
-    },
-    "CreditEvents": {
-      "type": "object",
-      "properties": {
-        "AccountId": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"index\""
-        },
-        "AuthorizedBy": {
-          "type": "string"
-        },
-        "Delta": {
-          "type": "number",
-          "format": "double",
-          "x-go-custom-tag": "gorm:\"type:numeric(23,13);default:0.0\""
-        },
-        "EventType": {
-          "type": "string",
-          "default": "Consumption",
-          "enum": [
-            "AuthorizedIncrease",
-            "AuthorizedDecrease",
-            "Consumption",
-            "AutomaticCreditExpiry",
-            "Refund"
-          ],
-          "x-go-custom-tag": "gorm:\"index;default:Consumption\""
-        },
-        "ID": {
-          "type": "integer",
-          "x-go-custom-tag": "gorm:\"primary_key\""
-        },
-        "Medium": {
-          "type": "string",
-          "default": "CREDIT",
-          "enum": [
-            "CREDIT",
-            "CASH"
-          ],
-          "x-go-custom-tag": "gorm:\"index;default:CREDIT\""
-        },
-        "Timestamp": {
-          "type": "string",
-          "format": "datetime",
-          "x-go-custom-tag": "gorm:\"type:timestamptz\""
-        }
-      }
     },
-    "CreditHistory": {
-      "type": "object",
-      "properties": {
-        "AccountID": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"primary_key\""
-        },
-        "Events": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Event"
+    "/status": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "statusManagement"
+        ],
+        "summary": "Basic status of the system",
+        "operationId": "showStatus",
+        "responses": {
+          "200": {
+            "description": "Status information of the system",
+            "schema": {
+              "$ref": "#/definitions/Status"
+            }
           }
         }
       }
     },
-    "CreditStatus": {
-      "type": "object",
-      "properties": {
-        "AccountID": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"primary_key\""
-        },
-        "AvailableCash": {
-          "type": "number",
-          "format": "double",
-          "x-go-custom-tag": "gorm:\"type:numeric(23,13);default:0.0\""
-        },
-        "AvailableCredit": {
-          "type": "number",
-          "format": "double",
-          "x-go-custom-tag": "gorm:\"type:numeric(23,13);default:0.0\""
-        },
-        "LastUpdate": {
-          "type": "string",
-          "format": "datetime",
-          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+    "/status/{id}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "statusManagement"
+        ],
+        "summary": "Basic status of the system",
+        "operationId": "getStatus",
+        "parameters": [
+          {
+            "enum": [
+              "kafka-receiver",
+              "kafka-sender",
+              "status",
+              "trigger",
+              "account",
+              "credit"
+            ],
+            "type": "string",
+            "description": "Id of the endpoint to be checked",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Status information of the system",
+            "schema": {
+              "$ref": "#/definitions/Status"
+            }
+          },
+          "404": {
+            "description": "The endpoint provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         }
       }
     },
-    "ErrorResponse": {
-      "type": "object",
-      "required": [
-        "errorString"
-      ],
-      "properties": {
-        "errorString": {
-          "type": "string"
+    "/trigger/sample": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "triggerManagement"
+        ],
+        "summary": "Sample task trigger",
+        "operationId": "execSample",
+        "responses": {
+          "200": {
+            "description": "Sample task executed successfully"
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         }
       }
     },
-    "Event": {
-      "type": "object",
-      "properties": {
-        "AuthorizedBy": {
-          "type": "string"
-        },
-        "Delta": {
-          "type": "number",
-          "format": "double",
-          "x-go-custom-tag": "gorm:\"type:numeric(23,13);default:0.0\""
-        },
-        "EventType": {
-          "type": "string",
-          "default": "Consumption",
-          "enum": [
-            "AuthorizedIncrease",
-            "AuthorizedDecrease",
-            "Consumption",
-            "AutomaticCreditExpiry",
-            "Refund"
-          ],
-          "x-go-custom-tag": "gorm:\"index;default:Consumption\""
-        },
-        "Medium": {
-          "type": "string",
-          "default": "CREDIT",
-          "enum": [
-            "CREDIT",
-            "CASH"
-          ],
-          "x-go-custom-tag": "gorm:\"index;default:CREDIT\""
-        },
-        "Timestamp": {
-          "type": "string",
-          "format": "datetime",
-          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+    "/{medium}/available/decrease/{id}": {
+      "post": {
+        "security": [
+          {
+            "Keycloak": [
+              "admin"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "creditManagement"
+        ],
+        "summary": "Insert a new reseller in the system.",
+        "operationId": "decreaseCredit",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Id of the account to be checked",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "number",
+            "format": "double",
+            "description": "Amount to be decreased",
+            "name": "amount",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "credit",
+              "cash"
+            ],
+            "type": "string",
+            "description": "Medium (cash/credit) to be used in the accounting",
+            "name": "medium",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Credit status of the account with the provided id",
+            "schema": {
+              "$ref": "#/definitions/CreditStatus"
+            }
+          },
+          "404": {
+            "description": "The account with the id provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/{medium}/available/increase/{id}": {
+      "post": {
+        "security": [
+          {
+            "Keycloak": [
+              "admin"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "creditManagement"
+        ],
+        "summary": "Insert a new reseller in the system.",
+        "operationId": "increaseCredit",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Id of the account to be checked",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "number",
+            "format": "double",
+            "description": "Amount to be inccreased",
+            "name": "amount",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "credit",
+              "cash"
+            ],
+            "type": "string",
+            "description": "Medium (cash/credit) to be used in the accounting",
+            "name": "medium",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Credit status of the account with the provided id",
+            "schema": {
+              "$ref": "#/definitions/CreditStatus"
+            }
+          },
+          "404": {
+            "description": "The account with the id provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/{medium}/consume/{id}": {
+      "post": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "creditManagement"
+        ],
+        "summary": "Adds a consumption to the system",
+        "operationId": "addConsumption",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Id of the account to be checked",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "number",
+            "format": "double",
+            "description": "Amount to be decreased",
+            "name": "amount",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "credit",
+              "cash"
+            ],
+            "type": "string",
+            "description": "Medium (cash/credit) to be used in the accounting",
+            "name": "medium",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Credit status of the account with the provided id",
+            "schema": {
+              "$ref": "#/definitions/CreditStatus"
+            }
+          },
+          "404": {
+            "description": "The account with the id provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "AccountStatus": {
+      "type": "object",
+      "required": [
+        "Enabled"
+      ],
+      "properties": {
+        "AccountID": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "CreatedAt": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+        },
+        "Enabled": {
+          "type": "boolean",
+          "default": true,
+          "x-go-custom-tag": "gorm:\"default:true\""
+        }
+      }
+    },
+    "Budget": {
+      "type": "object",
+      "required": [
+        "Amount",
+        "Name",
+        "Scope",
+        "ScopeId"
+      ],
+      "properties": {
+        "Amount": {
+          "description": "Monthly amount of the budget",
+          "type": "number",
+          "format": "double",
+          "x-go-custom-tag": "gorm:\"type:numeric(23,13)\""
+        },
+        "CreatedAt": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+        },
+        "Emails": {
+          "description": "Email addresses to be notified when a threshold is reached",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"serializer:json\""
+        },
+        "Enabled": {
+          "type": "boolean",
+          "default": true,
+          "x-go-custom-tag": "gorm:\"default:true\""
+        },
+        "ID": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"type:uuid;primary_key;unique;default:md5(random()::text || clock_timestamp()::text)::uuid\""
+        },
+        "Name": {
+          "type": "string"
+        },
+        "Scope": {
+          "type": "string",
+          "enum": [
+            "product",
+            "customer",
+            "reseller"
+          ],
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "ScopeId": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "Thresholds": {
+          "description": "Percentages of the budget that raise an alert, default 50, 80 and 100",
+          "type": "array",
+          "items": {
+            "type": "integer"
+          },
+          "x-go-custom-tag": "gorm:\"serializer:json\""
+        },
+        "Webhook": {
+          "description": "URL where the alerts are going to be posted",
+          "type": "string"
+        }
+      }
+    },
+    "BudgetAlert": {
+      "type": "object",
+      "properties": {
+        "Amount": {
+          "type": "number",
+          "format": "double",
+          "x-go-custom-tag": "gorm:\"type:numeric(23,13);default:0.0\""
+        },
+        "BudgetID": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"type:uuid;index\""
+        },
+        "Channel": {
+          "type": "string",
+          "enum": [
+            "webhook",
+            "email"
+          ]
+        },
+        "Consumed": {
+          "type": "number",
+          "format": "double",
+          "x-go-custom-tag": "gorm:\"type:numeric(23,13);default:0.0\""
+        },
+        "Error": {
+          "type": "string"
+        },
+        "ID": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "Period": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"index;type:timestamptz\""
+        },
+        "Status": {
+          "type": "string",
+          "enum": [
+            "SENT",
+            "FAILED",
+            "SKIPPED"
+          ]
+        },
+        "Threshold": {
+          "type": "integer"
+        },
+        "Timestamp": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+        }
+      }
+    },
+    "BudgetCharge": {
+      "type": "object",
+      "properties": {
+        "AccountID": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "BudgetID": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"type:uuid;primary_key\""
+        },
+        "Cost": {
+          "type": "number",
+          "format": "double",
+          "x-go-custom-tag": "gorm:\"type:numeric(23,13);default:0.0\""
+        },
+        "TimeFrom": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"primary_key;type:timestamptz\""
+        },
+        "TimeTo": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"primary_key;type:timestamptz\""
+        }
+      }
+    },
+    "BudgetConsumption": {
+      "type": "object",
+      "properties": {
+        "Amount": {
+          "type": "number",
+          "format": "double"
+        },
+        "BudgetID": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "Consumed": {
+          "type": "number",
+          "format": "double"
+        },
+        "Percentage": {
+          "type": "number",
+          "format": "double"
+        },
+        "PeriodFrom": {
+          "type": "string",
+          "format": "datetime"
+        },
+        "PeriodTo": {
+          "type": "string",
+          "format": "datetime"
+        },
+        "ThresholdsReached": {
+          "type": "array",
+          "items": {
+            "type": "integer"
+          }
+        }
+      }
+    },
+    "CreditEvents": {
+      "type": "object",
+      "properties": {
+        "AccountId": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "AuthorizedBy": {
+          "type": "string"
+        },
+        "Delta": {
+          "type": "number",
+          "format": "double",
+          "x-go-custom-tag": "gorm:\"type:numeric(23,13);default:0.0\""
+        },
+        "EventType": {
+          "type": "string",
+          "default": "Consumption",
+          "enum": [
+            "AuthorizedIncrease",
+            "AuthorizedDecrease",
+            "Consumption",
+            "AutomaticCreditExpiry",
+            "Refund"
+          ],
+          "x-go-custom-tag": "gorm:\"index;default:Consumption\""
+        },
+        "ID": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "Medium": {
+          "type": "string",
+          "default": "CREDIT",
+          "enum": [
+            "CREDIT",
+            "CASH"
+          ],
+          "x-go-custom-tag": "gorm:\"index;default:CREDIT\""
+        },
+        "Timestamp": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+        }
+      }
+    },
+    "CreditForecast": {
+      "type": "object",
+      "properties": {
+        "AccountID": {
+          "type": "string"
+        },
+        "Confidence": {
+          "type": "number",
+          "format": "double"
+        },
+        "HistoryDays": {
+          "type": "integer"
+        },
+        "Mediums": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/MediumForecast"
+          }
+        },
+        "Timestamp": {
+          "type": "string",
+          "format": "datetime"
+        }
+      }
+    },
+    "CreditHistory": {
+      "type": "object",
+      "properties": {
+        "AccountID": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "Events": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Event"
+          }
+        }
+      }
+    },
+    "CreditStatus": {
+      "type": "object",
+      "properties": {
+        "AccountID": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "AvailableCash": {
+          "type": "number",
+          "format": "double",
+          "x-go-custom-tag": "gorm:\"type:numeric(23,13);default:0.0\""
+        },
+        "AvailableCredit": {
+          "type": "number",
+          "format": "double",
+          "x-go-custom-tag": "gorm:\"type:numeric(23,13);default:0.0\""
+        },
+        "LastUpdate": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+        }
+      }
+    },
+    "ErrorResponse": {
+      "type": "object",
+      "required": [
+        "errorString"
+      ],
+      "properties": {
+        "errorString": {
+          "type": "string"
+        }
+      }
+    },
+    "Event": {
+      "type": "object",
+      "properties": {
+        "AuthorizedBy": {
+          "type": "string"
+        },
+        "Delta": {
+          "type": "number",
+          "format": "double",
+          "x-go-custom-tag": "gorm:\"type:numeric(23,13);default:0.0\""
+        },
+        "EventType": {
+          "type": "string",
+          "default": "Consumption",
+          "enum": [
+            "AuthorizedIncrease",
+            "AuthorizedDecrease",
+            "Consumption",
+            "AutomaticCreditExpiry",
+            "Refund"
+          ],
+          "x-go-custom-tag": "gorm:\"index;default:Consumption\""
+        },
+        "Medium": {
+          "type": "string",
+          "default": "CREDIT",
+          "enum": [
+            "CREDIT",
+            "CASH"
+          ],
+          "x-go-custom-tag": "gorm:\"index;default:CREDIT\""
+        },
+        "Timestamp": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+        }
+      }
+    },
+    "MediumForecast": {
+      "type": "object",
+      "properties": {
+        "Balance": {
+          "type": "number",
+          "format": "double"
+        },
+        "DailyBurn": {
+          "type": "number",
+          "format": "double"
+        },
+        "DaysLeft": {
+          "type": "integer"
+        },
+        "Earliest": {
+          "type": "string",
+          "format": "datetime"
+        },
+        "ExhaustionDate": {
+          "type": "string",
+          "format": "datetime"
+        },
+        "Exhausts": {
+          "type": "boolean"
+        },
+        "Latest": {
+          "type": "string",
+          "format": "datetime"
+        },
+        "Medium": {
+          "type": "string"
+        },
+        "Sigma": {
+          "type": "number",
+          "format": "double"
+        },
+        "Trend": {
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
+    "Status": {
+      "type": "object",
+      "required": [
+        "SystemState"
+      ],
+      "properties": {
+        "AverageResponseTime": {
+          "type": "number",
+          "format": "double"
+        },
+        "DBState": {
+          "type": "string"
+        },
+        "LastRequest": {
+          "type": "string"
+        },
+        "RequestsBoT": {
+          "type": "integer"
+        },
+        "RequestsLastHour": {
+          "type": "integer"
+        },
+        "RequestsToday": {
+          "type": "integer"
+        },
+        "SystemState": {
+          "type": "string"
+        }
+      }
+    }
+  },
+  "securityDefinitions": {
+    "APIKeyHeader": {
+      "type": "apiKey",
+      "name": "X-API-KEY",
+      "in": "header"
+    },
+    "APIKeyParam": {
+      "type": "apiKey",
+      "name": "api_key",
+      "in": "query"
+    },
+    "Keycloak": {
+      "type": "oauth2",
+      "flow": "accessCode",
+      "authorizationUrl": "http://localhost:8080/auth/realms/Dev/protocol/openid-connect/auth",
+      "tokenUrl": "http://localhost:8080/auth/realms/Dev/protocol/openid-connect/token",
+      "scopes": {
+        "admin": "Admin scope",
+        "user": "User scope"
+      }
+    }
+  },
+  "security": [
+    {
+      "Keycloak": [
+        "user",
+        "admin"
+      ]
+    },
+    {
+      "APIKeyHeader": []
+    },
+    {
+      "APIKeyParam": []
+    }
+  ],
+  "tags": [
+    {
+      "description": "Actions relating to the reporting of the state of the service",
+      "name": "statusManagement"
+    },
+    {
+      "description": "Actions relating to the periodics actions to be triggered in the system",
+      "name": "triggerManagement"
+    },
+    {
+      "description": "Actions relating to the control of the credits per account",
+      "name": "creditManagement"
+    },
+    {
+      "description": "Actions relating to the account management in the service",
+      "name": "accountManagement"
+    },
+    {
+      "description": "Actions relating to the budgets and their alerts",
+      "name": "budgetManagement"
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "An API which supports creation, deletion, listing etc of Credit Manager",
+    "title": "Credit Manager Management API",
+    "contact": {
+      "email": "diego@cyclops-labs.io"
+    },
+    "license": {
+      "name": "Apache 2.0",
+      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+    },
+    "version": "1.0.0"
+  },
+  "host": "localhost:8000",
+  "basePath": "/api/v1.0",
+  "paths": {
+    "/account/available/{id}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "creditManagement"
+        ],
+        "summary": "Credit status of the account with the provided id",
+        "operationId": "getCredit",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Id of the account to be checked",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Credit status of the account with the provided id",
+            "schema": {
+              "$ref": "#/definitions/CreditStatus"
+            }
+          },
+          "404": {
+            "description": "The account with the provided id doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/account/create/{id}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "admin"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "accountManagement"
+        ],
+        "summary": "Creates a new account in the system",
+        "operationId": "createAccount",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Id of the account to be created",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Account created, provided information of the new item created",
+            "schema": {
+              "$ref": "#/definitions/AccountStatus"
+            }
+          },
+          "409": {
+            "description": "The account with the id provided already exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/account/disable/{id}": {
+      "post": {
+        "security": [
+          {
+            "Keycloak": [
+              "admin"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "accountManagement"
+        ],
+        "summary": "Disables the account with the id provided in the system",
+        "operationId": "disableAccount",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Id of the account to be disabled",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Status information of the account with provided id in the system after the operation succeded",
+            "schema": {
+              "$ref": "#/definitions/AccountStatus"
+            }
+          },
+          "404": {
+            "description": "The account with the id provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/account/enable/{id}": {
+      "post": {
+        "security": [
+          {
+            "Keycloak": [
+              "admin"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "accountManagement"
+        ],
+        "summary": "Enables the account with the id provided in the system",
+        "operationId": "enableAccount",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Id of the account to be enabled",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Status information of the account with provided id in the system after the operation succeded",
+            "schema": {
+              "$ref": "#/definitions/AccountStatus"
+            }
+          },
+          "404": {
+            "description": "The account with the id provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         }
       }
     },
-    "Status": {
-      "type": "object",
-      "required": [
-        "SystemState"
-      ],
-      "properties": {
-        "AverageResponseTime": {
-          "type": "number",
-          "format": "double"
-        },
-        "DBState": {
-          "type": "string"
-        },
-        "LastRequest": {
-          "type": "string"
-        },
-        "RequestsBoT": {
-          "type": "integer"
-        },
-        "RequestsLastHour": {
-          "type": "integer"
-        },
-        "RequestsToday": {
-          "type": "integer"
-        },
-        "SystemState": {
-          "type": "string"
+    "/account/list": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "accountManagement"
+        ],
+        "summary": "List of the accounts in the system",
+        "operationId": "listAccounts",
+        "responses": {
+          "200": {
+            "description": "List of accounts in the system",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/AccountStatus"
+              }
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         }
       }
-    }
-  },
-  "securityDefinitions": {
-    "APIKeyHeader": {
-      "type": "apiKey",
-      "name": "X-API-KEY",
-      "in": "header"
-    },
-    "APIKeyParam": {
-      "type": "apiKey",
-      "name": "api_key",
-      "in": "query"
-    },
-    "Keycloak": {
-      "type": "oauth2",
-      "flow": "accessCode",
-      "authorizationUrl": "http://localhost:8080/auth/realms/Dev/protocol/openid-connect/auth",
-      "tokenUrl": "http://localhost:8080/auth/realms/Dev/protocol/openid-connect/token",
-      "scopes": {
-        "admin": "Admin scope",
-        "user": "User scope"
-      }
-    }
-  },
-  "security": [
-    {
-      "Keycloak": [
-        "user",
-        "admin"
-      ]
-    },
-    {
-      "APIKeyHeader": []
-    },
-    {
-      "APIKeyParam": []
-    }
-  ],
-  "tags": [
-    {
-      "description": "Actions relating to the reporting of the state of the service",
-      "name": "statusManagement"
-    },
-    {
-      "description": "Actions relating to the periodics actions to be triggered in the system",
-      "name": "triggerManagement"
-    },
-    {
-      "description": "Actions relating to the control of the credits per account",
-      "name": "creditManagement"
-    },
-    {
-      "description": "Actions relating to the account management in the service",
-      "name": "accountManagement"
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "schemes": [
-    "http",
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "An API which supports creation, deletion, listing etc of Credit Manager",
-    "title": "Credit Manager Management API",
-    "contact": {
-      "email": "diego@cyclops-labs.io"
     },
-    "license": {
-      "name": "Apache 2.0",
-      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
-    },
-    "version": "1.0.0"
-  },
-  "host": "localhost:8000",
-  "basePath": "/api/v1.0",
-  "paths": {
-    "/account/available/{id}": {
+    "/account/status/{id}": {
       "get": {
         "security": [
           {
@@ -1065,10 +2013,10 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "creditManagement"
+          "accountManagement"
         ],
-        "summary": "Credit status of the account with the provided id",
-        "operationId": "getCredit",
+        "summary": "Basic status of the account with the id provided in the system",
+        "operationId": "getAccountStatus",
         "parameters": [
           {
             "type": "string",
@@ -1080,13 +2028,13 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Credit status of the account with the provided id",
+            "description": "Status information of the account with provided id in the system",
             "schema": {
-              "$ref": "#/definitions/CreditStatus"
+              "$ref": "#/definitions/AccountStatus"
             }
           },
           "404": {
-            "description": "The account with the provided id doesn't exist",
+            "description": "The account with the id provided doesn't exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1100,12 +2048,12 @@ func init() {
         }
       }
     },
-    "/account/create/{id}": {
+    "/budget": {
       "get": {
         "security": [
           {
             "Keycloak": [
-              "admin"
+              "user"
             ]
           },
           {
@@ -1119,28 +2067,147 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "accountManagement"
+          "budgetManagement"
+        ],
+        "summary": "List of the budgets in the system",
+        "operationId": "listBudgets",
+        "parameters": [
+          {
+            "enum": [
+              "product",
+              "customer",
+              "reseller"
+            ],
+            "type": "string",
+            "description": "Scope of the budgets to be listed",
+            "name": "scope",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Id of the product, customer or reseller linked to the budgets",
+            "name": "scopeId",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "List of budgets in the system",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Budget"
+              }
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "budgetManagement"
+        ],
+        "summary": "Creates a new budget in the system",
+        "operationId": "createBudget",
+        "parameters": [
+          {
+            "description": "Budget to be added to the system",
+            "name": "budget",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Budget"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Budget created, provided information of the new item created",
+            "schema": {
+              "$ref": "#/definitions/Budget"
+            }
+          },
+          "409": {
+            "description": "A budget with the same name already exists for the scope provided",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/budget/{id}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "budgetManagement"
         ],
-        "summary": "Creates a new account in the system",
-        "operationId": "createAccount",
+        "summary": "Budget with the provided id",
+        "operationId": "getBudget",
         "parameters": [
           {
             "type": "string",
-            "description": "Id of the account to be created",
+            "format": "uuid",
+            "description": "Id of the budget to be retrieved",
             "name": "id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "201": {
-            "description": "Account created, provided information of the new item created",
+          "200": {
+            "description": "Budget with the provided id",
             "schema": {
-              "$ref": "#/definitions/AccountStatus"
+              "$ref": "#/definitions/Budget"
             }
           },
-          "409": {
-            "description": "The account with the id provided already exist",
+          "404": {
+            "description": "The budget with the id provided doesn't exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1152,14 +2219,12 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/account/disable/{id}": {
-      "post": {
+      },
+      "put": {
         "security": [
           {
             "Keycloak": [
-              "admin"
+              "user"
             ]
           },
           {
@@ -1176,28 +2241,38 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "accountManagement"
+          "budgetManagement"
         ],
-        "summary": "Disables the account with the id provided in the system",
-        "operationId": "disableAccount",
+        "summary": "Updates the budget with the provided id",
+        "operationId": "updateBudget",
         "parameters": [
           {
             "type": "string",
-            "description": "Id of the account to be disabled",
+            "format": "uuid",
+            "description": "Id of the budget to be updated",
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "Updated budget",
+            "name": "budget",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Budget"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Status information of the account with provided id in the system after the operation succeded",
+            "description": "Budget updated",
             "schema": {
-              "$ref": "#/definitions/AccountStatus"
+              "$ref": "#/definitions/Budget"
             }
           },
           "404": {
-            "description": "The account with the id provided doesn't exist",
+            "description": "The budget with the id provided doesn't exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1211,12 +2286,12 @@ func init() {
         }
       }
     },
-    "/account/enable/{id}": {
-      "post": {
+    "/budget/{id}/alerts": {
+      "get": {
         "security": [
           {
             "Keycloak": [
-              "admin"
+              "user"
             ]
           },
           {
@@ -1226,35 +2301,42 @@ func init() {
             "APIKeyParam": []
           }
         ],
-        "consumes": [
-          "application/json"
-        ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "accountManagement"
+          "budgetManagement"
         ],
-        "summary": "Enables the account with the id provided in the system",
-        "operationId": "enableAccount",
+        "summary": "History of the alerts raised by the budget with the provided id",
+        "operationId": "listBudgetAlerts",
         "parameters": [
           {
             "type": "string",
-            "description": "Id of the account to be enabled",
+            "format": "uuid",
+            "description": "Id of the budget to get the alerts",
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "integer",
+            "description": "Amount of months to go back in time for the alerts, default 3",
+            "name": "months",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Status information of the account with provided id in the system after the operation succeded",
+            "description": "Alerts raised by the budget with the provided id",
             "schema": {
-              "$ref": "#/definitions/AccountStatus"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BudgetAlert"
+              }
             }
           },
           "404": {
-            "description": "The account with the id provided doesn't exist",
+            "description": "The budget with the id provided doesn't exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1268,7 +2350,7 @@ func init() {
         }
       }
     },
-    "/account/list": {
+    "/budget/{id}/consumption": {
       "get": {
         "security": [
           {
@@ -1287,18 +2369,38 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "accountManagement"
+          "budgetManagement"
+        ],
+        "summary": "Consumption of the budget with the provided id in a month",
+        "operationId": "getBudgetConsumption",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Id of the budget to get the consumption",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime within the month to be checked, default the current one",
+            "name": "period",
+            "in": "query"
+          }
         ],
-        "summary": "List of the accounts in the system",
-        "operationId": "listAccounts",
         "responses": {
           "200": {
-            "description": "List of accounts in the system",
+            "description": "Consumption of the budget with the provided id",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/AccountStatus"
-              }
+              "$ref": "#/definitions/BudgetConsumption"
+            }
+          },
+          "404": {
+            "description": "The budget with the id provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
@@ -1310,7 +2412,7 @@ func init() {
         }
       }
     },
-    "/account/status/{id}": {
+    "/forecast/{id}": {
       "get": {
         "security": [
           {
@@ -1329,28 +2431,36 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "accountManagement"
+          "creditManagement"
         ],
-        "summary": "Basic status of the account with the id provided in the system",
-        "operationId": "getAccountStatus",
+        "summary": "Prediction of the date when the credit and cash of the account with id will be exhausted",
+        "operationId": "getForecast",
         "parameters": [
           {
             "type": "string",
-            "description": "Id of the account to be checked",
+            "description": "Id of the account to be forecasted",
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "maximum": 366,
+            "type": "integer",
+            "default": 28,
+            "description": "Number of days of consumption history used to fit the model",
+            "name": "history",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Status information of the account with provided id in the system",
+            "description": "Exhaustion forecast of the account with the provided id",
             "schema": {
-              "$ref": "#/definitions/AccountStatus"
+              "$ref": "#/definitions/CreditForecast"
             }
           },
           "404": {
-            "description": "The account with the id provided doesn't exist",
+            "description": "The account provided doesn't exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1811,6 +2921,193 @@ func init() {
         }
       }
     },
+    "Budget": {
+      "type": "object",
+      "required": [
+        "Amount",
+        "Name",
+        "Scope",
+        "ScopeId"
+      ],
+      "properties": {
+        "Amount": {
+          "description": "Monthly amount of the budget",
+          "type": "number",
+          "format": "double",
+          "x-go-custom-tag": "gorm:\"type:numeric(23,13)\""
+        },
+        "CreatedAt": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+        },
+        "Emails": {
+          "description": "Email addresses to be notified when a threshold is reached",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"serializer:json\""
+        },
+        "Enabled": {
+          "type": "boolean",
+          "default": true,
+          "x-go-custom-tag": "gorm:\"default:true\""
+        },
+        "ID": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"type:uuid;primary_key;unique;default:md5(random()::text || clock_timestamp()::text)::uuid\""
+        },
+        "Name": {
+          "type": "string"
+        },
+        "Scope": {
+          "type": "string",
+          "enum": [
+            "product",
+            "customer",
+            "reseller"
+          ],
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "ScopeId": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "Thresholds": {
+          "description": "Percentages of the budget that raise an alert, default 50, 80 and 100",
+          "type": "array",
+          "items": {
+            "type": "integer"
+          },
+          "x-go-custom-tag": "gorm:\"serializer:json\""
+        },
+        "Webhook": {
+          "description": "URL where the alerts are going to be posted",
+          "type": "string"
+        }
+      }
+    },
+    "BudgetAlert": {
+      "type": "object",
+      "properties": {
+        "Amount": {
+          "type": "number",
+          "format": "double",
+          "x-go-custom-tag": "gorm:\"type:numeric(23,13);default:0.0\""
+        },
+        "BudgetID": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"type:uuid;index\""
+        },
+        "Channel": {
+          "type": "string",
+          "enum": [
+            "webhook",
+            "email"
+          ]
+        },
+        "Consumed": {
+          "type": "number",
+          "format": "double",
+          "x-go-custom-tag": "gorm:\"type:numeric(23,13);default:0.0\""
+        },
+        "Error": {
+          "type": "string"
+        },
+        "ID": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "Period": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"index;type:timestamptz\""
+        },
+        "Status": {
+          "type": "string",
+          "enum": [
+            "SENT",
+            "FAILED",
+            "SKIPPED"
+          ]
+        },
+        "Threshold": {
+          "type": "integer"
+        },
+        "Timestamp": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+        }
+      }
+    },
+    "BudgetCharge": {
+      "type": "object",
+      "properties": {
+        "AccountID": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "BudgetID": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"type:uuid;primary_key\""
+        },
+        "Cost": {
+          "type": "number",
+          "format": "double",
+          "x-go-custom-tag": "gorm:\"type:numeric(23,13);default:0.0\""
+        },
+        "TimeFrom": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"primary_key;type:timestamptz\""
+        },
+        "TimeTo": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"primary_key;type:timestamptz\""
+        }
+      }
+    },
+    "BudgetConsumption": {
+      "type": "object",
+      "properties": {
+        "Amount": {
+          "type": "number",
+          "format": "double"
+        },
+        "BudgetID": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "Consumed": {
+          "type": "number",
+          "format": "double"
+        },
+        "Percentage": {
+          "type": "number",
+          "format": "double"
+        },
+        "PeriodFrom": {
+          "type": "string",
+          "format": "datetime"
+        },
+        "PeriodTo": {
+          "type": "string",
+          "format": "datetime"
+        },
+        "ThresholdsReached": {
+          "type": "array",
+          "items": {
+            "type": "integer"
+          }
+        }
+      }
+    },
     "CreditEvents": {
       "type": "object",
       "properties": {
@@ -1858,6 +3155,31 @@ func init() {
         }
       }
     },
+    "CreditForecast": {
+      "type": "object",
+      "properties": {
+        "AccountID": {
+          "type": "string"
+        },
+        "Confidence": {
+          "type": "number",
+          "format": "double"
+        },
+        "HistoryDays": {
+          "type": "integer"
+        },
+        "Mediums": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/MediumForecast"
+          }
+        },
+        "Timestamp": {
+          "type": "string",
+          "format": "datetime"
+        }
+      }
+    },
     "CreditHistory": {
       "type": "object",
       "properties": {
@@ -1947,6 +3269,48 @@ func init() {
         }
       }
     },
+    "MediumForecast": {
+      "type": "object",
+      "properties": {
+        "Balance": {
+          "type": "number",
+          "format": "double"
+        },
+        "DailyBurn": {
+          "type": "number",
+          "format": "double"
+        },
+        "DaysLeft": {
+          "type": "integer"
+        },
+        "Earliest": {
+          "type": "string",
+          "format": "datetime"
+        },
+        "ExhaustionDate": {
+          "type": "string",
+          "format": "datetime"
+        },
+        "Exhausts": {
+          "type": "boolean"
+        },
+        "Latest": {
+          "type": "string",
+          "format": "datetime"
+        },
+        "Medium": {
+          "type": "string"
+        },
+        "Sigma": {
+          "type": "number",
+          "format": "double"
+        },
+        "Trend": {
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
     "Status": {
       "type": "object",
       "required": [
@@ -2030,6 +3394,10 @@ func init() {
     {
       "description": "Actions relating to the account management in the service",
       "name": "accountManagement"
+    },
+    {
+      "description": "Actions relating to the budgets and their alerts",
+      "name": "budgetManagement"
     }
   ]
 }`))
@@ -20,6 +20,7 @@ import (
 	"github.com/go-openapi/swag"
 
 	"github.com/GoDieNow/TFT_Code/services/creditsystem/restapi/operations/account_management"
+	"github.com/GoDieNow/TFT_Code/services/creditsystem/restapi/operations/budget_management"
 	"github.com/GoDieNow/TFT_Code/services/creditsystem/restapi/operations/credit_management"
 	"github.com/GoDieNow/TFT_Code/services/creditsystem/restapi/operations/status_management"
 	"github.com/GoDieNow/TFT_Code/services/creditsystem/restapi/operations/trigger_management"
@@ -53,6 +54,9 @@ func NewCreditManagerManagementAPIAPI(spec *loads.Document) *CreditManagerManage
 		AccountManagementCreateAccountHandler: account_management.CreateAccountHandlerFunc(func(params account_management.CreateAccountParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation account_management.CreateAccount has not yet been implemented")
 		}),
+		BudgetManagementCreateBudgetHandler: budget_management.CreateBudgetHandlerFunc(func(params budget_management.CreateBudgetParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation budget_management.CreateBudget has not yet been implemented")
+		}),
 		CreditManagementDecreaseCreditHandler: credit_management.DecreaseCreditHandlerFunc(func(params credit_management.DecreaseCreditParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation credit_management.DecreaseCredit has not yet been implemented")
 		}),
@@ -68,9 +72,18 @@ func NewCreditManagerManagementAPIAPI(spec *loads.Document) *CreditManagerManage
 		AccountManagementGetAccountStatusHandler: account_management.GetAccountStatusHandlerFunc(func(params account_management.GetAccountStatusParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation account_management.GetAccountStatus has not yet been implemented")
 		}),
+		BudgetManagementGetBudgetHandler: budget_management.GetBudgetHandlerFunc(func(params budget_management.GetBudgetParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation budget_management.GetBudget has not yet been implemented")
+		}),
+		BudgetManagementGetBudgetConsumptionHandler: budget_management.GetBudgetConsumptionHandlerFunc(func(params budget_management.GetBudgetConsumptionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation budget_management.GetBudgetConsumption has not yet been implemented")
+		}),
 		CreditManagementGetCreditHandler: credit_management.GetCreditHandlerFunc(func(params credit_management.GetCreditParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation credit_management.GetCredit has not yet been implemented")
 		}),
+		CreditManagementGetForecastHandler: credit_management.GetForecastHandlerFunc(func(params credit_management.GetForecastParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation credit_management.GetForecast has not yet been implemented")
+		}),
 		CreditManagementGetHistoryHandler: credit_management.GetHistoryHandlerFunc(func(params credit_management.GetHistoryParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation credit_management.GetHistory has not yet been implemented")
 		}),
@@ -83,9 +96,18 @@ func NewCreditManagerManagementAPIAPI(spec *loads.Document) *CreditManagerManage
 		AccountManagementListAccountsHandler: account_management.ListAccountsHandlerFunc(func(params account_management.ListAccountsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation account_management.ListAccounts has not yet been implemented")
 		}),
+		BudgetManagementListBudgetAlertsHandler: budget_management.ListBudgetAlertsHandlerFunc(func(params budget_management.ListBudgetAlertsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation budget_management.ListBudgetAlerts has not yet been implemented")
+		}),
+		BudgetManagementListBudgetsHandler: budget_management.ListBudgetsHandlerFunc(func(params budget_management.ListBudgetsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation budget_management.ListBudgets has not yet been implemented")
+		}),
 		StatusManagementShowStatusHandler: status_management.ShowStatusHandlerFunc(func(params status_management.ShowStatusParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation status_management.ShowStatus has not yet been implemented")
 		}),
+		BudgetManagementUpdateBudgetHandler: budget_management.UpdateBudgetHandlerFunc(func(params budget_management.UpdateBudgetParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation budget_management.UpdateBudget has not yet been implemented")
+		}),
 
 		// Applies when the "X-API-KEY" header is set
 		APIKeyHeaderAuth: func(token string) (interface{}, error) {
@@ -153,6 +175,8 @@ type CreditManagerManagementAPIAPI struct {
 	CreditManagementAddConsumptionHandler credit_management.AddConsumptionHandler
 	// AccountManagementCreateAccountHandler sets the operation handler for the create account operation
 	AccountManagementCreateAccountHandler account_management.CreateAccountHandler
+	// BudgetManagementCreateBudgetHandler sets the operation handler for the create budget operation
+	BudgetManagementCreateBudgetHandler budget_management.CreateBudgetHandler
 	// CreditManagementDecreaseCreditHandler sets the operation handler for the decrease credit operation
 	CreditManagementDecreaseCreditHandler credit_management.DecreaseCreditHandler
 	// AccountManagementDisableAccountHandler sets the operation handler for the disable account operation
@@ -163,8 +187,14 @@ type CreditManagerManagementAPIAPI struct {
 	TriggerManagementExecSampleHandler trigger_management.ExecSampleHandler
 	// AccountManagementGetAccountStatusHandler sets the operation handler for the get account status operation
 	AccountManagementGetAccountStatusHandler account_management.GetAccountStatusHandler
+	// BudgetManagementGetBudgetHandler sets the operation handler for the get budget operation
+	BudgetManagementGetBudgetHandler budget_management.GetBudgetHandler
+	// BudgetManagementGetBudgetConsumptionHandler sets the operation handler for the get budget consumption operation
+	BudgetManagementGetBudgetConsumptionHandler budget_management.GetBudgetConsumptionHandler
 	// CreditManagementGetCreditHandler sets the operation handler for the get credit operation
 	CreditManagementGetCreditHandler credit_management.GetCreditHandler
+	// CreditManagementGetForecastHandler sets the operation handler for the get forecast operation
+	CreditManagementGetForecastHandler credit_management.GetForecastHandler
 	// CreditManagementGetHistoryHandler sets the operation handler for the get history operation
 	CreditManagementGetHistoryHandler credit_management.GetHistoryHandler
 	// StatusManagementGetStatusHandler sets the operation handler for the get status operation
@@ -173,8 +203,14 @@ type CreditManagerManagementAPIAPI struct {
 	CreditManagementIncreaseCreditHandler credit_management.IncreaseCreditHandler
 	// AccountManagementListAccountsHandler sets the operation handler for the list accounts operation
 	AccountManagementListAccountsHandler account_management.ListAccountsHandler
+	// BudgetManagementListBudgetAlertsHandler sets the operation handler for the list budget alerts operation
+	BudgetManagementListBudgetAlertsHandler budget_management.ListBudgetAlertsHandler
+	// BudgetManagementListBudgetsHandler sets the operation handler for the list budgets operation
+	BudgetManagementListBudgetsHandler budget_management.ListBudgetsHandler
 	// StatusManagementShowStatusHandler sets the operation handler for the show status operation
 	StatusManagementShowStatusHandler status_management.ShowStatusHandler
+	// BudgetManagementUpdateBudgetHandler sets the operation handler for the update budget operation
+	BudgetManagementUpdateBudgetHandler budget_management.UpdateBudgetHandler
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
 	ServeError func(http.ResponseWriter, *http.Request, error)
@@ -267,6 +303,9 @@ func (o *CreditManagerManagementAPIAPI) Validate() error {
 	if o.AccountManagementCreateAccountHandler == nil {
 		unregistered = append(unregistered, "account_management.CreateAccountHandler")
 	}
+	if o.BudgetManagementCreateBudgetHandler == nil {
+		unregistered = append(unregistered, "budget_management.CreateBudgetHandler")
+	}
 	if o.CreditManagementDecreaseCreditHandler == nil {
 		unregistered = append(unregistered, "credit_management.DecreaseCreditHandler")
 	}
@@ -282,9 +321,18 @@ func (o *CreditManagerManagementAPIAPI) Validate() error {
 	if o.AccountManagementGetAccountStatusHandler == nil {
 		unregistered = append(unregistered, "account_management.GetAccountStatusHandler")
 	}
+	if o.BudgetManagementGetBudgetHandler == nil {
+		unregistered = append(unregistered, "budget_management.GetBudgetHandler")
+	}
+	if o.BudgetManagementGetBudgetConsumptionHandler == nil {
+		unregistered = append(unregistered, "budget_management.GetBudgetConsumptionHandler")
+	}
 	if o.CreditManagementGetCreditHandler == nil {
 		unregistered = append(unregistered, "credit_management.GetCreditHandler")
 	}
+	if o.CreditManagementGetForecastHandler == nil {
+		unregistered = append(unregistered, "credit_management.GetForecastHandler")
+	}
 	if o.CreditManagementGetHistoryHandler == nil {
 		unregistered = append(unregistered, "credit_management.GetHistoryHandler")
 	}
@@ -297,9 +345,18 @@ func (o *CreditManagerManagementAPIAPI) Validate() error {
 	if o.AccountManagementListAccountsHandler == nil {
 		unregistered = append(unregistered, "account_management.ListAccountsHandler")
 	}
+	if o.BudgetManagementListBudgetAlertsHandler == nil {
+		unregistered = append(unregistered, "budget_management.ListBudgetAlertsHandler")
+	}
+	if o.BudgetManagementListBudgetsHandler == nil {
+		unregistered = append(unregistered, "budget_management.ListBudgetsHandler")
+	}
 	if o.StatusManagementShowStatusHandler == nil {
 		unregistered = append(unregistered, "status_management.ShowStatusHandler")
 	}
+	if o.BudgetManagementUpdateBudgetHandler == nil {
+		unregistered = append(unregistered, "budget_management.UpdateBudgetHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -415,6 +472,10 @@ func (o *CreditManagerManagementAPIAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/budget"] = budget_management.NewCreateBudget(o.context, o.BudgetManagementCreateBudgetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/{medium}/available/decrease/{id}"] = credit_management.NewDecreaseCredit(o.context, o.CreditManagementDecreaseCreditHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -435,10 +496,22 @@ func (o *CreditManagerManagementAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/budget/{id}"] = budget_management.NewGetBudget(o.context, o.BudgetManagementGetBudgetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/budget/{id}/consumption"] = budget_management.NewGetBudgetConsumption(o.context, o.BudgetManagementGetBudgetConsumptionHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/account/available/{id}"] = credit_management.NewGetCredit(o.context, o.CreditManagementGetCreditHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/forecast/{id}"] = credit_management.NewGetForecast(o.context, o.CreditManagementGetForecastHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/history/{id}"] = credit_management.NewGetHistory(o.context, o.CreditManagementGetHistoryHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -455,7 +528,19 @@ func (o *CreditManagerManagementAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/budget/{id}/alerts"] = budget_management.NewListBudgetAlerts(o.context, o.BudgetManagementListBudgetAlertsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/budget"] = budget_management.NewListBudgets(o.context, o.BudgetManagementListBudgetsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/status"] = status_management.NewShowStatus(o.context, o.StatusManagementShowStatusHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/budget/{id}"] = budget_management.NewUpdateBudget(o.context, o.BudgetManagementUpdateBudgetHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -0,0 +1,365 @@
+package budgetManager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/GoDieNow/TFT_Code/services/creditsystem/models"
+	"github.com/GoDieNow/TFT_Code/services/creditsystem/restapi/operations/budget_management"
+	"github.com/GoDieNow/TFT_Code/services/creditsystem/server/dbManager"
+	"github.com/GoDieNow/TFT_Code/services/creditsystem/server/statusManager"
+	l "gitlab.com/cyclops-utilities/logging"
+)
+
+// BudgetManager is the struct defined to group and contain all the methods
+// that interact with the budgets endpoint.
+// Parameters:
+// - db: a DbParameter reference to be able to use the DBManager methods.
+// - monit: a StatusManager reference to be able to use the status subsystem methods.
+type BudgetManager struct {
+	db    *dbManager.DbParameter
+	monit *statusManager.StatusManager
+}
+
+// New is the function to create the struct BudgetManager.
+// Parameters:
+// - DbParameter: reference pointing to the DbParameter that allows the interaction
+// with the DBManager methods.
+// - monit: a reference to the StatusManager to be able to interact with the
+// status subsystem.
+// Returns:
+// - BudgetManager: struct to interact with BudgetManager subsystem functionalities.
+func New(db *dbManager.DbParameter, monit *statusManager.StatusManager) *BudgetManager {
+
+	l.Trace.Printf("[BudgetManager] Gerenating new BudgetManager.\n")
+
+	monit.InitEndpoint("budget")
+
+	return &BudgetManager{
+		db:    db,
+		monit: monit,
+	}
+
+}
+
+// CreateBudget (Swagger func) is the function behind the (POST) API Endpoint
+// /budget
+// Its job is to add a new budget to the system.
+func (m *BudgetManager) CreateBudget(ctx context.Context, params budget_management.CreateBudgetParams) middleware.Responder {
+
+	l.Trace.Printf("[BudgetManager] CreateBudget endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("budget", callTime)
+
+	budget, e := m.db.CreateBudget(params.Budget)
+
+	if errors.Is(e, dbManager.ErrBudgetDuplicated) {
+
+		s := "The Budget already exists in the system."
+		conflictReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": fmt.Sprint(http.StatusConflict), "method": params.HTTPRequest.Method, "route": params.HTTPRequest.URL.Path}).Inc()
+
+		m.monit.APIHitDone("budget", callTime)
+
+		return budget_management.NewCreateBudgetConflict().WithPayload(&conflictReturn)
+
+	}
+
+	if e != nil {
+
+		s := "Problem creating the Budget: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": fmt.Sprint(http.StatusInternalServerError), "method": params.HTTPRequest.Method, "route": params.HTTPRequest.URL.Path}).Inc()
+
+		m.monit.APIHitDone("budget", callTime)
+
+		return budget_management.NewCreateBudgetInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": fmt.Sprint(http.StatusCreated), "method": params.HTTPRequest.Method, "route": params.HTTPRequest.URL.Path}).Inc()
+
+	m.monit.APIHitDone("budget", callTime)
+
+	return budget_management.NewCreateBudgetCreated().WithPayload(budget)
+
+}
+
+// GetBudget (Swagger func) is the function behind the (GET) API Endpoint
+// /budget/{id}
+// Its job is to retrieve the budget linked to the ID provided.
+func (m *BudgetManager) GetBudget(ctx context.Context, params budget_management.GetBudgetParams) middleware.Responder {
+
+	l.Trace.Printf("[BudgetManager] GetBudget endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("budget", callTime)
+
+	budget, e := m.db.GetBudget(params.ID)
+
+	if e != nil {
+
+		s := "Problem retrieving the Budget: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": fmt.Sprint(http.StatusInternalServerError), "method": params.HTTPRequest.Method, "route": params.HTTPRequest.URL.Path}).Inc()
+
+		m.monit.APIHitDone("budget", callTime)
+
+		return budget_management.NewGetBudgetInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	if budget != nil {
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": fmt.Sprint(http.StatusOK), "method": params.HTTPRequest.Method, "route": params.HTTPRequest.URL.Path}).Inc()
+
+		m.monit.APIHitDone("budget", callTime)
+
+		return budget_management.NewGetBudgetOK().WithPayload(budget)
+
+	}
+
+	s := "The Budget doesn't exists in the system."
+	missingReturn := models.ErrorResponse{
+		ErrorString: &s,
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": fmt.Sprint(http.StatusNotFound), "method": params.HTTPRequest.Method, "route": params.HTTPRequest.URL.Path}).Inc()
+
+	m.monit.APIHitDone("budget", callTime)
+
+	return budget_management.NewGetBudgetNotFound().WithPayload(&missingReturn)
+
+}
+
+// GetBudgetConsumption (Swagger func) is the function behind the (GET) API
+// Endpoint /budget/{id}/consumption
+// Its job is to provide the consumption of the budget linked to the ID provided
+// in the requested month, the current one by default.
+func (m *BudgetManager) GetBudgetConsumption(ctx context.Context, params budget_management.GetBudgetConsumptionParams) middleware.Responder {
+
+	l.Trace.Printf("[BudgetManager] GetBudgetConsumption endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("budget", callTime)
+
+	period := callTime
+
+	if params.Period != nil {
+
+		period = (time.Time)(*params.Period)
+
+	}
+
+	consumption, e := m.db.GetBudgetConsumption(params.ID, period)
+
+	if errors.Is(e, dbManager.ErrBudgetNotFound) {
+
+		s := "The Budget doesn't exists in the system."
+		missingReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": fmt.Sprint(http.StatusNotFound), "method": params.HTTPRequest.Method, "route": params.HTTPRequest.URL.Path}).Inc()
+
+		m.monit.APIHitDone("budget", callTime)
+
+		return budget_management.NewGetBudgetConsumptionNotFound().WithPayload(&missingReturn)
+
+	}
+
+	if e != nil {
+
+		s := "Problem retrieving the consumption of the Budget: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": fmt.Sprint(http.StatusInternalServerError), "method": params.HTTPRequest.Method, "route": params.HTTPRequest.URL.Path}).Inc()
+
+		m.monit.APIHitDone("budget", callTime)
+
+		return budget_management.NewGetBudgetConsumptionInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": fmt.Sprint(http.StatusOK), "method": params.HTTPRequest.Method, "route": params.HTTPRequest.URL.Path}).Inc()
+
+	m.monit.APIHitDone("budget", callTime)
+
+	return budget_management.NewGetBudgetConsumptionOK().WithPayload(consumption)
+
+}
+
+// ListBudgetAlerts (Swagger func) is the function behind the (GET) API Endpoint
+// /budget/{id}/alerts
+// Its job is to provide the history of the alerts raised by the budget linked
+// to the ID provided.
+func (m *BudgetManager) ListBudgetAlerts(ctx context.Context, params budget_management.ListBudgetAlertsParams) middleware.Responder {
+
+	l.Trace.Printf("[BudgetManager] ListBudgetAlerts endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("budget", callTime)
+
+	months := int64(3)
+
+	if params.Months != nil {
+
+		months = *params.Months
+
+	}
+
+	alerts, e := m.db.ListBudgetAlerts(params.ID, months)
+
+	if errors.Is(e, dbManager.ErrBudgetNotFound) {
+
+		s := "The Budget doesn't exists in the system."
+		missingReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": fmt.Sprint(http.StatusNotFound), "method": params.HTTPRequest.Method, "route": params.HTTPRequest.URL.Path}).Inc()
+
+		m.monit.APIHitDone("budget", callTime)
+
+		return budget_management.NewListBudgetAlertsNotFound().WithPayload(&missingReturn)
+
+	}
+
+	if e != nil {
+
+		s := "Problem retrieving the alerts of the Budget: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": fmt.Sprint(http.StatusInternalServerError), "method": params.HTTPRequest.Method, "route": params.HTTPRequest.URL.Path}).Inc()
+
+		m.monit.APIHitDone("budget", callTime)
+
+		return budget_management.NewListBudgetAlertsInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": fmt.Sprint(http.StatusOK), "method": params.HTTPRequest.Method, "route": params.HTTPRequest.URL.Path}).Inc()
+
+	m.monit.APIHitDone("budget", callTime)
+
+	return budget_management.NewListBudgetAlertsOK().WithPayload(alerts)
+
+}
+
+// ListBudgets (Swagger func) is the function behind the (GET) API Endpoint
+// /budget
+// Its job is to list the budgets in the system, optionally filtered by scope.
+func (m *BudgetManager) ListBudgets(ctx context.Context, params budget_management.ListBudgetsParams) middleware.Responder {
+
+	l.Trace.Printf("[BudgetManager] ListBudgets endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("budget", callTime)
+
+	var scope, scopeID string
+
+	if params.Scope != nil {
+
+		scope = *params.Scope
+
+	}
+
+	if params.ScopeID != nil {
+
+		scopeID = *params.ScopeID
+
+	}
+
+	budgets, e := m.db.ListBudgets(scope, scopeID)
+
+	if e != nil {
+
+		s := "Problem listing the Budgets: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": fmt.Sprint(http.StatusInternalServerError), "method": params.HTTPRequest.Method, "route": params.HTTPRequest.URL.Path}).Inc()
+
+		m.monit.APIHitDone("budget", callTime)
+
+		return budget_management.NewListBudgetsInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": fmt.Sprint(http.StatusOK), "method": params.HTTPRequest.Method, "route": params.HTTPRequest.URL.Path}).Inc()
+
+	m.monit.APIHitDone("budget", callTime)
+
+	return budget_management.NewListBudgetsOK().WithPayload(budgets)
+
+}
+
+// UpdateBudget (Swagger func) is the function behind the (PUT) API Endpoint
+// /budget/{id}
+// Its job is to update the budget linked to the ID provided.
+func (m *BudgetManager) UpdateBudget(ctx context.Context, params budget_management.UpdateBudgetParams) middleware.Responder {
+
+	l.Trace.Printf("[BudgetManager] UpdateBudget endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("budget", callTime)
+
+	budget, e := m.db.UpdateBudget(params.ID, params.Budget)
+
+	if errors.Is(e, dbManager.ErrBudgetNotFound) {
+
+		s := "The Budget doesn't exists in the system."
+		missingReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": fmt.Sprint(http.StatusNotFound), "method": params.HTTPRequest.Method, "route": params.HTTPRequest.URL.Path}).Inc()
+
+		m.monit.APIHitDone("budget", callTime)
+
+		return budget_management.NewUpdateBudgetNotFound().WithPayload(&missingReturn)
+
+	}
+
+	if e != nil {
+
+		s := "Problem updating the Budget: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": fmt.Sprint(http.StatusInternalServerError), "method": params.HTTPRequest.Method, "route": params.HTTPRequest.URL.Path}).Inc()
+
+		m.monit.APIHitDone("budget", callTime)
+
+		return budget_management.NewUpdateBudgetInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": fmt.Sprint(http.StatusOK), "method": params.HTTPRequest.Method, "route": params.HTTPRequest.URL.Path}).Inc()
+
+	m.monit.APIHitDone("budget", callTime)
+
+	return budget_management.NewUpdateBudgetOK().WithPayload(budget)
+
+}
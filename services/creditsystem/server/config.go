@@ -8,9 +8,18 @@ import (
 	l "gitlab.com/cyclops-utilities/logging"
 )
 
-// The following structs: apikey, dbConfig, eventsConfig, generalConfig,
-// kafkaConfig, and keycloakConfig are part of the configuration struct which
+// The following structs: alertsConfig, apikey, dbConfig, eventsConfig,
+// generalConfig, kafkaConfig, and keycloakConfig are part of the configuration struct which
 // acts as the main reference for configuration parameters in the system.
+type alertsConfig struct {
+	SMTPFrom       string
+	SMTPHost       string
+	SMTPPassword   string
+	SMTPPort       int
+	SMTPUsername   string
+	WebhookTimeout string
+}
+
 type apiKey struct {
 	Enabled bool `json:"enabled"`
 	Key     string
@@ -19,6 +28,7 @@ type apiKey struct {
 }
 
 type configuration struct {
+	Alerts       alertsConfig
 	APIKey       apiKey
 	DB           dbConfig
 	Events       eventsConfig
@@ -99,6 +109,7 @@ func dumpConfig(c configuration) {
 	cfgCopy := c
 
 	// deal with configuration params that should be masked
+	cfgCopy.Alerts.SMTPPassword = masked(c.Alerts.SMTPPassword, 4)
 	cfgCopy.APIKey.Token = masked(c.APIKey.Token, 4)
 	cfgCopy.DB.Password = masked(c.DB.Password, 4)
 	cfgCopy.Keycloak.ClientSecret = masked(c.Keycloak.ClientSecret, 4)
@@ -149,6 +160,15 @@ func parseConfig() (c configuration) {
 
 	c = configuration{
 
+		Alerts: alertsConfig{
+			SMTPFrom:       viper.GetString("alerts.smtpfrom"),
+			SMTPHost:       viper.GetString("alerts.smtphost"),
+			SMTPPassword:   viper.GetString("alerts.smtppassword"),
+			SMTPPort:       viper.GetInt("alerts.smtpport"),
+			SMTPUsername:   viper.GetString("alerts.smtpusername"),
+			WebhookTimeout: viper.GetString("alerts.webhooktimeout"),
+		},
+
 		APIKey: apiKey{
 			Enabled: viper.GetBool("apikey.enabled"),
 			Key:     viper.GetString("apikey.key"),
@@ -199,6 +199,57 @@ func (m *CreditManager) GetCredit(ctx context.Context, params credit_management.
 
 }
 
+// GetForecast (Swagger func) is the function behind the (GET) API Endpoint
+// /forecast/{id}
+// Its job is to predict when the credit and cash of an account in the system
+// will be exhausted provided the account ID.
+func (m *CreditManager) GetForecast(ctx context.Context, params credit_management.GetForecastParams) middleware.Responder {
+
+	l.Trace.Printf("[CreditManager] GetForecast endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("credit", callTime)
+
+	forecast, e := m.db.GetForecast(params.ID, *params.History)
+
+	if e != nil {
+
+		s := "Problem getting the Credit Forecast: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": fmt.Sprint(http.StatusInternalServerError), "method": params.HTTPRequest.Method, "route": params.HTTPRequest.URL.Path}).Inc()
+
+		m.monit.APIHitDone("credit", callTime)
+
+		return credit_management.NewGetForecastInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	if forecast != nil {
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": fmt.Sprint(http.StatusOK), "method": params.HTTPRequest.Method, "route": params.HTTPRequest.URL.Path}).Inc()
+
+		m.monit.APIHitDone("credit", callTime)
+
+		return credit_management.NewGetForecastOK().WithPayload(forecast)
+
+	}
+
+	s := "The Account doesn't exists in the system."
+	missingReturn := models.ErrorResponse{
+		ErrorString: &s,
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": fmt.Sprint(http.StatusNotFound), "method": params.HTTPRequest.Method, "route": params.HTTPRequest.URL.Path}).Inc()
+
+	m.monit.APIHitDone("credit", callTime)
+
+	return credit_management.NewGetForecastNotFound().WithPayload(&missingReturn)
+
+}
+
 // GetHistory (Swagger func) is the function behind the (GET) API Endpoint
 // /credit/history/{id}
 // Its job is to retrieve history of the credit balance for an account in the
@@ -3,8 +3,10 @@ package dbManager
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"math"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -13,11 +15,13 @@ import (
 	cdrModels "github.com/GoDieNow/TFT_Code/services/cdr/models"
 	"github.com/GoDieNow/TFT_Code/services/creditsystem/models"
 	"github.com/GoDieNow/TFT_Code/services/creditsystem/server/cacheManager"
+	"github.com/GoDieNow/TFT_Code/services/creditsystem/server/notificationManager"
 	cusModels "github.com/GoDieNow/TFT_Code/services/customerdb/models"
 	pmModels "github.com/GoDieNow/TFT_Code/services/planmanager/models"
 	l "gitlab.com/cyclops-utilities/logging"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 const (
@@ -32,13 +36,22 @@ const (
 	AC_NONE    = "NONE"
 	MED_CASH   = "CASH"
 	MED_CREDIT = "CREDIT"
+
+	forecastHistoryMax = 366
+	forecastHorizon    = 365
 )
 
 var (
 	//states = []string{"active", "error", "inactive", "suspended", "terminated"}
-	states     = []string{"active", "error", "inactive", "used"}
-	totalTime  float64
-	totalCount int64
+	states            = []string{"active", "error", "inactive", "used"}
+	defaultThresholds = []int64{50, 80, 100}
+	totalTime         float64
+	totalCount        int64
+
+	// ErrBudgetDuplicated is raised when the budget already exists in the system.
+	ErrBudgetDuplicated = errors.New("budget already exist in the system")
+	// ErrBudgetNotFound is raised when the budget doesn't exist in the system.
+	ErrBudgetNotFound = errors.New("budget doesn't exist in the system")
 )
 
 // DbParameter is the struct defined to group and contain all the methods
@@ -47,11 +60,13 @@ var (
 // - Cache: CacheManager pointer for the cache mechanism.
 // - connStr: strings with the connection information to the database
 // - Db: a gorm.DB pointer to the db to invoke all the db methods
+// - Notifier: NotificationManager pointer to deliver the budget alerts.
 type DbParameter struct {
-	Cache   *cacheManager.CacheManager
-	connStr string
-	Db      *gorm.DB
-	Metrics map[string]*prometheus.GaugeVec
+	Cache    *cacheManager.CacheManager
+	connStr  string
+	Db       *gorm.DB
+	Metrics  map[string]*prometheus.GaugeVec
+	Notifier *notificationManager.NotificationManager
 }
 
 // New is the function to create the struct DbParameter.
@@ -421,6 +436,109 @@ func (d *DbParameter) GetCredit(id string) (*models.CreditStatus, error) {
 
 }
 
+// GetForecast job is to predict when the credit and the cash of the provided
+// account will be exhausted. A linear trend is fitted over the daily
+// consumptions of the history window and projected against the balance, with
+// the residuals of the fit giving the earliest and latest dates.
+// Parameters:
+// - id: string containing the id of the account requested.
+// - history: number of days of consumption history used to fit the model.
+// Returns:
+// - reference to CreditForecast containing the prediction per medium, or nil
+// if the account doesn't exist.
+// - error raised in case of problems.
+func (d *DbParameter) GetForecast(id string, history int64) (*models.CreditForecast, error) {
+
+	l.Trace.Printf("[DB] Attempting to forecast the credit exhaustion of the account with id: %v", id)
+
+	var cs models.CreditStatus
+
+	if r := d.Db.Where(&models.CreditStatus{AccountID: id}).First(&cs).Error; r != nil {
+
+		if errors.Is(r, gorm.ErrRecordNotFound) {
+
+			l.Trace.Printf("[DB] Account with id: %v doesn't exist in the system, check with administrator.", id)
+
+			return nil, nil
+
+		}
+
+		return nil, r
+
+	}
+
+	if history < 1 {
+
+		history = 1
+
+	}
+
+	if history > forecastHistoryMax {
+
+		history = forecastHistoryMax
+
+	}
+
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	from := today.AddDate(0, 0, -int(history))
+	ts := d.Db.NamingStrategy.ColumnName("", "Timestamp")
+
+	forecast := models.CreditForecast{
+		AccountID:   id,
+		Confidence:  0.95,
+		HistoryDays: history,
+		Mediums:     []*models.MediumForecast{},
+		Timestamp:   strfmt.DateTime(now),
+	}
+
+	for medium, balance := range map[string]float64{
+		MED_CREDIT: cs.AvailableCredit,
+		MED_CASH:   cs.AvailableCash,
+	} {
+
+		var rows []struct {
+			Day  time.Time
+			Burn float64
+		}
+
+		e := d.Db.Model(&models.CreditEvents{}).
+			Select(fmt.Sprintf("date_trunc('day', %[1]v AT TIME ZONE 'UTC') AS day, SUM(-%[2]v)::float8 AS burn", ts, d.Db.NamingStrategy.ColumnName("", "Delta"))).
+			Where(&models.CreditEvents{AccountID: id}).
+			Where(fmt.Sprintf("%v = ? AND %v = ?", d.Db.NamingStrategy.ColumnName("", "EventType"), d.Db.NamingStrategy.ColumnName("", "Medium")), models.EventEventTypeConsumption, medium).
+			Where(fmt.Sprintf("%[1]v >= ? AND %[1]v < ?", ts), from, today).
+			Group("day").Scan(&rows).Error
+
+		if e != nil {
+
+			l.Warning.Printf("[DB] Something went wrong while aggregating the consumptions of the account [ %v ]. Error: %v\n", id, e)
+
+			return nil, e
+
+		}
+
+		burns := make([]float64, history)
+
+		for _, row := range rows {
+
+			if i := int(math.Round(row.Day.Sub(from).Hours() / 24)); i >= 0 && i < len(burns) {
+
+				burns[i] += row.Burn
+
+			}
+
+		}
+
+		forecast.Mediums = append(forecast.Mediums, d.getMediumForecast(medium, balance, burns, now))
+
+	}
+
+	sort.Slice(forecast.Mediums, func(i, j int) bool { return forecast.Mediums[i].Medium < forecast.Mediums[j].Medium })
+
+	return &forecast, nil
+
+}
+
 // GetHistory job is to retrieve the history of the credit balance of the account
 // provided with the posibility of filter the actions.
 // Parameters:
@@ -941,8 +1059,705 @@ PlanDefault:
 
 }
 
+// getMediumForecast job is to fit the least squares line over the daily
+// consumptions of a medium and walk it forward, day by day, until the
+// accumulated consumption reaches the balance available.
+// Parameters:
+// - medium: string with the medium being forecasted.
+// - balance: float64 with the amount available in the medium.
+// - burns: slice with the daily consumptions, the last one being yesterday.
+// - now: time from which the projection starts.
+// Returns:
+// - f: reference to the MediumForecast with the prediction.
+func (d *DbParameter) getMediumForecast(medium string, balance float64, burns []float64, now time.Time) (f *models.MediumForecast) {
+
+	n := float64(len(burns))
+
+	var meanT, meanB, sxx, sxy, slope, sigma float64
+
+	for i, v := range burns {
+
+		meanT += float64(i) / n
+		meanB += v / n
+
+	}
+
+	for i, v := range burns {
+
+		sxx += (float64(i) - meanT) * (float64(i) - meanT)
+		sxy += (float64(i) - meanT) * (v - meanB)
+
+	}
+
+	if sxx > 0 {
+
+		slope = sxy / sxx
+
+	}
+
+	intercept := meanB - slope*meanT
+
+	if len(burns) > 2 {
+
+		var squares float64
+
+		for i, v := range burns {
+
+			r := v - (intercept + slope*float64(i))
+			squares += r * r
+
+		}
+
+		sigma = math.Sqrt(squares / (n - 2))
+
+	}
+
+	f = &models.MediumForecast{
+		Balance:   d.getNiceFloat(balance),
+		DailyBurn: d.getNiceFloat(math.Max(0, intercept+slope*n)),
+		Medium:    medium,
+		Sigma:     d.getNiceFloat(sigma),
+		Trend:     d.getNiceFloat(slope),
+	}
+
+	if balance <= 0 {
+
+		f.Exhausts = true
+		f.ExhaustionDate = strfmt.DateTime(now)
+		f.Earliest = strfmt.DateTime(now)
+		f.Latest = strfmt.DateTime(now)
+
+		return
+
+	}
+
+	var consumed float64
+	var expected, earliest, latest int64
+
+	// Days are counted from today on, the band of the accumulated consumption
+	// widening with the square root of the days projected
+	for day := int64(1); day <= forecastHorizon; day++ {
+
+		consumed += math.Max(0, intercept+slope*(n+float64(day-1)))
+		band := 1.96 * sigma * math.Sqrt(float64(day))
+
+		if earliest == 0 && consumed+band >= balance {
+
+			earliest = day
+
+		}
+
+		if expected == 0 && consumed >= balance {
+
+			expected = day
+
+		}
+
+		if latest == 0 && consumed-band >= balance {
+
+			latest = day
+
+			break
+
+		}
+
+	}
+
+	if earliest != 0 {
+
+		f.Earliest = strfmt.DateTime(now.AddDate(0, 0, int(earliest)))
+
+	}
+
+	if expected != 0 {
+
+		f.Exhausts = true
+		f.DaysLeft = expected
+		f.ExhaustionDate = strfmt.DateTime(now.AddDate(0, 0, int(expected)))
+
+	}
+
+	if latest != 0 {
+
+		f.Latest = strfmt.DateTime(now.AddDate(0, 0, int(latest)))
+
+	}
+
+	return
+
+}
+
 func (d *DbParameter) getNiceFloat(i float64) (o float64) {
 
 	return float64(math.Round(i*scaler) / scaler)
 
 }
+
+// CreateBudget job is to add a new budget to the system, in case no thresholds
+// are provided the default ones (50, 80 and 100%) are used.
+// Parameters:
+// - b: reference to the Budget model to be added.
+// Returns:
+// - reference to Budget containing the budget after the operation.
+// - error raised in case of problems.
+func (d *DbParameter) CreateBudget(b *models.Budget) (*models.Budget, error) {
+
+	l.Trace.Printf("[DB] Attempting to create the budget [ %v ] for [ %v ] [ %v ].\n", *b.Name, *b.Scope, *b.ScopeID)
+
+	var b0 models.Budget
+
+	if r := d.Db.Where(&models.Budget{Name: b.Name, Scope: b.Scope, ScopeID: b.ScopeID}).First(&b0).Error; !errors.Is(r, gorm.ErrRecordNotFound) {
+
+		l.Trace.Printf("[DB] Budget [ %v ] already in the system for [ %v ] [ %v ], check with administrator.\n", *b.Name, *b.Scope, *b.ScopeID)
+
+		return nil, ErrBudgetDuplicated
+
+	}
+
+	if len(b.Thresholds) == 0 {
+
+		b.Thresholds = defaultThresholds
+
+	}
+
+	b.CreatedAt = strfmt.DateTime(time.Now())
+
+	if e := d.Db.Create(b).Error; e != nil {
+
+		l.Warning.Printf("[DB] Attempting to create the budget in the system failed. Error: %v\n", e)
+
+		return nil, e
+
+	}
+
+	d.Metrics["count"].With(prometheus.Labels{"type": "Budgets created"}).Inc()
+
+	return b, nil
+
+}
+
+// GetBudget job is to retrieve the budget linked to the provided ID.
+// Parameters:
+// - id: UUID containing the id of the budget to be retrieved.
+// Returns:
+// - reference to Budget containing the budget, nil if it doesn't exist.
+// - error raised in case of problems.
+func (d *DbParameter) GetBudget(id strfmt.UUID) (*models.Budget, error) {
+
+	l.Trace.Printf("[DB] Attempting to get the budget with id: %v\n", id)
+
+	var b models.Budget
+
+	r := d.Db.Where(&models.Budget{ID: id}).First(&b).Error
+
+	if errors.Is(r, gorm.ErrRecordNotFound) {
+
+		l.Trace.Printf("[DB] Budget with id: %v doesn't exist in the system.\n", id)
+
+		return nil, nil
+
+	}
+
+	return &b, r
+
+}
+
+// GetBudgetConsumption job is to compute the consumption of the provided budget
+// in the month that contains the provided time.
+// Parameters:
+// - id: UUID containing the id of the budget requested.
+// - t: time within the month to be checked.
+// Returns:
+// - reference to BudgetConsumption with the state of the budget in the month.
+// - error raised in case of problems.
+func (d *DbParameter) GetBudgetConsumption(id strfmt.UUID, t time.Time) (*models.BudgetConsumption, error) {
+
+	l.Trace.Printf("[DB] Attempting to get the consumption of the budget with id: %v\n", id)
+
+	b, e := d.GetBudget(id)
+
+	if e != nil {
+
+		return nil, e
+
+	}
+
+	if b == nil {
+
+		return nil, ErrBudgetNotFound
+
+	}
+
+	from, to := d.getBudgetPeriod(t)
+
+	consumed, e := d.getBudgetConsumed(id, from, to)
+
+	if e != nil {
+
+		return nil, e
+
+	}
+
+	c := models.BudgetConsumption{
+		Amount:            *b.Amount,
+		BudgetID:          id,
+		Consumed:          d.getNiceFloat(consumed),
+		PeriodFrom:        strfmt.DateTime(from),
+		PeriodTo:          strfmt.DateTime(to),
+		ThresholdsReached: []int64{},
+	}
+
+	if *b.Amount > 0 {
+
+		c.Percentage = d.getNiceFloat(consumed / *b.Amount * 100)
+
+	}
+
+	for _, threshold := range b.Thresholds {
+
+		if c.Percentage >= float64(threshold) {
+
+			c.ThresholdsReached = append(c.ThresholdsReached, threshold)
+
+		}
+
+	}
+
+	return &c, nil
+
+}
+
+// ListBudgetAlerts job is to retrieve the history of the alerts raised by the
+// provided budget.
+// Parameters:
+// - id: UUID containing the id of the budget requested.
+// - months: int64 with the amount of months to go back in time.
+// Returns:
+// - slice of references to BudgetAlert with the alerts raised.
+// - error raised in case of problems.
+func (d *DbParameter) ListBudgetAlerts(id strfmt.UUID, months int64) ([]*models.BudgetAlert, error) {
+
+	l.Trace.Printf("[DB] Attempting to get the alerts of the budget with id: %v\n", id)
+
+	var alerts []*models.BudgetAlert
+
+	b, e := d.GetBudget(id)
+
+	if e != nil {
+
+		return nil, e
+
+	}
+
+	if b == nil {
+
+		return nil, ErrBudgetNotFound
+
+	}
+
+	from, _ := d.getBudgetPeriod(time.Now().AddDate(0, -int(months), 0))
+
+	e = d.Db.Where(&models.BudgetAlert{BudgetID: id}).Where(fmt.Sprintf("%v >= ?", d.Db.NamingStrategy.ColumnName("", "Period")), from).Order(d.Db.NamingStrategy.ColumnName("", "Timestamp")).Find(&alerts).Error
+
+	return alerts, e
+
+}
+
+// ListBudgets job is to retrieve the budgets in the system with the
+// posibility of filtering them by scope.
+// Parameters:
+// - scope: string with the scope of the budgets, empty for all of them.
+// - scopeID: string with the id linked to the budgets, empty for all of them.
+// Returns:
+// - slice of references to Budget with the budgets in the system.
+// - error raised in case of problems.
+func (d *DbParameter) ListBudgets(scope, scopeID string) ([]*models.Budget, error) {
+
+	l.Trace.Printf("[DB] Attempting to list the budgets in the system.\n")
+
+	var budgets []*models.Budget
+	var b0 models.Budget
+
+	if scope != "" {
+
+		b0.Scope = &scope
+
+	}
+
+	if scopeID != "" {
+
+		b0.ScopeID = &scopeID
+
+	}
+
+	e := d.Db.Where(&b0).Find(&budgets).Error
+
+	return budgets, e
+
+}
+
+// ProcessBudgets job is to add the cost of the provided CDR to every budget
+// linked to its product, customer or reseller, and to raise the alerts of the
+// thresholds reached in the month because of it.
+// The cost is recorded per report so reprocessing a CDR doesn't count it twice.
+// Parameters:
+// - report: CDR Report model to be processed.
+// - token: an optional keycloak token in case it's provided.
+// Returns:
+// - error raised in case of problems.
+func (d *DbParameter) ProcessBudgets(report cdrModels.CReport, token string) error {
+
+	l.Trace.Printf("[DB] Starting the processing of the budgets linked to account [ %v ].\n", report.AccountID)
+
+	var budgets []*models.Budget
+
+	customerID := report.CustomerID
+	resellerID := report.ResellerID
+
+	if customerID == "" {
+
+		p, e := d.Cache.Get(report.AccountID, "product", token)
+
+		if e != nil {
+
+			l.Warning.Printf("[DB] Something went wrong while retrieving the product info for id [ %v ]. Error: %v\n", report.AccountID, e)
+
+			return e
+
+		}
+
+		customerID = p.(cusModels.Product).CustomerID
+
+	}
+
+	if resellerID == "" {
+
+		c, e := d.Cache.Get(customerID, "customer", token)
+
+		if e != nil {
+
+			l.Warning.Printf("[DB] Something went wrong while retrieving the customer info for id [ %v ]. Error: %v\n", customerID, e)
+
+			return e
+
+		}
+
+		resellerID = c.(cusModels.Customer).ResellerID
+
+	}
+
+	scope := d.Db.NamingStrategy.ColumnName("", "Scope")
+	scopeID := d.Db.NamingStrategy.ColumnName("", "ScopeID")
+	enabled := true
+
+	if e := d.Db.Where(&models.Budget{Enabled: &enabled}).Where(fmt.Sprintf("(%[1]v = ? AND %[2]v = ?) OR (%[1]v = ? AND %[2]v = ?) OR (%[1]v = ? AND %[2]v = ?)", scope, scopeID),
+		models.BudgetScopeProduct, report.AccountID,
+		models.BudgetScopeCustomer, customerID,
+		models.BudgetScopeReseller, resellerID).Find(&budgets).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while retrieving the budgets linked to account [ %v ]. Error: %v\n", report.AccountID, e)
+
+		return e
+
+	}
+
+	if len(budgets) == 0 {
+
+		l.Trace.Printf("[DB] There's no budgets linked to account [ %v ], skipping...\n", report.AccountID)
+
+		return nil
+
+	}
+
+	cost := float64(0)
+
+	for i := range report.Usage {
+
+		value := report.Usage[i].Cost["netTotal"]
+
+		if k := reflect.ValueOf(value); k.Kind() == reflect.Float64 {
+
+			cost += value.(float64)
+
+		} else if v, ok := value.(json.Number); ok {
+
+			f, _ := v.Float64()
+			cost += f
+
+		}
+
+	}
+
+	from, to := d.getBudgetPeriod((time.Time)(report.TimeFrom))
+
+	for _, b := range budgets {
+
+		charge := models.BudgetCharge{
+			AccountID: report.AccountID,
+			BudgetID:  b.ID,
+			Cost:      cost,
+			TimeFrom:  report.TimeFrom,
+			TimeTo:    report.TimeTo,
+		}
+
+		if e := d.Db.Clauses(clause.OnConflict{UpdateAll: true}).Create(&charge).Error; e != nil {
+
+			l.Warning.Printf("[DB] Something went wrong while adding the charge to the budget [ %v ]. Error: %v\n", b.ID, e)
+
+			continue
+
+		}
+
+		consumed, e := d.getBudgetConsumed(b.ID, from, to)
+
+		if e != nil {
+
+			l.Warning.Printf("[DB] Something went wrong while computing the consumption of the budget [ %v ]. Error: %v\n", b.ID, e)
+
+			continue
+
+		}
+
+		if *b.Amount <= 0 {
+
+			continue
+
+		}
+
+		percentage := consumed / *b.Amount * 100
+
+		for _, threshold := range b.Thresholds {
+
+			if percentage < float64(threshold) {
+
+				continue
+
+			}
+
+			d.raiseBudgetAlert(b, threshold, consumed, from)
+
+		}
+
+	}
+
+	d.Metrics["count"].With(prometheus.Labels{"type": "CDRs processed for budgets"}).Inc()
+
+	return nil
+
+}
+
+// UpdateBudget job is to update the budget linked to the provided ID with the
+// provided new data.
+// Parameters:
+// - id: UUID containing the id of the budget to be updated.
+// - b: reference to the Budget model with the new data.
+// Returns:
+// - reference to Budget containing the budget after the operation.
+// - error raised in case of problems.
+func (d *DbParameter) UpdateBudget(id strfmt.UUID, b *models.Budget) (*models.Budget, error) {
+
+	l.Trace.Printf("[DB] Attempting to update the budget with id: %v\n", id)
+
+	b0, e := d.GetBudget(id)
+
+	if e != nil {
+
+		return nil, e
+
+	}
+
+	if b0 == nil {
+
+		return nil, ErrBudgetNotFound
+
+	}
+
+	b.ID = id
+	b.CreatedAt = b0.CreatedAt
+
+	if e = d.Db.Model(b0).Updates(b).Error; e != nil {
+
+		l.Warning.Printf("[DB] Attempting to update the budget [ %v ] failed. Error: %v\n", id, e)
+
+		return nil, e
+
+	}
+
+	d.Metrics["count"].With(prometheus.Labels{"type": "Budgets updated"}).Inc()
+
+	return d.GetBudget(id)
+
+}
+
+// getBudgetConsumed job is to sum the charges of the provided budget within
+// the provided period.
+// Parameters:
+// - id: UUID containing the id of the budget.
+// - from: time with the beginning of the period.
+// - to: time with the end of the period.
+// Returns:
+// - consumed: float64 with the cost charged to the budget in the period.
+// - e: error raised in case of problems.
+func (d *DbParameter) getBudgetConsumed(id strfmt.UUID, from, to time.Time) (consumed float64, e error) {
+
+	col := d.Db.NamingStrategy.ColumnName("", "TimeFrom")
+
+	e = d.Db.Model(&models.BudgetCharge{}).Select(fmt.Sprintf("COALESCE(SUM(%v), 0)::float8", d.Db.NamingStrategy.ColumnName("", "Cost"))).
+		Where(&models.BudgetCharge{BudgetID: id}).Where(fmt.Sprintf("%[1]v >= ? AND %[1]v < ?", col), from, to).Scan(&consumed).Error
+
+	return
+
+}
+
+// getBudgetPeriod job is to provide the borders of the month containing the
+// provided time, as budgets are monthly.
+// Parameters:
+// - t: time within the month requested.
+// Returns:
+// - from: time with the beginning of the month.
+// - to: time with the beginning of the next month.
+func (d *DbParameter) getBudgetPeriod(t time.Time) (from, to time.Time) {
+
+	t = t.UTC()
+
+	from = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	to = from.AddDate(0, 1, 0)
+
+	return
+
+}
+
+// raiseBudgetAlert job is to notify through the channels configured in the
+// budget that a threshold has been reached, and to keep track of it with an
+// alert per channel. Only the channels without a SENT alert are notified, so
+// the failed deliveries are retried with the next CDRs of the period, and a
+// budget without channels gets a single SKIPPED alert.
+// Parameters:
+// - b: reference to the Budget that reached the threshold.
+// - threshold: int64 with the percentage reached.
+// - consumed: float64 with the cost consumed in the period.
+// - period: time with the beginning of the month of the alert.
+func (d *DbParameter) raiseBudgetAlert(b *models.Budget, threshold int64, consumed float64, period time.Time) {
+
+	var previous []*models.BudgetAlert
+
+	e := d.Db.Where(&models.BudgetAlert{BudgetID: b.ID, Period: strfmt.DateTime(period), Threshold: threshold}).Find(&previous).Error
+
+	if e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while retrieving the alerts of the budget [ %v ]. Error: %v\n", b.ID, e)
+
+		return
+
+	}
+
+	raised := make(map[string]*models.BudgetAlert)
+
+	for _, a := range previous {
+
+		raised[a.Channel] = a
+
+	}
+
+	alert := models.BudgetAlert{
+		Amount:    *b.Amount,
+		BudgetID:  b.ID,
+		Consumed:  d.getNiceFloat(consumed),
+		Period:    strfmt.DateTime(period),
+		Threshold: threshold,
+	}
+
+	channels := make(map[string]func() error)
+
+	if b.Webhook != "" {
+
+		channels[models.BudgetAlertChannelWebhook] = func() error {
+
+			return d.Notifier.SendWebhook(b.Webhook, alert)
+
+		}
+
+	}
+
+	if len(b.Emails) > 0 {
+
+		channels[models.BudgetAlertChannelEmail] = func() error {
+
+			subject := fmt.Sprintf("Budget %v reached %v%% of its amount", *b.Name, threshold)
+			body := fmt.Sprintf("The budget %v for %v %v has consumed %v out of %v in the month starting %v.",
+				*b.Name, *b.Scope, *b.ScopeID, alert.Consumed, *b.Amount, period.Format("2006-01-02"))
+
+			return d.Notifier.SendEmail(b.Emails, subject, body)
+
+		}
+
+	}
+
+	if len(channels) == 0 {
+
+		if len(previous) > 0 {
+
+			return
+
+		}
+
+		l.Warning.Printf("[DB] Budget [ %v ] reached the [ %v%% ] threshold but has no notification channels configured, the alert is not sent.\n", b.ID, threshold)
+
+		a := alert
+
+		a.Status = models.BudgetAlertStatusSKIPPED
+		a.Timestamp = strfmt.DateTime(time.Now())
+
+		if e := d.Db.Create(&a).Error; e != nil {
+
+			l.Warning.Printf("[DB] Something went wrong while saving the alert of the budget [ %v ]. Error: %v\n", b.ID, e)
+
+		}
+
+		d.Metrics["count"].With(prometheus.Labels{"type": "Budget alerts skipped"}).Inc()
+
+		return
+
+	}
+
+	for channel, send := range channels {
+
+		if p, exists := raised[channel]; exists && p.Status == models.BudgetAlertStatusSENT {
+
+			continue
+
+		}
+
+		l.Info.Printf("[DB] Budget [ %v ] reached the [ %v%% ] threshold, raising the [ %v ] alert.\n", b.ID, threshold, channel)
+
+		a := alert
+
+		// A failed delivery keeps its alert, which is updated with the retry
+		if p, exists := raised[channel]; exists {
+
+			a.ID = p.ID
+
+		}
+
+		a.Channel = channel
+		a.Status = models.BudgetAlertStatusSENT
+		a.Timestamp = strfmt.DateTime(time.Now())
+
+		if e := send(); e != nil {
+
+			a.Status = models.BudgetAlertStatusFAILED
+			a.Error = e.Error()
+
+		}
+
+		if e := d.Db.Save(&a).Error; e != nil {
+
+			l.Warning.Printf("[DB] Something went wrong while saving the alert of the budget [ %v ]. Error: %v\n", b.ID, e)
+
+		}
+
+		d.Metrics["count"].With(prometheus.Labels{"type": "Budget alerts raised"}).Inc()
+
+	}
+
+}
@@ -0,0 +1,151 @@
+package notificationManager
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	l "gitlab.com/cyclops-utilities/logging"
+)
+
+// NotificationManager is the struct defined to group and contain all the
+// methods that deliver notifications outside of the system.
+// Parameters:
+// - client: http.Client used to post the webhooks.
+// - from: string with the sender address of the emails.
+// - host: string with the SMTP server host.
+// - password: string with the password for the SMTP server.
+// - port: int with the SMTP server port.
+// - username: string with the username for the SMTP server.
+type NotificationManager struct {
+	client   *http.Client
+	from     string
+	host     string
+	password string
+	port     int
+	username string
+}
+
+// New is the function to create the struct NotificationManager.
+// Parameters:
+// - host: string with the SMTP server host, empty to disable the emails.
+// - port: int with the SMTP server port.
+// - username: string with the username for the SMTP server.
+// - password: string with the password for the SMTP server.
+// - from: string with the sender address of the emails.
+// - timeout: time.Duration with the max time to wait for a webhook to answer.
+// Returns:
+// - NotificationManager: struct to interact with NotificationManager subsystem
+// functionalities.
+func New(host string, port int, username, password, from string, timeout time.Duration) *NotificationManager {
+
+	l.Trace.Printf("[NOTIFICATION] Initializing the notification service.\n")
+
+	return &NotificationManager{
+		client: &http.Client{
+			Timeout: timeout,
+		},
+		from:     from,
+		host:     host,
+		password: password,
+		port:     port,
+		username: username,
+	}
+
+}
+
+// SendWebhook job is to post the provided payload as JSON to the provided URL.
+// Parameters:
+// - url: string with the URL of the webhook.
+// - payload: interface with the data to be posted.
+// Returns:
+// - e: error raised in case of problems.
+func (n *NotificationManager) SendWebhook(url string, payload interface{}) (e error) {
+
+	l.Trace.Printf("[NOTIFICATION] Posting notification to webhook [ %v ].\n", url)
+
+	body, e := json.Marshal(payload)
+
+	if e != nil {
+
+		l.Warning.Printf("[NOTIFICATION] Unable to marshal the payload for the webhook. Error: %v\n", e)
+
+		return
+
+	}
+
+	r, e := n.client.Post(url, "application/json", bytes.NewReader(body))
+
+	if e != nil {
+
+		l.Warning.Printf("[NOTIFICATION] Something went wrong while posting to the webhook [ %v ]. Error: %v\n", url, e)
+
+		return
+
+	}
+
+	defer r.Body.Close()
+
+	if r.StatusCode < 200 || r.StatusCode > 299 {
+
+		e = fmt.Errorf("webhook answered with status [ %v ]", r.StatusCode)
+
+		l.Warning.Printf("[NOTIFICATION] The webhook [ %v ] didn't accept the notification. Error: %v\n", url, e)
+
+	}
+
+	return
+
+}
+
+// SendEmail job is to send a plain text email to the provided recipients
+// through the configured SMTP server.
+// Parameters:
+// - to: slice of strings with the recipients of the email.
+// - subject: string with the subject of the email.
+// - body: string with the content of the email.
+// Returns:
+// - e: error raised in case of problems.
+func (n *NotificationManager) SendEmail(to []string, subject, body string) (e error) {
+
+	l.Trace.Printf("[NOTIFICATION] Sending email notification to [ %v ].\n", to)
+
+	if n.host == "" {
+
+		e = errors.New("there's no SMTP server configured")
+
+		l.Warning.Printf("[NOTIFICATION] Unable to send the email. Error: %v\n", e)
+
+		return
+
+	}
+
+	var auth smtp.Auth
+
+	if n.username != "" {
+
+		auth = smtp.PlainAuth("", n.username, n.password, n.host)
+
+	}
+
+	msg := "From: " + n.from + "\r\n" +
+		"To: " + strings.Join(to, ", ") + "\r\n" +
+		"Subject: " + subject + "\r\n" +
+		"Content-Type: text/plain; charset=UTF-8\r\n" +
+		"\r\n" + body + "\r\n"
+
+	if e = smtp.SendMail(n.host+":"+strconv.Itoa(n.port), auth, n.from, to, []byte(msg)); e != nil {
+
+		l.Warning.Printf("[NOTIFICATION] Something went wrong while sending the email to [ %v ]. Error: %v\n", to, e)
+
+	}
+
+	return
+
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/cors"
@@ -10,8 +11,10 @@ import (
 	"github.com/GoDieNow/TFT_Code/services/creditsystem/models"
 	"github.com/GoDieNow/TFT_Code/services/creditsystem/restapi"
 	"github.com/GoDieNow/TFT_Code/services/creditsystem/server/accountManager"
+	"github.com/GoDieNow/TFT_Code/services/creditsystem/server/budgetManager"
 	"github.com/GoDieNow/TFT_Code/services/creditsystem/server/creditManager"
 	"github.com/GoDieNow/TFT_Code/services/creditsystem/server/dbManager"
+	"github.com/GoDieNow/TFT_Code/services/creditsystem/server/notificationManager"
 	"github.com/GoDieNow/TFT_Code/services/creditsystem/server/statusManager"
 	l "gitlab.com/cyclops-utilities/logging"
 )
@@ -27,7 +30,8 @@ func getService() (handler http.Handler, register *prometheus.Registry, e error)
 	l.Trace.Printf("[MAIN] Intializing service [ %v ] handler\n", strings.Title(service))
 
 	// TABLE0,1,n have to be customized
-	db := dbStart(&models.AccountStatus{}, &models.CreditEvents{}, &models.CreditStatus{})
+	db := dbStart(&models.AccountStatus{}, &models.CreditEvents{}, &models.CreditStatus{},
+		&models.Budget{}, &models.BudgetAlert{}, &models.BudgetCharge{})
 	mon := statusManager.New(db)
 
 	// Prometheus Metrics linked to dbParameter
@@ -36,6 +40,9 @@ func getService() (handler http.Handler, register *prometheus.Registry, e error)
 	// cache linked to the dbParameter
 	db.Cache = cacheStart(db.Metrics["cache"])
 
+	// notifications linked to the dbParameter for the budget alerts
+	db.Notifier = notificationStart()
+
 	// In case of needed, here is where kafka support is started
 	// The functions needs to be customized accordingly to the needs of the service
 	// And the parts of the service that might need to send messages need to get
@@ -46,12 +53,14 @@ func getService() (handler http.Handler, register *prometheus.Registry, e error)
 
 	// Parts of the service HERE
 	a := accountManager.New(db, mon)
+	b := budgetManager.New(db, mon)
 	c := creditManager.New(db, mon)
 
 	// Initiate the http handler, with the objects that are implementing the business logic.
 	h, e := restapi.Handler(restapi.Config{
 		StatusManagementAPI:  mon,
 		AccountManagementAPI: a,
+		BudgetManagementAPI:  b,
 		CreditManagementAPI:  c,
 		Logger:               l.Info.Printf,
 		AuthKeycloak:         AuthKeycloak,
@@ -113,6 +122,12 @@ func kafkaStart(db *dbManager.DbParameter, mon *statusManager.StatusManager) (ch
 
 		}
 
+		if e := db.ProcessBudgets(cdr, ""); e != nil {
+
+			l.Warning.Printf("[KAFKA] There was an error while processing the budgets. Error: %v\n", e)
+
+		}
+
 		return
 
 	}
@@ -135,3 +150,24 @@ func kafkaStart(db *dbManager.DbParameter, mon *statusManager.StatusManager) (ch
 	return
 
 }
+
+// notificationStart handles the initialization of the notification service
+// used to deliver the budget alerts.
+// Returns:
+// - A notificationManager reference struct already initialized and ready to be used.
+func notificationStart() *notificationManager.NotificationManager {
+
+	l.Trace.Printf("[MAIN] Intializing the notification service\n")
+
+	timeout, e := time.ParseDuration(cfg.Alerts.WebhookTimeout)
+
+	if e != nil {
+
+		timeout = 10 * time.Second
+
+	}
+
+	return notificationManager.New(cfg.Alerts.SMTPHost, cfg.Alerts.SMTPPort, cfg.Alerts.SMTPUsername,
+		cfg.Alerts.SMTPPassword, cfg.Alerts.SMTPFrom, timeout)
+
+}
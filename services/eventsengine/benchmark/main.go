@@ -0,0 +1,424 @@
+// Package main provides the benchmark harness of the usage computation of the
+// events engine.
+//
+// It seeds a synthetic resource type in an isolated window of the configured
+// database, with a current state and a history of changes for every resource,
+// and computes the system usage with both the legacy approach, which loads the
+// states of every account and the history of every resource not fully within
+// the window, and the range approach, which clips the overlapping intervals in
+// a single query. For each of them it reports the time spent, the queries
+// issued and the seconds accounted, removing the synthetic data afterwards.
+//
+// Usage:
+//
+//	go run ./benchmark -db "host=localhost port=5432 user=postgres password=pass dbname=events sslmode=disable" -accounts 500 -resources 10 -changes 20
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/remeh/sizedwaitgroup"
+	"gitlab.com/cyclops-utilities/datamodels"
+	"github.com/GoDieNow/TFT_Code/services/eventsengine/models"
+	"github.com/GoDieNow/TFT_Code/services/eventsengine/server/dbManager"
+	l "gitlab.com/cyclops-utilities/logging"
+	"gorm.io/gorm"
+)
+
+const (
+	endOfTime  = int64(32503680000)
+	seedBatch  = 5000
+	terminated = "terminated"
+)
+
+type result struct {
+	Accounts int
+	Duration time.Duration
+	Queries  int64
+	Seconds  int64
+}
+
+var queries int64
+
+func main() {
+
+	conn := flag.String("db", "host=localhost port=5432 user=postgres password=pass dbname=events sslmode=disable", "connection string of the database")
+	accounts := flag.Int("accounts", 100, "number of accounts seeded")
+	resources := flag.Int("resources", 10, "number of resources seeded per account")
+	changes := flag.Int("changes", 10, "number of changes in the history of every resource")
+	step := flag.Duration("step", time.Hour, "time between the changes of a resource")
+	legacy := flag.Bool("legacy", true, "run also the legacy usage computation")
+	keep := flag.Bool("keep", false, "keep the synthetic data in the database")
+	logLevel := flag.String("loglevel", "warning", "log level of the harness")
+
+	flag.Parse()
+
+	if e := l.InitLogger("", *logLevel, true); e != nil {
+
+		fmt.Printf("Unable to initialize the logger: %v\n", e)
+
+		os.Exit(1)
+
+	}
+
+	db := dbManager.New(*conn, &models.State{}, &models.Event{}, &models.DiscreteEvent{})
+
+	if e := db.Db.Callback().Query().After("gorm:query").Register("benchmark:count", func(*gorm.DB) {
+
+		atomic.AddInt64(&queries, 1)
+
+	}); e != nil {
+
+		fmt.Printf("Unable to register the query counter: %v\n", e)
+
+		os.Exit(1)
+
+	}
+
+	// A past timeline and a unique resource type keep the synthetic data isolated
+	resourceType := fmt.Sprintf("benchmark-%v", time.Now().UnixNano())
+	start := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+	length := int64(step.Seconds())
+
+	// The window covers the second half of the history and the current state
+	from := start + length*int64(*changes/2)
+	to := start + length*int64(*changes+*changes/2)
+
+	defer func() {
+
+		if *keep {
+
+			return
+
+		}
+
+		db.Db.Where(&models.State{ResourceType: resourceType}).Delete(&models.State{})
+		db.Db.Where(&models.Event{ResourceType: resourceType}).Delete(&models.Event{})
+
+	}()
+
+	seeded, e := seed(db, resourceType, start, length, *accounts, *resources, *changes)
+
+	if e != nil {
+
+		fmt.Printf("Unable to seed the synthetic states: %v\n", e)
+
+		return
+
+	}
+
+	fmt.Printf("Seeded [ %v ] intervals of resource type [ %v ] for [ %v ] accounts with [ %v ] resources each.\n\n", seeded, resourceType, *accounts, *resources)
+
+	fmt.Printf("%-10v %15v %15v %15v %15v\n", "approach", "duration", "queries", "accounts", "seconds")
+
+	if *legacy {
+
+		r := measure(func() ([]*models.Usage, error) { return usageLegacy(db, resourceType, from, to) })
+
+		fmt.Printf("%-10v %15v %15v %15v %15v\n", "legacy", r.Duration.Round(time.Millisecond), r.Queries, r.Accounts, r.Seconds)
+
+	}
+
+	r := measure(func() ([]*models.Usage, error) { return db.GetSystemUsage(from, to, resourceType, "") })
+
+	fmt.Printf("%-10v %15v %15v %15v %15v\n", "range", r.Duration.Round(time.Millisecond), r.Queries, r.Accounts, r.Seconds)
+
+}
+
+// usageLegacy reproduces the former computation of the system usage, loading
+// the states of every account and the history of every resource whose current
+// state doesn't cover the start of the window.
+func usageLegacy(db *dbManager.DbParameter, ty string, from, to int64) (u []*models.Usage, e error) {
+
+	states, e := db.GetAllStates()
+
+	if e != nil {
+
+		return
+
+	}
+
+	ids := make(map[string]struct{})
+
+	for i := range states {
+
+		ids[states[i].Account] = struct{}{}
+
+	}
+
+	mutex := &sync.Mutex{}
+	swg := sizedwaitgroup.New(8)
+
+	for account := range ids {
+
+		swg.Add()
+		go func(account string) {
+
+			defer swg.Done()
+
+			if su := usageLegacyAccount(db, account, ty, from, to); su.Usage != nil {
+
+				mutex.Lock()
+				u = append(u, su)
+				mutex.Unlock()
+
+			}
+
+		}(account)
+
+	}
+
+	swg.Wait()
+
+	return
+
+}
+
+// usageLegacyAccount reproduces the former computation of the usage of an
+// account, checking the history of the resources one by one.
+func usageLegacyAccount(db *dbManager.DbParameter, ac, ty string, from, to int64) *models.Usage {
+
+	u := models.Usage{
+		AccountID: ac,
+		TimeFrom:  from,
+		TimeTo:    to,
+	}
+
+	states, e := db.GetState(ac)
+
+	if e != nil {
+
+		return &u
+
+	}
+
+	for i := range states {
+
+		if states[i].ResourceType != ty {
+
+			continue
+
+		}
+
+		use := models.Use{
+			MetaData:     states[i].MetaData,
+			Region:       states[i].Region,
+			ResourceID:   states[i].ResourceID,
+			ResourceName: states[i].ResourceName,
+			ResourceType: states[i].ResourceType,
+			Unit:         "seconds",
+		}
+
+		usage := make(datamodels.JSONdb)
+
+		_, contrib := db.DatesWithin(states[i].TimeFrom, states[i].TimeTo, from, to)
+
+		if contrib > 0 {
+
+			usage[*states[i].LastEvent] = contrib
+
+		}
+
+		if contrib == 0 || states[i].TimeFrom > from {
+
+			history, e := db.GetAllHistory(ac, use)
+
+			if e == nil {
+
+				for id := range history {
+
+					if _, contribution := db.DatesWithin(history[id].TimeFrom, history[id].TimeTo, from, to); contribution > 0 {
+
+						prev, _ := usage[*history[id].LastEvent].(int64)
+
+						usage[*history[id].LastEvent] = prev + contribution
+
+					}
+
+				}
+
+			}
+
+		}
+
+		delete(usage, terminated)
+
+		if len(usage) != 0 {
+
+			use.UsageBreakup = usage
+
+			u.Usage = append(u.Usage, &use)
+
+		}
+
+	}
+
+	return &u
+
+}
+
+// measure runs the usage computation provided, counting the queries issued
+// and the seconds accounted in the usages returned.
+func measure(f func() ([]*models.Usage, error)) (r result) {
+
+	atomic.StoreInt64(&queries, 0)
+
+	now := time.Now()
+
+	usages, e := f()
+
+	r.Duration = time.Since(now)
+	r.Queries = atomic.LoadInt64(&queries)
+
+	if e != nil {
+
+		fmt.Printf("The usage computation failed: %v\n", e)
+
+	}
+
+	for i := range usages {
+
+		if len(usages[i].Usage) == 0 {
+
+			continue
+
+		}
+
+		r.Accounts++
+
+		for _, use := range usages[i].Usage {
+
+			for _, v := range use.UsageBreakup {
+
+				if s, ok := v.(int64); ok {
+
+					r.Seconds += s
+
+				}
+
+			}
+
+		}
+
+	}
+
+	return
+
+}
+
+// seed adds the synthetic history of every resource of every account, with a
+// change every step alternating between active and inactive, followed by the
+// current state, active until the end of time.
+func seed(db *dbManager.DbParameter, ty string, start, step int64, accounts, resources, changes int) (count int, e error) {
+
+	active, inactive := "active", "inactive"
+
+	batch := make([]*models.Event, 0, seedBatch)
+	states := make([]*models.State, 0, accounts*resources)
+
+	flush := func() error {
+
+		if len(batch) == 0 {
+
+			return nil
+
+		}
+
+		if e := db.Db.Create(&batch).Error; e != nil {
+
+			return e
+
+		}
+
+		count += len(batch)
+		batch = batch[:0]
+
+		return nil
+
+	}
+
+	for a := 0; a < accounts; a++ {
+
+		for r := 0; r < resources; r++ {
+
+			meta := datamodels.JSONdb{"flavor": fmt.Sprintf("m%v.small", r%4)}
+			t := start
+
+			for c := 0; c < changes; c++ {
+
+				last := active
+
+				if c%2 == 1 {
+
+					last = inactive
+
+				}
+
+				eventTime := t
+
+				batch = append(batch, &models.Event{
+					Account:      fmt.Sprintf("account-%v", a),
+					EventTime:    &eventTime,
+					LastEvent:    &last,
+					MetaData:     meta,
+					Region:       "benchmark",
+					ResourceID:   fmt.Sprintf("resource-%v-%v", a, r),
+					ResourceName: fmt.Sprintf("vm-%v", r),
+					ResourceType: ty,
+					TimeFrom:     t,
+					TimeTo:       t + step,
+				})
+
+				t += step
+
+				if len(batch) == seedBatch {
+
+					if e = flush(); e != nil {
+
+						return
+
+					}
+
+				}
+
+			}
+
+			eventTime := t
+
+			states = append(states, &models.State{
+				Account:      fmt.Sprintf("account-%v", a),
+				EventTime:    &eventTime,
+				LastEvent:    &active,
+				MetaData:     meta,
+				Region:       "benchmark",
+				ResourceID:   fmt.Sprintf("resource-%v-%v", a, r),
+				ResourceName: fmt.Sprintf("vm-%v", r),
+				ResourceType: ty,
+				TimeFrom:     t,
+				TimeTo:       endOfTime,
+			})
+
+		}
+
+	}
+
+	if e = flush(); e != nil {
+
+		return
+
+	}
+
+	if e = db.Db.CreateInBatches(&states, seedBatch).Error; e == nil {
+
+		count += len(states)
+
+	}
+
+	return
+
+}
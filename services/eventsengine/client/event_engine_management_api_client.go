@@ -13,7 +13,10 @@ import (
 	rtclient "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 
+	"github.com/GoDieNow/TFT_Code/services/eventsengine/client/cloud_event_management"
 	"github.com/GoDieNow/TFT_Code/services/eventsengine/client/event_management"
+	"github.com/GoDieNow/TFT_Code/services/eventsengine/client/filter_management"
+	"github.com/GoDieNow/TFT_Code/services/eventsengine/client/lifecycle_management"
 	"github.com/GoDieNow/TFT_Code/services/eventsengine/client/status_management"
 	"github.com/GoDieNow/TFT_Code/services/eventsengine/client/trigger_management"
 	"github.com/GoDieNow/TFT_Code/services/eventsengine/client/usage_management"
@@ -61,7 +64,10 @@ func New(c Config) *EventEngineManagementAPI {
 
 	cli := new(EventEngineManagementAPI)
 	cli.Transport = transport
+	cli.CloudEventManagement = cloud_event_management.New(transport, strfmt.Default, c.AuthInfo)
 	cli.EventManagement = event_management.New(transport, strfmt.Default, c.AuthInfo)
+	cli.FilterManagement = filter_management.New(transport, strfmt.Default, c.AuthInfo)
+	cli.LifecycleManagement = lifecycle_management.New(transport, strfmt.Default, c.AuthInfo)
 	cli.StatusManagement = status_management.New(transport, strfmt.Default, c.AuthInfo)
 	cli.TriggerManagement = trigger_management.New(transport, strfmt.Default, c.AuthInfo)
 	cli.UsageManagement = usage_management.New(transport, strfmt.Default, c.AuthInfo)
@@ -70,9 +76,12 @@ func New(c Config) *EventEngineManagementAPI {
 
 // EventEngineManagementAPI is a client for event engine management API
 type EventEngineManagementAPI struct {
-	EventManagement   *event_management.Client
-	StatusManagement  *status_management.Client
-	TriggerManagement *trigger_management.Client
-	UsageManagement   *usage_management.Client
-	Transport         runtime.ClientTransport
+	CloudEventManagement *cloud_event_management.Client
+	EventManagement      *event_management.Client
+	FilterManagement     *filter_management.Client
+	LifecycleManagement  *lifecycle_management.Client
+	StatusManagement     *status_management.Client
+	TriggerManagement    *trigger_management.Client
+	UsageManagement      *usage_management.Client
+	Transport            runtime.ClientTransport
 }
@@ -29,6 +29,12 @@ func (o *AddEventReader) ReadResponse(response runtime.ClientResponse, consumer
 			return nil, err
 		}
 		return result, nil
+	case 202:
+		result := NewAddEventAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 400:
 		result := NewAddEventBadRequest()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -80,6 +86,39 @@ func (o *AddEventCreated) readResponse(response runtime.ClientResponse, consumer
 	return nil
 }
 
+// NewAddEventAccepted creates a AddEventAccepted with default headers values
+func NewAddEventAccepted() *AddEventAccepted {
+	return &AddEventAccepted{}
+}
+
+/*AddEventAccepted handles this case with default header values.
+
+Item filtered by a rule, dropped or quarantined
+*/
+type AddEventAccepted struct {
+	Payload *models.ItemCreatedResponse
+}
+
+func (o *AddEventAccepted) Error() string {
+	return fmt.Sprintf("[POST /event][%d] addEventAccepted  %+v", 202, o.Payload)
+}
+
+func (o *AddEventAccepted) GetPayload() *models.ItemCreatedResponse {
+	return o.Payload
+}
+
+func (o *AddEventAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ItemCreatedResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewAddEventBadRequest creates a AddEventBadRequest with default headers values
 func NewAddEventBadRequest() *AddEventBadRequest {
 	return &AddEventBadRequest{}
@@ -90,14 +129,26 @@ func NewAddEventBadRequest() *AddEventBadRequest {
 Invalid input, object invalid
 */
 type AddEventBadRequest struct {
+	Payload *models.ErrorResponse
 }
 
 func (o *AddEventBadRequest) Error() string {
-	return fmt.Sprintf("[POST /event][%d] addEventBadRequest ", 400)
+	return fmt.Sprintf("[POST /event][%d] addEventBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *AddEventBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
 }
 
 func (o *AddEventBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
 	return nil
 }
 
@@ -17,18 +17,39 @@ import (
 
 // API is the interface of the event management client
 type API interface {
+	/*
+	   AddDiscreteEvent takes into the system the provided discrete event counted instead of measured over time*/
+	AddDiscreteEvent(ctx context.Context, params *AddDiscreteEventParams) (*AddDiscreteEventCreated, error)
 	/*
 	   AddEvent takes into the system the provided event*/
 	AddEvent(ctx context.Context, params *AddEventParams) (*AddEventCreated, error)
 	/*
 	   GetHistory provides the events for the id provided*/
 	GetHistory(ctx context.Context, params *GetHistoryParams) (*GetHistoryOK, error)
+	/*
+	   GetInventory provides the resources alive for the account at the instant provided*/
+	GetInventory(ctx context.Context, params *GetInventoryParams) (*GetInventoryOK, error)
+	/*
+	   GetInventoryDiff provides the changes in the resources alive for the account between the instants provided*/
+	GetInventoryDiff(ctx context.Context, params *GetInventoryDiffParams) (*GetInventoryDiffOK, error)
 	/*
 	   GetState provides the events for the id provided*/
 	GetState(ctx context.Context, params *GetStateParams) (*GetStateOK, error)
+	/*
+	   ListDiscreteEvents provides the discrete events of the account provided*/
+	ListDiscreteEvents(ctx context.Context, params *ListDiscreteEventsParams) (*ListDiscreteEventsOK, error)
+	/*
+	   ListQuarantinedEvents provides the events held back for not following the lifecycle of their resource type*/
+	ListQuarantinedEvents(ctx context.Context, params *ListQuarantinedEventsParams) (*ListQuarantinedEventsOK, error)
+	/*
+	   ListRecomputations provides the time windows whose usage changed due to out of order events*/
+	ListRecomputations(ctx context.Context, params *ListRecomputationsParams) (*ListRecomputationsOK, error)
 	/*
 	   ListStates provides the list of states in not terminated state*/
 	ListStates(ctx context.Context, params *ListStatesParams) (*ListStatesOK, error)
+	/*
+	   RebuildStates rebuilds the states and their history from the log of raw events reporting the differences with the actual ones*/
+	RebuildStates(ctx context.Context, params *RebuildStatesParams) (*RebuildStatesOK, error)
 }
 
 // New creates a new event management API client.
@@ -49,6 +70,31 @@ type Client struct {
 	authInfo  runtime.ClientAuthInfoWriter
 }
 
+/*
+AddDiscreteEvent takes into the system the provided discrete event counted instead of measured over time
+*/
+func (a *Client) AddDiscreteEvent(ctx context.Context, params *AddDiscreteEventParams) (*AddDiscreteEventCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "addDiscreteEvent",
+		Method:             "POST",
+		PathPattern:        "/event/discrete",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &AddDiscreteEventReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*AddDiscreteEventCreated), nil
+
+}
+
 /*
 AddEvent takes into the system the provided event
 */
@@ -99,6 +145,56 @@ func (a *Client) GetHistory(ctx context.Context, params *GetHistoryParams) (*Get
 
 }
 
+/*
+GetInventory provides the resources alive for the account at the instant provided
+*/
+func (a *Client) GetInventory(ctx context.Context, params *GetInventoryParams) (*GetInventoryOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getInventory",
+		Method:             "GET",
+		PathPattern:        "/event/inventory/{account}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetInventoryReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetInventoryOK), nil
+
+}
+
+/*
+GetInventoryDiff provides the changes in the resources alive for the account between the instants provided
+*/
+func (a *Client) GetInventoryDiff(ctx context.Context, params *GetInventoryDiffParams) (*GetInventoryDiffOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getInventoryDiff",
+		Method:             "GET",
+		PathPattern:        "/event/inventory/{account}/diff",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetInventoryDiffReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetInventoryDiffOK), nil
+
+}
+
 /*
 GetState provides the events for the id provided
 */
@@ -124,6 +220,81 @@ func (a *Client) GetState(ctx context.Context, params *GetStateParams) (*GetStat
 
 }
 
+/*
+ListDiscreteEvents provides the discrete events of the account provided
+*/
+func (a *Client) ListDiscreteEvents(ctx context.Context, params *ListDiscreteEventsParams) (*ListDiscreteEventsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listDiscreteEvents",
+		Method:             "GET",
+		PathPattern:        "/event/discrete/{account}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListDiscreteEventsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListDiscreteEventsOK), nil
+
+}
+
+/*
+ListQuarantinedEvents provides the events held back for not following the lifecycle of their resource type
+*/
+func (a *Client) ListQuarantinedEvents(ctx context.Context, params *ListQuarantinedEventsParams) (*ListQuarantinedEventsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listQuarantinedEvents",
+		Method:             "GET",
+		PathPattern:        "/event/quarantine",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListQuarantinedEventsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListQuarantinedEventsOK), nil
+
+}
+
+/*
+ListRecomputations provides the time windows whose usage changed due to out of order events
+*/
+func (a *Client) ListRecomputations(ctx context.Context, params *ListRecomputationsParams) (*ListRecomputationsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listRecomputations",
+		Method:             "GET",
+		PathPattern:        "/event/recomputations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListRecomputationsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListRecomputationsOK), nil
+
+}
+
 /*
 ListStates provides the list of states in not terminated state
 */
@@ -148,3 +319,28 @@ func (a *Client) ListStates(ctx context.Context, params *ListStatesParams) (*Lis
 	return result.(*ListStatesOK), nil
 
 }
+
+/*
+RebuildStates rebuilds the states and their history from the log of raw events reporting the differences with the actual ones
+*/
+func (a *Client) RebuildStates(ctx context.Context, params *RebuildStatesParams) (*RebuildStatesOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "rebuildStates",
+		Method:             "POST",
+		PathPattern:        "/event/rebuild",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RebuildStatesReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*RebuildStatesOK), nil
+
+}
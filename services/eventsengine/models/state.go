@@ -21,7 +21,7 @@ import (
 type State struct {
 
 	// account
-	Account string `json:"Account,omitempty" gorm:"index"`
+	Account string `json:"Account,omitempty" gorm:"index;index:idx_states_usage,priority:1"`
 
 	// event time
 	// Required: true
@@ -51,10 +51,10 @@ type State struct {
 	ResourceType string `json:"ResourceType,omitempty"`
 
 	// time from
-	TimeFrom int64 `json:"TimeFrom,omitempty" gorm:"index"`
+	TimeFrom int64 `json:"TimeFrom,omitempty" gorm:"index;index:idx_states_usage,priority:2"`
 
 	// time to
-	TimeTo int64 `json:"TimeTo,omitempty" gorm:"index"`
+	TimeTo int64 `json:"TimeTo,omitempty" gorm:"index;index:idx_states_usage,priority:3"`
 }
 
 // Validate validates this state
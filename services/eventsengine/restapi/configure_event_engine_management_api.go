@@ -15,7 +15,10 @@ import (
 	"github.com/go-openapi/runtime/security"
 
 	"github.com/GoDieNow/TFT_Code/services/eventsengine/restapi/operations"
+	"github.com/GoDieNow/TFT_Code/services/eventsengine/restapi/operations/cloud_event_management"
 	"github.com/GoDieNow/TFT_Code/services/eventsengine/restapi/operations/event_management"
+	"github.com/GoDieNow/TFT_Code/services/eventsengine/restapi/operations/filter_management"
+	"github.com/GoDieNow/TFT_Code/services/eventsengine/restapi/operations/lifecycle_management"
 	"github.com/GoDieNow/TFT_Code/services/eventsengine/restapi/operations/status_management"
 	"github.com/GoDieNow/TFT_Code/services/eventsengine/restapi/operations/trigger_management"
 	"github.com/GoDieNow/TFT_Code/services/eventsengine/restapi/operations/usage_management"
@@ -25,21 +28,105 @@ type contextKey string
 
 const AuthKey contextKey = "Auth"
 
+//go:generate mockery -name CloudEventManagementAPI -inpkg
+
+/* CloudEventManagementAPI  */
+type CloudEventManagementAPI interface {
+	/* AddCloudEvent Takes into the system the provided CloudEvent, in structured or binary mode, through the mapping of its source */
+	AddCloudEvent(ctx context.Context, params cloud_event_management.AddCloudEventParams) middleware.Responder
+
+	/* CreateCloudEventMapping Defines a new mapping of the CloudEvents of a source into events */
+	CreateCloudEventMapping(ctx context.Context, params cloud_event_management.CreateCloudEventMappingParams) middleware.Responder
+
+	/* DeleteCloudEventMapping Removes the CloudEvents mapping */
+	DeleteCloudEventMapping(ctx context.Context, params cloud_event_management.DeleteCloudEventMappingParams) middleware.Responder
+
+	/* GetCloudEventMapping Provides the CloudEvents mapping */
+	GetCloudEventMapping(ctx context.Context, params cloud_event_management.GetCloudEventMappingParams) middleware.Responder
+
+	/* ListCloudEventMappings Provides the CloudEvents mappings defined in the system */
+	ListCloudEventMappings(ctx context.Context, params cloud_event_management.ListCloudEventMappingsParams) middleware.Responder
+
+	/* UpdateCloudEventMapping Updates the CloudEvents mapping */
+	UpdateCloudEventMapping(ctx context.Context, params cloud_event_management.UpdateCloudEventMappingParams) middleware.Responder
+}
+
 //go:generate mockery -name EventManagementAPI -inpkg
 
 /* EventManagementAPI  */
 type EventManagementAPI interface {
+	/* AddDiscreteEvent Takes into the system the provided discrete event, counted instead of measured over time */
+	AddDiscreteEvent(ctx context.Context, params event_management.AddDiscreteEventParams) middleware.Responder
+
 	/* AddEvent Takes into the system the provided event */
 	AddEvent(ctx context.Context, params event_management.AddEventParams) middleware.Responder
 
 	/* GetHistory Provides the events for the id provided */
 	GetHistory(ctx context.Context, params event_management.GetHistoryParams) middleware.Responder
 
+	/* GetInventory Provides the resources alive for the account at the instant provided */
+	GetInventory(ctx context.Context, params event_management.GetInventoryParams) middleware.Responder
+
+	/* GetInventoryDiff Provides the changes in the resources alive for the account between the instants provided */
+	GetInventoryDiff(ctx context.Context, params event_management.GetInventoryDiffParams) middleware.Responder
+
 	/* GetState Provides the events for the id provided */
 	GetState(ctx context.Context, params event_management.GetStateParams) middleware.Responder
 
+	/* ListDiscreteEvents Provides the discrete events of the account provided */
+	ListDiscreteEvents(ctx context.Context, params event_management.ListDiscreteEventsParams) middleware.Responder
+
+	/* ListQuarantinedEvents Provides the events held back for not following the lifecycle of their resource type */
+	ListQuarantinedEvents(ctx context.Context, params event_management.ListQuarantinedEventsParams) middleware.Responder
+
+	/* ListRecomputations Provides the time-windows whose usage changed due to out-of-order events */
+	ListRecomputations(ctx context.Context, params event_management.ListRecomputationsParams) middleware.Responder
+
 	/* ListStates Provides the list of states in not terminated state */
 	ListStates(ctx context.Context, params event_management.ListStatesParams) middleware.Responder
+
+	/* RebuildStates Rebuilds the states and their history from the log of raw events, reporting the differences with the actual ones */
+	RebuildStates(ctx context.Context, params event_management.RebuildStatesParams) middleware.Responder
+}
+
+//go:generate mockery -name FilterManagementAPI -inpkg
+
+/* FilterManagementAPI  */
+type FilterManagementAPI interface {
+	/* CreateFilterRule Defines a new filter rule for the events */
+	CreateFilterRule(ctx context.Context, params filter_management.CreateFilterRuleParams) middleware.Responder
+
+	/* DeleteFilterRule Removes the filter rule */
+	DeleteFilterRule(ctx context.Context, params filter_management.DeleteFilterRuleParams) middleware.Responder
+
+	/* GetFilterRule Provides the filter rule */
+	GetFilterRule(ctx context.Context, params filter_management.GetFilterRuleParams) middleware.Responder
+
+	/* ListFilterRules Provides the filter rules defined in the system */
+	ListFilterRules(ctx context.Context, params filter_management.ListFilterRulesParams) middleware.Responder
+
+	/* ReloadFilterRules Reloads the filter rules from the database */
+	ReloadFilterRules(ctx context.Context, params filter_management.ReloadFilterRulesParams) middleware.Responder
+
+	/* UpdateFilterRule Updates the filter rule */
+	UpdateFilterRule(ctx context.Context, params filter_management.UpdateFilterRuleParams) middleware.Responder
+}
+
+//go:generate mockery -name LifecycleManagementAPI -inpkg
+
+/* LifecycleManagementAPI  */
+type LifecycleManagementAPI interface {
+	/* CreateLifecycle Defines the lifecycle of a resource type */
+	CreateLifecycle(ctx context.Context, params lifecycle_management.CreateLifecycleParams) middleware.Responder
+
+	/* GetLifecycle Provides the lifecycle of the resource type */
+	GetLifecycle(ctx context.Context, params lifecycle_management.GetLifecycleParams) middleware.Responder
+
+	/* ListLifecycles Provides the lifecycles defined in the system */
+	ListLifecycles(ctx context.Context, params lifecycle_management.ListLifecyclesParams) middleware.Responder
+
+	/* UpdateLifecycle Updates the lifecycle of the resource type */
+	UpdateLifecycle(ctx context.Context, params lifecycle_management.UpdateLifecycleParams) middleware.Responder
 }
 
 //go:generate mockery -name StatusManagementAPI -inpkg
@@ -74,7 +161,10 @@ type UsageManagementAPI interface {
 
 // Config is configuration for Handler
 type Config struct {
+	CloudEventManagementAPI
 	EventManagementAPI
+	FilterManagementAPI
+	LifecycleManagementAPI
 	StatusManagementAPI
 	TriggerManagementAPI
 	UsageManagementAPI
@@ -132,7 +222,9 @@ func HandlerAPI(c Config) (http.Handler, *operations.EventEngineManagementAPIAPI
 		api.BearerAuthenticator = c.BearerAuthenticator
 	}
 
+	api.BinConsumer = runtime.ByteStreamConsumer()
 	api.JSONConsumer = runtime.JSONConsumer()
+	api.TxtConsumer = runtime.TextConsumer()
 	api.JSONProducer = runtime.JSONProducer()
 	api.APIKeyHeaderAuth = func(token string) (interface{}, error) {
 		if c.AuthAPIKeyHeader == nil {
@@ -155,21 +247,81 @@ func HandlerAPI(c Config) (http.Handler, *operations.EventEngineManagementAPIAPI
 		return c.AuthKeycloak(token, scopes)
 	}
 	api.APIAuthorizer = authorizer(c.Authorizer)
+	api.CloudEventManagementAddCloudEventHandler = cloud_event_management.AddCloudEventHandlerFunc(func(params cloud_event_management.AddCloudEventParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.CloudEventManagementAPI.AddCloudEvent(ctx, params)
+	})
+	api.EventManagementAddDiscreteEventHandler = event_management.AddDiscreteEventHandlerFunc(func(params event_management.AddDiscreteEventParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.EventManagementAPI.AddDiscreteEvent(ctx, params)
+	})
 	api.EventManagementAddEventHandler = event_management.AddEventHandlerFunc(func(params event_management.AddEventParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.EventManagementAPI.AddEvent(ctx, params)
 	})
+	api.CloudEventManagementCreateCloudEventMappingHandler = cloud_event_management.CreateCloudEventMappingHandlerFunc(func(params cloud_event_management.CreateCloudEventMappingParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.CloudEventManagementAPI.CreateCloudEventMapping(ctx, params)
+	})
+	api.FilterManagementCreateFilterRuleHandler = filter_management.CreateFilterRuleHandlerFunc(func(params filter_management.CreateFilterRuleParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.FilterManagementAPI.CreateFilterRule(ctx, params)
+	})
+	api.LifecycleManagementCreateLifecycleHandler = lifecycle_management.CreateLifecycleHandlerFunc(func(params lifecycle_management.CreateLifecycleParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.LifecycleManagementAPI.CreateLifecycle(ctx, params)
+	})
+	api.CloudEventManagementDeleteCloudEventMappingHandler = cloud_event_management.DeleteCloudEventMappingHandlerFunc(func(params cloud_event_management.DeleteCloudEventMappingParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.CloudEventManagementAPI.DeleteCloudEventMapping(ctx, params)
+	})
+	api.FilterManagementDeleteFilterRuleHandler = filter_management.DeleteFilterRuleHandlerFunc(func(params filter_management.DeleteFilterRuleParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.FilterManagementAPI.DeleteFilterRule(ctx, params)
+	})
 	api.TriggerManagementExecSampleHandler = trigger_management.ExecSampleHandlerFunc(func(params trigger_management.ExecSampleParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.TriggerManagementAPI.ExecSample(ctx, params)
 	})
+	api.CloudEventManagementGetCloudEventMappingHandler = cloud_event_management.GetCloudEventMappingHandlerFunc(func(params cloud_event_management.GetCloudEventMappingParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.CloudEventManagementAPI.GetCloudEventMapping(ctx, params)
+	})
+	api.FilterManagementGetFilterRuleHandler = filter_management.GetFilterRuleHandlerFunc(func(params filter_management.GetFilterRuleParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.FilterManagementAPI.GetFilterRule(ctx, params)
+	})
 	api.EventManagementGetHistoryHandler = event_management.GetHistoryHandlerFunc(func(params event_management.GetHistoryParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.EventManagementAPI.GetHistory(ctx, params)
 	})
+	api.EventManagementGetInventoryHandler = event_management.GetInventoryHandlerFunc(func(params event_management.GetInventoryParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.EventManagementAPI.GetInventory(ctx, params)
+	})
+	api.EventManagementGetInventoryDiffHandler = event_management.GetInventoryDiffHandlerFunc(func(params event_management.GetInventoryDiffParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.EventManagementAPI.GetInventoryDiff(ctx, params)
+	})
+	api.LifecycleManagementGetLifecycleHandler = lifecycle_management.GetLifecycleHandlerFunc(func(params lifecycle_management.GetLifecycleParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.LifecycleManagementAPI.GetLifecycle(ctx, params)
+	})
 	api.EventManagementGetStateHandler = event_management.GetStateHandlerFunc(func(params event_management.GetStateParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -190,16 +342,71 @@ func HandlerAPI(c Config) (http.Handler, *operations.EventEngineManagementAPIAPI
 		ctx = storeAuth(ctx, principal)
 		return c.UsageManagementAPI.GetUsage(ctx, params)
 	})
+	api.CloudEventManagementListCloudEventMappingsHandler = cloud_event_management.ListCloudEventMappingsHandlerFunc(func(params cloud_event_management.ListCloudEventMappingsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.CloudEventManagementAPI.ListCloudEventMappings(ctx, params)
+	})
+	api.EventManagementListDiscreteEventsHandler = event_management.ListDiscreteEventsHandlerFunc(func(params event_management.ListDiscreteEventsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.EventManagementAPI.ListDiscreteEvents(ctx, params)
+	})
+	api.FilterManagementListFilterRulesHandler = filter_management.ListFilterRulesHandlerFunc(func(params filter_management.ListFilterRulesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.FilterManagementAPI.ListFilterRules(ctx, params)
+	})
+	api.LifecycleManagementListLifecyclesHandler = lifecycle_management.ListLifecyclesHandlerFunc(func(params lifecycle_management.ListLifecyclesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.LifecycleManagementAPI.ListLifecycles(ctx, params)
+	})
+	api.EventManagementListQuarantinedEventsHandler = event_management.ListQuarantinedEventsHandlerFunc(func(params event_management.ListQuarantinedEventsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.EventManagementAPI.ListQuarantinedEvents(ctx, params)
+	})
+	api.EventManagementListRecomputationsHandler = event_management.ListRecomputationsHandlerFunc(func(params event_management.ListRecomputationsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.EventManagementAPI.ListRecomputations(ctx, params)
+	})
 	api.EventManagementListStatesHandler = event_management.ListStatesHandlerFunc(func(params event_management.ListStatesParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.EventManagementAPI.ListStates(ctx, params)
 	})
+	api.EventManagementRebuildStatesHandler = event_management.RebuildStatesHandlerFunc(func(params event_management.RebuildStatesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.EventManagementAPI.RebuildStates(ctx, params)
+	})
+	api.FilterManagementReloadFilterRulesHandler = filter_management.ReloadFilterRulesHandlerFunc(func(params filter_management.ReloadFilterRulesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.FilterManagementAPI.ReloadFilterRules(ctx, params)
+	})
 	api.StatusManagementShowStatusHandler = status_management.ShowStatusHandlerFunc(func(params status_management.ShowStatusParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.StatusManagementAPI.ShowStatus(ctx, params)
 	})
+	api.CloudEventManagementUpdateCloudEventMappingHandler = cloud_event_management.UpdateCloudEventMappingHandlerFunc(func(params cloud_event_management.UpdateCloudEventMappingParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.CloudEventManagementAPI.UpdateCloudEventMapping(ctx, params)
+	})
+	api.FilterManagementUpdateFilterRuleHandler = filter_management.UpdateFilterRuleHandlerFunc(func(params filter_management.UpdateFilterRuleParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.FilterManagementAPI.UpdateFilterRule(ctx, params)
+	})
+	api.LifecycleManagementUpdateLifecycleHandler = lifecycle_management.UpdateLifecycleHandlerFunc(func(params lifecycle_management.UpdateLifecycleParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.LifecycleManagementAPI.UpdateLifecycle(ctx, params)
+	})
 	api.ServerShutdown = func() {}
 	return api.Serve(c.InnerMiddleware), api, nil
 }
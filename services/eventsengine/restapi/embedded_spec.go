@@ -38,7 +38,47 @@ func init() {
   "host": "localhost:8000",
   "basePath": "/api/v1.0",
   "paths": {
-    "/event": {
+    "/cloudevent/mapping": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "cloudEventManagement"
+        ],
+        "summary": "Provides the CloudEvents mappings defined in the system",
+        "operationId": "listCloudEventMappings",
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/CloudEventMapping"
+              }
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
       "post": {
         "security": [
           {
@@ -60,18 +100,18 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "eventManagement"
+          "cloudEventManagement"
         ],
-        "summary": "Takes into the system the provided event",
-        "operationId": "addEvent",
+        "summary": "Defines a new mapping of the CloudEvents of a source into events",
+        "operationId": "createCloudEventMapping",
         "parameters": [
           {
-            "description": "Event to be added to the system",
-            "name": "event",
+            "description": "Mapping to be added to the system",
+            "name": "mapping",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Event"
+              "$ref": "#/definitions/CloudEventMapping"
             }
           }
         ],
@@ -83,7 +123,16 @@ func init() {
             }
           },
           "400": {
-            "description": "Invalid input, object invalid"
+            "description": "Invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "A mapping with the same name or for the same source and type already exists",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "Something unexpected happend, error raised",
@@ -94,7 +143,7 @@ func init() {
         }
       }
     },
-    "/event/history/{account}": {
+    "/cloudevent/mapping/{id}": {
       "get": {
         "security": [
           {
@@ -113,51 +162,24 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "eventManagement"
+          "cloudEventManagement"
         ],
-        "summary": "Provides the events for the id provided",
-        "operationId": "getHistory",
+        "summary": "Provides the CloudEvents mapping",
+        "operationId": "getCloudEventMapping",
         "parameters": [
           {
-            "type": "string",
-            "description": "Id of the account to be checked",
-            "name": "account",
+            "type": "integer",
+            "description": "Id of the mapping",
+            "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "integer",
-            "description": "Datetime from which to get the usage report",
-            "name": "from",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "description": "Datetime until which to get the usage report",
-            "name": "to",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Resource type to filter the usage",
-            "name": "resource",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Resource region to filter the usage",
-            "name": "region",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Description of a successfully operation",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Event"
-              }
+              "$ref": "#/definitions/CloudEventMapping"
             }
           },
           "404": {
@@ -173,14 +195,12 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/event/status": {
-      "get": {
+      },
+      "put": {
         "security": [
           {
             "Keycloak": [
-              "user"
+              "admin"
             ]
           },
           {
@@ -190,36 +210,58 @@ func init() {
             "APIKeyParam": []
           }
         ],
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "eventManagement"
+          "cloudEventManagement"
         ],
-        "summary": "Provides the list of states in not terminated state",
-        "operationId": "listStates",
+        "summary": "Updates the CloudEvents mapping",
+        "operationId": "updateCloudEventMapping",
         "parameters": [
           {
-            "type": "string",
-            "description": "Resource type to filter the usage",
-            "name": "resource",
-            "in": "query"
+            "type": "integer",
+            "description": "Id of the mapping",
+            "name": "id",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "string",
-            "description": "Resource region to filter the usage",
-            "name": "region",
-            "in": "query"
+            "description": "Mapping updated",
+            "name": "mapping",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CloudEventMapping"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Description of a successfully operation",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/MinimalState"
-              }
+              "$ref": "#/definitions/CloudEventMapping"
+            }
+          },
+          "400": {
+            "description": "Invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Item not found in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "A mapping with the same name or for the same source and type already exists",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
@@ -229,14 +271,12 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/event/status/{account}": {
-      "get": {
+      },
+      "delete": {
         "security": [
           {
             "Keycloak": [
-              "user"
+              "admin"
             ]
           },
           {
@@ -250,27 +290,24 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "eventManagement"
+          "cloudEventManagement"
         ],
-        "summary": "Provides the events for the id provided",
-        "operationId": "getState",
+        "summary": "Removes the CloudEvents mapping",
+        "operationId": "deleteCloudEventMapping",
         "parameters": [
           {
-            "type": "string",
-            "description": "Id of the account to be checked",
-            "name": "account",
+            "type": "integer",
+            "description": "Id of the mapping",
+            "name": "id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Description of a successfully operation",
+            "description": "Item removed successfully",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/State"
-              }
+              "$ref": "#/definitions/CloudEventMapping"
             }
           },
           "404": {
@@ -288,12 +325,12 @@ func init() {
         }
       }
     },
-    "/status": {
-      "get": {
+    "/event": {
+      "post": {
         "security": [
           {
             "Keycloak": [
-              "user"
+              "admin"
             ]
           },
           {
@@ -303,19 +340,45 @@ func init() {
             "APIKeyParam": []
           }
         ],
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "statusManagement"
+          "eventManagement"
+        ],
+        "summary": "Takes into the system the provided event",
+        "operationId": "addEvent",
+        "parameters": [
+          {
+            "description": "Event to be added to the system",
+            "name": "event",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Event"
+            }
+          }
         ],
-        "summary": "Basic status of the system",
-        "operationId": "showStatus",
         "responses": {
-          "200": {
-            "description": "Status information of the system",
+          "201": {
+            "description": "Item added successfully",
             "schema": {
-              "$ref": "#/definitions/Status"
+              "$ref": "#/definitions/ItemCreatedResponse"
+            }
+          },
+          "202": {
+            "description": "Item filtered by a rule, dropped or quarantined",
+            "schema": {
+              "$ref": "#/definitions/ItemCreatedResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
@@ -327,12 +390,12 @@ func init() {
         }
       }
     },
-    "/status/{id}": {
-      "get": {
+    "/event/cloudevent": {
+      "post": {
         "security": [
           {
             "Keycloak": [
-              "user"
+              "admin"
             ]
           },
           {
@@ -342,40 +405,53 @@ func init() {
             "APIKeyParam": []
           }
         ],
+        "consumes": [
+          "application/cloudevents+json",
+          "application/json",
+          "application/octet-stream",
+          "text/plain"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "statusManagement"
+          "cloudEventManagement"
         ],
-        "summary": "Basic status of the system",
-        "operationId": "getStatus",
+        "summary": "Takes into the system the provided CloudEvent, in structured or binary mode, through the mapping of its source",
+        "operationId": "addCloudEvent",
         "parameters": [
           {
-            "enum": [
-              "status",
-              "kafka-receiver",
-              "kafka-sender",
-              "trigger",
-              "usage",
-              "event"
-            ],
-            "type": "string",
-            "description": "Id of the endpoint to be checked",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "description": "CloudEvent in structured mode, or its data in binary mode with the attributes in the ce- headers",
+            "name": "event",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Status information of the system",
+          "201": {
+            "description": "Item added successfully",
             "schema": {
-              "$ref": "#/definitions/Status"
+              "$ref": "#/definitions/ItemCreatedResponse"
             }
           },
-          "404": {
-            "description": "The endpoint provided doesn't exist",
+          "202": {
+            "description": "Item filtered by a rule, dropped or quarantined",
+            "schema": {
+              "$ref": "#/definitions/ItemCreatedResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid input, the CloudEvent is not valid or it cannot be mapped into an event",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -383,12 +459,12 @@ func init() {
         }
       }
     },
-    "/trigger/sample": {
-      "get": {
+    "/event/discrete": {
+      "post": {
         "security": [
           {
             "Keycloak": [
-              "user"
+              "admin"
             ]
           },
           {
@@ -398,18 +474,41 @@ func init() {
             "APIKeyParam": []
           }
         ],
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "triggerManagement"
+          "eventManagement"
         ],
-        "summary": "Sample task trigger",
-        "operationId": "execSample",
-        "responses": {
-          "200": {
-            "description": "Sample task executed successfully"
-          },
+        "summary": "Takes into the system the provided discrete event, counted instead of measured over time",
+        "operationId": "addDiscreteEvent",
+        "parameters": [
+          {
+            "description": "Discrete event to be added to the system",
+            "name": "event",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/DiscreteEvent"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Item added successfully",
+            "schema": {
+              "$ref": "#/definitions/ItemCreatedResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "Something unexpected happend, error raised",
             "schema": {
@@ -419,7 +518,7 @@ func init() {
         }
       }
     },
-    "/usage": {
+    "/event/discrete/{account}": {
       "get": {
         "security": [
           {
@@ -438,32 +537,39 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "usageManagement"
+          "eventManagement"
         ],
-        "summary": "Generates an aggregated response by account of the usage recorded in the system during the time-window specified",
-        "operationId": "getSystemUsage",
+        "summary": "Provides the discrete events of the account provided",
+        "operationId": "listDiscreteEvents",
         "parameters": [
+          {
+            "type": "string",
+            "description": "Id of the account to be checked",
+            "name": "account",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "integer",
-            "description": "Datetime from which to get the usage report",
+            "description": "Datetime from which to get the events",
             "name": "from",
             "in": "query"
           },
           {
             "type": "integer",
-            "description": "Datetime until which to get the usage report",
+            "description": "Datetime until which to get the events",
             "name": "to",
             "in": "query"
           },
           {
             "type": "string",
-            "description": "Resource type to filter the usage",
+            "description": "Resource type to filter the events",
             "name": "resource",
             "in": "query"
           },
           {
             "type": "string",
-            "description": "Resource region to filter the usage",
+            "description": "Resource region to filter the events",
             "name": "region",
             "in": "query"
           }
@@ -474,7 +580,7 @@ func init() {
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/Usage"
+                "$ref": "#/definitions/DiscreteEvent"
               }
             }
           },
@@ -487,7 +593,7 @@ func init() {
         }
       }
     },
-    "/usage/{id}": {
+    "/event/history/{account}": {
       "get": {
         "security": [
           {
@@ -506,15 +612,15 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "usageManagement"
+          "eventManagement"
         ],
-        "summary": "Generates an aggregated response of the usage recorded in the system during the time-window specified for the selected account",
-        "operationId": "getUsage",
+        "summary": "Provides the events for the id provided",
+        "operationId": "getHistory",
         "parameters": [
           {
             "type": "string",
             "description": "Id of the account to be checked",
-            "name": "id",
+            "name": "account",
             "in": "path",
             "required": true
           },
@@ -547,7 +653,10 @@ func init() {
           "200": {
             "description": "Description of a successfully operation",
             "schema": {
-              "$ref": "#/definitions/Usage"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Event"
+              }
             }
           },
           "404": {
@@ -564,320 +673,157 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "ErrorResponse": {
-      "type": "object",
-      "required": [
-        "errorString"
-      ],
-      "properties": {
-        "errorString": {
-          "type": "string"
-        }
-      }
     },
-    "Event": {
-      "type": "object",
-      "required": [
-        "EventTime",
-        "LastEvent"
-      ],
-      "properties": {
-        "Account": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"index\""
-        },
-        "EventTime": {
-          "type": "integer"
-        },
-        "ID": {
-          "type": "integer",
-          "x-go-custom-tag": "gorm:\"primary_key;auto_increment\""
-        },
-        "LastEvent": {
-          "type": "string",
-          "enum": [
-            "active",
-            "error",
-            "inactive",
-            "terminated",
-            "suspended"
-          ]
-        },
-        "MetaData": {
-          "x-go-custom-tag": "gorm:\"type:jsonb\"",
-          "$ref": "#/definitions/Metadata"
-        },
-        "Region": {
-          "type": "string"
-        },
-        "ResourceId": {
-          "type": "string"
-        },
-        "ResourceName": {
-          "type": "string"
-        },
-        "ResourceType": {
-          "type": "string"
-        },
-        "TimeFrom": {
-          "type": "integer",
-          "x-go-custom-tag": "gorm:\"index\""
-        },
-        "TimeTo": {
-          "type": "integer",
-          "x-go-custom-tag": "gorm:\"index\""
+    "/event/inventory/{account}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "eventManagement"
+        ],
+        "summary": "Provides the resources alive for the account at the instant provided",
+        "operationId": "getInventory",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Id of the account to be checked",
+            "name": "account",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "Datetime of the inventory, now by default",
+            "name": "at",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resource type to filter the inventory",
+            "name": "resource",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resource region to filter the inventory",
+            "name": "region",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/InventoryItem"
+              }
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         }
       }
     },
-    "ItemCreatedResponse": {
-      "properties": {
-        "ApiLink": {
-          "type": "string"
-        },
-        "Message": {
-          "type": "string"
+    "/event/inventory/{account}/diff": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "eventManagement"
+        ],
+        "summary": "Provides the changes in the resources alive for the account between the instants provided",
+        "operationId": "getInventoryDiff",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Id of the account to be checked",
+            "name": "account",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "Datetime of the initial inventory",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "Datetime of the final inventory, now by default",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resource type to filter the inventory",
+            "name": "resource",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resource region to filter the inventory",
+            "name": "region",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/InventoryDiff"
+            }
+          },
+          "400": {
+            "description": "Invalid input, the time-window is not valid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         }
       }
     },
-    "Metadata": {
-      "type": "object",
-      "x-go-type": {
-        "import": {
-          "package": "gitlab.com/cyclops-utilities/datamodels"
-        },
-        "type": "JSONdb"
-      }
-    },
-    "MinimalState": {
-      "type": "object",
-      "properties": {
-        "Account": {
-          "type": "string"
-        },
-        "MetaData": {
-          "x-go-custom-tag": "gorm:\"type:jsonb\"",
-          "$ref": "#/definitions/Metadata"
-        },
-        "ResourceId": {
-          "type": "string"
-        },
-        "ResourceName": {
-          "type": "string"
-        }
-      }
-    },
-    "State": {
-      "type": "object",
-      "required": [
-        "EventTime",
-        "LastEvent"
-      ],
-      "properties": {
-        "Account": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"index\""
-        },
-        "EventTime": {
-          "type": "integer"
-        },
-        "ID": {
-          "type": "integer",
-          "x-go-custom-tag": "gorm:\"primary_key;auto_increment\""
-        },
-        "LastEvent": {
-          "type": "string",
-          "enum": [
-            "active",
-            "error",
-            "inactive",
-            "terminated",
-            "suspended"
-          ]
-        },
-        "MetaData": {
-          "x-go-custom-tag": "gorm:\"type:jsonb\"",
-          "$ref": "#/definitions/Metadata"
-        },
-        "Region": {
-          "type": "string"
-        },
-        "ResourceId": {
-          "type": "string"
-        },
-        "ResourceName": {
-          "type": "string"
-        },
-        "ResourceType": {
-          "type": "string"
-        },
-        "TimeFrom": {
-          "type": "integer",
-          "x-go-custom-tag": "gorm:\"index\""
-        },
-        "TimeTo": {
-          "type": "integer",
-          "x-go-custom-tag": "gorm:\"index\""
-        }
-      }
-    },
-    "Status": {
-      "type": "object",
-      "required": [
-        "SystemState"
-      ],
-      "properties": {
-        "AverageResponseTime": {
-          "type": "number",
-          "format": "double"
-        },
-        "DBState": {
-          "type": "string"
-        },
-        "LastRequest": {
-          "type": "string"
-        },
-        "RequestsBoT": {
-          "type": "integer"
-        },
-        "RequestsLastHour": {
-          "type": "integer"
-        },
-        "RequestsToday": {
-          "type": "integer"
-        },
-        "SystemState": {
-          "type": "string"
-        }
-      }
-    },
-    "Usage": {
-      "type": "object",
-      "properties": {
-        "AccountId": {
-          "type": "string"
-        },
-        "TimeFrom": {
-          "type": "integer"
-        },
-        "TimeTo": {
-          "type": "integer"
-        },
-        "Usage": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Use"
-          }
-        }
-      }
-    },
-    "Use": {
-      "type": "object",
-      "properties": {
-        "MetaData": {
-          "$ref": "#/definitions/Metadata"
-        },
-        "Region": {
-          "type": "string"
-        },
-        "ResourceId": {
-          "type": "string"
-        },
-        "ResourceName": {
-          "type": "string"
-        },
-        "ResourceType": {
-          "type": "string"
-        },
-        "Unit": {
-          "type": "string"
-        },
-        "UsageBreakup": {
-          "$ref": "#/definitions/Metadata"
-        }
-      }
-    }
-  },
-  "securityDefinitions": {
-    "APIKeyHeader": {
-      "type": "apiKey",
-      "name": "X-API-KEY",
-      "in": "header"
-    },
-    "APIKeyParam": {
-      "type": "apiKey",
-      "name": "api_key",
-      "in": "query"
-    },
-    "Keycloak": {
-      "type": "oauth2",
-      "flow": "accessCode",
-      "authorizationUrl": "http://localhost:8080/auth/realms/Dev/protocol/openid-connect/auth",
-      "tokenUrl": "http://localhost:8080/auth/realms/Dev/protocol/openid-connect/token",
-      "scopes": {
-        "admin": "Admin scope",
-        "user": "User scope"
-      }
-    }
-  },
-  "security": [
-    {
-      "Keycloak": [
-        "user",
-        "admin"
-      ]
-    },
-    {
-      "APIKeyHeader": []
-    },
-    {
-      "APIKeyParam": []
-    }
-  ],
-  "tags": [
-    {
-      "description": "Actions relating to the reporting of the state of the service",
-      "name": "statusManagement"
-    },
-    {
-      "description": "Actions relating to the periodics actions to be triggered in the system",
-      "name": "triggerManagement"
-    },
-    {
-      "description": "Actions relating to the adquisition of events in the system",
-      "name": "eventManagement"
-    },
-    {
-      "description": "Actions relating to the reporting of the usages in the system",
-      "name": "usageManagement"
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "schemes": [
-    "http",
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "An API which supports creation, deletion, listing etc of Event Engine",
-    "title": "Event Engine Management API",
-    "contact": {
-      "email": "diego@cyclops-labs.io"
-    },
-    "license": {
-      "name": "Apache 2.0",
-      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
-    },
-    "version": "1.0.0"
-  },
-  "host": "localhost:8000",
-  "basePath": "/api/v1.0",
-  "paths": {
-    "/event": {
-      "post": {
+    "/event/quarantine": {
+      "get": {
         "security": [
           {
             "Keycloak": [
@@ -891,37 +837,37 @@ func init() {
             "APIKeyParam": []
           }
         ],
-        "consumes": [
-          "application/json"
-        ],
         "produces": [
           "application/json"
         ],
         "tags": [
           "eventManagement"
         ],
-        "summary": "Takes into the system the provided event",
-        "operationId": "addEvent",
+        "summary": "Provides the events held back for not following the lifecycle of their resource type",
+        "operationId": "listQuarantinedEvents",
         "parameters": [
           {
-            "description": "Event to be added to the system",
-            "name": "event",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Event"
-            }
+            "type": "string",
+            "description": "Id of the account to filter the events",
+            "name": "account",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resource type to filter the events",
+            "name": "resource",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Item added successfully",
+          "200": {
+            "description": "Description of a successfully operation",
             "schema": {
-              "$ref": "#/definitions/ItemCreatedResponse"
-            }
-          },
-          "400": {
-            "description": "Invalid input, object invalid"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/QuarantinedEvent"
+              }
+            }
           },
           "500": {
             "description": "Something unexpected happend, error raised",
@@ -932,12 +878,12 @@ func init() {
         }
       }
     },
-    "/event/history/{account}": {
-      "get": {
+    "/event/rebuild": {
+      "post": {
         "security": [
           {
             "Keycloak": [
-              "user"
+              "admin"
             ]
           },
           {
@@ -953,38 +899,26 @@ func init() {
         "tags": [
           "eventManagement"
         ],
-        "summary": "Provides the events for the id provided",
-        "operationId": "getHistory",
+        "summary": "Rebuilds the states and their history from the log of raw events, reporting the differences with the actual ones",
+        "operationId": "rebuildStates",
         "parameters": [
           {
             "type": "string",
-            "description": "Id of the account to be checked",
+            "description": "Id of the account to be rebuilt, all of them by default",
             "name": "account",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "integer",
-            "description": "Datetime from which to get the usage report",
-            "name": "from",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "description": "Datetime until which to get the usage report",
-            "name": "to",
             "in": "query"
           },
           {
             "type": "string",
-            "description": "Resource type to filter the usage",
+            "description": "Id of the resource to be rebuilt, all of them by default",
             "name": "resource",
             "in": "query"
           },
           {
-            "type": "string",
-            "description": "Resource region to filter the usage",
-            "name": "region",
+            "type": "boolean",
+            "default": false,
+            "description": "Apply the rebuild instead of only reporting the differences",
+            "name": "apply",
             "in": "query"
           }
         ],
@@ -992,17 +926,64 @@ func init() {
           "200": {
             "description": "Description of a successfully operation",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Event"
-              }
+              "$ref": "#/definitions/RebuildReport"
             }
           },
           "404": {
-            "description": "Item not found in the system",
+            "description": "No raw events logged for the scope requested",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          }
+        }
+      }
+    },
+    "/event/recomputations": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "eventManagement"
+        ],
+        "summary": "Provides the time-windows whose usage changed due to out-of-order events",
+        "operationId": "listRecomputations",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "Datetime from which the recomputations were flagged",
+            "name": "since",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Recomputation"
+              }
+            }
           },
           "500": {
             "description": "Something unexpected happend, error raised",
@@ -1126,7 +1107,7 @@ func init() {
         }
       }
     },
-    "/status": {
+    "/filter": {
       "get": {
         "security": [
           {
@@ -1145,15 +1126,18 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "statusManagement"
+          "filterManagement"
         ],
-        "summary": "Basic status of the system",
-        "operationId": "showStatus",
+        "summary": "Provides the filter rules defined in the system",
+        "operationId": "listFilterRules",
         "responses": {
           "200": {
-            "description": "Status information of the system",
+            "description": "Description of a successfully operation",
             "schema": {
-              "$ref": "#/definitions/Status"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/FilterRule"
+              }
             }
           },
           "500": {
@@ -1163,14 +1147,12 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/status/{id}": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
             "Keycloak": [
-              "user"
+              "admin"
             ]
           },
           {
@@ -1180,40 +1162,49 @@ func init() {
             "APIKeyParam": []
           }
         ],
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "statusManagement"
+          "filterManagement"
         ],
-        "summary": "Basic status of the system",
-        "operationId": "getStatus",
+        "summary": "Defines a new filter rule for the events",
+        "operationId": "createFilterRule",
         "parameters": [
           {
-            "enum": [
-              "status",
-              "kafka-receiver",
-              "kafka-sender",
-              "trigger",
-              "usage",
-              "event"
-            ],
-            "type": "string",
-            "description": "Id of the endpoint to be checked",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "description": "Filter rule to be added to the system",
+            "name": "rule",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/FilterRule"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Status information of the system",
+          "201": {
+            "description": "Item added successfully",
             "schema": {
-              "$ref": "#/definitions/Status"
+              "$ref": "#/definitions/ItemCreatedResponse"
             }
           },
-          "404": {
-            "description": "The endpoint provided doesn't exist",
+          "400": {
+            "description": "Invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "A filter rule with the same name already exists",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1221,12 +1212,12 @@ func init() {
         }
       }
     },
-    "/trigger/sample": {
-      "get": {
+    "/filter/reload": {
+      "post": {
         "security": [
           {
             "Keycloak": [
-              "user"
+              "admin"
             ]
           },
           {
@@ -1240,13 +1231,19 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "triggerManagement"
+          "filterManagement"
         ],
-        "summary": "Sample task trigger",
-        "operationId": "execSample",
+        "summary": "Reloads the filter rules from the database",
+        "operationId": "reloadFilterRules",
         "responses": {
           "200": {
-            "description": "Sample task executed successfully"
+            "description": "Filter rules in use after the reload",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/FilterRule"
+              }
+            }
           },
           "500": {
             "description": "Something unexpected happend, error raised",
@@ -1257,7 +1254,7 @@ func init() {
         }
       }
     },
-    "/usage": {
+    "/filter/{id}": {
       "get": {
         "security": [
           {
@@ -1276,44 +1273,106 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "usageManagement"
+          "filterManagement"
         ],
-        "summary": "Generates an aggregated response by account of the usage recorded in the system during the time-window specified",
-        "operationId": "getSystemUsage",
+        "summary": "Provides the filter rule",
+        "operationId": "getFilterRule",
         "parameters": [
           {
             "type": "integer",
-            "description": "Datetime from which to get the usage report",
-            "name": "from",
-            "in": "query"
+            "description": "Id of the filter rule",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/FilterRule"
+            }
+          },
+          "404": {
+            "description": "Item not found in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
           {
-            "type": "integer",
-            "description": "Datetime until which to get the usage report",
-            "name": "to",
-            "in": "query"
+            "Keycloak": [
+              "admin"
+            ]
           },
           {
-            "type": "string",
-            "description": "Resource type to filter the usage",
-            "name": "resource",
-            "in": "query"
+            "APIKeyHeader": []
           },
           {
-            "type": "string",
-            "description": "Resource region to filter the usage",
-            "name": "region",
-            "in": "query"
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "filterManagement"
+        ],
+        "summary": "Updates the filter rule",
+        "operationId": "updateFilterRule",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "Id of the filter rule",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Filter rule updated",
+            "name": "rule",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/FilterRule"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Description of a successfully operation",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Usage"
-              }
+              "$ref": "#/definitions/FilterRule"
+            }
+          },
+          "400": {
+            "description": "Invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Item not found in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "A filter rule with the same name already exists",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
@@ -1323,105 +1382,3801 @@ func init() {
             }
           }
         }
+      },
+      "delete": {
+        "security": [
+          {
+            "Keycloak": [
+              "admin"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "filterManagement"
+        ],
+        "summary": "Removes the filter rule",
+        "operationId": "deleteFilterRule",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "Id of the filter rule",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Item removed successfully",
+            "schema": {
+              "$ref": "#/definitions/FilterRule"
+            }
+          },
+          "404": {
+            "description": "Item not found in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/lifecycle": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "lifecycleManagement"
+        ],
+        "summary": "Provides the lifecycles defined in the system",
+        "operationId": "listLifecycles",
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Lifecycle"
+              }
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "Keycloak": [
+              "admin"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "lifecycleManagement"
+        ],
+        "summary": "Defines the lifecycle of a resource type",
+        "operationId": "createLifecycle",
+        "parameters": [
+          {
+            "description": "Lifecycle to be added to the system",
+            "name": "lifecycle",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Lifecycle"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Item added successfully",
+            "schema": {
+              "$ref": "#/definitions/ItemCreatedResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The lifecycle of the resource type already exists",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/lifecycle/{type}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "lifecycleManagement"
+        ],
+        "summary": "Provides the lifecycle of the resource type",
+        "operationId": "getLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Resource type of the lifecycle",
+            "name": "type",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/Lifecycle"
+            }
+          },
+          "404": {
+            "description": "Item not found in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "Keycloak": [
+              "admin"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "lifecycleManagement"
+        ],
+        "summary": "Updates the lifecycle of the resource type",
+        "operationId": "updateLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Resource type of the lifecycle",
+            "name": "type",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Lifecycle updated",
+            "name": "lifecycle",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Lifecycle"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/Lifecycle"
+            }
+          },
+          "400": {
+            "description": "Invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Item not found in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/status": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "statusManagement"
+        ],
+        "summary": "Basic status of the system",
+        "operationId": "showStatus",
+        "responses": {
+          "200": {
+            "description": "Status information of the system",
+            "schema": {
+              "$ref": "#/definitions/Status"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/status/{id}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "statusManagement"
+        ],
+        "summary": "Basic status of the system",
+        "operationId": "getStatus",
+        "parameters": [
+          {
+            "enum": [
+              "status",
+              "kafka-receiver",
+              "kafka-sender",
+              "trigger",
+              "usage",
+              "event"
+            ],
+            "type": "string",
+            "description": "Id of the endpoint to be checked",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Status information of the system",
+            "schema": {
+              "$ref": "#/definitions/Status"
+            }
+          },
+          "404": {
+            "description": "The endpoint provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/trigger/sample": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "triggerManagement"
+        ],
+        "summary": "Sample task trigger",
+        "operationId": "execSample",
+        "responses": {
+          "200": {
+            "description": "Sample task executed successfully"
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/usage": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "usageManagement"
+        ],
+        "summary": "Generates an aggregated response by account of the usage recorded in the system during the time-window specified",
+        "operationId": "getSystemUsage",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "Datetime from which to get the usage report",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Datetime until which to get the usage report",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resource type to filter the usage",
+            "name": "resource",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resource region to filter the usage",
+            "name": "region",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Usage"
+              }
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/usage/{id}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "usageManagement"
+        ],
+        "summary": "Generates an aggregated response of the usage recorded in the system during the time-window specified for the selected account",
+        "operationId": "getUsage",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Id of the account to be checked",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "Datetime from which to get the usage report",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Datetime until which to get the usage report",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resource type to filter the usage",
+            "name": "resource",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resource region to filter the usage",
+            "name": "region",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/Usage"
+            }
+          },
+          "404": {
+            "description": "Item not found in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "CloudEventField": {
+      "type": "object",
+      "required": [
+        "Key",
+        "Value"
+      ],
+      "properties": {
+        "Key": {
+          "type": "string"
+        },
+        "Value": {
+          "description": "Expression providing the value",
+          "type": "string"
+        }
+      }
+    },
+    "CloudEventMapping": {
+      "description": "Mapping of the CloudEvents of a source into events. The fields hold expressions: the name of a context attribute or extension of the CloudEvent (id, source, type, subject, time...), a path within its JSON data starting with data (data.instance.status), or a constant value preceded by = (=server).\n",
+      "type": "object",
+      "required": [
+        "Account",
+        "LastEvent",
+        "Name",
+        "ResourceId",
+        "ResourceType",
+        "Source"
+      ],
+      "properties": {
+        "Account": {
+          "type": "string"
+        },
+        "Disabled": {
+          "type": "boolean"
+        },
+        "EventTime": {
+          "description": "Expression with the time of the event, as RFC 3339 or in seconds since the epoch, the time attribute if empty",
+          "type": "string"
+        },
+        "ID": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primary_key;auto_increment\""
+        },
+        "LastEvent": {
+          "description": "Expression with the state of the resource, translated through the States if any",
+          "type": "string"
+        },
+        "MetaData": {
+          "description": "Metadata keys added to the event with the value of their expressions",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CloudEventField"
+          },
+          "x-go-custom-tag": "gorm:\"serializer:json\""
+        },
+        "Name": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"unique\""
+        },
+        "Region": {
+          "type": "string"
+        },
+        "ResourceId": {
+          "type": "string"
+        },
+        "ResourceName": {
+          "type": "string"
+        },
+        "ResourceType": {
+          "type": "string"
+        },
+        "Source": {
+          "description": "Source attribute of the CloudEvents mapped",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "States": {
+          "description": "Translation of the values of the LastEvent expression into the states of the system",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CloudEventState"
+          },
+          "x-go-custom-tag": "gorm:\"serializer:json\""
+        },
+        "Type": {
+          "description": "Type attribute of the CloudEvents mapped, empty for any type of the source",
+          "type": "string"
+        }
+      }
+    },
+    "CloudEventState": {
+      "type": "object",
+      "required": [
+        "From",
+        "To"
+      ],
+      "properties": {
+        "From": {
+          "type": "string"
+        },
+        "To": {
+          "type": "string",
+          "enum": [
+            "active",
+            "error",
+            "inactive",
+            "terminated",
+            "suspended"
+          ]
+        }
+      }
+    },
+    "DiscreteEvent": {
+      "type": "object",
+      "required": [
+        "EventTime",
+        "Kind"
+      ],
+      "properties": {
+        "Account": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index;index:idx_discrete_events_usage,priority:1\""
+        },
+        "EventTime": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"index;index:idx_discrete_events_usage,priority:2\""
+        },
+        "ID": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primary_key;auto_increment\""
+        },
+        "Kind": {
+          "description": "What happened to the resource, reported as key of the usage breakup and priced by the cycle with it as state",
+          "type": "string"
+        },
+        "MetaData": {
+          "x-go-custom-tag": "gorm:\"type:jsonb\"",
+          "$ref": "#/definitions/Metadata"
+        },
+        "Quantity": {
+          "description": "Amount of the occurrence, 1 if not provided",
+          "type": "number",
+          "format": "double"
+        },
+        "Region": {
+          "type": "string"
+        },
+        "ResourceId": {
+          "type": "string"
+        },
+        "ResourceName": {
+          "type": "string"
+        },
+        "ResourceType": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "ErrorResponse": {
+      "type": "object",
+      "required": [
+        "errorString"
+      ],
+      "properties": {
+        "errorString": {
+          "type": "string"
+        }
+      }
+    },
+    "Event": {
+      "type": "object",
+      "required": [
+        "EventTime",
+        "LastEvent"
+      ],
+      "properties": {
+        "Account": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index;index:idx_events_usage,priority:1\""
+        },
+        "EventTime": {
+          "type": "integer"
+        },
+        "ID": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primary_key;auto_increment\""
+        },
+        "LastEvent": {
+          "type": "string",
+          "enum": [
+            "active",
+            "error",
+            "inactive",
+            "terminated",
+            "suspended"
+          ]
+        },
+        "MetaData": {
+          "x-go-custom-tag": "gorm:\"type:jsonb\"",
+          "$ref": "#/definitions/Metadata"
+        },
+        "Region": {
+          "type": "string"
+        },
+        "ResourceId": {
+          "type": "string"
+        },
+        "ResourceName": {
+          "type": "string"
+        },
+        "ResourceType": {
+          "type": "string"
+        },
+        "TimeFrom": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"index;index:idx_events_usage,priority:2\""
+        },
+        "TimeTo": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"index;index:idx_events_usage,priority:3\""
+        }
+      }
+    },
+    "FilterMatch": {
+      "type": "object",
+      "required": [
+        "Key"
+      ],
+      "properties": {
+        "Key": {
+          "type": "string"
+        },
+        "Value": {
+          "type": "string"
+        }
+      }
+    },
+    "FilterRule": {
+      "type": "object",
+      "required": [
+        "Action",
+        "Name"
+      ],
+      "properties": {
+        "Account": {
+          "description": "Account to match, empty for any",
+          "type": "string"
+        },
+        "Action": {
+          "type": "string",
+          "enum": [
+            "drop",
+            "quarantine",
+            "tag"
+          ]
+        },
+        "Disabled": {
+          "type": "boolean"
+        },
+        "Hits": {
+          "description": "Events matched by the rule",
+          "type": "integer",
+          "readOnly": true
+        },
+        "ID": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primary_key;auto_increment\""
+        },
+        "MetaData": {
+          "description": "Metadata keys the event must have with the given values, any value if empty",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FilterMatch"
+          },
+          "x-go-custom-tag": "gorm:\"serializer:json\""
+        },
+        "Name": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"unique\""
+        },
+        "NamePattern": {
+          "description": "Regular expression the resource name must match, empty for any",
+          "type": "string"
+        },
+        "Priority": {
+          "description": "Rules are evaluated in ascending priority",
+          "type": "integer"
+        },
+        "Region": {
+          "description": "Region to match, empty for any",
+          "type": "string"
+        },
+        "ResourceType": {
+          "description": "Resource type to match, empty for any",
+          "type": "string"
+        },
+        "Tags": {
+          "description": "Metadata added to the event by the tag action",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FilterMatch"
+          },
+          "x-go-custom-tag": "gorm:\"serializer:json\""
+        }
+      }
+    },
+    "InventoryChange": {
+      "type": "object",
+      "properties": {
+        "After": {
+          "$ref": "#/definitions/InventoryItem"
+        },
+        "Before": {
+          "$ref": "#/definitions/InventoryItem"
+        }
+      }
+    },
+    "InventoryDiff": {
+      "type": "object",
+      "properties": {
+        "Account": {
+          "type": "string"
+        },
+        "Added": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/InventoryItem"
+          }
+        },
+        "Changed": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/InventoryChange"
+          }
+        },
+        "From": {
+          "type": "integer"
+        },
+        "Removed": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/InventoryItem"
+          }
+        },
+        "To": {
+          "type": "integer"
+        }
+      }
+    },
+    "InventoryItem": {
+      "type": "object",
+      "properties": {
+        "Account": {
+          "type": "string"
+        },
+        "MetaData": {
+          "$ref": "#/definitions/Metadata"
+        },
+        "Region": {
+          "type": "string"
+        },
+        "ResourceId": {
+          "type": "string"
+        },
+        "ResourceName": {
+          "type": "string"
+        },
+        "ResourceType": {
+          "type": "string"
+        },
+        "Since": {
+          "type": "integer"
+        },
+        "State": {
+          "type": "string",
+          "enum": [
+            "active",
+            "error",
+            "inactive",
+            "suspended"
+          ]
+        }
+      }
+    },
+    "ItemCreatedResponse": {
+      "properties": {
+        "ApiLink": {
+          "type": "string"
+        },
+        "Message": {
+          "type": "string"
+        }
+      }
+    },
+    "Lifecycle": {
+      "type": "object",
+      "required": [
+        "ResourceType",
+        "States"
+      ],
+      "properties": {
+        "ResourceType": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "States": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"serializer:json\""
+        },
+        "Terminal": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"serializer:json\""
+        },
+        "Transitions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/LifecycleTransition"
+          },
+          "x-go-custom-tag": "gorm:\"serializer:json\""
+        }
+      }
+    },
+    "LifecycleTransition": {
+      "type": "object",
+      "properties": {
+        "From": {
+          "type": "string"
+        },
+        "To": {
+          "type": "string"
+        }
+      }
+    },
+    "Metadata": {
+      "type": "object",
+      "x-go-type": {
+        "import": {
+          "package": "gitlab.com/cyclops-utilities/datamodels"
+        },
+        "type": "JSONdb"
+      }
+    },
+    "MinimalState": {
+      "type": "object",
+      "properties": {
+        "Account": {
+          "type": "string"
+        },
+        "MetaData": {
+          "x-go-custom-tag": "gorm:\"type:jsonb\"",
+          "$ref": "#/definitions/Metadata"
+        },
+        "ResourceId": {
+          "type": "string"
+        },
+        "ResourceName": {
+          "type": "string"
+        }
+      }
+    },
+    "QuarantinedEvent": {
+      "type": "object",
+      "properties": {
+        "Account": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "EventTime": {
+          "type": "integer"
+        },
+        "ID": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primary_key;auto_increment\""
+        },
+        "LastEvent": {
+          "type": "string"
+        },
+        "MetaData": {
+          "x-go-custom-tag": "gorm:\"type:jsonb\"",
+          "$ref": "#/definitions/Metadata"
+        },
+        "Reason": {
+          "type": "string"
+        },
+        "ReceivedAt": {
+          "type": "integer"
+        },
+        "Region": {
+          "type": "string"
+        },
+        "ResourceId": {
+          "type": "string"
+        },
+        "ResourceName": {
+          "type": "string"
+        },
+        "ResourceType": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "RawEvent": {
+      "type": "object",
+      "properties": {
+        "Account": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "EventTime": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "ID": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primary_key;auto_increment\""
+        },
+        "LastEvent": {
+          "type": "string"
+        },
+        "MetaData": {
+          "x-go-custom-tag": "gorm:\"type:jsonb\"",
+          "$ref": "#/definitions/Metadata"
+        },
+        "ReceivedAt": {
+          "type": "integer"
+        },
+        "Region": {
+          "type": "string"
+        },
+        "ResourceId": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "ResourceName": {
+          "type": "string"
+        },
+        "ResourceType": {
+          "type": "string"
+        }
+      }
+    },
+    "RebuildDifference": {
+      "type": "object",
+      "properties": {
+        "After": {
+          "$ref": "#/definitions/State"
+        },
+        "Before": {
+          "$ref": "#/definitions/State"
+        },
+        "Change": {
+          "type": "string",
+          "enum": [
+            "added",
+            "changed",
+            "removed"
+          ]
+        },
+        "Kind": {
+          "type": "string",
+          "enum": [
+            "history",
+            "state"
+          ]
+        }
+      }
+    },
+    "RebuildReport": {
+      "type": "object",
+      "properties": {
+        "Account": {
+          "type": "string"
+        },
+        "Applied": {
+          "type": "boolean"
+        },
+        "Differences": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RebuildDifference"
+          }
+        },
+        "Events": {
+          "type": "integer"
+        },
+        "Histories": {
+          "type": "integer"
+        },
+        "LogStart": {
+          "type": "integer"
+        },
+        "ResourceId": {
+          "type": "string"
+        },
+        "Skipped": {
+          "type": "integer"
+        },
+        "States": {
+          "type": "integer"
+        }
+      }
+    },
+    "Recomputation": {
+      "type": "object",
+      "properties": {
+        "Account": {
+          "type": "string"
+        },
+        "CreatedAt": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "ID": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primary_key;auto_increment\""
+        },
+        "Region": {
+          "type": "string"
+        },
+        "ResourceId": {
+          "type": "string"
+        },
+        "ResourceType": {
+          "type": "string"
+        },
+        "TimeFrom": {
+          "type": "integer"
+        },
+        "TimeTo": {
+          "type": "integer"
+        }
+      }
+    },
+    "State": {
+      "type": "object",
+      "required": [
+        "EventTime",
+        "LastEvent"
+      ],
+      "properties": {
+        "Account": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index;index:idx_states_usage,priority:1\""
+        },
+        "EventTime": {
+          "type": "integer"
+        },
+        "ID": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primary_key;auto_increment\""
+        },
+        "LastEvent": {
+          "type": "string",
+          "enum": [
+            "active",
+            "error",
+            "inactive",
+            "terminated",
+            "suspended"
+          ]
+        },
+        "MetaData": {
+          "x-go-custom-tag": "gorm:\"type:jsonb\"",
+          "$ref": "#/definitions/Metadata"
+        },
+        "Region": {
+          "type": "string"
+        },
+        "ResourceId": {
+          "type": "string"
+        },
+        "ResourceName": {
+          "type": "string"
+        },
+        "ResourceType": {
+          "type": "string"
+        },
+        "TimeFrom": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"index;index:idx_states_usage,priority:2\""
+        },
+        "TimeTo": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"index;index:idx_states_usage,priority:3\""
+        }
+      }
+    },
+    "Status": {
+      "type": "object",
+      "required": [
+        "SystemState"
+      ],
+      "properties": {
+        "AverageResponseTime": {
+          "type": "number",
+          "format": "double"
+        },
+        "DBState": {
+          "type": "string"
+        },
+        "LastRequest": {
+          "type": "string"
+        },
+        "RequestsBoT": {
+          "type": "integer"
+        },
+        "RequestsLastHour": {
+          "type": "integer"
+        },
+        "RequestsToday": {
+          "type": "integer"
+        },
+        "SystemState": {
+          "type": "string"
+        }
+      }
+    },
+    "Usage": {
+      "type": "object",
+      "properties": {
+        "AccountId": {
+          "type": "string"
+        },
+        "TimeFrom": {
+          "type": "integer"
+        },
+        "TimeTo": {
+          "type": "integer"
+        },
+        "Usage": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Use"
+          }
+        }
+      }
+    },
+    "Use": {
+      "type": "object",
+      "properties": {
+        "MetaData": {
+          "$ref": "#/definitions/Metadata"
+        },
+        "Region": {
+          "type": "string"
+        },
+        "ResourceId": {
+          "type": "string"
+        },
+        "ResourceName": {
+          "type": "string"
+        },
+        "ResourceType": {
+          "type": "string"
+        },
+        "Unit": {
+          "type": "string"
+        },
+        "UsageBreakup": {
+          "$ref": "#/definitions/Metadata"
+        }
+      }
+    }
+  },
+  "securityDefinitions": {
+    "APIKeyHeader": {
+      "type": "apiKey",
+      "name": "X-API-KEY",
+      "in": "header"
+    },
+    "APIKeyParam": {
+      "type": "apiKey",
+      "name": "api_key",
+      "in": "query"
+    },
+    "Keycloak": {
+      "type": "oauth2",
+      "flow": "accessCode",
+      "authorizationUrl": "http://localhost:8080/auth/realms/Dev/protocol/openid-connect/auth",
+      "tokenUrl": "http://localhost:8080/auth/realms/Dev/protocol/openid-connect/token",
+      "scopes": {
+        "admin": "Admin scope",
+        "user": "User scope"
+      }
+    }
+  },
+  "security": [
+    {
+      "Keycloak": [
+        "user",
+        "admin"
+      ]
+    },
+    {
+      "APIKeyHeader": []
+    },
+    {
+      "APIKeyParam": []
+    }
+  ],
+  "tags": [
+    {
+      "description": "Actions relating to the reporting of the state of the service",
+      "name": "statusManagement"
+    },
+    {
+      "description": "Actions relating to the periodics actions to be triggered in the system",
+      "name": "triggerManagement"
+    },
+    {
+      "description": "Actions relating to the adquisition of events in the system",
+      "name": "eventManagement"
+    },
+    {
+      "description": "Actions relating to the adquisition of CloudEvents in the system",
+      "name": "cloudEventManagement"
+    },
+    {
+      "description": "Actions relating to the filtering of the events in the system",
+      "name": "filterManagement"
+    },
+    {
+      "description": "Actions relating to the lifecycle of the resources in the system",
+      "name": "lifecycleManagement"
+    },
+    {
+      "description": "Actions relating to the reporting of the usages in the system",
+      "name": "usageManagement"
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "An API which supports creation, deletion, listing etc of Event Engine",
+    "title": "Event Engine Management API",
+    "contact": {
+      "email": "diego@cyclops-labs.io"
+    },
+    "license": {
+      "name": "Apache 2.0",
+      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+    },
+    "version": "1.0.0"
+  },
+  "host": "localhost:8000",
+  "basePath": "/api/v1.0",
+  "paths": {
+    "/cloudevent/mapping": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "cloudEventManagement"
+        ],
+        "summary": "Provides the CloudEvents mappings defined in the system",
+        "operationId": "listCloudEventMappings",
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/CloudEventMapping"
+              }
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "Keycloak": [
+              "admin"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "cloudEventManagement"
+        ],
+        "summary": "Defines a new mapping of the CloudEvents of a source into events",
+        "operationId": "createCloudEventMapping",
+        "parameters": [
+          {
+            "description": "Mapping to be added to the system",
+            "name": "mapping",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CloudEventMapping"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Item added successfully",
+            "schema": {
+              "$ref": "#/definitions/ItemCreatedResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "A mapping with the same name or for the same source and type already exists",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/cloudevent/mapping/{id}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "cloudEventManagement"
+        ],
+        "summary": "Provides the CloudEvents mapping",
+        "operationId": "getCloudEventMapping",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "Id of the mapping",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/CloudEventMapping"
+            }
+          },
+          "404": {
+            "description": "Item not found in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "Keycloak": [
+              "admin"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "cloudEventManagement"
+        ],
+        "summary": "Updates the CloudEvents mapping",
+        "operationId": "updateCloudEventMapping",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "Id of the mapping",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Mapping updated",
+            "name": "mapping",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CloudEventMapping"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/CloudEventMapping"
+            }
+          },
+          "400": {
+            "description": "Invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Item not found in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "A mapping with the same name or for the same source and type already exists",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "Keycloak": [
+              "admin"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "cloudEventManagement"
+        ],
+        "summary": "Removes the CloudEvents mapping",
+        "operationId": "deleteCloudEventMapping",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "Id of the mapping",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Item removed successfully",
+            "schema": {
+              "$ref": "#/definitions/CloudEventMapping"
+            }
+          },
+          "404": {
+            "description": "Item not found in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/event": {
+      "post": {
+        "security": [
+          {
+            "Keycloak": [
+              "admin"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "eventManagement"
+        ],
+        "summary": "Takes into the system the provided event",
+        "operationId": "addEvent",
+        "parameters": [
+          {
+            "description": "Event to be added to the system",
+            "name": "event",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Event"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Item added successfully",
+            "schema": {
+              "$ref": "#/definitions/ItemCreatedResponse"
+            }
+          },
+          "202": {
+            "description": "Item filtered by a rule, dropped or quarantined",
+            "schema": {
+              "$ref": "#/definitions/ItemCreatedResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/event/cloudevent": {
+      "post": {
+        "security": [
+          {
+            "Keycloak": [
+              "admin"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/cloudevents+json",
+          "application/json",
+          "application/octet-stream",
+          "text/plain"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "cloudEventManagement"
+        ],
+        "summary": "Takes into the system the provided CloudEvent, in structured or binary mode, through the mapping of its source",
+        "operationId": "addCloudEvent",
+        "parameters": [
+          {
+            "description": "CloudEvent in structured mode, or its data in binary mode with the attributes in the ce- headers",
+            "name": "event",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Item added successfully",
+            "schema": {
+              "$ref": "#/definitions/ItemCreatedResponse"
+            }
+          },
+          "202": {
+            "description": "Item filtered by a rule, dropped or quarantined",
+            "schema": {
+              "$ref": "#/definitions/ItemCreatedResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid input, the CloudEvent is not valid or it cannot be mapped into an event",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/event/discrete": {
+      "post": {
+        "security": [
+          {
+            "Keycloak": [
+              "admin"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "eventManagement"
+        ],
+        "summary": "Takes into the system the provided discrete event, counted instead of measured over time",
+        "operationId": "addDiscreteEvent",
+        "parameters": [
+          {
+            "description": "Discrete event to be added to the system",
+            "name": "event",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/DiscreteEvent"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Item added successfully",
+            "schema": {
+              "$ref": "#/definitions/ItemCreatedResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/event/discrete/{account}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "eventManagement"
+        ],
+        "summary": "Provides the discrete events of the account provided",
+        "operationId": "listDiscreteEvents",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Id of the account to be checked",
+            "name": "account",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "Datetime from which to get the events",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Datetime until which to get the events",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resource type to filter the events",
+            "name": "resource",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resource region to filter the events",
+            "name": "region",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/DiscreteEvent"
+              }
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/event/history/{account}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "eventManagement"
+        ],
+        "summary": "Provides the events for the id provided",
+        "operationId": "getHistory",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Id of the account to be checked",
+            "name": "account",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "Datetime from which to get the usage report",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Datetime until which to get the usage report",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resource type to filter the usage",
+            "name": "resource",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resource region to filter the usage",
+            "name": "region",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Event"
+              }
+            }
+          },
+          "404": {
+            "description": "Item not found in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/event/inventory/{account}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "eventManagement"
+        ],
+        "summary": "Provides the resources alive for the account at the instant provided",
+        "operationId": "getInventory",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Id of the account to be checked",
+            "name": "account",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "Datetime of the inventory, now by default",
+            "name": "at",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resource type to filter the inventory",
+            "name": "resource",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resource region to filter the inventory",
+            "name": "region",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/InventoryItem"
+              }
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/event/inventory/{account}/diff": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "eventManagement"
+        ],
+        "summary": "Provides the changes in the resources alive for the account between the instants provided",
+        "operationId": "getInventoryDiff",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Id of the account to be checked",
+            "name": "account",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "Datetime of the initial inventory",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "Datetime of the final inventory, now by default",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resource type to filter the inventory",
+            "name": "resource",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resource region to filter the inventory",
+            "name": "region",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/InventoryDiff"
+            }
+          },
+          "400": {
+            "description": "Invalid input, the time-window is not valid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/event/quarantine": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "admin"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "eventManagement"
+        ],
+        "summary": "Provides the events held back for not following the lifecycle of their resource type",
+        "operationId": "listQuarantinedEvents",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Id of the account to filter the events",
+            "name": "account",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resource type to filter the events",
+            "name": "resource",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/QuarantinedEvent"
+              }
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/event/rebuild": {
+      "post": {
+        "security": [
+          {
+            "Keycloak": [
+              "admin"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "eventManagement"
+        ],
+        "summary": "Rebuilds the states and their history from the log of raw events, reporting the differences with the actual ones",
+        "operationId": "rebuildStates",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Id of the account to be rebuilt, all of them by default",
+            "name": "account",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Id of the resource to be rebuilt, all of them by default",
+            "name": "resource",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Apply the rebuild instead of only reporting the differences",
+            "name": "apply",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/RebuildReport"
+            }
+          },
+          "404": {
+            "description": "No raw events logged for the scope requested",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/event/recomputations": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "eventManagement"
+        ],
+        "summary": "Provides the time-windows whose usage changed due to out-of-order events",
+        "operationId": "listRecomputations",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "Datetime from which the recomputations were flagged",
+            "name": "since",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Recomputation"
+              }
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/event/status": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "eventManagement"
+        ],
+        "summary": "Provides the list of states in not terminated state",
+        "operationId": "listStates",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Resource type to filter the usage",
+            "name": "resource",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resource region to filter the usage",
+            "name": "region",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/MinimalState"
+              }
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/event/status/{account}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "eventManagement"
+        ],
+        "summary": "Provides the events for the id provided",
+        "operationId": "getState",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Id of the account to be checked",
+            "name": "account",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/State"
+              }
+            }
+          },
+          "404": {
+            "description": "Item not found in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/filter": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "filterManagement"
+        ],
+        "summary": "Provides the filter rules defined in the system",
+        "operationId": "listFilterRules",
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/FilterRule"
+              }
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "Keycloak": [
+              "admin"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "filterManagement"
+        ],
+        "summary": "Defines a new filter rule for the events",
+        "operationId": "createFilterRule",
+        "parameters": [
+          {
+            "description": "Filter rule to be added to the system",
+            "name": "rule",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/FilterRule"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Item added successfully",
+            "schema": {
+              "$ref": "#/definitions/ItemCreatedResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "A filter rule with the same name already exists",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/filter/reload": {
+      "post": {
+        "security": [
+          {
+            "Keycloak": [
+              "admin"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "filterManagement"
+        ],
+        "summary": "Reloads the filter rules from the database",
+        "operationId": "reloadFilterRules",
+        "responses": {
+          "200": {
+            "description": "Filter rules in use after the reload",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/FilterRule"
+              }
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/filter/{id}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "filterManagement"
+        ],
+        "summary": "Provides the filter rule",
+        "operationId": "getFilterRule",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "Id of the filter rule",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/FilterRule"
+            }
+          },
+          "404": {
+            "description": "Item not found in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "Keycloak": [
+              "admin"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "filterManagement"
+        ],
+        "summary": "Updates the filter rule",
+        "operationId": "updateFilterRule",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "Id of the filter rule",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Filter rule updated",
+            "name": "rule",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/FilterRule"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/FilterRule"
+            }
+          },
+          "400": {
+            "description": "Invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Item not found in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "A filter rule with the same name already exists",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "Keycloak": [
+              "admin"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "filterManagement"
+        ],
+        "summary": "Removes the filter rule",
+        "operationId": "deleteFilterRule",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "Id of the filter rule",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Item removed successfully",
+            "schema": {
+              "$ref": "#/definitions/FilterRule"
+            }
+          },
+          "404": {
+            "description": "Item not found in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/lifecycle": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "lifecycleManagement"
+        ],
+        "summary": "Provides the lifecycles defined in the system",
+        "operationId": "listLifecycles",
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Lifecycle"
+              }
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "Keycloak": [
+              "admin"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "lifecycleManagement"
+        ],
+        "summary": "Defines the lifecycle of a resource type",
+        "operationId": "createLifecycle",
+        "parameters": [
+          {
+            "description": "Lifecycle to be added to the system",
+            "name": "lifecycle",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Lifecycle"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Item added successfully",
+            "schema": {
+              "$ref": "#/definitions/ItemCreatedResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The lifecycle of the resource type already exists",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/lifecycle/{type}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "lifecycleManagement"
+        ],
+        "summary": "Provides the lifecycle of the resource type",
+        "operationId": "getLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Resource type of the lifecycle",
+            "name": "type",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/Lifecycle"
+            }
+          },
+          "404": {
+            "description": "Item not found in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "Keycloak": [
+              "admin"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "lifecycleManagement"
+        ],
+        "summary": "Updates the lifecycle of the resource type",
+        "operationId": "updateLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Resource type of the lifecycle",
+            "name": "type",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Lifecycle updated",
+            "name": "lifecycle",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Lifecycle"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/Lifecycle"
+            }
+          },
+          "400": {
+            "description": "Invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Item not found in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/status": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "statusManagement"
+        ],
+        "summary": "Basic status of the system",
+        "operationId": "showStatus",
+        "responses": {
+          "200": {
+            "description": "Status information of the system",
+            "schema": {
+              "$ref": "#/definitions/Status"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/status/{id}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "statusManagement"
+        ],
+        "summary": "Basic status of the system",
+        "operationId": "getStatus",
+        "parameters": [
+          {
+            "enum": [
+              "status",
+              "kafka-receiver",
+              "kafka-sender",
+              "trigger",
+              "usage",
+              "event"
+            ],
+            "type": "string",
+            "description": "Id of the endpoint to be checked",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Status information of the system",
+            "schema": {
+              "$ref": "#/definitions/Status"
+            }
+          },
+          "404": {
+            "description": "The endpoint provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/trigger/sample": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "triggerManagement"
+        ],
+        "summary": "Sample task trigger",
+        "operationId": "execSample",
+        "responses": {
+          "200": {
+            "description": "Sample task executed successfully"
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/usage": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "usageManagement"
+        ],
+        "summary": "Generates an aggregated response by account of the usage recorded in the system during the time-window specified",
+        "operationId": "getSystemUsage",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "Datetime from which to get the usage report",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Datetime until which to get the usage report",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resource type to filter the usage",
+            "name": "resource",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resource region to filter the usage",
+            "name": "region",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Usage"
+              }
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/usage/{id}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "usageManagement"
+        ],
+        "summary": "Generates an aggregated response of the usage recorded in the system during the time-window specified for the selected account",
+        "operationId": "getUsage",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Id of the account to be checked",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "Datetime from which to get the usage report",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Datetime until which to get the usage report",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resource type to filter the usage",
+            "name": "resource",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Resource region to filter the usage",
+            "name": "region",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/Usage"
+            }
+          },
+          "404": {
+            "description": "Item not found in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "CloudEventField": {
+      "type": "object",
+      "required": [
+        "Key",
+        "Value"
+      ],
+      "properties": {
+        "Key": {
+          "type": "string"
+        },
+        "Value": {
+          "description": "Expression providing the value",
+          "type": "string"
+        }
+      }
+    },
+    "CloudEventMapping": {
+      "description": "Mapping of the CloudEvents of a source into events. The fields hold expressions: the name of a context attribute or extension of the CloudEvent (id, source, type, subject, time...), a path within its JSON data starting with data (data.instance.status), or a constant value preceded by = (=server).\n",
+      "type": "object",
+      "required": [
+        "Account",
+        "LastEvent",
+        "Name",
+        "ResourceId",
+        "ResourceType",
+        "Source"
+      ],
+      "properties": {
+        "Account": {
+          "type": "string"
+        },
+        "Disabled": {
+          "type": "boolean"
+        },
+        "EventTime": {
+          "description": "Expression with the time of the event, as RFC 3339 or in seconds since the epoch, the time attribute if empty",
+          "type": "string"
+        },
+        "ID": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primary_key;auto_increment\""
+        },
+        "LastEvent": {
+          "description": "Expression with the state of the resource, translated through the States if any",
+          "type": "string"
+        },
+        "MetaData": {
+          "description": "Metadata keys added to the event with the value of their expressions",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CloudEventField"
+          },
+          "x-go-custom-tag": "gorm:\"serializer:json\""
+        },
+        "Name": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"unique\""
+        },
+        "Region": {
+          "type": "string"
+        },
+        "ResourceId": {
+          "type": "string"
+        },
+        "ResourceName": {
+          "type": "string"
+        },
+        "ResourceType": {
+          "type": "string"
+        },
+        "Source": {
+          "description": "Source attribute of the CloudEvents mapped",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "States": {
+          "description": "Translation of the values of the LastEvent expression into the states of the system",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CloudEventState"
+          },
+          "x-go-custom-tag": "gorm:\"serializer:json\""
+        },
+        "Type": {
+          "description": "Type attribute of the CloudEvents mapped, empty for any type of the source",
+          "type": "string"
+        }
+      }
+    },
+    "CloudEventState": {
+      "type": "object",
+      "required": [
+        "From",
+        "To"
+      ],
+      "properties": {
+        "From": {
+          "type": "string"
+        },
+        "To": {
+          "type": "string",
+          "enum": [
+            "active",
+            "error",
+            "inactive",
+            "terminated",
+            "suspended"
+          ]
+        }
+      }
+    },
+    "DiscreteEvent": {
+      "type": "object",
+      "required": [
+        "EventTime",
+        "Kind"
+      ],
+      "properties": {
+        "Account": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index;index:idx_discrete_events_usage,priority:1\""
+        },
+        "EventTime": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"index;index:idx_discrete_events_usage,priority:2\""
+        },
+        "ID": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primary_key;auto_increment\""
+        },
+        "Kind": {
+          "description": "What happened to the resource, reported as key of the usage breakup and priced by the cycle with it as state",
+          "type": "string"
+        },
+        "MetaData": {
+          "x-go-custom-tag": "gorm:\"type:jsonb\"",
+          "$ref": "#/definitions/Metadata"
+        },
+        "Quantity": {
+          "description": "Amount of the occurrence, 1 if not provided",
+          "type": "number",
+          "format": "double"
+        },
+        "Region": {
+          "type": "string"
+        },
+        "ResourceId": {
+          "type": "string"
+        },
+        "ResourceName": {
+          "type": "string"
+        },
+        "ResourceType": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "ErrorResponse": {
+      "type": "object",
+      "required": [
+        "errorString"
+      ],
+      "properties": {
+        "errorString": {
+          "type": "string"
+        }
+      }
+    },
+    "Event": {
+      "type": "object",
+      "required": [
+        "EventTime",
+        "LastEvent"
+      ],
+      "properties": {
+        "Account": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index;index:idx_events_usage,priority:1\""
+        },
+        "EventTime": {
+          "type": "integer"
+        },
+        "ID": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primary_key;auto_increment\""
+        },
+        "LastEvent": {
+          "type": "string",
+          "enum": [
+            "active",
+            "error",
+            "inactive",
+            "terminated",
+            "suspended"
+          ]
+        },
+        "MetaData": {
+          "x-go-custom-tag": "gorm:\"type:jsonb\"",
+          "$ref": "#/definitions/Metadata"
+        },
+        "Region": {
+          "type": "string"
+        },
+        "ResourceId": {
+          "type": "string"
+        },
+        "ResourceName": {
+          "type": "string"
+        },
+        "ResourceType": {
+          "type": "string"
+        },
+        "TimeFrom": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"index;index:idx_events_usage,priority:2\""
+        },
+        "TimeTo": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"index;index:idx_events_usage,priority:3\""
+        }
+      }
+    },
+    "FilterMatch": {
+      "type": "object",
+      "required": [
+        "Key"
+      ],
+      "properties": {
+        "Key": {
+          "type": "string"
+        },
+        "Value": {
+          "type": "string"
+        }
+      }
+    },
+    "FilterRule": {
+      "type": "object",
+      "required": [
+        "Action",
+        "Name"
+      ],
+      "properties": {
+        "Account": {
+          "description": "Account to match, empty for any",
+          "type": "string"
+        },
+        "Action": {
+          "type": "string",
+          "enum": [
+            "drop",
+            "quarantine",
+            "tag"
+          ]
+        },
+        "Disabled": {
+          "type": "boolean"
+        },
+        "Hits": {
+          "description": "Events matched by the rule",
+          "type": "integer",
+          "readOnly": true
+        },
+        "ID": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primary_key;auto_increment\""
+        },
+        "MetaData": {
+          "description": "Metadata keys the event must have with the given values, any value if empty",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FilterMatch"
+          },
+          "x-go-custom-tag": "gorm:\"serializer:json\""
+        },
+        "Name": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"unique\""
+        },
+        "NamePattern": {
+          "description": "Regular expression the resource name must match, empty for any",
+          "type": "string"
+        },
+        "Priority": {
+          "description": "Rules are evaluated in ascending priority",
+          "type": "integer"
+        },
+        "Region": {
+          "description": "Region to match, empty for any",
+          "type": "string"
+        },
+        "ResourceType": {
+          "description": "Resource type to match, empty for any",
+          "type": "string"
+        },
+        "Tags": {
+          "description": "Metadata added to the event by the tag action",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FilterMatch"
+          },
+          "x-go-custom-tag": "gorm:\"serializer:json\""
+        }
+      }
+    },
+    "InventoryChange": {
+      "type": "object",
+      "properties": {
+        "After": {
+          "$ref": "#/definitions/InventoryItem"
+        },
+        "Before": {
+          "$ref": "#/definitions/InventoryItem"
+        }
+      }
+    },
+    "InventoryDiff": {
+      "type": "object",
+      "properties": {
+        "Account": {
+          "type": "string"
+        },
+        "Added": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/InventoryItem"
+          }
+        },
+        "Changed": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/InventoryChange"
+          }
+        },
+        "From": {
+          "type": "integer"
+        },
+        "Removed": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/InventoryItem"
+          }
+        },
+        "To": {
+          "type": "integer"
+        }
+      }
+    },
+    "InventoryItem": {
+      "type": "object",
+      "properties": {
+        "Account": {
+          "type": "string"
+        },
+        "MetaData": {
+          "$ref": "#/definitions/Metadata"
+        },
+        "Region": {
+          "type": "string"
+        },
+        "ResourceId": {
+          "type": "string"
+        },
+        "ResourceName": {
+          "type": "string"
+        },
+        "ResourceType": {
+          "type": "string"
+        },
+        "Since": {
+          "type": "integer"
+        },
+        "State": {
+          "type": "string",
+          "enum": [
+            "active",
+            "error",
+            "inactive",
+            "suspended"
+          ]
+        }
+      }
+    },
+    "ItemCreatedResponse": {
+      "properties": {
+        "ApiLink": {
+          "type": "string"
+        },
+        "Message": {
+          "type": "string"
+        }
       }
     },
-    "/usage/{id}": {
-      "get": {
-        "security": [
-          {
-            "Keycloak": [
-              "user"
-            ]
-          },
-          {
-            "APIKeyHeader": []
-          },
-          {
-            "APIKeyParam": []
-          }
-        ],
-        "produces": [
-          "application/json"
-        ],
-        "tags": [
-          "usageManagement"
-        ],
-        "summary": "Generates an aggregated response of the usage recorded in the system during the time-window specified for the selected account",
-        "operationId": "getUsage",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "Id of the account to be checked",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "integer",
-            "description": "Datetime from which to get the usage report",
-            "name": "from",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "description": "Datetime until which to get the usage report",
-            "name": "to",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Resource type to filter the usage",
-            "name": "resource",
-            "in": "query"
+    "Lifecycle": {
+      "type": "object",
+      "required": [
+        "ResourceType",
+        "States"
+      ],
+      "properties": {
+        "ResourceType": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "States": {
+          "type": "array",
+          "items": {
+            "type": "string"
           },
-          {
-            "type": "string",
-            "description": "Resource region to filter the usage",
-            "name": "region",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Description of a successfully operation",
-            "schema": {
-              "$ref": "#/definitions/Usage"
-            }
+          "x-go-custom-tag": "gorm:\"serializer:json\""
+        },
+        "Terminal": {
+          "type": "array",
+          "items": {
+            "type": "string"
           },
-          "404": {
-            "description": "Item not found in the system",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "x-go-custom-tag": "gorm:\"serializer:json\""
+        },
+        "Transitions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/LifecycleTransition"
           },
-          "500": {
-            "description": "Something unexpected happend, error raised",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
+          "x-go-custom-tag": "gorm:\"serializer:json\""
         }
       }
-    }
-  },
-  "definitions": {
-    "ErrorResponse": {
+    },
+    "LifecycleTransition": {
       "type": "object",
-      "required": [
-        "errorString"
-      ],
       "properties": {
-        "errorString": {
+        "From": {
+          "type": "string"
+        },
+        "To": {
           "type": "string"
         }
       }
     },
-    "Event": {
+    "Metadata": {
+      "type": "object",
+      "x-go-type": {
+        "import": {
+          "package": "gitlab.com/cyclops-utilities/datamodels"
+        },
+        "type": "JSONdb"
+      }
+    },
+    "MinimalState": {
+      "type": "object",
+      "properties": {
+        "Account": {
+          "type": "string"
+        },
+        "MetaData": {
+          "x-go-custom-tag": "gorm:\"type:jsonb\"",
+          "$ref": "#/definitions/Metadata"
+        },
+        "ResourceId": {
+          "type": "string"
+        },
+        "ResourceName": {
+          "type": "string"
+        }
+      }
+    },
+    "QuarantinedEvent": {
       "type": "object",
-      "required": [
-        "EventTime",
-        "LastEvent"
-      ],
       "properties": {
         "Account": {
           "type": "string",
@@ -1435,19 +5190,18 @@ func init() {
           "x-go-custom-tag": "gorm:\"primary_key;auto_increment\""
         },
         "LastEvent": {
-          "type": "string",
-          "enum": [
-            "active",
-            "error",
-            "inactive",
-            "terminated",
-            "suspended"
-          ]
+          "type": "string"
         },
         "MetaData": {
           "x-go-custom-tag": "gorm:\"type:jsonb\"",
           "$ref": "#/definitions/Metadata"
         },
+        "Reason": {
+          "type": "string"
+        },
+        "ReceivedAt": {
+          "type": "integer"
+        },
         "Region": {
           "type": "string"
         },
@@ -1458,52 +5212,140 @@ func init() {
           "type": "string"
         },
         "ResourceType": {
-          "type": "string"
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "RawEvent": {
+      "type": "object",
+      "properties": {
+        "Account": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
         },
-        "TimeFrom": {
+        "EventTime": {
           "type": "integer",
           "x-go-custom-tag": "gorm:\"index\""
         },
-        "TimeTo": {
+        "ID": {
           "type": "integer",
+          "x-go-custom-tag": "gorm:\"primary_key;auto_increment\""
+        },
+        "LastEvent": {
+          "type": "string"
+        },
+        "MetaData": {
+          "x-go-custom-tag": "gorm:\"type:jsonb\"",
+          "$ref": "#/definitions/Metadata"
+        },
+        "ReceivedAt": {
+          "type": "integer"
+        },
+        "Region": {
+          "type": "string"
+        },
+        "ResourceId": {
+          "type": "string",
           "x-go-custom-tag": "gorm:\"index\""
+        },
+        "ResourceName": {
+          "type": "string"
+        },
+        "ResourceType": {
+          "type": "string"
         }
       }
     },
-    "ItemCreatedResponse": {
+    "RebuildDifference": {
+      "type": "object",
       "properties": {
-        "ApiLink": {
-          "type": "string"
+        "After": {
+          "$ref": "#/definitions/State"
         },
-        "Message": {
-          "type": "string"
+        "Before": {
+          "$ref": "#/definitions/State"
+        },
+        "Change": {
+          "type": "string",
+          "enum": [
+            "added",
+            "changed",
+            "removed"
+          ]
+        },
+        "Kind": {
+          "type": "string",
+          "enum": [
+            "history",
+            "state"
+          ]
         }
       }
     },
-    "Metadata": {
+    "RebuildReport": {
       "type": "object",
-      "x-go-type": {
-        "import": {
-          "package": "gitlab.com/cyclops-utilities/datamodels"
+      "properties": {
+        "Account": {
+          "type": "string"
         },
-        "type": "JSONdb"
+        "Applied": {
+          "type": "boolean"
+        },
+        "Differences": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RebuildDifference"
+          }
+        },
+        "Events": {
+          "type": "integer"
+        },
+        "Histories": {
+          "type": "integer"
+        },
+        "LogStart": {
+          "type": "integer"
+        },
+        "ResourceId": {
+          "type": "string"
+        },
+        "Skipped": {
+          "type": "integer"
+        },
+        "States": {
+          "type": "integer"
+        }
       }
     },
-    "MinimalState": {
+    "Recomputation": {
       "type": "object",
       "properties": {
         "Account": {
           "type": "string"
         },
-        "MetaData": {
-          "x-go-custom-tag": "gorm:\"type:jsonb\"",
-          "$ref": "#/definitions/Metadata"
+        "CreatedAt": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "ID": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primary_key;auto_increment\""
+        },
+        "Region": {
+          "type": "string"
         },
         "ResourceId": {
           "type": "string"
         },
-        "ResourceName": {
+        "ResourceType": {
           "type": "string"
+        },
+        "TimeFrom": {
+          "type": "integer"
+        },
+        "TimeTo": {
+          "type": "integer"
         }
       }
     },
@@ -1516,7 +5358,7 @@ func init() {
       "properties": {
         "Account": {
           "type": "string",
-          "x-go-custom-tag": "gorm:\"index\""
+          "x-go-custom-tag": "gorm:\"index;index:idx_states_usage,priority:1\""
         },
         "EventTime": {
           "type": "integer"
@@ -1553,11 +5395,11 @@ func init() {
         },
         "TimeFrom": {
           "type": "integer",
-          "x-go-custom-tag": "gorm:\"index\""
+          "x-go-custom-tag": "gorm:\"index;index:idx_states_usage,priority:2\""
         },
         "TimeTo": {
           "type": "integer",
-          "x-go-custom-tag": "gorm:\"index\""
+          "x-go-custom-tag": "gorm:\"index;index:idx_states_usage,priority:3\""
         }
       }
     },
@@ -1687,6 +5529,18 @@ func init() {
       "description": "Actions relating to the adquisition of events in the system",
       "name": "eventManagement"
     },
+    {
+      "description": "Actions relating to the adquisition of CloudEvents in the system",
+      "name": "cloudEventManagement"
+    },
+    {
+      "description": "Actions relating to the filtering of the events in the system",
+      "name": "filterManagement"
+    },
+    {
+      "description": "Actions relating to the lifecycle of the resources in the system",
+      "name": "lifecycleManagement"
+    },
     {
       "description": "Actions relating to the reporting of the usages in the system",
       "name": "usageManagement"
@@ -19,7 +19,10 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/GoDieNow/TFT_Code/services/eventsengine/restapi/operations/cloud_event_management"
 	"github.com/GoDieNow/TFT_Code/services/eventsengine/restapi/operations/event_management"
+	"github.com/GoDieNow/TFT_Code/services/eventsengine/restapi/operations/filter_management"
+	"github.com/GoDieNow/TFT_Code/services/eventsengine/restapi/operations/lifecycle_management"
 	"github.com/GoDieNow/TFT_Code/services/eventsengine/restapi/operations/status_management"
 	"github.com/GoDieNow/TFT_Code/services/eventsengine/restapi/operations/trigger_management"
 	"github.com/GoDieNow/TFT_Code/services/eventsengine/restapi/operations/usage_management"
@@ -43,19 +46,57 @@ func NewEventEngineManagementAPIAPI(spec *loads.Document) *EventEngineManagement
 		APIKeyAuthenticator: security.APIKeyAuth,
 		BearerAuthenticator: security.BearerAuth,
 
+		BinConsumer:  runtime.ByteStreamConsumer(),
 		JSONConsumer: runtime.JSONConsumer(),
+		TxtConsumer:  runtime.TextConsumer(),
 
 		JSONProducer: runtime.JSONProducer(),
 
+		CloudEventManagementAddCloudEventHandler: cloud_event_management.AddCloudEventHandlerFunc(func(params cloud_event_management.AddCloudEventParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cloud_event_management.AddCloudEvent has not yet been implemented")
+		}),
+		EventManagementAddDiscreteEventHandler: event_management.AddDiscreteEventHandlerFunc(func(params event_management.AddDiscreteEventParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation event_management.AddDiscreteEvent has not yet been implemented")
+		}),
 		EventManagementAddEventHandler: event_management.AddEventHandlerFunc(func(params event_management.AddEventParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation event_management.AddEvent has not yet been implemented")
 		}),
+		CloudEventManagementCreateCloudEventMappingHandler: cloud_event_management.CreateCloudEventMappingHandlerFunc(func(params cloud_event_management.CreateCloudEventMappingParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cloud_event_management.CreateCloudEventMapping has not yet been implemented")
+		}),
+		FilterManagementCreateFilterRuleHandler: filter_management.CreateFilterRuleHandlerFunc(func(params filter_management.CreateFilterRuleParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation filter_management.CreateFilterRule has not yet been implemented")
+		}),
+		LifecycleManagementCreateLifecycleHandler: lifecycle_management.CreateLifecycleHandlerFunc(func(params lifecycle_management.CreateLifecycleParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation lifecycle_management.CreateLifecycle has not yet been implemented")
+		}),
+		CloudEventManagementDeleteCloudEventMappingHandler: cloud_event_management.DeleteCloudEventMappingHandlerFunc(func(params cloud_event_management.DeleteCloudEventMappingParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cloud_event_management.DeleteCloudEventMapping has not yet been implemented")
+		}),
+		FilterManagementDeleteFilterRuleHandler: filter_management.DeleteFilterRuleHandlerFunc(func(params filter_management.DeleteFilterRuleParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation filter_management.DeleteFilterRule has not yet been implemented")
+		}),
 		TriggerManagementExecSampleHandler: trigger_management.ExecSampleHandlerFunc(func(params trigger_management.ExecSampleParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation trigger_management.ExecSample has not yet been implemented")
 		}),
+		CloudEventManagementGetCloudEventMappingHandler: cloud_event_management.GetCloudEventMappingHandlerFunc(func(params cloud_event_management.GetCloudEventMappingParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cloud_event_management.GetCloudEventMapping has not yet been implemented")
+		}),
+		FilterManagementGetFilterRuleHandler: filter_management.GetFilterRuleHandlerFunc(func(params filter_management.GetFilterRuleParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation filter_management.GetFilterRule has not yet been implemented")
+		}),
 		EventManagementGetHistoryHandler: event_management.GetHistoryHandlerFunc(func(params event_management.GetHistoryParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation event_management.GetHistory has not yet been implemented")
 		}),
+		EventManagementGetInventoryHandler: event_management.GetInventoryHandlerFunc(func(params event_management.GetInventoryParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation event_management.GetInventory has not yet been implemented")
+		}),
+		EventManagementGetInventoryDiffHandler: event_management.GetInventoryDiffHandlerFunc(func(params event_management.GetInventoryDiffParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation event_management.GetInventoryDiff has not yet been implemented")
+		}),
+		LifecycleManagementGetLifecycleHandler: lifecycle_management.GetLifecycleHandlerFunc(func(params lifecycle_management.GetLifecycleParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation lifecycle_management.GetLifecycle has not yet been implemented")
+		}),
 		EventManagementGetStateHandler: event_management.GetStateHandlerFunc(func(params event_management.GetStateParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation event_management.GetState has not yet been implemented")
 		}),
@@ -68,12 +109,45 @@ func NewEventEngineManagementAPIAPI(spec *loads.Document) *EventEngineManagement
 		UsageManagementGetUsageHandler: usage_management.GetUsageHandlerFunc(func(params usage_management.GetUsageParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation usage_management.GetUsage has not yet been implemented")
 		}),
+		CloudEventManagementListCloudEventMappingsHandler: cloud_event_management.ListCloudEventMappingsHandlerFunc(func(params cloud_event_management.ListCloudEventMappingsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cloud_event_management.ListCloudEventMappings has not yet been implemented")
+		}),
+		EventManagementListDiscreteEventsHandler: event_management.ListDiscreteEventsHandlerFunc(func(params event_management.ListDiscreteEventsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation event_management.ListDiscreteEvents has not yet been implemented")
+		}),
+		FilterManagementListFilterRulesHandler: filter_management.ListFilterRulesHandlerFunc(func(params filter_management.ListFilterRulesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation filter_management.ListFilterRules has not yet been implemented")
+		}),
+		LifecycleManagementListLifecyclesHandler: lifecycle_management.ListLifecyclesHandlerFunc(func(params lifecycle_management.ListLifecyclesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation lifecycle_management.ListLifecycles has not yet been implemented")
+		}),
+		EventManagementListQuarantinedEventsHandler: event_management.ListQuarantinedEventsHandlerFunc(func(params event_management.ListQuarantinedEventsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation event_management.ListQuarantinedEvents has not yet been implemented")
+		}),
+		EventManagementListRecomputationsHandler: event_management.ListRecomputationsHandlerFunc(func(params event_management.ListRecomputationsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation event_management.ListRecomputations has not yet been implemented")
+		}),
 		EventManagementListStatesHandler: event_management.ListStatesHandlerFunc(func(params event_management.ListStatesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation event_management.ListStates has not yet been implemented")
 		}),
+		EventManagementRebuildStatesHandler: event_management.RebuildStatesHandlerFunc(func(params event_management.RebuildStatesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation event_management.RebuildStates has not yet been implemented")
+		}),
+		FilterManagementReloadFilterRulesHandler: filter_management.ReloadFilterRulesHandlerFunc(func(params filter_management.ReloadFilterRulesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation filter_management.ReloadFilterRules has not yet been implemented")
+		}),
 		StatusManagementShowStatusHandler: status_management.ShowStatusHandlerFunc(func(params status_management.ShowStatusParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation status_management.ShowStatus has not yet been implemented")
 		}),
+		CloudEventManagementUpdateCloudEventMappingHandler: cloud_event_management.UpdateCloudEventMappingHandlerFunc(func(params cloud_event_management.UpdateCloudEventMappingParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cloud_event_management.UpdateCloudEventMapping has not yet been implemented")
+		}),
+		FilterManagementUpdateFilterRuleHandler: filter_management.UpdateFilterRuleHandlerFunc(func(params filter_management.UpdateFilterRuleParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation filter_management.UpdateFilterRule has not yet been implemented")
+		}),
+		LifecycleManagementUpdateLifecycleHandler: lifecycle_management.UpdateLifecycleHandlerFunc(func(params lifecycle_management.UpdateLifecycleParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation lifecycle_management.UpdateLifecycle has not yet been implemented")
+		}),
 
 		// Applies when the "X-API-KEY" header is set
 		APIKeyHeaderAuth: func(token string) (interface{}, error) {
@@ -114,9 +188,16 @@ type EventEngineManagementAPIAPI struct {
 	// It has a default implementation in the security package, however you can replace it for your particular usage.
 	BearerAuthenticator func(string, security.ScopedTokenAuthentication) runtime.Authenticator
 
+	// BinConsumer registers a consumer for the following mime types:
+	//   - application/octet-stream
+	BinConsumer runtime.Consumer
 	// JSONConsumer registers a consumer for the following mime types:
+	//   - application/cloudevents+json
 	//   - application/json
 	JSONConsumer runtime.Consumer
+	// TxtConsumer registers a consumer for the following mime types:
+	//   - text/plain
+	TxtConsumer runtime.Consumer
 
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
@@ -137,12 +218,36 @@ type EventEngineManagementAPIAPI struct {
 	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
 	APIAuthorizer runtime.Authorizer
 
+	// CloudEventManagementAddCloudEventHandler sets the operation handler for the add cloud event operation
+	CloudEventManagementAddCloudEventHandler cloud_event_management.AddCloudEventHandler
+	// EventManagementAddDiscreteEventHandler sets the operation handler for the add discrete event operation
+	EventManagementAddDiscreteEventHandler event_management.AddDiscreteEventHandler
 	// EventManagementAddEventHandler sets the operation handler for the add event operation
 	EventManagementAddEventHandler event_management.AddEventHandler
+	// CloudEventManagementCreateCloudEventMappingHandler sets the operation handler for the create cloud event mapping operation
+	CloudEventManagementCreateCloudEventMappingHandler cloud_event_management.CreateCloudEventMappingHandler
+	// FilterManagementCreateFilterRuleHandler sets the operation handler for the create filter rule operation
+	FilterManagementCreateFilterRuleHandler filter_management.CreateFilterRuleHandler
+	// LifecycleManagementCreateLifecycleHandler sets the operation handler for the create lifecycle operation
+	LifecycleManagementCreateLifecycleHandler lifecycle_management.CreateLifecycleHandler
+	// CloudEventManagementDeleteCloudEventMappingHandler sets the operation handler for the delete cloud event mapping operation
+	CloudEventManagementDeleteCloudEventMappingHandler cloud_event_management.DeleteCloudEventMappingHandler
+	// FilterManagementDeleteFilterRuleHandler sets the operation handler for the delete filter rule operation
+	FilterManagementDeleteFilterRuleHandler filter_management.DeleteFilterRuleHandler
 	// TriggerManagementExecSampleHandler sets the operation handler for the exec sample operation
 	TriggerManagementExecSampleHandler trigger_management.ExecSampleHandler
+	// CloudEventManagementGetCloudEventMappingHandler sets the operation handler for the get cloud event mapping operation
+	CloudEventManagementGetCloudEventMappingHandler cloud_event_management.GetCloudEventMappingHandler
+	// FilterManagementGetFilterRuleHandler sets the operation handler for the get filter rule operation
+	FilterManagementGetFilterRuleHandler filter_management.GetFilterRuleHandler
 	// EventManagementGetHistoryHandler sets the operation handler for the get history operation
 	EventManagementGetHistoryHandler event_management.GetHistoryHandler
+	// EventManagementGetInventoryHandler sets the operation handler for the get inventory operation
+	EventManagementGetInventoryHandler event_management.GetInventoryHandler
+	// EventManagementGetInventoryDiffHandler sets the operation handler for the get inventory diff operation
+	EventManagementGetInventoryDiffHandler event_management.GetInventoryDiffHandler
+	// LifecycleManagementGetLifecycleHandler sets the operation handler for the get lifecycle operation
+	LifecycleManagementGetLifecycleHandler lifecycle_management.GetLifecycleHandler
 	// EventManagementGetStateHandler sets the operation handler for the get state operation
 	EventManagementGetStateHandler event_management.GetStateHandler
 	// StatusManagementGetStatusHandler sets the operation handler for the get status operation
@@ -151,10 +256,32 @@ type EventEngineManagementAPIAPI struct {
 	UsageManagementGetSystemUsageHandler usage_management.GetSystemUsageHandler
 	// UsageManagementGetUsageHandler sets the operation handler for the get usage operation
 	UsageManagementGetUsageHandler usage_management.GetUsageHandler
+	// CloudEventManagementListCloudEventMappingsHandler sets the operation handler for the list cloud event mappings operation
+	CloudEventManagementListCloudEventMappingsHandler cloud_event_management.ListCloudEventMappingsHandler
+	// EventManagementListDiscreteEventsHandler sets the operation handler for the list discrete events operation
+	EventManagementListDiscreteEventsHandler event_management.ListDiscreteEventsHandler
+	// FilterManagementListFilterRulesHandler sets the operation handler for the list filter rules operation
+	FilterManagementListFilterRulesHandler filter_management.ListFilterRulesHandler
+	// LifecycleManagementListLifecyclesHandler sets the operation handler for the list lifecycles operation
+	LifecycleManagementListLifecyclesHandler lifecycle_management.ListLifecyclesHandler
+	// EventManagementListQuarantinedEventsHandler sets the operation handler for the list quarantined events operation
+	EventManagementListQuarantinedEventsHandler event_management.ListQuarantinedEventsHandler
+	// EventManagementListRecomputationsHandler sets the operation handler for the list recomputations operation
+	EventManagementListRecomputationsHandler event_management.ListRecomputationsHandler
 	// EventManagementListStatesHandler sets the operation handler for the list states operation
 	EventManagementListStatesHandler event_management.ListStatesHandler
+	// EventManagementRebuildStatesHandler sets the operation handler for the rebuild states operation
+	EventManagementRebuildStatesHandler event_management.RebuildStatesHandler
+	// FilterManagementReloadFilterRulesHandler sets the operation handler for the reload filter rules operation
+	FilterManagementReloadFilterRulesHandler filter_management.ReloadFilterRulesHandler
 	// StatusManagementShowStatusHandler sets the operation handler for the show status operation
 	StatusManagementShowStatusHandler status_management.ShowStatusHandler
+	// CloudEventManagementUpdateCloudEventMappingHandler sets the operation handler for the update cloud event mapping operation
+	CloudEventManagementUpdateCloudEventMappingHandler cloud_event_management.UpdateCloudEventMappingHandler
+	// FilterManagementUpdateFilterRuleHandler sets the operation handler for the update filter rule operation
+	FilterManagementUpdateFilterRuleHandler filter_management.UpdateFilterRuleHandler
+	// LifecycleManagementUpdateLifecycleHandler sets the operation handler for the update lifecycle operation
+	LifecycleManagementUpdateLifecycleHandler lifecycle_management.UpdateLifecycleHandler
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
 	ServeError func(http.ResponseWriter, *http.Request, error)
@@ -223,9 +350,15 @@ func (o *EventEngineManagementAPIAPI) RegisterFormat(name string, format strfmt.
 func (o *EventEngineManagementAPIAPI) Validate() error {
 	var unregistered []string
 
+	if o.BinConsumer == nil {
+		unregistered = append(unregistered, "BinConsumer")
+	}
 	if o.JSONConsumer == nil {
 		unregistered = append(unregistered, "JSONConsumer")
 	}
+	if o.TxtConsumer == nil {
+		unregistered = append(unregistered, "TxtConsumer")
+	}
 
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
@@ -241,15 +374,51 @@ func (o *EventEngineManagementAPIAPI) Validate() error {
 		unregistered = append(unregistered, "KeycloakAuth")
 	}
 
+	if o.CloudEventManagementAddCloudEventHandler == nil {
+		unregistered = append(unregistered, "cloud_event_management.AddCloudEventHandler")
+	}
+	if o.EventManagementAddDiscreteEventHandler == nil {
+		unregistered = append(unregistered, "event_management.AddDiscreteEventHandler")
+	}
 	if o.EventManagementAddEventHandler == nil {
 		unregistered = append(unregistered, "event_management.AddEventHandler")
 	}
+	if o.CloudEventManagementCreateCloudEventMappingHandler == nil {
+		unregistered = append(unregistered, "cloud_event_management.CreateCloudEventMappingHandler")
+	}
+	if o.FilterManagementCreateFilterRuleHandler == nil {
+		unregistered = append(unregistered, "filter_management.CreateFilterRuleHandler")
+	}
+	if o.LifecycleManagementCreateLifecycleHandler == nil {
+		unregistered = append(unregistered, "lifecycle_management.CreateLifecycleHandler")
+	}
+	if o.CloudEventManagementDeleteCloudEventMappingHandler == nil {
+		unregistered = append(unregistered, "cloud_event_management.DeleteCloudEventMappingHandler")
+	}
+	if o.FilterManagementDeleteFilterRuleHandler == nil {
+		unregistered = append(unregistered, "filter_management.DeleteFilterRuleHandler")
+	}
 	if o.TriggerManagementExecSampleHandler == nil {
 		unregistered = append(unregistered, "trigger_management.ExecSampleHandler")
 	}
+	if o.CloudEventManagementGetCloudEventMappingHandler == nil {
+		unregistered = append(unregistered, "cloud_event_management.GetCloudEventMappingHandler")
+	}
+	if o.FilterManagementGetFilterRuleHandler == nil {
+		unregistered = append(unregistered, "filter_management.GetFilterRuleHandler")
+	}
 	if o.EventManagementGetHistoryHandler == nil {
 		unregistered = append(unregistered, "event_management.GetHistoryHandler")
 	}
+	if o.EventManagementGetInventoryHandler == nil {
+		unregistered = append(unregistered, "event_management.GetInventoryHandler")
+	}
+	if o.EventManagementGetInventoryDiffHandler == nil {
+		unregistered = append(unregistered, "event_management.GetInventoryDiffHandler")
+	}
+	if o.LifecycleManagementGetLifecycleHandler == nil {
+		unregistered = append(unregistered, "lifecycle_management.GetLifecycleHandler")
+	}
 	if o.EventManagementGetStateHandler == nil {
 		unregistered = append(unregistered, "event_management.GetStateHandler")
 	}
@@ -262,12 +431,45 @@ func (o *EventEngineManagementAPIAPI) Validate() error {
 	if o.UsageManagementGetUsageHandler == nil {
 		unregistered = append(unregistered, "usage_management.GetUsageHandler")
 	}
+	if o.CloudEventManagementListCloudEventMappingsHandler == nil {
+		unregistered = append(unregistered, "cloud_event_management.ListCloudEventMappingsHandler")
+	}
+	if o.EventManagementListDiscreteEventsHandler == nil {
+		unregistered = append(unregistered, "event_management.ListDiscreteEventsHandler")
+	}
+	if o.FilterManagementListFilterRulesHandler == nil {
+		unregistered = append(unregistered, "filter_management.ListFilterRulesHandler")
+	}
+	if o.LifecycleManagementListLifecyclesHandler == nil {
+		unregistered = append(unregistered, "lifecycle_management.ListLifecyclesHandler")
+	}
+	if o.EventManagementListQuarantinedEventsHandler == nil {
+		unregistered = append(unregistered, "event_management.ListQuarantinedEventsHandler")
+	}
+	if o.EventManagementListRecomputationsHandler == nil {
+		unregistered = append(unregistered, "event_management.ListRecomputationsHandler")
+	}
 	if o.EventManagementListStatesHandler == nil {
 		unregistered = append(unregistered, "event_management.ListStatesHandler")
 	}
+	if o.EventManagementRebuildStatesHandler == nil {
+		unregistered = append(unregistered, "event_management.RebuildStatesHandler")
+	}
+	if o.FilterManagementReloadFilterRulesHandler == nil {
+		unregistered = append(unregistered, "filter_management.ReloadFilterRulesHandler")
+	}
 	if o.StatusManagementShowStatusHandler == nil {
 		unregistered = append(unregistered, "status_management.ShowStatusHandler")
 	}
+	if o.CloudEventManagementUpdateCloudEventMappingHandler == nil {
+		unregistered = append(unregistered, "cloud_event_management.UpdateCloudEventMappingHandler")
+	}
+	if o.FilterManagementUpdateFilterRuleHandler == nil {
+		unregistered = append(unregistered, "filter_management.UpdateFilterRuleHandler")
+	}
+	if o.LifecycleManagementUpdateLifecycleHandler == nil {
+		unregistered = append(unregistered, "lifecycle_management.UpdateLifecycleHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -313,8 +515,14 @@ func (o *EventEngineManagementAPIAPI) ConsumersFor(mediaTypes []string) map[stri
 	result := make(map[string]runtime.Consumer, len(mediaTypes))
 	for _, mt := range mediaTypes {
 		switch mt {
+		case "application/cloudevents+json":
+			result["application/cloudevents+json"] = o.JSONConsumer
 		case "application/json":
 			result["application/json"] = o.JSONConsumer
+		case "application/octet-stream":
+			result["application/octet-stream"] = o.BinConsumer
+		case "text/plain":
+			result["text/plain"] = o.TxtConsumer
 		}
 
 		if c, ok := o.customConsumers[mt]; ok {
@@ -372,10 +580,38 @@ func (o *EventEngineManagementAPIAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/event/cloudevent"] = cloud_event_management.NewAddCloudEvent(o.context, o.CloudEventManagementAddCloudEventHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/event/discrete"] = event_management.NewAddDiscreteEvent(o.context, o.EventManagementAddDiscreteEventHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/event"] = event_management.NewAddEvent(o.context, o.EventManagementAddEventHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/cloudevent/mapping"] = cloud_event_management.NewCreateCloudEventMapping(o.context, o.CloudEventManagementCreateCloudEventMappingHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/filter"] = filter_management.NewCreateFilterRule(o.context, o.FilterManagementCreateFilterRuleHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/lifecycle"] = lifecycle_management.NewCreateLifecycle(o.context, o.LifecycleManagementCreateLifecycleHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/cloudevent/mapping/{id}"] = cloud_event_management.NewDeleteCloudEventMapping(o.context, o.CloudEventManagementDeleteCloudEventMappingHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/filter/{id}"] = filter_management.NewDeleteFilterRule(o.context, o.FilterManagementDeleteFilterRuleHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -383,10 +619,30 @@ func (o *EventEngineManagementAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/cloudevent/mapping/{id}"] = cloud_event_management.NewGetCloudEventMapping(o.context, o.CloudEventManagementGetCloudEventMappingHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/filter/{id}"] = filter_management.NewGetFilterRule(o.context, o.FilterManagementGetFilterRuleHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/event/history/{account}"] = event_management.NewGetHistory(o.context, o.EventManagementGetHistoryHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/event/inventory/{account}"] = event_management.NewGetInventory(o.context, o.EventManagementGetInventoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/event/inventory/{account}/diff"] = event_management.NewGetInventoryDiff(o.context, o.EventManagementGetInventoryDiffHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/lifecycle/{type}"] = lifecycle_management.NewGetLifecycle(o.context, o.LifecycleManagementGetLifecycleHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/event/status/{account}"] = event_management.NewGetState(o.context, o.EventManagementGetStateHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -403,11 +659,55 @@ func (o *EventEngineManagementAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/cloudevent/mapping"] = cloud_event_management.NewListCloudEventMappings(o.context, o.CloudEventManagementListCloudEventMappingsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/event/discrete/{account}"] = event_management.NewListDiscreteEvents(o.context, o.EventManagementListDiscreteEventsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/filter"] = filter_management.NewListFilterRules(o.context, o.FilterManagementListFilterRulesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/lifecycle"] = lifecycle_management.NewListLifecycles(o.context, o.LifecycleManagementListLifecyclesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/event/quarantine"] = event_management.NewListQuarantinedEvents(o.context, o.EventManagementListQuarantinedEventsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/event/recomputations"] = event_management.NewListRecomputations(o.context, o.EventManagementListRecomputationsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/event/status"] = event_management.NewListStates(o.context, o.EventManagementListStatesHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/event/rebuild"] = event_management.NewRebuildStates(o.context, o.EventManagementRebuildStatesHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/filter/reload"] = filter_management.NewReloadFilterRules(o.context, o.FilterManagementReloadFilterRulesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/status"] = status_management.NewShowStatus(o.context, o.StatusManagementShowStatusHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/cloudevent/mapping/{id}"] = cloud_event_management.NewUpdateCloudEventMapping(o.context, o.CloudEventManagementUpdateCloudEventMappingHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/filter/{id}"] = filter_management.NewUpdateFilterRule(o.context, o.FilterManagementUpdateFilterRuleHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/lifecycle/{type}"] = lifecycle_management.NewUpdateLifecycle(o.context, o.LifecycleManagementUpdateLifecycleHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -57,6 +57,50 @@ func (o *AddEventCreated) WriteResponse(rw http.ResponseWriter, producer runtime
 	}
 }
 
+// AddEventAcceptedCode is the HTTP code returned for type AddEventAccepted
+const AddEventAcceptedCode int = 202
+
+/*AddEventAccepted Item filtered by a rule, dropped or quarantined
+
+swagger:response addEventAccepted
+*/
+type AddEventAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ItemCreatedResponse `json:"body,omitempty"`
+}
+
+// NewAddEventAccepted creates AddEventAccepted with default headers values
+func NewAddEventAccepted() *AddEventAccepted {
+
+	return &AddEventAccepted{}
+}
+
+// WithPayload adds the payload to the add event accepted response
+func (o *AddEventAccepted) WithPayload(payload *models.ItemCreatedResponse) *AddEventAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add event accepted response
+func (o *AddEventAccepted) SetPayload(payload *models.ItemCreatedResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddEventAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // AddEventBadRequestCode is the HTTP code returned for type AddEventBadRequest
 const AddEventBadRequestCode int = 400
 
@@ -65,6 +109,11 @@ const AddEventBadRequestCode int = 400
 swagger:response addEventBadRequest
 */
 type AddEventBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
 }
 
 // NewAddEventBadRequest creates AddEventBadRequest with default headers values
@@ -73,12 +122,27 @@ func NewAddEventBadRequest() *AddEventBadRequest {
 	return &AddEventBadRequest{}
 }
 
+// WithPayload adds the payload to the add event bad request response
+func (o *AddEventBadRequest) WithPayload(payload *models.ErrorResponse) *AddEventBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add event bad request response
+func (o *AddEventBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
 // WriteResponse to the client
 func (o *AddEventBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
-	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
-
 	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
 }
 
 // AddEventInternalServerErrorCode is the HTTP code returned for type AddEventInternalServerError
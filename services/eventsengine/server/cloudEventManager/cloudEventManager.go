@@ -0,0 +1,732 @@
+package cloudEventManager
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	kafka "github.com/segmentio/kafka-go"
+	"github.com/GoDieNow/TFT_Code/services/eventsengine/models"
+	"github.com/GoDieNow/TFT_Code/services/eventsengine/restapi/operations/cloud_event_management"
+	"github.com/GoDieNow/TFT_Code/services/eventsengine/server/dbManager"
+	"github.com/GoDieNow/TFT_Code/services/eventsengine/server/statusManager"
+	l "gitlab.com/cyclops-utilities/logging"
+)
+
+const (
+	batchMediaType      = "application/cloudevents-batch+json"
+	structuredMediaType = "application/cloudevents+json"
+)
+
+// CloudEventManager is the struct defined to group and contain all the methods
+// that interact with the CloudEvents endpoints.
+// Parameters:
+// - basePath: a string with the base path of the system.
+// - db: a DbParameter reference to be able to use the DBManager methods.
+// - monit: a StatusManager reference to be able to use the status subsystem methods.
+type CloudEventManager struct {
+	basePath string
+	db       *dbManager.DbParameter
+	monit    *statusManager.StatusManager
+}
+
+// New is the function to create the struct CloudEventManager that grant
+// access to the methods to interact with the CloudEvents endpoints.
+// Parameters:
+// - db: a reference to the DbParameter to be able to interact with the db methods.
+// - monit: a reference to the StatusManager to be able to interact with the
+// status subsystem.
+// - bp: a string containing the base path of the service.
+// Returns:
+// - CloudEventManager: struct to interact with the CloudEvents endpoints functionalities.
+func New(db *dbManager.DbParameter, monit *statusManager.StatusManager, bp string) *CloudEventManager {
+
+	l.Trace.Printf("[CloudEventManager] Generating new CloudEventManager.\n")
+
+	monit.InitEndpoint("cloudevent")
+
+	return &CloudEventManager{
+		basePath: bp,
+		db:       db,
+		monit:    monit,
+	}
+
+}
+
+// AddCloudEvent (Swagger func) is the function behind the (POST) endpoint
+// /event/cloudevent
+// Its job is to include in the system the CloudEvent provided, either in
+// structured or in binary mode, mapped into an event.
+func (m *CloudEventManager) AddCloudEvent(ctx context.Context, params cloud_event_management.AddCloudEventParams) middleware.Responder {
+
+	l.Trace.Printf("[CloudEventManager] AddCloudEvent endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("cloudevent", callTime)
+
+	defer params.Event.Close()
+
+	body, e := io.ReadAll(params.Event)
+
+	if e == nil {
+
+		var ce *dbManager.CloudEvent
+
+		if ce, e = ParseHTTP(params.HTTPRequest.Header, body); e == nil {
+
+			e = m.db.AddCloudEvent(ce)
+
+		}
+
+	}
+
+	if errors.Is(e, dbManager.ErrEventFiltered) {
+
+		acceptedReturn := models.ItemCreatedResponse{
+			Message: "CloudEvent not registered in the system, " + e.Error(),
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "202", "method": "POST", "route": "/event/cloudevent"}).Inc()
+
+		m.monit.APIHitDone("cloudevent", callTime)
+
+		return cloud_event_management.NewAddCloudEventAccepted().WithPayload(&acceptedReturn)
+
+	}
+
+	if errors.Is(e, dbManager.ErrCloudEventInvalid) || errors.Is(e, dbManager.ErrCloudEventUnmappable) || errors.Is(e, dbManager.ErrEventQuarantined) {
+
+		s := "The CloudEvent provided cannot be registered: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "400", "method": "POST", "route": "/event/cloudevent"}).Inc()
+
+		m.monit.APIHitDone("cloudevent", callTime)
+
+		return cloud_event_management.NewAddCloudEventBadRequest().WithPayload(&errorReturn)
+
+	}
+
+	if e != nil {
+
+		s := "Error registering the CloudEvent: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "POST", "route": "/event/cloudevent"}).Inc()
+
+		m.monit.APIHitDone("cloudevent", callTime)
+
+		return cloud_event_management.NewAddCloudEventInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	createdReturn := models.ItemCreatedResponse{
+		Message: "New CloudEvent registered in the system.",
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "201", "method": "POST", "route": "/event/cloudevent"}).Inc()
+
+	m.monit.APIHitDone("cloudevent", callTime)
+
+	return cloud_event_management.NewAddCloudEventCreated().WithPayload(&createdReturn)
+
+}
+
+// CreateCloudEventMapping (Swagger func) is the function behind the (POST)
+// endpoint /cloudevent/mapping
+// Its job is to define a new mapping of the CloudEvents of a source.
+func (m *CloudEventManager) CreateCloudEventMapping(ctx context.Context, params cloud_event_management.CreateCloudEventMappingParams) middleware.Responder {
+
+	l.Trace.Printf("[CloudEventManager] CreateCloudEventMapping endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("cloudevent", callTime)
+
+	mapping, e := m.db.CreateCloudEventMapping(params.Mapping)
+
+	if e == nil {
+
+		createdReturn := models.ItemCreatedResponse{
+			APILink: m.basePath + "/cloudevent/mapping/" + strconv.FormatInt(mapping.ID, 10),
+			Message: "New CloudEvents mapping registered in the system.",
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "201", "method": "POST", "route": "/cloudevent/mapping"}).Inc()
+
+		m.monit.APIHitDone("cloudevent", callTime)
+
+		return cloud_event_management.NewCreateCloudEventMappingCreated().WithPayload(&createdReturn)
+
+	}
+
+	if errors.Is(e, dbManager.ErrCloudEventMappingInvalid) {
+
+		s := "The CloudEvents mapping provided is not valid: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "400", "method": "POST", "route": "/cloudevent/mapping"}).Inc()
+
+		m.monit.APIHitDone("cloudevent", callTime)
+
+		return cloud_event_management.NewCreateCloudEventMappingBadRequest().WithPayload(&errorReturn)
+
+	}
+
+	if errors.Is(e, dbManager.ErrCloudEventMappingDuplicated) {
+
+		s := "A CloudEvents mapping with the same name or for the same source and type already exists in the system."
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "409", "method": "POST", "route": "/cloudevent/mapping"}).Inc()
+
+		m.monit.APIHitDone("cloudevent", callTime)
+
+		return cloud_event_management.NewCreateCloudEventMappingConflict().WithPayload(&errorReturn)
+
+	}
+
+	s := "There was an error registering the CloudEvents mapping in the system: " + e.Error()
+	errorReturn := models.ErrorResponse{
+		ErrorString: &s,
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "POST", "route": "/cloudevent/mapping"}).Inc()
+
+	m.monit.APIHitDone("cloudevent", callTime)
+
+	return cloud_event_management.NewCreateCloudEventMappingInternalServerError().WithPayload(&errorReturn)
+
+}
+
+// DeleteCloudEventMapping (Swagger func) is the function behind the (DELETE)
+// endpoint /cloudevent/mapping/{id}
+// Its job is to remove the CloudEvents mapping from the system.
+func (m *CloudEventManager) DeleteCloudEventMapping(ctx context.Context, params cloud_event_management.DeleteCloudEventMappingParams) middleware.Responder {
+
+	l.Trace.Printf("[CloudEventManager] DeleteCloudEventMapping endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("cloudevent", callTime)
+
+	id := strconv.FormatInt(params.ID, 10)
+
+	mapping, e := m.db.DeleteCloudEventMapping(params.ID)
+
+	if e != nil {
+
+		s := "There was an error removing the CloudEvents mapping from the system: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "DELETE", "route": "/cloudevent/mapping/" + id}).Inc()
+
+		m.monit.APIHitDone("cloudevent", callTime)
+
+		return cloud_event_management.NewDeleteCloudEventMappingInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	if mapping == nil {
+
+		s := "The CloudEvents mapping doesn't exist in the system."
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "404", "method": "DELETE", "route": "/cloudevent/mapping/" + id}).Inc()
+
+		m.monit.APIHitDone("cloudevent", callTime)
+
+		return cloud_event_management.NewDeleteCloudEventMappingNotFound().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "DELETE", "route": "/cloudevent/mapping/" + id}).Inc()
+
+	m.monit.APIHitDone("cloudevent", callTime)
+
+	return cloud_event_management.NewDeleteCloudEventMappingOK().WithPayload(mapping)
+
+}
+
+// GetCloudEventMapping (Swagger func) is the function behind the (GET)
+// endpoint /cloudevent/mapping/{id}
+// Its job is to provide the definition of the requested CloudEvents mapping.
+func (m *CloudEventManager) GetCloudEventMapping(ctx context.Context, params cloud_event_management.GetCloudEventMappingParams) middleware.Responder {
+
+	l.Trace.Printf("[CloudEventManager] GetCloudEventMapping endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("cloudevent", callTime)
+
+	id := strconv.FormatInt(params.ID, 10)
+
+	mapping, e := m.db.GetCloudEventMapping(params.ID)
+
+	if e != nil {
+
+		s := "There was an error retrieving the CloudEvents mapping from the system: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/cloudevent/mapping/" + id}).Inc()
+
+		m.monit.APIHitDone("cloudevent", callTime)
+
+		return cloud_event_management.NewGetCloudEventMappingInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	if mapping == nil {
+
+		s := "The CloudEvents mapping doesn't exist in the system."
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "404", "method": "GET", "route": "/cloudevent/mapping/" + id}).Inc()
+
+		m.monit.APIHitDone("cloudevent", callTime)
+
+		return cloud_event_management.NewGetCloudEventMappingNotFound().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/cloudevent/mapping/" + id}).Inc()
+
+	m.monit.APIHitDone("cloudevent", callTime)
+
+	return cloud_event_management.NewGetCloudEventMappingOK().WithPayload(mapping)
+
+}
+
+// ListCloudEventMappings (Swagger func) is the function behind the (GET)
+// endpoint /cloudevent/mapping
+// Its job is to provide the list of CloudEvents mappings defined in the system.
+func (m *CloudEventManager) ListCloudEventMappings(ctx context.Context, params cloud_event_management.ListCloudEventMappingsParams) middleware.Responder {
+
+	l.Trace.Printf("[CloudEventManager] ListCloudEventMappings endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("cloudevent", callTime)
+
+	mappings, e := m.db.ListCloudEventMappings()
+
+	if e == nil {
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/cloudevent/mapping"}).Inc()
+
+		m.monit.APIHitDone("cloudevent", callTime)
+
+		return cloud_event_management.NewListCloudEventMappingsOK().WithPayload(mappings)
+
+	}
+
+	s := "There was an error retrieving the CloudEvents mappings from the system: " + e.Error()
+	errorReturn := models.ErrorResponse{
+		ErrorString: &s,
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/cloudevent/mapping"}).Inc()
+
+	m.monit.APIHitDone("cloudevent", callTime)
+
+	return cloud_event_management.NewListCloudEventMappingsInternalServerError().WithPayload(&errorReturn)
+
+}
+
+// UpdateCloudEventMapping (Swagger func) is the function behind the (PUT)
+// endpoint /cloudevent/mapping/{id}
+// Its job is to update the definition of the provided CloudEvents mapping.
+func (m *CloudEventManager) UpdateCloudEventMapping(ctx context.Context, params cloud_event_management.UpdateCloudEventMappingParams) middleware.Responder {
+
+	l.Trace.Printf("[CloudEventManager] UpdateCloudEventMapping endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("cloudevent", callTime)
+
+	id := strconv.FormatInt(params.ID, 10)
+
+	mapping, e := m.db.UpdateCloudEventMapping(params.ID, params.Mapping)
+
+	if errors.Is(e, dbManager.ErrCloudEventMappingInvalid) {
+
+		s := "The CloudEvents mapping provided is not valid: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "400", "method": "PUT", "route": "/cloudevent/mapping/" + id}).Inc()
+
+		m.monit.APIHitDone("cloudevent", callTime)
+
+		return cloud_event_management.NewUpdateCloudEventMappingBadRequest().WithPayload(&errorReturn)
+
+	}
+
+	if errors.Is(e, dbManager.ErrCloudEventMappingDuplicated) {
+
+		s := "A CloudEvents mapping with the same name or for the same source and type already exists in the system."
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "409", "method": "PUT", "route": "/cloudevent/mapping/" + id}).Inc()
+
+		m.monit.APIHitDone("cloudevent", callTime)
+
+		return cloud_event_management.NewUpdateCloudEventMappingConflict().WithPayload(&errorReturn)
+
+	}
+
+	if e != nil {
+
+		s := "There was an error updating the CloudEvents mapping in the system: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "PUT", "route": "/cloudevent/mapping/" + id}).Inc()
+
+		m.monit.APIHitDone("cloudevent", callTime)
+
+		return cloud_event_management.NewUpdateCloudEventMappingInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	if mapping == nil {
+
+		s := "The CloudEvents mapping doesn't exist in the system."
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "404", "method": "PUT", "route": "/cloudevent/mapping/" + id}).Inc()
+
+		m.monit.APIHitDone("cloudevent", callTime)
+
+		return cloud_event_management.NewUpdateCloudEventMappingNotFound().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "PUT", "route": "/cloudevent/mapping/" + id}).Inc()
+
+	m.monit.APIHitDone("cloudevent", callTime)
+
+	return cloud_event_management.NewUpdateCloudEventMappingOK().WithPayload(mapping)
+
+}
+
+// ParseHTTP job is to obtain the CloudEvent from a request following the HTTP
+// protocol binding: in structured mode when the Content-Type is the CloudEvents
+// one, otherwise in binary mode with the attributes in the ce- headers.
+// Parameters:
+// - header: http.Header of the request.
+// - body: slice of bytes with the body of the request.
+// Returns:
+// - reference to the CloudEvent obtained.
+// - error raised in case of problems, wrapping ErrCloudEventInvalid.
+func ParseHTTP(header http.Header, body []byte) (*dbManager.CloudEvent, error) {
+
+	contentType := header.Get("Content-Type")
+
+	mt, _, _ := mime.ParseMediaType(contentType)
+
+	switch mt {
+
+	case structuredMediaType:
+
+		return parseStructured(body)
+
+	case batchMediaType:
+
+		return nil, fmt.Errorf("%w: batched mode is not supported", dbManager.ErrCloudEventInvalid)
+
+	}
+
+	attributes := make(map[string]string)
+
+	for key, values := range header {
+
+		key = strings.ToLower(key)
+
+		if !strings.HasPrefix(key, "ce-") || len(values) == 0 {
+
+			continue
+
+		}
+
+		// The values of the attributes are percent-encoded in the headers
+		value, e := url.PathUnescape(values[0])
+
+		if e != nil {
+
+			value = values[0]
+
+		}
+
+		attributes[strings.TrimPrefix(key, "ce-")] = value
+
+	}
+
+	return parseBinary(attributes, contentType, body)
+
+}
+
+// ParseKafka job is to obtain the CloudEvent from a message following the
+// Kafka protocol binding: in structured mode when the content-type header is
+// the CloudEvents one, otherwise in binary mode with the attributes in the ce_
+// headers. The key of the message is kept as the partitionkey extension.
+// Parameters:
+// - msg: kafka.Message received.
+// Returns:
+// - reference to the CloudEvent obtained.
+// - error raised in case of problems, wrapping ErrCloudEventInvalid.
+func ParseKafka(msg kafka.Message) (ce *dbManager.CloudEvent, e error) {
+
+	var contentType string
+
+	attributes := make(map[string]string)
+
+	for _, h := range msg.Headers {
+
+		key := strings.ToLower(h.Key)
+
+		if key == "content-type" {
+
+			contentType = string(h.Value)
+
+		} else if strings.HasPrefix(key, "ce_") {
+
+			attributes[strings.TrimPrefix(key, "ce_")] = string(h.Value)
+
+		}
+
+	}
+
+	mt, _, _ := mime.ParseMediaType(contentType)
+
+	switch mt {
+
+	case structuredMediaType:
+
+		ce, e = parseStructured(msg.Value)
+
+	case batchMediaType:
+
+		return nil, fmt.Errorf("%w: batched mode is not supported", dbManager.ErrCloudEventInvalid)
+
+	default:
+
+		ce, e = parseBinary(attributes, contentType, msg.Value)
+
+	}
+
+	if e == nil && len(msg.Key) > 0 {
+
+		if _, exists := ce.Attributes["partitionkey"]; !exists {
+
+			ce.Attributes["partitionkey"] = string(msg.Key)
+
+		}
+
+	}
+
+	return
+
+}
+
+// parseBinary job is to build the CloudEvent received in binary mode, with the
+// attributes already taken from the headers and the data as the payload.
+// Parameters:
+// - attributes: map with the context attributes and extensions by name.
+// - contentType: string with the content type of the payload.
+// - payload: slice of bytes with the data of the CloudEvent.
+// Returns:
+// - reference to the CloudEvent built.
+// - error raised in case of problems, wrapping ErrCloudEventInvalid.
+func parseBinary(attributes map[string]string, contentType string, payload []byte) (*dbManager.CloudEvent, error) {
+
+	if attributes["specversion"] == "" {
+
+		return nil, fmt.Errorf("%w: neither in structured mode nor with the specversion attribute in binary mode", dbManager.ErrCloudEventInvalid)
+
+	}
+
+	if contentType != "" {
+
+		attributes["datacontenttype"] = contentType
+
+	}
+
+	data, e := parseData(contentType, payload)
+
+	if e != nil {
+
+		return nil, e
+
+	}
+
+	return &dbManager.CloudEvent{
+		Attributes: attributes,
+		Data:       data,
+	}, nil
+
+}
+
+// parseData job is to decode the data of the CloudEvent when it's JSON,
+// keeping it as a string otherwise.
+// Parameters:
+// - contentType: string with the content type of the data, JSON if empty.
+// - payload: slice of bytes with the data.
+// Returns:
+// - interface{} with the data decoded.
+// - error raised in case of problems, wrapping ErrCloudEventInvalid.
+func parseData(contentType string, payload []byte) (interface{}, error) {
+
+	if len(payload) == 0 {
+
+		return nil, nil
+
+	}
+
+	mt, _, _ := mime.ParseMediaType(contentType)
+
+	if mt != "" && mt != "application/json" && mt != "text/json" && !strings.HasSuffix(mt, "+json") {
+
+		return string(payload), nil
+
+	}
+
+	var data interface{}
+
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+
+	if e := decoder.Decode(&data); e != nil {
+
+		return nil, fmt.Errorf("%w: the data is not valid JSON: %v", dbManager.ErrCloudEventInvalid, e)
+
+	}
+
+	return data, nil
+
+}
+
+// parseStructured job is to build the CloudEvent received in structured mode,
+// with the whole event JSON encoded in the payload.
+// Parameters:
+// - payload: slice of bytes with the CloudEvent.
+// Returns:
+// - reference to the CloudEvent built.
+// - error raised in case of problems, wrapping ErrCloudEventInvalid.
+func parseStructured(payload []byte) (*dbManager.CloudEvent, error) {
+
+	var envelope map[string]interface{}
+
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+
+	if e := decoder.Decode(&envelope); e != nil {
+
+		return nil, fmt.Errorf("%w: not a JSON object: %v", dbManager.ErrCloudEventInvalid, e)
+
+	}
+
+	ce := dbManager.CloudEvent{
+		Attributes: make(map[string]string),
+	}
+
+	var encoded interface{}
+
+	for key, value := range envelope {
+
+		switch v := value.(type) {
+
+		case nil:
+
+		case string:
+
+			ce.Attributes[key] = v
+
+		case json.Number:
+
+			ce.Attributes[key] = v.String()
+
+		case bool:
+
+			ce.Attributes[key] = strconv.FormatBool(v)
+
+		default:
+
+			if key != "data" {
+
+				return nil, fmt.Errorf("%w: the %v attribute is not a scalar", dbManager.ErrCloudEventInvalid, key)
+
+			}
+
+		}
+
+		if key == "data" {
+
+			delete(ce.Attributes, key)
+
+			ce.Data = value
+
+		}
+
+		if key == "data_base64" {
+
+			delete(ce.Attributes, key)
+
+			encoded = value
+
+		}
+
+	}
+
+	if encoded != nil {
+
+		s, _ := encoded.(string)
+
+		raw, e := base64.StdEncoding.DecodeString(s)
+
+		if e != nil {
+
+			return nil, fmt.Errorf("%w: data_base64 is not valid base64: %v", dbManager.ErrCloudEventInvalid, e)
+
+		}
+
+		if ce.Data, e = parseData(ce.Attributes["datacontenttype"], raw); e != nil {
+
+			return nil, e
+
+		}
+
+	}
+
+	return &ce, nil
+
+}
@@ -60,14 +60,15 @@ type generalConfig struct {
 }
 
 type kafkaConfig struct {
-	Brokers    []string
-	MaxBytes   int
-	MinBytes   int
-	Offset     int64
-	Partition  int
-	TopicsIn   []string
-	TopicsOut  []string
-	TLSEnabled bool
+	Brokers             []string
+	MaxBytes            int
+	MinBytes            int
+	Offset              int64
+	Partition           int
+	TopicsCloudEventsIn []string
+	TopicsIn            []string
+	TopicsOut           []string
+	TLSEnabled          bool
 }
 
 type keycloakConfig struct {
@@ -187,14 +188,15 @@ func parseConfig() (c configuration) {
 		},
 
 		Kafka: kafkaConfig{
-			Brokers:    viper.GetStringSlice("kafka.brokers"),
-			MaxBytes:   viper.GetInt("kafka.sizemax"),
-			MinBytes:   viper.GetInt("kafka.sizemin"),
-			Offset:     viper.GetInt64("kafka.offset"),
-			Partition:  viper.GetInt("kafka.partition"),
-			TopicsIn:   viper.GetStringSlice("kafka." + service + "in"),
-			TopicsOut:  viper.GetStringSlice("kafka." + service + "out"),
-			TLSEnabled: viper.GetBool("kafka.tlsenabled"),
+			Brokers:             viper.GetStringSlice("kafka.brokers"),
+			MaxBytes:            viper.GetInt("kafka.sizemax"),
+			MinBytes:            viper.GetInt("kafka.sizemin"),
+			Offset:              viper.GetInt64("kafka.offset"),
+			Partition:           viper.GetInt("kafka.partition"),
+			TopicsCloudEventsIn: viper.GetStringSlice("kafka." + service + "cloudeventsin"),
+			TopicsIn:            viper.GetStringSlice("kafka." + service + "in"),
+			TopicsOut:           viper.GetStringSlice("kafka." + service + "out"),
+			TLSEnabled:          viper.GetBool("kafka.tlsenabled"),
 		},
 
 		Keycloak: keycloakConfig{
@@ -1,17 +1,25 @@
 package dbManager
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/remeh/sizedwaitgroup"
 	"gitlab.com/cyclops-utilities/datamodels"
 	"github.com/GoDieNow/TFT_Code/services/eventsengine/models"
 	l "gitlab.com/cyclops-utilities/logging"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 const (
@@ -36,15 +44,91 @@ const (
 	endOfTime = int64(32503680000)
 )
 
+var (
+	// ErrCloudEventInvalid is raised when a CloudEvent doesn't follow the
+	// CloudEvents 1.0 specification.
+	ErrCloudEventInvalid = errors.New("invalid cloud event")
+
+	// ErrCloudEventMappingDuplicated is raised when defining a CloudEvents
+	// mapping with the name, or for the source and type, of another one in the
+	// system.
+	ErrCloudEventMappingDuplicated = errors.New("cloud event mapping already exists in the system")
+
+	// ErrCloudEventMappingInvalid is raised when a CloudEvents mapping
+	// definition is not valid.
+	ErrCloudEventMappingInvalid = errors.New("invalid cloud event mapping")
+
+	// ErrCloudEventUnmappable is raised when a CloudEvent has no mapping for
+	// its source and type, or it doesn't provide what its mapping requires.
+	ErrCloudEventUnmappable = errors.New("cloud event cannot be mapped")
+
+	// ErrEventQuarantined is raised when an event doesn't follow the
+	// lifecycle of its resource type and it's held back.
+	ErrEventQuarantined = errors.New("event quarantined")
+
+	// ErrDiscreteEventInvalid is raised when a discrete event is not valid.
+	ErrDiscreteEventInvalid = errors.New("invalid discrete event")
+
+	// ErrEventFiltered is raised when an event is dropped or quarantined by a
+	// filter rule.
+	ErrEventFiltered = errors.New("event filtered")
+
+	// ErrEventInvalid is raised when an event lacks the time or the state it
+	// reports.
+	ErrEventInvalid = errors.New("invalid event")
+
+	// ErrFilterRuleDuplicated is raised when defining a filter rule with the
+	// name of another one in the system.
+	ErrFilterRuleDuplicated = errors.New("filter rule already exists in the system")
+
+	// ErrFilterRuleInvalid is raised when a filter rule definition is not valid.
+	ErrFilterRuleInvalid = errors.New("invalid filter rule")
+
+	// ErrLifecycleDuplicated is raised when defining a lifecycle already in
+	// the system.
+	ErrLifecycleDuplicated = errors.New("lifecycle already exists in the system")
+
+	// ErrLifecycleInvalid is raised when a lifecycle definition is not valid.
+	ErrLifecycleInvalid = errors.New("invalid lifecycle")
+
+	// ErrNoRawEvents is raised when rebuilding a scope without raw events
+	// logged in the system.
+	ErrNoRawEvents = errors.New("no raw events logged for the scope")
+
+	errDryRun = errors.New("dry run")
+
+	// cloudEventExpression matches the expressions of the CloudEvents mappings:
+	// a constant, a path within the data or the name of an attribute.
+	cloudEventExpression = regexp.MustCompile(`^(=.*|data(\.[^.]+)*|[a-z0-9]+)$`)
+)
+
 // DbParameter is the struct defined to group and contain all the methods
 // that interact with the database.
 // On it there is the following parameters:
 // - connStr: strings with the connection information to the database
 // - Db: a gorm.DB pointer to the db to invoke all the db methods
+// - filters: the filter rules in use, loaded from the db.
 type DbParameter struct {
 	connStr string
 	Db      *gorm.DB
 	Metrics map[string]*prometheus.GaugeVec
+
+	filters      []*filterRule
+	filtersMutex sync.RWMutex
+}
+
+// CloudEvent groups a CloudEvent received through any of the supported
+// bindings, with its context attributes and extensions by name and its data
+// already decoded when it's JSON.
+type CloudEvent struct {
+	Attributes map[string]string
+	Data       interface{}
+}
+
+// filterRule groups a filter rule with its name pattern already compiled.
+type filterRule struct {
+	pattern *regexp.Regexp
+	rule    *models.FilterRule
 }
 
 var (
@@ -92,134 +176,211 @@ func New(dbConn string, tables ...interface{}) *DbParameter {
 
 }
 
-// AddEvent job is to register the new event on its corresponding resource state.
+// AddCloudEvent job is to register the CloudEvent provided as an event,
+// mapped through the mapping defined for its source and type.
 // Parameters:
-// - event: a reference containing the new event in the resource.
+// - ce: reference to the CloudEvent to be registered.
 // Returns:
-// - e: error raised in case of problems
-func (d *DbParameter) AddEvent(event models.Event) (e error) {
+// - e: error raised in case of problems, ErrCloudEventInvalid if the CloudEvent
+// is not valid and ErrCloudEventUnmappable if it cannot be mapped into an event.
+func (d *DbParameter) AddCloudEvent(ce *CloudEvent) (e error) {
 
-	l.Trace.Printf("[DB] Attempting to register a new in the resource [ %v ] from the account [ %v ].\n", event.ResourceID, event.Account)
+	if e = d.checkCloudEvent(ce); e != nil {
 
-	now := time.Now().UnixNano()
+		return
 
-	var states []*models.State
-	var s, state models.State
+	}
+
+	source, ty := ce.Attributes["source"], ce.Attributes["type"]
+
+	l.Trace.Printf("[DB] Attempting to register the cloud event [ %v ] of type [ %v ] from the source [ %v ].\n", ce.Attributes["id"], ty, source)
+
+	mapping, e := d.findCloudEventMapping(source, ty)
+
+	if e != nil {
+
+		return
 
-	patternSearch := models.State{
-		Account:    event.Account,
-		ResourceID: event.ResourceID,
-		Region:     event.Region,
 	}
 
-	patternUpdate := models.State{
-		TimeFrom:  event.TimeFrom,
-		EventTime: event.EventTime,
-		LastEvent: func(s string) *string { return &s }(stateTerminated),
+	if mapping == nil {
+
+		return fmt.Errorf("%w: no mapping defined for the source [ %v ] and type [ %v ]", ErrCloudEventUnmappable, source, ty)
+
 	}
 
-	s = patternSearch
-	s.MetaData = event.MetaData
+	event, e := d.mapCloudEvent(ce, mapping)
 
-	// First check is there's anything from same resourceid with different metadata,
-	// if so, terminate them all
-	if e = d.Db.Where(patternSearch).Not(models.State{
-		MetaData: event.MetaData,
-	}).Not(models.State{
-		LastEvent: func(s string) *string { return &s }(stateTerminated),
-	}).Find(&states).Error; e != nil {
+	if e != nil {
 
-		l.Warning.Printf("[DB] Something went wrong while checking if the resource already has registered states in the system. Error: %v\n", e)
+		return
 
 	}
 
-	if len(states) != 0 {
+	d.Metrics["count"].With(prometheus.Labels{"type": "Total cloud events processed"}).Inc()
 
-		l.Debug.Printf("[DB] We found [ %v ] states linked to the resource [ %v ] proceeding to mark them as terminated...\n", len(states), event.ResourceID)
+	return d.AddEvent(event)
 
-		if e = d.UpdateStates(states, patternUpdate); e != nil {
+}
 
-			l.Warning.Printf("[DB] Something went wrong while terminating the other states linked to the resource [ %v ]. Error: %v\n", event.ResourceID, e)
+// AddDiscreteEvent job is to register a discrete event, which is counted in
+// the usage instead of measured over time.
+// Parameters:
+// - event: the DiscreteEvent to be registered, with quantity 1 if not provided.
+// Returns:
+// - e: error raised in case of problems, ErrDiscreteEventInvalid if the event
+// is not valid.
+func (d *DbParameter) AddDiscreteEvent(event models.DiscreteEvent) (e error) {
 
-		} else {
+	l.Trace.Printf("[DB] Attempting to register a new discrete event in the resource [ %v ] from the account [ %v ].\n", event.ResourceID, event.Account)
 
-			d.Metrics["count"].With(prometheus.Labels{"type": "States updated"}).Add(float64(len(states)))
+	if event.Kind == nil || *event.Kind == "" {
 
-		}
+		return fmt.Errorf("%w: the kind is missing", ErrDiscreteEventInvalid)
 
 	}
 
-	// check if Status is the same
-	// if so, update the last time event field
-	// if diferent, copy actual state to history, then change state into new values from event
-	if r := d.Db.Where(&s).First(&state).Error; errors.Is(r, gorm.ErrRecordNotFound) {
+	if event.Quantity < 0 {
 
-		l.Debug.Printf("[DB] The resource [ %v ] doesn't have a linked state in the system, proceeding to generate one...\n", event.ResourceID)
+		return fmt.Errorf("%w: negative quantity [ %v ]", ErrDiscreteEventInvalid, event.Quantity)
 
-		s.ResourceName = event.ResourceName
-		s.TimeTo = endOfTime
-		s.TimeFrom = *event.EventTime
-		s.ResourceType = event.ResourceType
-		s.LastEvent = event.LastEvent
-		s.EventTime = event.EventTime
+	}
 
-		if e = d.Db.Create(&s).Error; e != nil {
+	if event.Quantity == 0 {
 
-			l.Warning.Printf("[DB] Something went wrong while trying to create the state. Error: %v\n", e)
+		event.Quantity = 1
 
-		} else {
+	}
 
-			d.Metrics["count"].With(prometheus.Labels{"type": "States created"}).Inc()
+	if event.MetaData == nil {
 
-		}
+		event.MetaData = make(datamodels.JSONdb)
 
-	} else {
+	}
 
-		l.Debug.Printf("[DB] The resource [ %v ] seems to be already in the system with state [ %v ], proceeding to update its state...\n", event.ResourceID, *state.LastEvent)
+	event.ID = 0
 
-		if *state.LastEvent == *event.LastEvent {
+	if e = d.Db.Create(&event).Error; e != nil {
 
-			if e = d.Db.Model(&state).Updates(models.State{
-				EventTime: event.EventTime,
-			}).Error; e != nil {
+		l.Warning.Printf("[DB] Something went wrong while registering the discrete event. Error: %v\n", e)
 
-				l.Warning.Printf("[DB] Something went wrong while updating the state. Error: %v\n", e)
+		return
 
-			} else {
+	}
 
-				d.Metrics["count"].With(prometheus.Labels{"type": "States updated"}).Inc()
+	d.Metrics["count"].With(prometheus.Labels{"type": "Total discrete events processed"}).Inc()
 
-			}
+	d.Metrics["count"].With(prometheus.Labels{"type": "Total " + event.ResourceType + " discrete events processed"}).Inc()
 
-		} else {
+	return
 
-			l.Debug.Printf("[DB] The resource [ %v ] seems to have a previous different state: [ %v ] -> [ %v ], proceeding to update it...\n", event.ResourceID, *state.LastEvent, *event.LastEvent)
+}
 
-			h := state
-			h.TimeTo = *event.EventTime
+// AddEvent job is to register the new event on its corresponding resource
+// state, keeping it first in the append-only log of raw events.
+// Parameters:
+// - event: a reference containing the new event in the resource.
+// Returns:
+// - e: error raised in case of problems, ErrEventInvalid if the event lacks
+// its time or state.
+func (d *DbParameter) AddEvent(event models.Event) (e error) {
 
-			if e = d.AddToHistory(h); e != nil {
+	l.Trace.Printf("[DB] Attempting to register a new in the resource [ %v ] from the account [ %v ].\n", event.ResourceID, event.Account)
 
-				l.Warning.Printf("[DB] Something went wrong while saving in the history the previous state. Error: %v\n", e)
+	// The events coming through Kafka skip the validation of the API
+	if event.EventTime == nil {
 
-			}
+		return fmt.Errorf("%w: the event time is missing", ErrEventInvalid)
 
-			if e = d.Db.Model(&state).Updates(models.State{
-				EventTime: event.EventTime,
-				LastEvent: event.LastEvent,
-				TimeFrom:  *event.EventTime,
-			}).Error; e != nil {
+	}
 
-				l.Warning.Printf("[DB] Something went wrong while updating the state. Error: %v\n", e)
+	if event.LastEvent == nil || *event.LastEvent == "" {
 
-			} else {
+		return fmt.Errorf("%w: the state is missing", ErrEventInvalid)
 
-				d.Metrics["count"].With(prometheus.Labels{"type": "States updated"}).Inc()
+	}
+
+	now := time.Now().UnixNano()
+
+	if rule := d.filterEvent(&event); rule != nil {
+
+		if *rule.Action == models.FilterRuleActionQuarantine {
+
+			if e = d.quarantineEvent(event, "filter rule [ "+*rule.Name+" ]"); e != nil {
+
+				return
 
 			}
 
 		}
 
+		return fmt.Errorf("%w: %v by rule [ %v ]", ErrEventFiltered, *rule.Action, *rule.Name)
+
+	}
+
+	// Events not following the lifecycle of the resource type are held back
+	if reason, e := d.checkTransition(event); e != nil {
+
+		return e
+
+	} else if reason != "" {
+
+		l.Warning.Printf("[DB] The event of resource [ %v ] was quarantined: %v\n", event.ResourceID, reason)
+
+		if e = d.quarantineEvent(event, reason); e != nil {
+
+			return e
+
+		}
+
+		return fmt.Errorf("%w: %v", ErrEventQuarantined, reason)
+
+	}
+
+	raw := models.RawEvent{
+		Account:      event.Account,
+		EventTime:    *event.EventTime,
+		LastEvent:    *event.LastEvent,
+		MetaData:     event.MetaData,
+		ReceivedAt:   time.Now().Unix(),
+		Region:       event.Region,
+		ResourceID:   event.ResourceID,
+		ResourceName: event.ResourceName,
+		ResourceType: event.ResourceType,
+	}
+
+	// Without the raw event logged the state couldn't be rebuilt later
+	if e = d.Db.Create(&raw).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while logging the raw event. Error: %v\n", e)
+
+		return
+
+	}
+
+	// The states of the resource are locked while its timeline changes, so its
+	// events received concurrently are applied one after the other
+	e = d.Db.Transaction(func(tx *gorm.DB) error {
+
+		locked := DbParameter{
+			Db:      tx,
+			Metrics: d.Metrics,
+		}
+
+		if e := locked.lockResource(event); e != nil {
+
+			return e
+
+		}
+
+		return locked.applyEvent(event)
+
+	})
+
+	if e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while applying the event of the resource [ %v ]. Error: %v\n", event.ResourceID, e)
+
 	}
 
 	eeTotal++
@@ -273,6 +434,145 @@ func (d *DbParameter) AddToHistory(state models.State) (e error) {
 
 }
 
+// CreateCloudEventMapping job is to define a new mapping of the CloudEvents
+// of a source into events.
+// Parameters:
+// - m: reference to the CloudEventMapping to be defined.
+// Returns:
+// - reference to the CloudEventMapping defined.
+// - error raised in case of problems, ErrCloudEventMappingDuplicated if its
+// name, or its source and type, are already in use and
+// ErrCloudEventMappingInvalid if it's not consistent.
+func (d *DbParameter) CreateCloudEventMapping(m *models.CloudEventMapping) (*models.CloudEventMapping, error) {
+
+	if e := d.checkCloudEventMapping(m); e != nil {
+
+		return nil, e
+
+	}
+
+	l.Trace.Printf("[DB] Attempting to define the cloud event mapping [ %v ] in the system.\n", *m.Name)
+
+	if e := d.checkCloudEventMappingDuplicated(m, 0); e != nil {
+
+		return nil, e
+
+	}
+
+	m.ID = 0
+
+	if e := d.Db.Create(m).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while defining the cloud event mapping [ %v ]. Error: %v\n", *m.Name, e)
+
+		return nil, e
+
+	}
+
+	return m, nil
+
+}
+
+// CreateFilterRule job is to define a new filter rule for the events, reloading
+// the rules in use afterwards.
+// Parameters:
+// - r: reference to the FilterRule to be defined.
+// Returns:
+// - reference to the FilterRule defined.
+// - error raised in case of problems, ErrFilterRuleDuplicated if its name is
+// already in use and ErrFilterRuleInvalid if it's not consistent.
+func (d *DbParameter) CreateFilterRule(r *models.FilterRule) (*models.FilterRule, error) {
+
+	if e := d.checkFilterRule(r); e != nil {
+
+		return nil, e
+
+	}
+
+	l.Trace.Printf("[DB] Attempting to define the filter rule [ %v ] in the system.\n", *r.Name)
+
+	if e := d.Db.Where(&models.FilterRule{Name: r.Name}).First(&models.FilterRule{}).Error; !errors.Is(e, gorm.ErrRecordNotFound) {
+
+		if e != nil {
+
+			return nil, e
+
+		}
+
+		l.Trace.Printf("[DB] The filter rule [ %v ] already exists in the system.\n", *r.Name)
+
+		return nil, ErrFilterRuleDuplicated
+
+	}
+
+	r.ID = 0
+	r.Hits = 0
+
+	if e := d.Db.Create(r).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while defining the filter rule [ %v ]. Error: %v\n", *r.Name, e)
+
+		return nil, e
+
+	}
+
+	// The rule is in the system even if the reload fails, it's picked up later
+	if _, e := d.LoadFilterRules(); e != nil {
+
+		l.Warning.Printf("[DB] The filter rules couldn't be reloaded. Error: %v\n", e)
+
+	}
+
+	return r, nil
+
+}
+
+// CreateLifecycle job is to define the lifecycle of a resource type in the
+// system.
+// Parameters:
+// - lc: reference to the Lifecycle to be defined.
+// Returns:
+// - reference to the Lifecycle defined.
+// - error raised in case of problems, ErrLifecycleDuplicated if it already
+// exists and ErrLifecycleInvalid if it's not consistent.
+func (d *DbParameter) CreateLifecycle(lc *models.Lifecycle) (*models.Lifecycle, error) {
+
+	if e := d.checkLifecycle(lc); e != nil {
+
+		return nil, e
+
+	}
+
+	l.Trace.Printf("[DB] Attempting to define the lifecycle of [ %v ] in the system.\n", *lc.ResourceType)
+
+	if e := d.Db.Where(&models.Lifecycle{ResourceType: lc.ResourceType}).First(&models.Lifecycle{}).Error; !errors.Is(e, gorm.ErrRecordNotFound) {
+
+		if e != nil {
+
+			return nil, e
+
+		}
+
+		l.Trace.Printf("[DB] The lifecycle of [ %v ] already exists in the system.\n", *lc.ResourceType)
+
+		return nil, ErrLifecycleDuplicated
+
+	}
+
+	if e := d.Db.Create(lc).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while defining the lifecycle of [ %v ]. Error: %v\n", *lc.ResourceType, e)
+
+		return nil, e
+
+	}
+
+	d.Metrics["count"].With(prometheus.Labels{"type": "Lifecycles added"}).Inc()
+
+	return lc, nil
+
+}
+
 // DatesWithin job is to check whether the analized time-window overlaps or not,
 // reporting how this happens and how much overlapping exists.
 // Parameters:
@@ -361,25 +661,185 @@ func (d *DbParameter) DatesWithin(EventFrom, EventTo, TimeFrom, TimeTo int64) (i
 
 }
 
-// GetAllStates job is to retrieve a snapshot of all the states present in the
-// system at the time of invokation-
+// DeleteCloudEventMapping job is to remove the CloudEvents mapping linked to
+// the provided id.
+// Parameters:
+// - id: int64 with the id of the mapping.
 // Returns:
-// - slice of States containing all the resources state in the system
-// - error raised in case of problems
-func (d *DbParameter) GetAllStates() ([]*models.State, error) {
+// - reference to the CloudEventMapping removed, nil if it doesn't exist.
+// - error raised in case of problems.
+func (d *DbParameter) DeleteCloudEventMapping(id int64) (*models.CloudEventMapping, error) {
 
-	l.Trace.Printf("[DB] Attempting to retrieve all the states present in the system.\n")
+	l.Trace.Printf("[DB] Attempting to remove the cloud event mapping [ %v ].\n", id)
 
-	var s []*models.State
-	var e error
+	m, e := d.GetCloudEventMapping(id)
 
-	if e := d.Db.Find(&s).Error; e != nil {
+	if m == nil {
 
-		l.Warning.Printf("[DB] Something went wrong while retrieving the states in the system. Error: %v\n", e)
+		return nil, e
 
 	}
 
-	return s, e
+	if e = d.Db.Delete(m).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while removing the cloud event mapping [ %v ]. Error: %v\n", id, e)
+
+		return nil, e
+
+	}
+
+	return m, nil
+
+}
+
+// DeleteFilterRule job is to remove the filter rule linked to the provided id,
+// reloading the rules in use afterwards.
+// Parameters:
+// - id: int64 with the id of the filter rule.
+// Returns:
+// - reference to the FilterRule removed, nil if it doesn't exist.
+// - error raised in case of problems.
+func (d *DbParameter) DeleteFilterRule(id int64) (*models.FilterRule, error) {
+
+	l.Trace.Printf("[DB] Attempting to remove the filter rule [ %v ].\n", id)
+
+	r, e := d.GetFilterRule(id)
+
+	if r == nil {
+
+		return nil, e
+
+	}
+
+	if e = d.Db.Delete(r).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while removing the filter rule [ %v ]. Error: %v\n", id, e)
+
+		return nil, e
+
+	}
+
+	// The rule is in the system even if the reload fails, it's picked up later
+	if _, e := d.LoadFilterRules(); e != nil {
+
+		l.Warning.Printf("[DB] The filter rules couldn't be reloaded. Error: %v\n", e)
+
+	}
+
+	return r, nil
+
+}
+
+// GetCloudEventMapping job is to retrieve the CloudEvents mapping linked to
+// the provided id.
+// Parameters:
+// - id: int64 with the id of the mapping.
+// Returns:
+// - reference to the CloudEventMapping, nil if it doesn't exist.
+// - error raised in case of problems.
+func (d *DbParameter) GetCloudEventMapping(id int64) (*models.CloudEventMapping, error) {
+
+	l.Trace.Printf("[DB] Attempting to retrieve the cloud event mapping [ %v ].\n", id)
+
+	var m models.CloudEventMapping
+
+	if e := d.Db.Where(&models.CloudEventMapping{ID: id}).First(&m).Error; e != nil {
+
+		if errors.Is(e, gorm.ErrRecordNotFound) {
+
+			return nil, nil
+
+		}
+
+		l.Warning.Printf("[DB] Something went wrong while retrieving the cloud event mapping [ %v ]. Error: %v\n", id, e)
+
+		return nil, e
+
+	}
+
+	return &m, nil
+
+}
+
+// GetFilterRule job is to retrieve the filter rule linked to the provided id.
+// Parameters:
+// - id: int64 with the id of the filter rule.
+// Returns:
+// - reference to the FilterRule, nil if it doesn't exist.
+// - error raised in case of problems.
+func (d *DbParameter) GetFilterRule(id int64) (*models.FilterRule, error) {
+
+	l.Trace.Printf("[DB] Attempting to retrieve the filter rule [ %v ].\n", id)
+
+	var r models.FilterRule
+
+	if e := d.Db.Where(&models.FilterRule{ID: id}).First(&r).Error; e != nil {
+
+		if errors.Is(e, gorm.ErrRecordNotFound) {
+
+			return nil, nil
+
+		}
+
+		l.Warning.Printf("[DB] Something went wrong while retrieving the filter rule [ %v ]. Error: %v\n", id, e)
+
+		return nil, e
+
+	}
+
+	return &r, nil
+
+}
+
+// GetLifecycle job is to retrieve the lifecycle of the provided resource type.
+// Parameters:
+// - resourceType: string with the resource type.
+// Returns:
+// - reference to the Lifecycle, nil if it's not defined.
+// - error raised in case of problems.
+func (d *DbParameter) GetLifecycle(resourceType string) (*models.Lifecycle, error) {
+
+	l.Trace.Printf("[DB] Attempting to retrieve the lifecycle of [ %v ].\n", resourceType)
+
+	var lc models.Lifecycle
+
+	if e := d.Db.Where(&models.Lifecycle{ResourceType: &resourceType}).First(&lc).Error; e != nil {
+
+		if errors.Is(e, gorm.ErrRecordNotFound) {
+
+			return nil, nil
+
+		}
+
+		l.Warning.Printf("[DB] Something went wrong while retrieving the lifecycle of [ %v ]. Error: %v\n", resourceType, e)
+
+		return nil, e
+
+	}
+
+	return &lc, nil
+
+}
+
+// GetAllStates job is to retrieve a snapshot of all the states present in the
+// system at the time of invokation-
+// Returns:
+// - slice of States containing all the resources state in the system
+// - error raised in case of problems
+func (d *DbParameter) GetAllStates() ([]*models.State, error) {
+
+	l.Trace.Printf("[DB] Attempting to retrieve all the states present in the system.\n")
+
+	var s []*models.State
+	var e error
+
+	if e := d.Db.Find(&s).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while retrieving the states in the system. Error: %v\n", e)
+
+	}
+
+	return s, e
 
 }
 
@@ -501,144 +961,253 @@ func (d *DbParameter) GetHistory(ac, ty, rg string, from, to int64) ([]*models.E
 
 }
 
-// GetUsage job is to compute the usage accumulated by the provided account
-// considering the time in the actual state and the history of changes that
-// the account resources have had during the provided time-window, with the
-// posibility of filtering by resource type.
+// GetInventory job is to reconstruct the resources alive for the provided
+// account at the given instant from the actual states and their history of
+// changes, with the posibility of filter the result by resource type and
+// region.
 // Parameters:
 // - ac: string representing the ID of the account to be processed.
 // - ty: string representing the resource type to use as filter
 // - rg: string representing the resource region to use as filter
-// - from: int representing the start of the time-window.
-// - to: int representing the end of the time-window.
+// - at: int representing the instant of the inventory.
 // Returns:
-// - Usage reference containing the usage of the account in the system in the
-// provided time-window.
+// - slice of InventoryItem with the resources alive and their state at the
+// instant, sorted by resource type and id.
 // - error raised in case of problems
-func (d *DbParameter) GetUsage(ac, ty, rg string, from, to int64) (*models.Usage, error) {
+func (d *DbParameter) GetInventory(ac, ty, rg string, at int64) ([]*models.InventoryItem, error) {
 
-	l.Trace.Printf("[DB] Attempting to compute the usage of the account [ %v ].\n", ac)
+	l.Trace.Printf("[DB] Attempting to reconstruct the inventory of the account [ %v ] at [ %v ].\n", ac, at)
 
-	var u models.Usage
-	var e error
+	var ev []*models.Event
+	var st []*models.State
 
-	// First we check if the actual State is in the time-window
-	states, e := d.GetState(ac)
+	inventory := make(map[string]*models.InventoryItem)
+	terminated := stateTerminated
 
-	u.AccountID = ac
-	u.TimeFrom = from
-	u.TimeTo = to
+	whereString := d.Db.NamingStrategy.ColumnName("", "TimeFrom") + " <= ? AND " + d.Db.NamingStrategy.ColumnName("", "TimeTo") + " > ?"
 
-	for i := range states {
+	if e := d.Db.Where(whereString, at, at).Where(&models.State{
+		Account:      ac,
+		ResourceType: ty,
+		Region:       rg,
+	}).Not(&models.State{LastEvent: &terminated}).Find(&st).Error; e != nil {
 
-		if ty != "" && states[i].ResourceType != ty {
+		l.Warning.Printf("[DB] Something went wrong while retrieving the states of the account [ %v ]. Error: %v\n", ac, e)
 
-			l.Trace.Printf("[DB] Resource filtering active. Resource [ %v ] not matching criteria [ %v ].\n", states[i].ResourceType, ty)
+		return nil, e
 
-			continue
+	}
 
-		}
+	if e := d.Db.Where(whereString, at, at).Where(&models.Event{
+		Account:      ac,
+		ResourceType: ty,
+		Region:       rg,
+	}).Not(&models.Event{LastEvent: &terminated}).Find(&ev).Error; e != nil {
 
-		if rg != "" && states[i].Region != rg {
+		l.Warning.Printf("[DB] Something went wrong while retrieving the history of the account [ %v ]. Error: %v\n", ac, e)
 
-			l.Trace.Printf("[DB] Resource filtering active. Resource [ %v ] not matching criteria [ %v ].\n", states[i].ResourceType, rg)
+		return nil, e
 
-			continue
+	}
+
+	add := func(item *models.InventoryItem) {
+
+		key := item.ResourceType + "/" + item.Region + "/" + item.ResourceID
+
+		// In case of overlapping intervals, the latest state prevails
+		if prev, exists := inventory[key]; !exists || prev.Since < item.Since {
+
+			inventory[key] = item
 
 		}
 
-		var use models.Use
-		use.Region = states[i].Region
-		use.ResourceID = states[i].ResourceID
-		use.ResourceName = states[i].ResourceName
-		use.ResourceType = states[i].ResourceType
-		use.MetaData = states[i].MetaData
-		use.Unit = "seconds"
+	}
+
+	for _, s := range st {
 
-		usage := make(datamodels.JSONdb)
+		add(&models.InventoryItem{
+			Account:      s.Account,
+			MetaData:     s.MetaData,
+			Region:       s.Region,
+			ResourceID:   s.ResourceID,
+			ResourceName: s.ResourceName,
+			ResourceType: s.ResourceType,
+			Since:        s.TimeFrom,
+			State:        *s.LastEvent,
+		})
 
-		usage[*states[i].LastEvent] = int64(0)
+	}
 
-		// If it is, then we check is the time window goes before the actual state,
-		// if not, we create the usage and done
-		// If it does..
+	for _, h := range ev {
 
-		if inc, contrib := d.DatesWithin(states[i].TimeFrom, states[i].TimeTo, from, to); inc == innerIncluded || inc == upperIncluded {
+		add(&models.InventoryItem{
+			Account:      h.Account,
+			MetaData:     h.MetaData,
+			Region:       h.Region,
+			ResourceID:   h.ResourceID,
+			ResourceName: h.ResourceName,
+			ResourceType: h.ResourceType,
+			Since:        h.TimeFrom,
+			State:        *h.LastEvent,
+		})
 
-			l.Trace.Printf("[DB] Resource [ %v ] state contained within the time-window, skipping history check.\n", states[i].ResourceID)
+	}
 
-			usage[*states[i].LastEvent] = usage[*states[i].LastEvent].(int64) + contrib
+	items := make([]*models.InventoryItem, 0, len(inventory))
 
-		} else {
+	for _, item := range inventory {
 
-			if inc != notIncluded {
+		items = append(items, item)
 
-				l.Trace.Printf("[DB] Resource [ %v ] state not (fully) contained within the time-window, checking history of the resource.\n", states[i].ResourceID)
+	}
 
-				usage[*states[i].LastEvent] = usage[*states[i].LastEvent].(int64) + contrib
+	sort.Slice(items, func(i, j int) bool {
 
-			}
+		if items[i].ResourceType != items[j].ResourceType {
 
-			// We get the History of the State,
-			// for each entry we check if the time-windows covers it,
-			// if so we add the contribution to the usage
-			// if not, we skip it
-			history, e := d.GetAllHistory(ac, use)
+			return items[i].ResourceType < items[j].ResourceType
 
-			if e != nil {
+		}
 
-				l.Warning.Printf("[DB] Something went wrong while retrieving the resource [ %v ] history. Error: %v\n", states[i].ResourceID, e)
+		if items[i].ResourceID != items[j].ResourceID {
 
-			} else {
+			return items[i].ResourceID < items[j].ResourceID
 
-				for id := range history {
+		}
 
-					if included, contribution := d.DatesWithin(history[id].TimeFrom, history[id].TimeTo, from, to); included != notIncluded {
+		return items[i].Region < items[j].Region
 
-						if _, ok := usage[*history[id].LastEvent]; !ok {
+	})
 
-							usage[*history[id].LastEvent] = int64(0)
+	l.Debug.Printf("[DB] [ %v ] resources alive for the account [ %v ] at [ %v ].\n", len(items), ac, at)
 
-						}
+	return items, nil
 
-						l.Debug.Printf("[DB] Resource [ %v ] history register contained within the time-window.\n", states[i].ResourceID)
+}
 
-						usage[*history[id].LastEvent] = usage[*history[id].LastEvent].(int64) + contribution
+// GetInventoryDiff job is to compare the inventories of the provided account
+// at the two instants given, reporting the resources added, removed and
+// changed in state, name or metadata between them.
+// Parameters:
+// - ac: string representing the ID of the account to be processed.
+// - ty: string representing the resource type to use as filter
+// - rg: string representing the resource region to use as filter
+// - from: int representing the instant of the initial inventory.
+// - to: int representing the instant of the final inventory.
+// Returns:
+// - InventoryDiff reference with the changes between both inventories.
+// - error raised in case of problems
+func (d *DbParameter) GetInventoryDiff(ac, ty, rg string, from, to int64) (*models.InventoryDiff, error) {
 
-					}
+	l.Trace.Printf("[DB] Attempting to compare the inventories of the account [ %v ] between [ %v ] and [ %v ].\n", ac, from, to)
 
-				}
+	before, e := d.GetInventory(ac, ty, rg, from)
 
-			}
+	if e != nil {
 
-		}
+		return nil, e
 
-		delete(usage, stateTerminated)
+	}
 
-		for id := range usage {
+	after, e := d.GetInventory(ac, ty, rg, to)
 
-			if usage[id].(int64) == int64(0) {
+	if e != nil {
 
-				delete(usage, id)
+		return nil, e
 
-			}
+	}
+
+	diff := models.InventoryDiff{
+		Account: ac,
+		Added:   []*models.InventoryItem{},
+		Changed: []*models.InventoryChange{},
+		From:    from,
+		Removed: []*models.InventoryItem{},
+		To:      to,
+	}
+
+	key := func(item *models.InventoryItem) string {
+
+		return item.ResourceType + "/" + item.Region + "/" + item.ResourceID
+
+	}
+
+	previous := make(map[string]*models.InventoryItem)
+
+	for _, item := range before {
+
+		previous[key(item)] = item
+
+	}
+
+	for _, item := range after {
+
+		prev, exists := previous[key(item)]
+
+		if !exists {
+
+			diff.Added = append(diff.Added, item)
+
+			continue
 
 		}
 
-		if len(usage) != 0 {
+		delete(previous, key(item))
 
-			use.UsageBreakup = usage
+		if prev.State != item.State || prev.ResourceName != item.ResourceName || !reflect.DeepEqual(prev.MetaData, item.MetaData) {
 
-			u.Usage = append(u.Usage, &use)
+			diff.Changed = append(diff.Changed, &models.InventoryChange{
+				After:  item,
+				Before: prev,
+			})
 
-		} else {
+		}
+
+	}
+
+	// The ones left were not alive anymore, kept in the order of the inventory
+	for _, item := range before {
+
+		if _, exists := previous[key(item)]; exists {
 
-			l.Warning.Printf("[DB] The resource [ %v ] has no data in the specified time-window, skipping it's usage report.\n", states[i].ResourceID)
+			diff.Removed = append(diff.Removed, item)
 
 		}
 
 	}
 
+	return &diff, nil
+
+}
+
+// GetUsage job is to compute the usage accumulated by the provided account
+// considering the time in the actual state and the history of changes that
+// the account resources have had during the provided time-window, with the
+// posibility of filtering by resource type.
+// Parameters:
+// - ac: string representing the ID of the account to be processed.
+// - ty: string representing the resource type to use as filter
+// - rg: string representing the resource region to use as filter
+// - from: int representing the start of the time-window.
+// - to: int representing the end of the time-window.
+// Returns:
+// - Usage reference containing the usage of the account in the system in the
+// provided time-window.
+// - error raised in case of problems
+func (d *DbParameter) GetUsage(ac, ty, rg string, from, to int64) (*models.Usage, error) {
+
+	l.Trace.Printf("[DB] Attempting to compute the usage of the account [ %v ].\n", ac)
+
+	u := models.Usage{
+		AccountID: ac,
+		TimeFrom:  from,
+		TimeTo:    to,
+	}
+
+	uses, e := d.computeUsage(ac, ty, rg, from, to)
+
+	u.Usage = uses[ac]
+
 	return &u, e
 
 }
@@ -661,110 +1230,2163 @@ func (d *DbParameter) GetSystemUsage(from, to int64, ty, rg string) ([]*models.U
 	l.Trace.Printf("[DB] Attempting to compute the system usage.\n")
 
 	var u []*models.Usage
-	var e error
 
-	ids := make(map[string]struct{})
-	mutex := &sync.Mutex{}
+	// The usage of all the accounts is computed at once instead of by account
+	uses, e := d.computeUsage("", ty, rg, from, to)
 
-	swg := sizedwaitgroup.New(8)
+	accounts := make([]string, 0, len(uses))
 
-	if states, e := d.GetAllStates(); e != nil {
+	for account := range uses {
 
-		l.Warning.Printf("[DB] Something went wrong while retrieving the states recorded in the system. Error: %v\n", e)
+		accounts = append(accounts, account)
 
-	} else {
+	}
 
-		for index := range states {
+	sort.Strings(accounts)
 
-			// Goroutines start
-			swg.Add()
-			go func(index int) {
+	for _, account := range accounts {
 
-				i := index
-				defer swg.Done()
+		u = append(u, &models.Usage{
+			AccountID: account,
+			TimeFrom:  from,
+			TimeTo:    to,
+			Usage:     uses[account],
+		})
 
-				mutex.Lock()
-				_, exists := ids[states[i].Account]
-				mutex.Unlock()
+	}
 
-				if exists {
+	return u, e
 
-					l.Trace.Printf("[DB] Account [ %v ] already processed, skipping...\n", states[i].Account)
+}
 
-					return
+// ListCloudEventMappings job is to retrieve the CloudEvents mappings defined
+// in the system.
+// Returns:
+// - slice of CloudEventMapping sorted by source and type.
+// - error raised in case of problems
+func (d *DbParameter) ListCloudEventMappings() ([]*models.CloudEventMapping, error) {
 
-				}
+	l.Trace.Printf("[DB] Attempting to list the cloud event mappings in the system.\n")
 
-				mutex.Lock()
-				ids[states[i].Account] = struct{}{}
-				mutex.Unlock()
+	var m []*models.CloudEventMapping
+	var e error
 
-				if su, e := d.GetUsage(states[i].Account, ty, rg, from, to); e != nil || su.Usage == nil {
+	if e = d.Db.Order(d.Db.NamingStrategy.ColumnName("", "Source")).Order(d.Db.NamingStrategy.ColumnName("", "Type")).Find(&m).Error; e != nil {
 
-					l.Warning.Printf("[DB] Something went wrong while retrieving the usage of the account [ %v ]. Error: %v\n", states[i].Account, e)
+		l.Warning.Printf("[DB] Something went wrong while listing the cloud event mappings in the system. Error: %v\n", e)
 
-				} else {
+	}
 
-					mutex.Lock()
-					u = append(u, su)
-					mutex.Unlock()
+	return m, e
 
-				}
+}
 
-			}(index)
+// ListFilterRules job is to retrieve the filter rules defined in the system.
+// Returns:
+// - slice of FilterRule sorted in evaluation order.
+// - error raised in case of problems
+func (d *DbParameter) ListFilterRules() ([]*models.FilterRule, error) {
 
-		}
-	}
+	l.Trace.Printf("[DB] Attempting to list the filter rules in the system.\n")
 
-	swg.Wait()
+	var r []*models.FilterRule
+	var e error
 
-	return u, e
+	if e = d.Db.Order(d.Db.NamingStrategy.ColumnName("", "Priority")).Order(d.Db.NamingStrategy.ColumnName("", "ID")).Find(&r).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while listing the filter rules in the system. Error: %v\n", e)
+
+	}
+
+	return r, e
 
 }
 
-// UpdateStates job is to process the provided states given a pattern, ranging
-// through the given states, records the change in the history of the state and
-// then updates the state with in the system according to the pattern.
+// ListDiscreteEvents job is to retrieve the discrete events of the account
+// within the time-window, with the posibility of filtering by resource type
+// and region.
 // Parameters:
-// - states: slice of State to be updated in the system.
-// - pattern: a State reference containing the changes to be done previous to
-// the update in the system.
+// - ac: string with the account.
+// - ty: string with the resource type to filter, empty for all of them.
+// - rg: string with the region to filter, empty for all of them.
+// - from: int64 with the start of the time-window.
+// - to: int64 with the end of the time-window, excluded.
 // Returns:
+// - slice of DiscreteEvent sorted by time.
 // - error raised in case of problems
-func (d *DbParameter) UpdateStates(states []*models.State, pattern models.State) error {
+func (d *DbParameter) ListDiscreteEvents(ac, ty, rg string, from, to int64) ([]*models.DiscreteEvent, error) {
 
-	l.Trace.Printf("[DB] Attempting to update [ %v ] states.\n", len(states))
+	l.Trace.Printf("[DB] Attempting to list the discrete events of the account [ %v ].\n", ac)
 
-	var counth, countdb int = 0, 0
+	var events []*models.DiscreteEvent
 	var e error
 
-	for i := range states {
+	eventTime := d.Db.NamingStrategy.ColumnName("", "EventTime")
 
-		l.Debug.Printf("[DB] Processing state for resource [ %v ] from account [ %v ].\n", states[i].ResourceID, states[i].Account)
+	if e = d.Db.Where(&models.DiscreteEvent{Account: ac, ResourceType: ty, Region: rg}).Where(eventTime+" >= ? AND "+eventTime+" < ?", from, to).Order(eventTime).Find(&events).Error; e != nil {
 
-		s := states[i]
-		s.TimeTo = pattern.TimeFrom
+		l.Warning.Printf("[DB] Something went wrong while listing the discrete events of the account [ %v ]. Error: %v\n", ac, e)
 
-		if e = d.AddToHistory(*s); e != nil {
+	}
 
-			l.Warning.Printf("[DB] Something went wrong while saving the history of the state. Error: %v\n", e)
+	return events, e
 
-			counth++
+}
 
-		}
+// ListLifecycles job is to retrieve the lifecycles defined in the system.
+// Returns:
+// - slice of Lifecycle sorted by resource type.
+// - error raised in case of problems
+func (d *DbParameter) ListLifecycles() ([]*models.Lifecycle, error) {
 
-		if e = d.Db.Model(states[i]).Updates(pattern).Error; e != nil {
+	l.Trace.Printf("[DB] Attempting to list the lifecycles in the system.\n")
 
-			l.Warning.Printf("[DB] Something went wrong while updating the state in the system. Error: %v\n", e)
+	var lc []*models.Lifecycle
+	var e error
 
-			countdb++
+	if e = d.Db.Order(d.Db.NamingStrategy.ColumnName("", "ResourceType")).Find(&lc).Error; e != nil {
 
-		}
+		l.Warning.Printf("[DB] Something went wrong while listing the lifecycles in the system. Error: %v\n", e)
 
 	}
 
-	l.Trace.Printf("[DB] Update of states finished with [ %v ] errors while saving the history and [ %v ] while updating the states\n", counth, countdb)
+	return lc, e
 
-	return e
+}
+
+// ListQuarantinedEvents job is to retrieve the events held back for not
+// following the lifecycle of their resource type, with the posibility of
+// filtering by account and resource type.
+// Parameters:
+// - account: string with the account to filter, empty for all of them.
+// - resourceType: string with the resource type to filter, empty for all of them.
+// Returns:
+// - slice of QuarantinedEvent sorted by the time they were received.
+// - error raised in case of problems
+func (d *DbParameter) ListQuarantinedEvents(account, resourceType string) ([]*models.QuarantinedEvent, error) {
+
+	l.Trace.Printf("[DB] Attempting to list the quarantined events of account [ %v ] and resource type [ %v ].\n", account, resourceType)
+
+	var q []*models.QuarantinedEvent
+	var e error
+
+	if e = d.Db.Where(&models.QuarantinedEvent{Account: account, ResourceType: resourceType}).Order(d.Db.NamingStrategy.ColumnName("", "ReceivedAt")).Find(&q).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while listing the quarantined events. Error: %v\n", e)
+
+	}
+
+	return q, e
+
+}
+
+// ListRecomputations job is to retrieve the time-windows whose usage changed
+// due to the insertion of out-of-order events since the provided time.
+// Parameters:
+// - since: int representing the time from which the recomputations were flagged.
+// Returns:
+// - slice of Recomputation sorted by the time they were flagged.
+// - error raised in case of problems
+func (d *DbParameter) ListRecomputations(since int64) ([]*models.Recomputation, error) {
+
+	l.Trace.Printf("[DB] Attempting to retrieve the recomputations flagged since [ %v ].\n", since)
+
+	var r []*models.Recomputation
+	var e error
+
+	createdAt := d.Db.NamingStrategy.ColumnName("", "CreatedAt")
+
+	if e = d.Db.Where(createdAt+" >= ?", since).Order(createdAt).Find(&r).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while retrieving the recomputations from the system. Error: %v\n", e)
+
+	}
+
+	return r, e
+
+}
+
+// LoadFilterRules job is to load from the db the filter rules to be used with
+// the incoming events, replacing the ones in use. It allows the rules to be
+// changed without restarting the service.
+// Returns:
+// - slice of FilterRule enabled and now in use, in evaluation order.
+// - error raised in case of problems, keeping the rules in use unchanged.
+func (d *DbParameter) LoadFilterRules() ([]*models.FilterRule, error) {
+
+	l.Trace.Printf("[DB] Attempting to load the filter rules in the system.\n")
+
+	rules, e := d.ListFilterRules()
+
+	if e != nil {
+
+		return nil, e
+
+	}
+
+	var enabled []*models.FilterRule
+	var filters []*filterRule
+
+	for _, r := range rules {
+
+		if r.Disabled {
+
+			continue
+
+		}
+
+		f := filterRule{
+			rule: r,
+		}
+
+		if r.NamePattern != "" {
+
+			if f.pattern, e = regexp.Compile(r.NamePattern); e != nil {
+
+				l.Warning.Printf("[DB] The pattern of the filter rule [ %v ] is not valid, the rule is ignored. Error: %v\n", *r.Name, e)
+
+				continue
+
+			}
+
+		}
+
+		enabled = append(enabled, r)
+		filters = append(filters, &f)
+
+	}
+
+	d.filtersMutex.Lock()
+	d.filters = filters
+	d.filtersMutex.Unlock()
+
+	l.Info.Printf("[DB] [ %v ] filter rules loaded.\n", len(filters))
+
+	return enabled, nil
+
+}
+
+// RebuildStates job is to rebuild the states and their history of changes by
+// replaying the log of raw events for the provided account and resource, or
+// globally, reporting the differences with the actual ones. Only the resources
+// with raw events logged are rebuilt, skipping the ones whose history predates
+// their first raw event, as the log can't reproduce it. The rebuild is only
+// kept when requested, otherwise it's rolled back.
+// Parameters:
+// - account: string with the account to rebuild, empty for all of them.
+// - resource: string with the resource to rebuild, empty for all of them.
+// - apply: bool to keep the rebuild instead of only reporting it.
+// Returns:
+// - RebuildReport reference with the differences found.
+// - error raised in case of problems, ErrNoRawEvents if there's nothing to
+// replay.
+func (d *DbParameter) RebuildStates(account, resource string, apply bool) (*models.RebuildReport, error) {
+
+	l.Trace.Printf("[DB] Attempting to rebuild the states of account [ %v ] and resource [ %v ] from the raw events.\n", account, resource)
+
+	var raws []*models.RawEvent
+
+	if e := d.Db.Where(&models.RawEvent{Account: account, ResourceID: resource}).Order(d.Db.NamingStrategy.ColumnName("", "EventTime") + ", id").Find(&raws).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while retrieving the raw events from the system. Error: %v\n", e)
+
+		return nil, e
+
+	}
+
+	if len(raws) == 0 {
+
+		return nil, ErrNoRawEvents
+
+	}
+
+	// The resources are rebuilt from their first raw event logged
+	resourceKey := func(account, resource string) string {
+
+		return account + "|" + resource
+
+	}
+
+	starts := make(map[string]int64)
+
+	for _, r := range raws {
+
+		if _, exists := starts[resourceKey(r.Account, r.ResourceID)]; !exists {
+
+			starts[resourceKey(r.Account, r.ResourceID)] = r.EventTime
+
+		}
+
+	}
+
+	report := models.RebuildReport{
+		Account:     account,
+		Applied:     apply,
+		Differences: []*models.RebuildDifference{},
+		LogStart:    raws[0].EventTime,
+		ResourceID:  resource,
+	}
+
+	e := d.Db.Transaction(func(tx *gorm.DB) error {
+
+		before, e := d.snapshotStates(tx, account, resource)
+
+		if e != nil {
+
+			return e
+
+		}
+
+		// A history older than the log means the resource was tracked before
+		// it, so replaying the log would lose that part of its history
+		skipped := make(map[string]bool)
+
+		for _, s := range before {
+
+			key := resourceKey(s.Account, s.ResourceID)
+
+			if start, exists := starts[key]; exists && s.TimeFrom < start && !skipped[key] {
+
+				l.Warning.Printf("[DB] The history of resource [ %v ] of account [ %v ] predates its raw events, its rebuild is skipped.\n", s.ResourceID, s.Account)
+
+				skipped[key] = true
+				report.Skipped++
+
+			}
+
+		}
+
+		for key := range starts {
+
+			if skipped[key] {
+
+				continue
+
+			}
+
+			parts := strings.SplitN(key, "|", 2)
+
+			if e := tx.Where(&models.State{Account: parts[0], ResourceID: parts[1]}).Delete(&models.State{}).Error; e != nil {
+
+				return e
+
+			}
+
+			if e := tx.Where(&models.Event{Account: parts[0], ResourceID: parts[1]}).Delete(&models.Event{}).Error; e != nil {
+
+				return e
+
+			}
+
+		}
+
+		// The replay doesn't count in the metrics of the service
+		replay := DbParameter{
+			Db: tx,
+			Metrics: map[string]*prometheus.GaugeVec{
+				"count": prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "rebuild_count"}, []string{"type"}),
+			},
+		}
+
+		for _, r := range raws {
+
+			if skipped[resourceKey(r.Account, r.ResourceID)] {
+
+				continue
+
+			}
+
+			eventTime, lastEvent := r.EventTime, r.LastEvent
+
+			if e := replay.applyEvent(models.Event{
+				Account:      r.Account,
+				EventTime:    &eventTime,
+				LastEvent:    &lastEvent,
+				MetaData:     r.MetaData,
+				Region:       r.Region,
+				ResourceID:   r.ResourceID,
+				ResourceName: r.ResourceName,
+				ResourceType: r.ResourceType,
+				TimeFrom:     eventTime,
+			}); e != nil {
+
+				return e
+
+			}
+
+			report.Events++
+
+		}
+
+		after, e := d.snapshotStates(tx, account, resource)
+
+		if e != nil {
+
+			return e
+
+		}
+
+		for key, b := range before {
+
+			if a, exists := after[key]; !exists {
+
+				report.Differences = append(report.Differences, &models.RebuildDifference{Before: b, Change: "removed", Kind: d.getKind(key)})
+
+			} else if !d.sameState(b, a) {
+
+				report.Differences = append(report.Differences, &models.RebuildDifference{After: a, Before: b, Change: "changed", Kind: d.getKind(key)})
+
+			}
+
+		}
+
+		for key, a := range after {
+
+			rebuilt := resourceKey(a.Account, a.ResourceID)
+
+			if _, exists := starts[rebuilt]; !exists || skipped[rebuilt] {
+
+				continue
+
+			}
+
+			if d.getKind(key) == "history" {
+
+				report.Histories++
+
+			} else {
+
+				report.States++
+
+			}
+
+			if _, exists := before[key]; !exists {
+
+				report.Differences = append(report.Differences, &models.RebuildDifference{After: a, Change: "added", Kind: d.getKind(key)})
+
+			}
+
+		}
+
+		if !apply {
+
+			return errDryRun
+
+		}
+
+		return nil
+
+	})
+
+	if e != nil && !errors.Is(e, errDryRun) {
+
+		l.Warning.Printf("[DB] Something went wrong while rebuilding the states from the raw events. Error: %v\n", e)
+
+		return nil, e
+
+	}
+
+	sort.Slice(report.Differences, func(i, j int) bool {
+
+		a, b := report.Differences[i], report.Differences[j]
+
+		if a.Kind != b.Kind {
+
+			return a.Kind > b.Kind
+
+		}
+
+		x, y := a.Before, b.Before
+
+		if x == nil {
+
+			x = a.After
+
+		}
+
+		if y == nil {
+
+			y = b.After
+
+		}
+
+		if x.Account != y.Account {
+
+			return x.Account < y.Account
+
+		}
+
+		if x.ResourceID != y.ResourceID {
+
+			return x.ResourceID < y.ResourceID
+
+		}
+
+		return x.TimeFrom < y.TimeFrom
+
+	})
+
+	if apply {
+
+		d.Metrics["count"].With(prometheus.Labels{"type": "States rebuilt"}).Add(float64(report.States))
+
+	}
+
+	l.Info.Printf("[DB] Rebuild of the states from [ %v ] raw events found [ %v ] differences, skipped [ %v ] resources, applied: [ %v ].\n", report.Events, len(report.Differences), report.Skipped, apply)
+
+	return &report, nil
+
+}
+
+// UpdateCloudEventMapping job is to update the CloudEvents mapping linked to
+// the provided id.
+// Parameters:
+// - id: int64 with the id of the mapping.
+// - m: reference to the CloudEventMapping with the new definition.
+// Returns:
+// - reference to the CloudEventMapping updated, nil if it doesn't exist.
+// - error raised in case of problems, ErrCloudEventMappingDuplicated if its
+// name, or its source and type, are in use by another mapping and
+// ErrCloudEventMappingInvalid if it's not consistent.
+func (d *DbParameter) UpdateCloudEventMapping(id int64, m *models.CloudEventMapping) (*models.CloudEventMapping, error) {
+
+	l.Trace.Printf("[DB] Attempting to update the cloud event mapping [ %v ].\n", id)
+
+	if e := d.checkCloudEventMapping(m); e != nil {
+
+		return nil, e
+
+	}
+
+	previous, e := d.GetCloudEventMapping(id)
+
+	if previous == nil {
+
+		return nil, e
+
+	}
+
+	if e = d.checkCloudEventMappingDuplicated(m, id); e != nil {
+
+		return nil, e
+
+	}
+
+	m.ID = id
+
+	if e = d.Db.Save(m).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while updating the cloud event mapping [ %v ]. Error: %v\n", id, e)
+
+		return nil, e
+
+	}
+
+	return m, nil
+
+}
+
+// UpdateFilterRule job is to update the filter rule linked to the provided id,
+// reloading the rules in use afterwards.
+// Parameters:
+// - id: int64 with the id of the filter rule.
+// - r: reference to the FilterRule with the new definition.
+// Returns:
+// - reference to the FilterRule updated, nil if it doesn't exist.
+// - error raised in case of problems, ErrFilterRuleDuplicated if its name is
+// in use by other rule and ErrFilterRuleInvalid if it's not consistent.
+func (d *DbParameter) UpdateFilterRule(id int64, r *models.FilterRule) (*models.FilterRule, error) {
+
+	l.Trace.Printf("[DB] Attempting to update the filter rule [ %v ].\n", id)
+
+	if e := d.checkFilterRule(r); e != nil {
+
+		return nil, e
+
+	}
+
+	previous, e := d.GetFilterRule(id)
+
+	if previous == nil {
+
+		return nil, e
+
+	}
+
+	var other models.FilterRule
+
+	if e := d.Db.Where(&models.FilterRule{Name: r.Name}).First(&other).Error; e == nil && other.ID != id {
+
+		return nil, ErrFilterRuleDuplicated
+
+	}
+
+	// The hits are kept as they are only counted by the system
+	r.ID = id
+	r.Hits = previous.Hits
+
+	if e = d.Db.Save(r).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while updating the filter rule [ %v ]. Error: %v\n", id, e)
+
+		return nil, e
+
+	}
+
+	// The rule is in the system even if the reload fails, it's picked up later
+	if _, e := d.LoadFilterRules(); e != nil {
+
+		l.Warning.Printf("[DB] The filter rules couldn't be reloaded. Error: %v\n", e)
+
+	}
+
+	return r, nil
+
+}
+
+// UpdateLifecycle job is to update the lifecycle of the provided resource type.
+// Parameters:
+// - resourceType: string with the resource type.
+// - lc: reference to the Lifecycle with the new definition.
+// Returns:
+// - reference to the Lifecycle updated, nil if it's not defined.
+// - error raised in case of problems, ErrLifecycleInvalid if it's not
+// consistent.
+func (d *DbParameter) UpdateLifecycle(resourceType string, lc *models.Lifecycle) (*models.Lifecycle, error) {
+
+	l.Trace.Printf("[DB] Attempting to update the lifecycle of [ %v ].\n", resourceType)
+
+	lc.ResourceType = &resourceType
+
+	if e := d.checkLifecycle(lc); e != nil {
+
+		return nil, e
+
+	}
+
+	if previous, e := d.GetLifecycle(resourceType); previous == nil {
+
+		return nil, e
+
+	}
+
+	if e := d.Db.Save(lc).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while updating the lifecycle of [ %v ]. Error: %v\n", resourceType, e)
+
+		return nil, e
+
+	}
+
+	return lc, nil
+
+}
+
+// UpdateStates job is to process the provided states given a pattern, ranging
+// through the given states, records the change in the history of the state and
+// then updates the state with in the system according to the pattern.
+// Parameters:
+// - states: slice of State to be updated in the system.
+// - pattern: a State reference containing the changes to be done previous to
+// the update in the system.
+// Returns:
+// - error raised in case of problems
+func (d *DbParameter) UpdateStates(states []*models.State, pattern models.State) error {
+
+	l.Trace.Printf("[DB] Attempting to update [ %v ] states.\n", len(states))
+
+	var counth, countdb int = 0, 0
+	var e error
+
+	for i := range states {
+
+		l.Debug.Printf("[DB] Processing state for resource [ %v ] from account [ %v ].\n", states[i].ResourceID, states[i].Account)
+
+		s := states[i]
+		s.TimeTo = pattern.TimeFrom
+
+		if e = d.AddToHistory(*s); e != nil {
+
+			l.Warning.Printf("[DB] Something went wrong while saving the history of the state. Error: %v\n", e)
+
+			counth++
+
+		}
+
+		if e = d.Db.Model(states[i]).Updates(pattern).Error; e != nil {
+
+			l.Warning.Printf("[DB] Something went wrong while updating the state in the system. Error: %v\n", e)
+
+			countdb++
+
+		}
+
+	}
+
+	l.Trace.Printf("[DB] Update of states finished with [ %v ] errors while saving the history and [ %v ] while updating the states\n", counth, countdb)
+
+	return e
+
+}
+
+// flagRecomputation job is to keep track of the time-window of a resource
+// whose usage changed after the insertion of an out-of-order event, so the
+// consumers of the usage can recompute it.
+// Parameters:
+// - event: Event with the resource affected.
+// - from: int representing the start of the time-window affected.
+// - to: int representing the end of the time-window affected.
+func (d *DbParameter) flagRecomputation(event models.Event, from, to int64) {
+
+	r := models.Recomputation{
+		Account:      event.Account,
+		CreatedAt:    time.Now().Unix(),
+		Region:       event.Region,
+		ResourceID:   event.ResourceID,
+		ResourceType: event.ResourceType,
+		TimeFrom:     from,
+		TimeTo:       to,
+	}
+
+	if e := d.Db.Create(&r).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while flagging the recomputation of the resource [ %v ]. Error: %v\n", event.ResourceID, e)
+
+		return
+
+	}
+
+	d.Metrics["count"].With(prometheus.Labels{"type": "Recomputations flagged"}).Inc()
+
+}
+
+// insertEvent job is to place an out-of-order event in the timeline of its
+// resource, splitting the history interval containing it or filling the gap
+// before the next known change, merging the adjacent intervals left with the
+// same state and flagging the time-window affected for recomputation.
+// It's meant to be run within the transaction of the event, so the timeline
+// isn't left half-changed on failure.
+// Parameters:
+// - event: Event older than the latest change of its resource.
+// Returns:
+// - e: error raised in case of problems
+func (d *DbParameter) insertEvent(event models.Event) (e error) {
+
+	t := *event.EventTime
+
+	timeFrom := d.Db.NamingStrategy.ColumnName("", "TimeFrom")
+	timeTo := d.Db.NamingStrategy.ColumnName("", "TimeTo")
+
+	pattern := models.Event{
+		Account:    event.Account,
+		ResourceID: event.ResourceID,
+		Region:     event.Region,
+	}
+
+	same := func(state *string, meta datamodels.JSONdb) bool {
+
+		return *state == *event.LastEvent && reflect.DeepEqual(meta, event.MetaData)
+
+	}
+
+	piece := models.Event{
+		Account:      event.Account,
+		EventTime:    event.EventTime,
+		LastEvent:    event.LastEvent,
+		MetaData:     event.MetaData,
+		Region:       event.Region,
+		ResourceID:   event.ResourceID,
+		ResourceName: event.ResourceName,
+		ResourceType: event.ResourceType,
+		TimeFrom:     t,
+	}
+
+	var h models.Event
+
+	r := d.Db.Where(pattern).Where(timeFrom+" <= ? AND "+timeTo+" > ?", t, t).Order(timeFrom + " desc").First(&h).Error
+
+	switch {
+
+	case r == nil:
+
+		if same(h.LastEvent, h.MetaData) {
+
+			l.Debug.Printf("[DB] The event of resource [ %v ] at [ %v ] doesn't change its history.\n", event.ResourceID, t)
+
+			return
+
+		}
+
+		piece.TimeTo = h.TimeTo
+
+		// The interval is either split at the event or fully replaced by it
+		if h.TimeFrom < t {
+
+			e = d.Db.Model(&h).Update(timeTo, t).Error
+
+		} else {
+
+			e = d.Db.Delete(&h).Error
+
+		}
+
+		if e != nil {
+
+			l.Warning.Printf("[DB] Something went wrong while splitting the history of the resource [ %v ]. Error: %v\n", event.ResourceID, e)
+
+			return
+
+		}
+
+	case errors.Is(r, gorm.ErrRecordNotFound):
+
+		// Outside of the history, the event lasts until the next known change
+		var next models.Event
+		var state models.State
+
+		piece.TimeTo = endOfTime
+
+		if d.Db.Where(pattern).Where(timeFrom+" > ?", t).Order(timeFrom).First(&next).Error == nil {
+
+			piece.TimeTo = next.TimeFrom
+
+		}
+
+		if d.Db.Where(models.State{Account: event.Account, ResourceID: event.ResourceID, Region: event.Region}).Where(timeFrom+" > ?", t).Order(timeFrom).First(&state).Error == nil && state.TimeFrom < piece.TimeTo {
+
+			piece.TimeTo = state.TimeFrom
+
+		}
+
+	default:
+
+		l.Warning.Printf("[DB] Something went wrong while retrieving the history of the resource [ %v ]. Error: %v\n", event.ResourceID, r)
+
+		return r
+
+	}
+
+	// The usage changes from the event until the next change known
+	end := piece.TimeTo
+
+	// Merge with the previous interval in case of having the same state
+	var prev models.Event
+
+	if d.Db.Where(pattern).Where(timeTo+" = ?", t).First(&prev).Error == nil && same(prev.LastEvent, prev.MetaData) {
+
+		piece.TimeFrom = prev.TimeFrom
+		piece.EventTime = prev.EventTime
+
+		if e = d.Db.Delete(&prev).Error; e != nil {
+
+			l.Warning.Printf("[DB] Something went wrong while merging the history of the resource [ %v ]. Error: %v\n", event.ResourceID, e)
+
+			return
+
+		}
+
+	}
+
+	// And with the next one, which might be the actual state of the resource
+	var next models.Event
+	var state models.State
+
+	if d.Db.Where(pattern).Where(timeFrom+" = ?", piece.TimeTo).First(&next).Error == nil && same(next.LastEvent, next.MetaData) {
+
+		piece.TimeTo = next.TimeTo
+
+		if e = d.Db.Delete(&next).Error; e != nil {
+
+			l.Warning.Printf("[DB] Something went wrong while merging the history of the resource [ %v ]. Error: %v\n", event.ResourceID, e)
+
+			return
+
+		}
+
+	} else if d.Db.Where(models.State{Account: event.Account, ResourceID: event.ResourceID, Region: event.Region}).Where(timeFrom+" = ?", piece.TimeTo).First(&state).Error == nil && same(state.LastEvent, state.MetaData) {
+
+		if e = d.Db.Model(&state).Update(timeFrom, piece.TimeFrom).Error; e != nil {
+
+			l.Warning.Printf("[DB] Something went wrong while merging the state of the resource [ %v ]. Error: %v\n", event.ResourceID, e)
+
+			return
+
+		}
+
+		d.flagRecomputation(event, t, end)
+
+		return
+
+	}
+
+	if e = d.Db.Create(&piece).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while inserting the event in the history of the resource [ %v ]. Error: %v\n", event.ResourceID, e)
+
+		return
+
+	}
+
+	d.Metrics["count"].With(prometheus.Labels{"type": "Histories updated"}).Inc()
+
+	d.flagRecomputation(event, t, end)
+
+	return
+
+}
+
+// lockResource job is to lock the states of the resource of the event until
+// the end of the transaction, making the rest of the events of the resource
+// wait for it.
+// Parameters:
+// - event: Event with the resource to be locked.
+// Returns:
+// - e: error raised in case of problems
+func (d *DbParameter) lockResource(event models.Event) (e error) {
+
+	var states []*models.State
+
+	e = d.Db.Clauses(clause.Locking{Strength: "UPDATE"}).Where(models.State{
+		Account:    event.Account,
+		ResourceID: event.ResourceID,
+		Region:     event.Region,
+	}).Find(&states).Error
+
+	return
+
+}
+
+// applyEvent job is to apply the event on its corresponding resource state,
+// archiving the previous state in the history when it changes.
+// Parameters:
+// - event: a reference containing the new event in the resource.
+// Returns:
+// - e: error raised in case of problems
+func (d *DbParameter) applyEvent(event models.Event) (e error) {
+
+	var states []*models.State
+	var s, state models.State
+
+	patternSearch := models.State{
+		Account:    event.Account,
+		ResourceID: event.ResourceID,
+		Region:     event.Region,
+	}
+
+	patternUpdate := models.State{
+		TimeFrom:  event.TimeFrom,
+		EventTime: event.EventTime,
+		LastEvent: func(s string) *string { return &s }(stateTerminated),
+	}
+
+	s = patternSearch
+	s.MetaData = event.MetaData
+
+	// Events older than the latest change known of the resource don't change
+	// its actual state, they are placed in their point of the timeline
+	var latest models.State
+
+	if r := d.Db.Where(patternSearch).Order(d.Db.NamingStrategy.ColumnName("", "TimeFrom") + " desc").First(&latest).Error; r == nil && *event.EventTime < latest.TimeFrom {
+
+		l.Debug.Printf("[DB] The event of resource [ %v ] at [ %v ] is older than its latest change at [ %v ], proceeding to insert it in the timeline...\n", event.ResourceID, *event.EventTime, latest.TimeFrom)
+
+		e = d.insertEvent(event)
+
+		d.Metrics["count"].With(prometheus.Labels{"type": "Backdated events processed"}).Inc()
+
+		return
+
+	}
+
+	// First check is there's anything from same resourceid with different metadata,
+	// if so, terminate them all
+	if e = d.Db.Where(patternSearch).Not(models.State{
+		MetaData: event.MetaData,
+	}).Not(models.State{
+		LastEvent: func(s string) *string { return &s }(stateTerminated),
+	}).Find(&states).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while checking if the resource already has registered states in the system. Error: %v\n", e)
+
+	}
+
+	if len(states) != 0 {
+
+		l.Debug.Printf("[DB] We found [ %v ] states linked to the resource [ %v ] proceeding to mark them as terminated...\n", len(states), event.ResourceID)
+
+		if e = d.UpdateStates(states, patternUpdate); e != nil {
+
+			l.Warning.Printf("[DB] Something went wrong while terminating the other states linked to the resource [ %v ]. Error: %v\n", event.ResourceID, e)
+
+		} else {
+
+			d.Metrics["count"].With(prometheus.Labels{"type": "States updated"}).Add(float64(len(states)))
+
+		}
+
+	}
+
+	// check if Status is the same
+	// if so, update the last time event field
+	// if diferent, copy actual state to history, then change state into new values from event
+	if r := d.Db.Where(&s).First(&state).Error; errors.Is(r, gorm.ErrRecordNotFound) {
+
+		l.Debug.Printf("[DB] The resource [ %v ] doesn't have a linked state in the system, proceeding to generate one...\n", event.ResourceID)
+
+		s.ResourceName = event.ResourceName
+		s.TimeTo = endOfTime
+		s.TimeFrom = *event.EventTime
+		s.ResourceType = event.ResourceType
+		s.LastEvent = event.LastEvent
+		s.EventTime = event.EventTime
+
+		if e = d.Db.Create(&s).Error; e != nil {
+
+			l.Warning.Printf("[DB] Something went wrong while trying to create the state. Error: %v\n", e)
+
+		} else {
+
+			d.Metrics["count"].With(prometheus.Labels{"type": "States created"}).Inc()
+
+		}
+
+	} else {
+
+		l.Debug.Printf("[DB] The resource [ %v ] seems to be already in the system with state [ %v ], proceeding to update its state...\n", event.ResourceID, *state.LastEvent)
+
+		if *state.LastEvent == *event.LastEvent {
+
+			// Delayed events of the same state don't move back the last event time
+			if state.EventTime != nil && *state.EventTime >= *event.EventTime {
+
+				l.Debug.Printf("[DB] The resource [ %v ] already has a later event of the same state, nothing to update.\n", event.ResourceID)
+
+			} else if e = d.Db.Model(&state).Updates(models.State{
+				EventTime: event.EventTime,
+			}).Error; e != nil {
+
+				l.Warning.Printf("[DB] Something went wrong while updating the state. Error: %v\n", e)
+
+			} else {
+
+				d.Metrics["count"].With(prometheus.Labels{"type": "States updated"}).Inc()
+
+			}
+
+		} else {
+
+			l.Debug.Printf("[DB] The resource [ %v ] seems to have a previous different state: [ %v ] -> [ %v ], proceeding to update it...\n", event.ResourceID, *state.LastEvent, *event.LastEvent)
+
+			h := state
+			h.TimeTo = *event.EventTime
+
+			if e = d.AddToHistory(h); e != nil {
+
+				l.Warning.Printf("[DB] Something went wrong while saving in the history the previous state. Error: %v\n", e)
+
+			}
+
+			if e = d.Db.Model(&state).Updates(models.State{
+				EventTime: event.EventTime,
+				LastEvent: event.LastEvent,
+				TimeFrom:  *event.EventTime,
+			}).Error; e != nil {
+
+				l.Warning.Printf("[DB] Something went wrong while updating the state. Error: %v\n", e)
+
+			} else {
+
+				d.Metrics["count"].With(prometheus.Labels{"type": "States updated"}).Inc()
+
+			}
+
+		}
+
+	}
+
+	return
+
+}
+
+// getKind job is to tell from the key of a snapshot if it belongs to a state
+// or to the history of changes.
+// Parameters:
+// - key: string with the key of the snapshot.
+// Returns:
+// - string with the kind: history or state.
+func (d *DbParameter) getKind(key string) string {
+
+	return strings.SplitN(key, "|", 2)[0]
+
+}
+
+// sameState job is to compare two snapshots of a state, ignoring their ids.
+// Parameters:
+// - a: reference to the first State.
+// - b: reference to the second State.
+// Returns:
+// - bool with the result of the comparison.
+func (d *DbParameter) sameState(a, b *models.State) bool {
+
+	x, y := *a, *b
+	x.ID, y.ID = 0, 0
+
+	return reflect.DeepEqual(x, y)
+
+}
+
+// snapshotStates job is to retrieve the states and history of changes of the
+// scope provided, keyed by the resource, its metadata and, for the history,
+// the start of the interval.
+// Parameters:
+// - db: gorm.DB reference where to retrieve them from.
+// - account: string with the account to retrieve, empty for all of them.
+// - resource: string with the resource to retrieve, empty for all of them.
+// Returns:
+// - m: map with the key and the State, histories included as states.
+// - e: error raised in case of problems
+func (d *DbParameter) snapshotStates(db *gorm.DB, account, resource string) (m map[string]*models.State, e error) {
+
+	var ev []*models.Event
+	var st []*models.State
+
+	m = make(map[string]*models.State)
+
+	if e = db.Where(&models.State{Account: account, ResourceID: resource}).Find(&st).Error; e != nil {
+
+		return
+
+	}
+
+	if e = db.Where(&models.Event{Account: account, ResourceID: resource}).Find(&ev).Error; e != nil {
+
+		return
+
+	}
+
+	key := func(s *models.State) string {
+
+		return s.Account + "|" + s.ResourceType + "|" + s.Region + "|" + s.ResourceID + "|" + fmt.Sprintf("%v", s.MetaData)
+
+	}
+
+	for _, s := range st {
+
+		m["state|"+key(s)] = s
+
+	}
+
+	for _, h := range ev {
+
+		s := models.State(*h)
+
+		m["history|"+key(&s)+"|"+strconv.FormatInt(s.TimeFrom, 10)] = &s
+
+	}
+
+	return
+
+}
+
+// checkLifecycle job is to validate the consistency of a lifecycle, with its
+// terminal states and transitions among the states declared.
+// Parameters:
+// - lc: reference to the Lifecycle to be checked.
+// Returns:
+// - e: ErrLifecycleInvalid with the reason in case of not being consistent.
+func (d *DbParameter) checkLifecycle(lc *models.Lifecycle) (e error) {
+
+	if lc.ResourceType == nil || *lc.ResourceType == "" {
+
+		return fmt.Errorf("%w: the resource type is missing", ErrLifecycleInvalid)
+
+	}
+
+	if len(lc.States) == 0 {
+
+		return fmt.Errorf("%w: no states declared", ErrLifecycleInvalid)
+
+	}
+
+	for _, t := range lc.Terminal {
+
+		if !inList(lc.States, t) {
+
+			return fmt.Errorf("%w: terminal state [ %v ] not declared", ErrLifecycleInvalid, t)
+
+		}
+
+	}
+
+	for _, t := range lc.Transitions {
+
+		if t == nil || !inList(lc.States, t.From) || !inList(lc.States, t.To) {
+
+			return fmt.Errorf("%w: transition between states not declared", ErrLifecycleInvalid)
+
+		}
+
+		if inList(lc.Terminal, t.From) {
+
+			return fmt.Errorf("%w: transition from terminal state [ %v ]", ErrLifecycleInvalid, t.From)
+
+		}
+
+	}
+
+	return
+
+}
+
+// checkTransition job is to validate the event against the lifecycle of its
+// resource type, if any, checking that its state is declared and that the
+// resource can move to it from its actual state.
+// Parameters:
+// - event: Event to be checked.
+// Returns:
+// - reason: string with the reason why the event is not valid, empty if it is.
+// - e: error raised in case of problems
+func (d *DbParameter) checkTransition(event models.Event) (reason string, e error) {
+
+	lc, e := d.GetLifecycle(event.ResourceType)
+
+	if e != nil || lc == nil {
+
+		return
+
+	}
+
+	to := *event.LastEvent
+
+	if !inList(lc.States, to) {
+
+		return fmt.Sprintf("state [ %v ] not declared for [ %v ]", to, event.ResourceType), nil
+
+	}
+
+	var current models.State
+
+	if e = d.Db.Where(models.State{Account: event.Account, ResourceID: event.ResourceID, Region: event.Region}).Order(d.Db.NamingStrategy.ColumnName("", "TimeFrom") + " desc").First(&current).Error; e != nil {
+
+		if errors.Is(e, gorm.ErrRecordNotFound) {
+
+			e = nil
+
+		}
+
+		return
+
+	}
+
+	// Backdated events are only checked against the states declared, as they
+	// are placed in the timeline instead of moving the actual state
+	if *event.EventTime < current.TimeFrom {
+
+		return
+
+	}
+
+	from := *current.LastEvent
+
+	if from == to {
+
+		return
+
+	}
+
+	if inList(lc.Terminal, from) {
+
+		return fmt.Sprintf("transition from terminal state [ %v ] to [ %v ]", from, to), nil
+
+	}
+
+	if len(lc.Transitions) == 0 {
+
+		return
+
+	}
+
+	for _, t := range lc.Transitions {
+
+		if t.From == from && t.To == to {
+
+			return
+
+		}
+
+	}
+
+	return fmt.Sprintf("transition [ %v ] -> [ %v ] not allowed for [ %v ]", from, to, event.ResourceType), nil
+
+}
+
+// inList job is to check if the provided string is in the list.
+// Parameters:
+// - list: slice of strings to look into.
+// - s: string to look for.
+// Returns:
+// - bool with the result of the check.
+func inList(list []string, s string) bool {
+
+	for i := range list {
+
+		if list[i] == s {
+
+			return true
+
+		}
+
+	}
+
+	return false
+
+}
+
+// quarantineEvent job is to hold back an event not following the lifecycle of
+// its resource type.
+// Parameters:
+// - event: Event held back.
+// - reason: string with the reason of the quarantine.
+// Returns:
+// - e: error raised in case of problems.
+func (d *DbParameter) quarantineEvent(event models.Event, reason string) (e error) {
+
+	q := models.QuarantinedEvent{
+		Account:      event.Account,
+		EventTime:    *event.EventTime,
+		LastEvent:    *event.LastEvent,
+		MetaData:     event.MetaData,
+		Reason:       reason,
+		ReceivedAt:   time.Now().Unix(),
+		Region:       event.Region,
+		ResourceID:   event.ResourceID,
+		ResourceName: event.ResourceName,
+		ResourceType: event.ResourceType,
+	}
+
+	if e = d.Db.Create(&q).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while quarantining the event. Error: %v\n", e)
+
+		return
+
+	}
+
+	d.Metrics["count"].With(prometheus.Labels{"type": "Events quarantined"}).Inc()
+
+	return
+
+}
+
+// checkFilterRule job is to validate the consistency of a filter rule.
+// Parameters:
+// - r: reference to the FilterRule to be checked.
+// Returns:
+// - e: ErrFilterRuleInvalid with the reason in case of not being consistent.
+func (d *DbParameter) checkFilterRule(r *models.FilterRule) (e error) {
+
+	if r.Name == nil || *r.Name == "" {
+
+		return fmt.Errorf("%w: the name is missing", ErrFilterRuleInvalid)
+
+	}
+
+	if r.Action == nil {
+
+		return fmt.Errorf("%w: the action is missing", ErrFilterRuleInvalid)
+
+	}
+
+	switch *r.Action {
+
+	case models.FilterRuleActionDrop, models.FilterRuleActionQuarantine:
+
+	case models.FilterRuleActionTag:
+
+		if len(r.Tags) == 0 {
+
+			return fmt.Errorf("%w: no tags to add with the tag action", ErrFilterRuleInvalid)
+
+		}
+
+	default:
+
+		return fmt.Errorf("%w: unknown action [ %v ]", ErrFilterRuleInvalid, *r.Action)
+
+	}
+
+	if _, e = regexp.Compile(r.NamePattern); e != nil {
+
+		return fmt.Errorf("%w: name pattern not valid: %v", ErrFilterRuleInvalid, e)
+
+	}
+
+	for _, list := range [][]*models.FilterMatch{r.MetaData, r.Tags} {
+
+		for _, m := range list {
+
+			if m == nil || m.Key == nil || *m.Key == "" {
+
+				return fmt.Errorf("%w: metadata without key", ErrFilterRuleInvalid)
+
+			}
+
+		}
+
+	}
+
+	return
+
+}
+
+// filterEvent job is to evaluate the filter rules in use against the event.
+// The rules with the tag action add their tags to the metadata of the event
+// and the evaluation goes on, while the first rule matching with a drop or
+// quarantine action stops it.
+// Parameters:
+// - event: reference to the Event to be evaluated.
+// Returns:
+// - reference to the FilterRule dropping or quarantining the event, nil if
+// the event has to be processed.
+func (d *DbParameter) filterEvent(event *models.Event) *models.FilterRule {
+
+	d.filtersMutex.RLock()
+	filters := d.filters
+	d.filtersMutex.RUnlock()
+
+	for _, f := range filters {
+
+		if !f.matches(event) {
+
+			continue
+
+		}
+
+		d.Metrics["count"].With(prometheus.Labels{"type": "Events filtered by rule " + *f.rule.Name}).Inc()
+
+		if e := d.Db.Model(&models.FilterRule{}).Where(&models.FilterRule{ID: f.rule.ID}).UpdateColumn(d.Db.NamingStrategy.ColumnName("", "Hits"), gorm.Expr(d.Db.NamingStrategy.ColumnName("", "Hits")+" + ?", 1)).Error; e != nil {
+
+			l.Warning.Printf("[DB] Something went wrong while counting the hit of the filter rule [ %v ]. Error: %v\n", *f.rule.Name, e)
+
+		}
+
+		if *f.rule.Action != models.FilterRuleActionTag {
+
+			l.Debug.Printf("[DB] The event of resource [ %v ] was filtered by the rule [ %v ] with action [ %v ].\n", event.ResourceID, *f.rule.Name, *f.rule.Action)
+
+			return f.rule
+
+		}
+
+		// The metadata is copied to not modify the one of the caller
+		metadata := make(datamodels.JSONdb, len(event.MetaData)+len(f.rule.Tags))
+
+		for k, v := range event.MetaData {
+
+			metadata[k] = v
+
+		}
+
+		for _, t := range f.rule.Tags {
+
+			metadata[*t.Key] = t.Value
+
+		}
+
+		event.MetaData = metadata
+
+	}
+
+	return nil
+
+}
+
+// matches job is to check if the event fulfills all the conditions of the
+// filter rule, with the empty ones matching any value.
+// Parameters:
+// - event: reference to the Event to be checked.
+// Returns:
+// - bool with the result of the check.
+func (f *filterRule) matches(event *models.Event) bool {
+
+	if (f.rule.Account != "" && f.rule.Account != event.Account) ||
+		(f.rule.ResourceType != "" && f.rule.ResourceType != event.ResourceType) ||
+		(f.rule.Region != "" && f.rule.Region != event.Region) {
+
+		return false
+
+	}
+
+	if f.pattern != nil && !f.pattern.MatchString(event.ResourceName) {
+
+		return false
+
+	}
+
+	for _, m := range f.rule.MetaData {
+
+		v, exists := event.MetaData[*m.Key]
+
+		if !exists || (m.Value != "" && fmt.Sprint(v) != m.Value) {
+
+			return false
+
+		}
+
+	}
+
+	return true
+
+}
+
+// getDiscreteUsage job is to count the discrete events within the time-window
+// by resource, adding up their quantities by kind.
+// Parameters:
+// - ac: string with the account, empty for all of them.
+// - ty: string with the resource type to filter, empty for all of them.
+// - rg: string with the region to filter, empty for all of them.
+// - from: int64 with the start of the time-window.
+// - to: int64 with the end of the time-window, excluded.
+// Returns:
+// - uses: map of slices of Use with the count unit by account, one per resource
+// and metadata.
+// - e: error raised in case of problems
+func (d *DbParameter) getDiscreteUsage(ac, ty, rg string, from, to int64) (uses map[string][]*models.Use, e error) {
+
+	type discreteCount struct {
+		Account      string
+		Kind         string
+		MetaData     datamodels.JSONdb
+		Quantity     float64
+		Region       string
+		ResourceID   string
+		ResourceName string
+		ResourceType string
+	}
+
+	var counts []discreteCount
+
+	column := func(field string) string {
+
+		return d.Db.NamingStrategy.ColumnName("", field)
+
+	}
+
+	group := strings.Join([]string{column("Account"), column("Kind"), column("MetaData"), column("Region"), column("ResourceID"), column("ResourceName"), column("ResourceType")}, ", ")
+
+	if e = d.Db.Model(&models.DiscreteEvent{}).
+		Select(group+", SUM("+column("Quantity")+") AS "+column("Quantity")).
+		Where(&models.DiscreteEvent{Account: ac, ResourceType: ty, Region: rg}).
+		Where(column("EventTime")+" >= ? AND "+column("EventTime")+" < ?", from, to).
+		Group(group).
+		Order(column("Account")).
+		Order(column("ResourceID")).
+		Scan(&counts).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while counting the discrete events of the account [ %v ]. Error: %v\n", ac, e)
+
+		return
+
+	}
+
+	uses = make(map[string][]*models.Use)
+	index := make(map[string]*models.Use)
+
+	for _, c := range counts {
+
+		key := fmt.Sprintf("%v|%v|%v|%v|%v", c.Account, c.ResourceID, c.ResourceType, c.Region, c.MetaData)
+
+		use, exists := index[key]
+
+		if !exists {
+
+			use = &models.Use{
+				MetaData:     c.MetaData,
+				Region:       c.Region,
+				ResourceID:   c.ResourceID,
+				ResourceName: c.ResourceName,
+				ResourceType: c.ResourceType,
+				Unit:         "count",
+				UsageBreakup: make(datamodels.JSONdb),
+			}
+
+			index[key] = use
+			uses[c.Account] = append(uses[c.Account], use)
+
+		}
+
+		use.UsageBreakup[c.Kind] = c.Quantity
+
+	}
+
+	return
+
+}
+
+// computeUsage job is to compute the usage within the time-window by resource
+// with a single range query over the actual states and their history, where
+// every interval overlapping the time-window contributes with the seconds
+// within it, plus the count of the discrete events.
+// Parameters:
+// - ac: string with the account, empty for all of them.
+// - ty: string with the resource type to filter, empty for all of them.
+// - rg: string with the region to filter, empty for all of them.
+// - from: int64 with the start of the time-window.
+// - to: int64 with the end of the time-window.
+// Returns:
+// - uses: map of slices of Use by account.
+// - e: error raised in case of problems
+func (d *DbParameter) computeUsage(ac, ty, rg string, from, to int64) (uses map[string][]*models.Use, e error) {
+
+	type intervalUsage struct {
+		Account      string
+		LastEvent    string
+		MetaData     datamodels.JSONdb
+		Region       string
+		ResourceID   string
+		ResourceName string
+		ResourceType string
+		Seconds      int64
+	}
+
+	var rows []intervalUsage
+
+	column := func(field string) string {
+
+		return d.Db.NamingStrategy.ColumnName("", field)
+
+	}
+
+	fields := []string{column("Account"), column("LastEvent"), column("MetaData"), column("Region"), column("ResourceID"), column("ResourceName"), column("ResourceType")}
+	group := strings.Join(fields, ", ")
+	interval := group + ", " + column("TimeFrom") + ", " + column("TimeTo")
+	overlap := column("TimeFrom") + " < ? AND " + column("TimeTo") + " > ?"
+
+	states := d.Db.Model(&models.State{}).Select(interval).Where(&models.State{Account: ac, ResourceType: ty, Region: rg}).Where(overlap, to, from)
+	history := d.Db.Model(&models.Event{}).Select(interval).Where(&models.Event{Account: ac, ResourceType: ty, Region: rg}).Where(overlap, to, from)
+
+	// The intervals are clipped to the time-window in the database
+	if e = d.Db.Table("(? UNION ALL ?) AS intervals", states, history).
+		Select(group+", SUM(LEAST("+column("TimeTo")+", ?) - GREATEST("+column("TimeFrom")+", ?))::bigint AS "+column("Seconds"), to, from).
+		Where(column("LastEvent")+" <> ?", stateTerminated).
+		Group(group).
+		Order(column("Account")).
+		Order(column("ResourceID")).
+		Scan(&rows).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while computing the usage of the account [ %v ]. Error: %v\n", ac, e)
+
+		return
+
+	}
+
+	uses = make(map[string][]*models.Use)
+	index := make(map[string]*models.Use)
+
+	for _, r := range rows {
+
+		key := fmt.Sprintf("%v|%v|%v|%v|%v|%v", r.Account, r.ResourceID, r.ResourceName, r.ResourceType, r.Region, r.MetaData)
+
+		use, exists := index[key]
+
+		if !exists {
+
+			use = &models.Use{
+				MetaData:     r.MetaData,
+				Region:       r.Region,
+				ResourceID:   r.ResourceID,
+				ResourceName: r.ResourceName,
+				ResourceType: r.ResourceType,
+				Unit:         "seconds",
+				UsageBreakup: make(datamodels.JSONdb),
+			}
+
+			index[key] = use
+			uses[r.Account] = append(uses[r.Account], use)
+
+		}
+
+		use.UsageBreakup[r.LastEvent] = r.Seconds
+
+	}
+
+	// The discrete events are counted instead of measured over time
+	discrete, e := d.getDiscreteUsage(ac, ty, rg, from, to)
+
+	for account := range discrete {
+
+		uses[account] = append(uses[account], discrete[account]...)
+
+	}
+
+	return
+
+}
+
+// checkCloudEvent job is to check that the CloudEvent follows the CloudEvents
+// 1.0 specification, with the required context attributes and a valid time.
+// Parameters:
+// - ce: reference to the CloudEvent to be checked.
+// Returns:
+// - e: ErrCloudEventInvalid with the reason in case it's not valid.
+func (d *DbParameter) checkCloudEvent(ce *CloudEvent) (e error) {
+
+	if ce == nil || ce.Attributes == nil {
+
+		return fmt.Errorf("%w: no context attributes", ErrCloudEventInvalid)
+
+	}
+
+	if v := ce.Attributes["specversion"]; v != "1.0" {
+
+		return fmt.Errorf("%w: unsupported specversion [ %v ]", ErrCloudEventInvalid, v)
+
+	}
+
+	for _, a := range []string{"id", "source", "type"} {
+
+		if ce.Attributes[a] == "" {
+
+			return fmt.Errorf("%w: the %v attribute is missing", ErrCloudEventInvalid, a)
+
+		}
+
+	}
+
+	if t, exists := ce.Attributes["time"]; exists {
+
+		if _, e = time.Parse(time.RFC3339Nano, t); e != nil {
+
+			return fmt.Errorf("%w: the time attribute is not RFC 3339: %v", ErrCloudEventInvalid, e)
+
+		}
+
+	}
+
+	return
+
+}
+
+// checkCloudEventMapping job is to check the consistency of a CloudEvents
+// mapping: the source and the required fields have to be provided and every
+// expression has to be valid.
+// Parameters:
+// - m: reference to the CloudEventMapping to be checked.
+// Returns:
+// - e: ErrCloudEventMappingInvalid with the reason in case it's not valid.
+func (d *DbParameter) checkCloudEventMapping(m *models.CloudEventMapping) (e error) {
+
+	if e = m.Validate(strfmt.Default); e != nil {
+
+		return fmt.Errorf("%w: %v", ErrCloudEventMappingInvalid, e)
+
+	}
+
+	if *m.Name == "" {
+
+		return fmt.Errorf("%w: the name is missing", ErrCloudEventMappingInvalid)
+
+	}
+
+	if *m.Source == "" {
+
+		return fmt.Errorf("%w: the source is missing", ErrCloudEventMappingInvalid)
+
+	}
+
+	fields := map[string]string{
+		"Account":      *m.Account,
+		"LastEvent":    *m.LastEvent,
+		"ResourceId":   *m.ResourceID,
+		"ResourceType": *m.ResourceType,
+	}
+
+	for field, expr := range fields {
+
+		if expr == "" {
+
+			return fmt.Errorf("%w: the %v expression is missing", ErrCloudEventMappingInvalid, field)
+
+		}
+
+	}
+
+	fields["EventTime"] = m.EventTime
+	fields["Region"] = m.Region
+	fields["ResourceName"] = m.ResourceName
+
+	for _, f := range m.MetaData {
+
+		if f == nil || *f.Key == "" {
+
+			return fmt.Errorf("%w: metadata without key", ErrCloudEventMappingInvalid)
+
+		}
+
+		fields["MetaData "+*f.Key] = *f.Value
+
+	}
+
+	for field, expr := range fields {
+
+		if expr != "" && !cloudEventExpression.MatchString(expr) {
+
+			return fmt.Errorf("%w: the %v expression [ %v ] is not valid", ErrCloudEventMappingInvalid, field, expr)
+
+		}
+
+	}
+
+	for _, s := range m.States {
+
+		if s == nil || *s.From == "" {
+
+			return fmt.Errorf("%w: state translation without value", ErrCloudEventMappingInvalid)
+
+		}
+
+	}
+
+	return
+
+}
+
+// checkCloudEventMappingDuplicated job is to check that no other CloudEvents
+// mapping has the name, or the source and type, of the one provided.
+// Parameters:
+// - m: reference to the CloudEventMapping to be checked.
+// - id: int64 with the id of the mapping itself, 0 if it's a new one.
+// Returns:
+// - e: ErrCloudEventMappingDuplicated in case another mapping clashes with it.
+func (d *DbParameter) checkCloudEventMappingDuplicated(m *models.CloudEventMapping, id int64) (e error) {
+
+	var others []*models.CloudEventMapping
+
+	if e = d.Db.Where(&models.CloudEventMapping{Name: m.Name}).
+		Or(d.Db.Where(&models.CloudEventMapping{Source: m.Source}).Where(d.Db.NamingStrategy.ColumnName("", "Type")+" = ?", m.Type)).
+		Find(&others).Error; e != nil {
+
+		return
+
+	}
+
+	for _, o := range others {
+
+		if o.ID != id {
+
+			l.Trace.Printf("[DB] The cloud event mapping [ %v ] clashes with [ %v ].\n", *m.Name, *o.Name)
+
+			return ErrCloudEventMappingDuplicated
+
+		}
+
+	}
+
+	return
+
+}
+
+// findCloudEventMapping job is to retrieve the enabled CloudEvents mapping to
+// be used with the source and type provided, preferring the one defined for
+// the type over the one for any type of the source.
+// Parameters:
+// - source: string with the source of the CloudEvent.
+// - ty: string with the type of the CloudEvent.
+// Returns:
+// - reference to the CloudEventMapping, nil if there's none.
+// - error raised in case of problems.
+func (d *DbParameter) findCloudEventMapping(source, ty string) (*models.CloudEventMapping, error) {
+
+	var m models.CloudEventMapping
+
+	typeColumn := d.Db.NamingStrategy.ColumnName("", "Type")
+
+	if e := d.Db.Where(&models.CloudEventMapping{Source: &source}).
+		Where(typeColumn+" IN (?)", []string{ty, ""}).
+		Where(d.Db.NamingStrategy.ColumnName("", "Disabled")+" = ?", false).
+		Order(typeColumn + " DESC").
+		First(&m).Error; e != nil {
+
+		if errors.Is(e, gorm.ErrRecordNotFound) {
+
+			return nil, nil
+
+		}
+
+		l.Warning.Printf("[DB] Something went wrong while retrieving the cloud event mapping of the source [ %v ]. Error: %v\n", source, e)
+
+		return nil, e
+
+	}
+
+	return &m, nil
+
+}
+
+// mapCloudEvent job is to build the event described by the CloudEvent
+// following the mapping provided.
+// Parameters:
+// - ce: reference to the CloudEvent to be mapped.
+// - m: reference to the CloudEventMapping to follow.
+// Returns:
+// - event: the Event built from the CloudEvent.
+// - e: ErrCloudEventUnmappable with the reason in case the CloudEvent doesn't
+// provide what the mapping requires.
+func (d *DbParameter) mapCloudEvent(ce *CloudEvent, m *models.CloudEventMapping) (event models.Event, e error) {
+
+	value := func(field, expr string, required bool) (string, error) {
+
+		v, found := ce.resolve(expr)
+
+		if !found && required {
+
+			return "", fmt.Errorf("%w: no value for the %v with [ %v ] in mapping [ %v ]", ErrCloudEventUnmappable, field, expr, *m.Name)
+
+		}
+
+		return cloudEventString(v), nil
+
+	}
+
+	if event.Account, e = value("Account", *m.Account, true); e != nil {
+
+		return
+
+	}
+
+	if event.ResourceID, e = value("ResourceId", *m.ResourceID, true); e != nil {
+
+		return
+
+	}
+
+	if event.ResourceType, e = value("ResourceType", *m.ResourceType, true); e != nil {
+
+		return
+
+	}
+
+	if m.Region != "" {
+
+		event.Region, _ = value("Region", m.Region, false)
+
+	}
+
+	if m.ResourceName != "" {
+
+		event.ResourceName, _ = value("ResourceName", m.ResourceName, false)
+
+	}
+
+	state, e := value("LastEvent", *m.LastEvent, true)
+
+	if e != nil {
+
+		return
+
+	}
+
+	for _, s := range m.States {
+
+		if s != nil && *s.From == state {
+
+			state = *s.To
+
+			break
+
+		}
+
+	}
+
+	event.LastEvent = &state
+
+	timeExpr := m.EventTime
+
+	if timeExpr == "" {
+
+		timeExpr = "time"
+
+	}
+
+	t, found := ce.resolve(timeExpr)
+
+	if !found {
+
+		e = fmt.Errorf("%w: no value for the EventTime with [ %v ] in mapping [ %v ]", ErrCloudEventUnmappable, timeExpr, *m.Name)
+
+		return
+
+	}
+
+	eventTime, e := cloudEventTime(t)
+
+	if e != nil {
+
+		e = fmt.Errorf("%w: the EventTime with [ %v ] is not valid: %v", ErrCloudEventUnmappable, timeExpr, e)
+
+		return
+
+	}
+
+	event.EventTime = &eventTime
+	event.MetaData = make(datamodels.JSONdb)
+
+	for _, f := range m.MetaData {
+
+		if f == nil {
+
+			continue
+
+		}
+
+		if v, found := ce.resolve(*f.Value); found {
+
+			event.MetaData[*f.Key] = v
+
+		}
+
+	}
+
+	// The mapped values still have to make a valid event, e.g. a known state
+	if e = event.Validate(strfmt.Default); e != nil {
+
+		e = fmt.Errorf("%w: %v", ErrCloudEventUnmappable, e)
+
+	}
+
+	return
+
+}
+
+// resolve job is to evaluate the mapping expression against the CloudEvent:
+// a constant preceded by =, a path within its data starting with data, or the
+// name of a context attribute or extension.
+// Parameters:
+// - expr: string with the expression to be evaluated.
+// Returns:
+// - the value found.
+// - bool reporting if the expression has a value in the CloudEvent.
+func (ce *CloudEvent) resolve(expr string) (interface{}, bool) {
+
+	if strings.HasPrefix(expr, "=") {
+
+		return expr[1:], true
+
+	}
+
+	path := strings.Split(expr, ".")
+
+	if path[0] != "data" {
+
+		v, exists := ce.Attributes[expr]
+
+		return v, exists
+
+	}
+
+	v := ce.Data
+
+	for _, key := range path[1:] {
+
+		switch node := v.(type) {
+
+		case map[string]interface{}:
+
+			var exists bool
+
+			if v, exists = node[key]; !exists {
+
+				return nil, false
+
+			}
+
+		case []interface{}:
+
+			i, e := strconv.Atoi(key)
+
+			if e != nil || i < 0 || i >= len(node) {
+
+				return nil, false
+
+			}
+
+			v = node[i]
+
+		default:
+
+			return nil, false
+
+		}
+
+	}
+
+	return v, v != nil
+
+}
+
+// cloudEventString job is to represent as a string a value obtained from a
+// CloudEvent, keeping the objects and arrays as JSON.
+// Parameters:
+// - v: interface{} with the value.
+// Returns:
+// - string representing the value.
+func cloudEventString(v interface{}) string {
+
+	switch value := v.(type) {
+
+	case nil:
+
+		return ""
+
+	case string:
+
+		return value
+
+	case json.Number:
+
+		return value.String()
+
+	case bool:
+
+		return strconv.FormatBool(value)
+
+	case float64:
+
+		return strconv.FormatFloat(value, 'f', -1, 64)
+
+	}
+
+	b, _ := json.Marshal(v)
+
+	return string(b)
+
+}
+
+// cloudEventTime job is to obtain the time in seconds since the epoch from a
+// value of a CloudEvent, either a RFC 3339 timestamp or a number of seconds.
+// Parameters:
+// - v: interface{} with the value.
+// Returns:
+// - t: int64 with the seconds since the epoch.
+// - e: error raised in case the value is not a time.
+func cloudEventTime(v interface{}) (t int64, e error) {
+
+	s := cloudEventString(v)
+
+	if ts, err := time.Parse(time.RFC3339Nano, s); err == nil {
+
+		return ts.Unix(), nil
+
+	}
+
+	f, e := strconv.ParseFloat(s, 64)
+
+	if e != nil {
+
+		return 0, fmt.Errorf("[ %v ] is neither RFC 3339 nor seconds since the epoch", s)
+
+	}
+
+	return int64(f), nil
 
 }
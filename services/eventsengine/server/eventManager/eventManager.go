@@ -2,7 +2,7 @@ package eventManager
 
 import (
 	"context"
-	"strings"
+	"errors"
 	"time"
 
 	"github.com/go-openapi/runtime/middleware"
@@ -25,9 +25,8 @@ const (
 // - db: a DbParameter reference to be able to use the DBManager methods.
 // - monit: a StatusManager reference to be able to use the status subsystem methods.
 type EventManager struct {
-	db      *dbManager.DbParameter
-	monit   *statusManager.StatusManager
-	filters []string
+	db    *dbManager.DbParameter
+	monit *statusManager.StatusManager
 }
 
 // New is the function to create the struct EventManager.
@@ -38,20 +37,74 @@ type EventManager struct {
 // status subsystem.
 // Returns:
 // - EventManager: struct to interact with EventManager subsystem functionalities.
-func New(db *dbManager.DbParameter, monit *statusManager.StatusManager, filters []string) *EventManager {
+func New(db *dbManager.DbParameter, monit *statusManager.StatusManager) *EventManager {
 
 	l.Trace.Printf("[EventManager] Generating new EventManager.\n")
 
 	monit.InitEndpoint("event")
 
 	return &EventManager{
-		db:      db,
-		monit:   monit,
-		filters: filters,
+		db:    db,
+		monit: monit,
 	}
 
 }
 
+// AddDiscreteEvent (Swagger func) is the function behind the (POST) API
+// Endpoint /event/discrete
+// Its job is to include in the system the discrete event provided, to be
+// counted in the usage instead of measured over time.
+func (m *EventManager) AddDiscreteEvent(ctx context.Context, params event_management.AddDiscreteEventParams) middleware.Responder {
+
+	l.Trace.Printf("[EventManager] AddDiscreteEvent endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("event", callTime)
+
+	e := m.db.AddDiscreteEvent(*params.Event)
+
+	if errors.Is(e, dbManager.ErrDiscreteEventInvalid) {
+
+		s := "The discrete event provided is not valid: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "400", "method": "POST", "route": "/event/discrete"}).Inc()
+
+		m.monit.APIHitDone("event", callTime)
+
+		return event_management.NewAddDiscreteEventBadRequest().WithPayload(&errorReturn)
+
+	}
+
+	if e != nil {
+
+		s := "Error registering the discrete event: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "POST", "route": "/event/discrete"}).Inc()
+
+		m.monit.APIHitDone("event", callTime)
+
+		return event_management.NewAddDiscreteEventInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	createdReturn := models.ItemCreatedResponse{
+		Message: "New discrete Event registered in the system.",
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "201", "method": "POST", "route": "/event/discrete"}).Inc()
+
+	m.monit.APIHitDone("event", callTime)
+
+	return event_management.NewAddDiscreteEventCreated().WithPayload(&createdReturn)
+
+}
+
 // AddEvent (Swagger func) is the function behind the (POST) API Endpoint
 // /event
 // Its job is to include in the system the information given by the new event.
@@ -62,28 +115,51 @@ func (m *EventManager) AddEvent(ctx context.Context, params event_management.Add
 	callTime := time.Now()
 	m.monit.APIHit("event", callTime)
 
-	for _, f := range m.filters {
+	e := m.db.AddEvent(*params.Event)
 
-		if f != "" && strings.Contains(params.Event.ResourceName, f) {
+	if errors.Is(e, dbManager.ErrEventFiltered) {
 
-			l.Warning.Printf("Ignoring event: %v", params.Event.ResourceName)
+		acceptedReturn := models.ItemCreatedResponse{
+			Message: "Event not registered in the system, " + e.Error(),
+		}
 
-			s := "Event resource is blacklisted, event ignored."
-			errorReturn := models.ErrorResponse{
-				ErrorString: &s,
-			}
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "202", "method": "POST", "route": "/event"}).Inc()
 
-			m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "POST", "route": "/event"}).Inc()
+		m.monit.APIHitDone("event", callTime)
+
+		return event_management.NewAddEventAccepted().WithPayload(&acceptedReturn)
 
-			m.monit.APIHitDone("event", callTime)
+	}
 
-			return event_management.NewAddEventInternalServerError().WithPayload(&errorReturn)
+	if errors.Is(e, dbManager.ErrEventInvalid) {
 
+		s := "The event provided is not valid: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
 		}
 
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "400", "method": "POST", "route": "/event"}).Inc()
+
+		m.monit.APIHitDone("event", callTime)
+
+		return event_management.NewAddEventBadRequest().WithPayload(&errorReturn)
+
 	}
 
-	e := m.db.AddEvent(*params.Event)
+	if errors.Is(e, dbManager.ErrEventQuarantined) {
+
+		s := "The event doesn't follow the lifecycle of the resource type: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "400", "method": "POST", "route": "/event"}).Inc()
+
+		m.monit.APIHitDone("event", callTime)
+
+		return event_management.NewAddEventBadRequest().WithPayload(&errorReturn)
+
+	}
 
 	if e != nil {
 
@@ -240,6 +316,356 @@ func (m *EventManager) GetHistory(ctx context.Context, params event_management.G
 
 }
 
+// GetInventory (Swagger func) is the function behind the (GET) API Endpoint
+// /event/inventory/{account}
+// Its job is to provide the resources alive for the provided account at the
+// requested instant.
+func (m *EventManager) GetInventory(ctx context.Context, params event_management.GetInventoryParams) middleware.Responder {
+
+	l.Trace.Printf("[EventManager] GetInventory endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("event", callTime)
+
+	ty, rg := string(""), string("")
+	at := callTime.Unix()
+
+	if params.Resource != nil {
+
+		ty = *params.Resource
+
+	}
+
+	if params.Region != nil {
+
+		rg = *params.Region
+
+	}
+
+	if params.At != nil {
+
+		at = *params.At
+
+	}
+
+	inventory, e := m.db.GetInventory(params.Account, ty, rg, at)
+
+	if e != nil {
+
+		s := "Problem reconstructing the inventory of the account: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/event/inventory/" + params.Account}).Inc()
+
+		m.monit.APIHitDone("event", callTime)
+
+		return event_management.NewGetInventoryInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/event/inventory/" + params.Account}).Inc()
+
+	m.monit.APIHitDone("event", callTime)
+
+	return event_management.NewGetInventoryOK().WithPayload(inventory)
+
+}
+
+// GetInventoryDiff (Swagger func) is the function behind the (GET) API Endpoint
+// /event/inventory/{account}/diff
+// Its job is to provide the changes in the resources alive for the provided
+// account between the requested instants.
+func (m *EventManager) GetInventoryDiff(ctx context.Context, params event_management.GetInventoryDiffParams) middleware.Responder {
+
+	l.Trace.Printf("[EventManager] GetInventoryDiff endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("event", callTime)
+
+	ty, rg := string(""), string("")
+	to := callTime.Unix()
+
+	if params.Resource != nil {
+
+		ty = *params.Resource
+
+	}
+
+	if params.Region != nil {
+
+		rg = *params.Region
+
+	}
+
+	if params.To != nil {
+
+		to = *params.To
+
+	}
+
+	if params.From > to {
+
+		s := "The initial instant of the diff must be before the final one."
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "400", "method": "GET", "route": "/event/inventory/" + params.Account + "/diff"}).Inc()
+
+		m.monit.APIHitDone("event", callTime)
+
+		return event_management.NewGetInventoryDiffBadRequest().WithPayload(&errorReturn)
+
+	}
+
+	diff, e := m.db.GetInventoryDiff(params.Account, ty, rg, params.From, to)
+
+	if e != nil {
+
+		s := "Problem comparing the inventories of the account: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/event/inventory/" + params.Account + "/diff"}).Inc()
+
+		m.monit.APIHitDone("event", callTime)
+
+		return event_management.NewGetInventoryDiffInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/event/inventory/" + params.Account + "/diff"}).Inc()
+
+	m.monit.APIHitDone("event", callTime)
+
+	return event_management.NewGetInventoryDiffOK().WithPayload(diff)
+
+}
+
+// ListDiscreteEvents (Swagger func) is the function behind the (GET) API
+// Endpoint /event/discrete/{account}
+// Its job is to provide the discrete events of the account in the time-window.
+func (m *EventManager) ListDiscreteEvents(ctx context.Context, params event_management.ListDiscreteEventsParams) middleware.Responder {
+
+	l.Trace.Printf("[EventManager] ListDiscreteEvents endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("event", callTime)
+
+	ty, rg := string(""), string("")
+	from, to := bigBang, endOfTime
+
+	if params.Resource != nil {
+
+		ty = *params.Resource
+
+	}
+
+	if params.Region != nil {
+
+		rg = *params.Region
+
+	}
+
+	if params.From != nil {
+
+		from = *params.From
+
+	}
+
+	if params.To != nil {
+
+		to = *params.To
+
+	}
+
+	events, e := m.db.ListDiscreteEvents(params.Account, ty, rg, from, to)
+
+	if e != nil {
+
+		s := "Problem retrieving the discrete events: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/event/discrete/" + params.Account}).Inc()
+
+		m.monit.APIHitDone("event", callTime)
+
+		return event_management.NewListDiscreteEventsInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/event/discrete/" + params.Account}).Inc()
+
+	m.monit.APIHitDone("event", callTime)
+
+	return event_management.NewListDiscreteEventsOK().WithPayload(events)
+
+}
+
+// ListQuarantinedEvents (Swagger func) is the function behind the (GET) API
+// Endpoint /event/quarantine
+// Its job is to provide the events held back for not following the lifecycle
+// of their resource type.
+func (m *EventManager) ListQuarantinedEvents(ctx context.Context, params event_management.ListQuarantinedEventsParams) middleware.Responder {
+
+	l.Trace.Printf("[EventManager] ListQuarantinedEvents endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("event", callTime)
+
+	var account, resource string
+
+	if params.Account != nil {
+
+		account = *params.Account
+
+	}
+
+	if params.Resource != nil {
+
+		resource = *params.Resource
+
+	}
+
+	events, e := m.db.ListQuarantinedEvents(account, resource)
+
+	if e != nil {
+
+		s := "List of quarantined events failed: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/event/quarantine"}).Inc()
+
+		m.monit.APIHitDone("event", callTime)
+
+		return event_management.NewListQuarantinedEventsInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/event/quarantine"}).Inc()
+
+	m.monit.APIHitDone("event", callTime)
+
+	return event_management.NewListQuarantinedEventsOK().WithPayload(events)
+
+}
+
+// ListRecomputations (Swagger func) is the function behind the (GET) API Endpoint
+// /event/recomputations
+// Its job is to provide the time-windows whose usage changed due to the
+// insertion of out-of-order events.
+func (m *EventManager) ListRecomputations(ctx context.Context, params event_management.ListRecomputationsParams) middleware.Responder {
+
+	l.Trace.Printf("[EventManager] ListRecomputations endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("event", callTime)
+
+	since := bigBang
+
+	if params.Since != nil {
+
+		since = *params.Since
+
+	}
+
+	recomputations, e := m.db.ListRecomputations(since)
+
+	if e != nil {
+
+		s := "List of recomputations failed: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/event/recomputations"}).Inc()
+
+		m.monit.APIHitDone("event", callTime)
+
+		return event_management.NewListRecomputationsInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/event/recomputations"}).Inc()
+
+	m.monit.APIHitDone("event", callTime)
+
+	return event_management.NewListRecomputationsOK().WithPayload(recomputations)
+
+}
+
+// RebuildStates (Swagger func) is the function behind the (POST) API Endpoint
+// /event/rebuild
+// Its job is to rebuild the states and their history from the log of raw
+// events, reporting the differences with the actual ones.
+func (m *EventManager) RebuildStates(ctx context.Context, params event_management.RebuildStatesParams) middleware.Responder {
+
+	l.Trace.Printf("[EventManager] RebuildStates endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("event", callTime)
+
+	var account, resource string
+
+	if params.Account != nil {
+
+		account = *params.Account
+
+	}
+
+	if params.Resource != nil {
+
+		resource = *params.Resource
+
+	}
+
+	report, e := m.db.RebuildStates(account, resource, params.Apply != nil && *params.Apply)
+
+	if errors.Is(e, dbManager.ErrNoRawEvents) {
+
+		s := "There are no raw events logged to rebuild the states requested."
+		missingReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "404", "method": "POST", "route": "/event/rebuild"}).Inc()
+
+		m.monit.APIHitDone("event", callTime)
+
+		return event_management.NewRebuildStatesNotFound().WithPayload(&missingReturn)
+
+	}
+
+	if e != nil {
+
+		s := "Rebuild of the states failed: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "POST", "route": "/event/rebuild"}).Inc()
+
+		m.monit.APIHitDone("event", callTime)
+
+		return event_management.NewRebuildStatesInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "POST", "route": "/event/rebuild"}).Inc()
+
+	m.monit.APIHitDone("event", callTime)
+
+	return event_management.NewRebuildStatesOK().WithPayload(report)
+
+}
+
 // ListState (Swagger func) is the function behind the (GET) API Endpoint
 // /event/status
 // Its job is to get the actual state of the provided account.
@@ -0,0 +1,379 @@
+package filterManager
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/GoDieNow/TFT_Code/services/eventsengine/models"
+	"github.com/GoDieNow/TFT_Code/services/eventsengine/restapi/operations/filter_management"
+	"github.com/GoDieNow/TFT_Code/services/eventsengine/server/dbManager"
+	"github.com/GoDieNow/TFT_Code/services/eventsengine/server/statusManager"
+	l "gitlab.com/cyclops-utilities/logging"
+)
+
+// FilterManager is the struct defined to group and contain all the methods
+// that interact with the filter endpoints.
+// Parameters:
+// - basePath: a string with the base path of the system.
+// - db: a DbParameter reference to be able to use the DBManager methods.
+// - monit: a StatusManager reference to be able to use the status subsystem methods.
+type FilterManager struct {
+	basePath string
+	db       *dbManager.DbParameter
+	monit    *statusManager.StatusManager
+}
+
+// New is the function to create the struct FilterManager that grant
+// access to the methods to interact with the filter endpoints.
+// Parameters:
+// - db: a reference to the DbParameter to be able to interact with the db methods.
+// - monit: a reference to the StatusManager to be able to interact with the
+// status subsystem.
+// - bp: a string containing the base path of the service.
+// Returns:
+// - FilterManager: struct to interact with the filter endpoints functionalities.
+func New(db *dbManager.DbParameter, monit *statusManager.StatusManager, bp string) *FilterManager {
+
+	l.Trace.Printf("[FilterManager] Generating new FilterManager.\n")
+
+	monit.InitEndpoint("filter")
+
+	return &FilterManager{
+		basePath: bp,
+		db:       db,
+		monit:    monit,
+	}
+
+}
+
+// CreateFilterRule (Swagger func) is the function behind the (POST) endpoint
+// /filter
+// Its job is to define a new rule to filter the events received.
+func (m *FilterManager) CreateFilterRule(ctx context.Context, params filter_management.CreateFilterRuleParams) middleware.Responder {
+
+	l.Trace.Printf("[FilterManager] CreateFilterRule endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("filter", callTime)
+
+	rule, e := m.db.CreateFilterRule(params.Rule)
+
+	if e == nil {
+
+		createdReturn := models.ItemCreatedResponse{
+			APILink: m.basePath + "/filter/" + strconv.FormatInt(rule.ID, 10),
+			Message: "New Filter rule registered in the system.",
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "201", "method": "POST", "route": "/filter"}).Inc()
+
+		m.monit.APIHitDone("filter", callTime)
+
+		return filter_management.NewCreateFilterRuleCreated().WithPayload(&createdReturn)
+
+	}
+
+	if errors.Is(e, dbManager.ErrFilterRuleInvalid) {
+
+		s := "The Filter rule provided is not valid: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "400", "method": "POST", "route": "/filter"}).Inc()
+
+		m.monit.APIHitDone("filter", callTime)
+
+		return filter_management.NewCreateFilterRuleBadRequest().WithPayload(&errorReturn)
+
+	}
+
+	if errors.Is(e, dbManager.ErrFilterRuleDuplicated) {
+
+		s := "A Filter rule with the same name already exists in the system."
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "409", "method": "POST", "route": "/filter"}).Inc()
+
+		m.monit.APIHitDone("filter", callTime)
+
+		return filter_management.NewCreateFilterRuleConflict().WithPayload(&errorReturn)
+
+	}
+
+	s := "There was an error registering the Filter rule in the system: " + e.Error()
+	errorReturn := models.ErrorResponse{
+		ErrorString: &s,
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "POST", "route": "/filter"}).Inc()
+
+	m.monit.APIHitDone("filter", callTime)
+
+	return filter_management.NewCreateFilterRuleInternalServerError().WithPayload(&errorReturn)
+
+}
+
+// DeleteFilterRule (Swagger func) is the function behind the (DELETE) endpoint
+// /filter/{id}
+// Its job is to remove the filter rule from the system.
+func (m *FilterManager) DeleteFilterRule(ctx context.Context, params filter_management.DeleteFilterRuleParams) middleware.Responder {
+
+	l.Trace.Printf("[FilterManager] DeleteFilterRule endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("filter", callTime)
+
+	id := strconv.FormatInt(params.ID, 10)
+
+	rule, e := m.db.DeleteFilterRule(params.ID)
+
+	if e != nil {
+
+		s := "There was an error removing the Filter rule from the system: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "DELETE", "route": "/filter/" + id}).Inc()
+
+		m.monit.APIHitDone("filter", callTime)
+
+		return filter_management.NewDeleteFilterRuleInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	if rule == nil {
+
+		s := "The Filter rule doesn't exist in the system."
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "404", "method": "DELETE", "route": "/filter/" + id}).Inc()
+
+		m.monit.APIHitDone("filter", callTime)
+
+		return filter_management.NewDeleteFilterRuleNotFound().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "DELETE", "route": "/filter/" + id}).Inc()
+
+	m.monit.APIHitDone("filter", callTime)
+
+	return filter_management.NewDeleteFilterRuleOK().WithPayload(rule)
+
+}
+
+// GetFilterRule (Swagger func) is the function behind the (GET) endpoint
+// /filter/{id}
+// Its job is to provide the definition of the requested filter rule.
+func (m *FilterManager) GetFilterRule(ctx context.Context, params filter_management.GetFilterRuleParams) middleware.Responder {
+
+	l.Trace.Printf("[FilterManager] GetFilterRule endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("filter", callTime)
+
+	id := strconv.FormatInt(params.ID, 10)
+
+	rule, e := m.db.GetFilterRule(params.ID)
+
+	if e != nil {
+
+		s := "There was an error retrieving the Filter rule from the system: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/filter/" + id}).Inc()
+
+		m.monit.APIHitDone("filter", callTime)
+
+		return filter_management.NewGetFilterRuleInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	if rule == nil {
+
+		s := "The Filter rule doesn't exist in the system."
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "404", "method": "GET", "route": "/filter/" + id}).Inc()
+
+		m.monit.APIHitDone("filter", callTime)
+
+		return filter_management.NewGetFilterRuleNotFound().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/filter/" + id}).Inc()
+
+	m.monit.APIHitDone("filter", callTime)
+
+	return filter_management.NewGetFilterRuleOK().WithPayload(rule)
+
+}
+
+// ListFilterRules (Swagger func) is the function behind the (GET) endpoint
+// /filter
+// Its job is to provide the list of filter rules defined in the system, with
+// the events matched by each of them.
+func (m *FilterManager) ListFilterRules(ctx context.Context, params filter_management.ListFilterRulesParams) middleware.Responder {
+
+	l.Trace.Printf("[FilterManager] ListFilterRules endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("filter", callTime)
+
+	rules, e := m.db.ListFilterRules()
+
+	if e == nil {
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/filter"}).Inc()
+
+		m.monit.APIHitDone("filter", callTime)
+
+		return filter_management.NewListFilterRulesOK().WithPayload(rules)
+
+	}
+
+	s := "There was an error retrieving the Filter rules from the system: " + e.Error()
+	errorReturn := models.ErrorResponse{
+		ErrorString: &s,
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/filter"}).Inc()
+
+	m.monit.APIHitDone("filter", callTime)
+
+	return filter_management.NewListFilterRulesInternalServerError().WithPayload(&errorReturn)
+
+}
+
+// ReloadFilterRules (Swagger func) is the function behind the (POST) endpoint
+// /filter/reload
+// Its job is to load again the filter rules from the db, picking up the
+// changes made outside of the API without restarting the service.
+func (m *FilterManager) ReloadFilterRules(ctx context.Context, params filter_management.ReloadFilterRulesParams) middleware.Responder {
+
+	l.Trace.Printf("[FilterManager] ReloadFilterRules endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("filter", callTime)
+
+	rules, e := m.db.LoadFilterRules()
+
+	if e == nil {
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "POST", "route": "/filter/reload"}).Inc()
+
+		m.monit.APIHitDone("filter", callTime)
+
+		return filter_management.NewReloadFilterRulesOK().WithPayload(rules)
+
+	}
+
+	s := "There was an error reloading the Filter rules: " + e.Error()
+	errorReturn := models.ErrorResponse{
+		ErrorString: &s,
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "POST", "route": "/filter/reload"}).Inc()
+
+	m.monit.APIHitDone("filter", callTime)
+
+	return filter_management.NewReloadFilterRulesInternalServerError().WithPayload(&errorReturn)
+
+}
+
+// UpdateFilterRule (Swagger func) is the function behind the (PUT) endpoint
+// /filter/{id}
+// Its job is to update the definition of the provided filter rule.
+func (m *FilterManager) UpdateFilterRule(ctx context.Context, params filter_management.UpdateFilterRuleParams) middleware.Responder {
+
+	l.Trace.Printf("[FilterManager] UpdateFilterRule endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("filter", callTime)
+
+	id := strconv.FormatInt(params.ID, 10)
+
+	rule, e := m.db.UpdateFilterRule(params.ID, params.Rule)
+
+	if errors.Is(e, dbManager.ErrFilterRuleInvalid) {
+
+		s := "The Filter rule provided is not valid: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "400", "method": "PUT", "route": "/filter/" + id}).Inc()
+
+		m.monit.APIHitDone("filter", callTime)
+
+		return filter_management.NewUpdateFilterRuleBadRequest().WithPayload(&errorReturn)
+
+	}
+
+	if errors.Is(e, dbManager.ErrFilterRuleDuplicated) {
+
+		s := "A Filter rule with the same name already exists in the system."
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "409", "method": "PUT", "route": "/filter/" + id}).Inc()
+
+		m.monit.APIHitDone("filter", callTime)
+
+		return filter_management.NewUpdateFilterRuleConflict().WithPayload(&errorReturn)
+
+	}
+
+	if e != nil {
+
+		s := "There was an error updating the Filter rule in the system: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "PUT", "route": "/filter/" + id}).Inc()
+
+		m.monit.APIHitDone("filter", callTime)
+
+		return filter_management.NewUpdateFilterRuleInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	if rule == nil {
+
+		s := "The Filter rule doesn't exist in the system."
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "404", "method": "PUT", "route": "/filter/" + id}).Inc()
+
+		m.monit.APIHitDone("filter", callTime)
+
+		return filter_management.NewUpdateFilterRuleNotFound().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "PUT", "route": "/filter/" + id}).Inc()
+
+	m.monit.APIHitDone("filter", callTime)
+
+	return filter_management.NewUpdateFilterRuleOK().WithPayload(rule)
+
+}
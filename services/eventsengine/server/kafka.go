@@ -19,6 +19,8 @@ import (
 
 type kafkaFunction func(*dbManager.DbParameter, interface{}) error
 
+type kafkaDecoder func(kafka.Message) (interface{}, error)
+
 type kafkaHandlerConf struct {
 	in  []kafkaPackage
 	out []kafkaPackage
@@ -28,6 +30,7 @@ type kafkaPackage struct {
 	topic     string
 	partition int
 	model     interface{}
+	decoder   kafkaDecoder
 	function  kafkaFunction
 	channel   chan interface{}
 	saveDB    bool
@@ -50,7 +53,7 @@ func kafkaHandler(db *dbManager.DbParameter, monit *statusManager.StatusManager,
 
 		for _, p := range kH.in {
 
-			go kafkaReceiver(db, monit, p.topic, p.partition, p.model, p.function, p.saveDB)
+			go kafkaReceiver(db, monit, p.topic, p.partition, p.model, p.decoder, p.function, p.saveDB)
 
 		}
 	}
@@ -79,9 +82,10 @@ func kafkaHandler(db *dbManager.DbParameter, monit *statusManager.StatusManager,
 // - t: string containing the kafka-topic in use.
 // - p: int containing the kafka-topic partition.
 // - m: model to validate data against.
+// - dec: optional decoder of the messages, used instead of the JSON model.
 // - f: optional external function for more functionality.
 // - saveDB: bool to control is the received data needs to be saved in the db.
-func kafkaReceiver(db *dbManager.DbParameter, monit *statusManager.StatusManager, t string, p int, m interface{}, f kafkaFunction, saveDB bool) {
+func kafkaReceiver(db *dbManager.DbParameter, monit *statusManager.StatusManager, t string, p int, m interface{}, dec kafkaDecoder, f kafkaFunction, saveDB bool) {
 
 	l.Trace.Printf("[KAFKA] Initializing kafka receiver for topic: %v.\n", t)
 
@@ -148,9 +152,22 @@ func kafkaReceiver(db *dbManager.DbParameter, monit *statusManager.StatusManager
 
 			defer swg.Done()
 
-			o := reflect.New(reflect.TypeOf(m)).Interface()
+			var o interface{}
+			var e error
+
+			if dec != nil {
+
+				o, e = dec(rm)
+
+			} else {
+
+				o = reflect.New(reflect.TypeOf(m)).Interface()
+
+				e = json.Unmarshal(rm.Value, &o)
 
-			if e := json.Unmarshal(rm.Value, &o); e == nil {
+			}
+
+			if e == nil {
 
 				l.Info.Printf("[KAFKA] Relevant information detected in the stream: Topic: %v, partition: %v.\n", t, p)
 
@@ -0,0 +1,280 @@
+package lifecycleManager
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/GoDieNow/TFT_Code/services/eventsengine/models"
+	"github.com/GoDieNow/TFT_Code/services/eventsengine/restapi/operations/lifecycle_management"
+	"github.com/GoDieNow/TFT_Code/services/eventsengine/server/dbManager"
+	"github.com/GoDieNow/TFT_Code/services/eventsengine/server/statusManager"
+	l "gitlab.com/cyclops-utilities/logging"
+)
+
+// LifecycleManager is the struct defined to group and contain all the methods
+// that interact with the lifecycle endpoints.
+// Parameters:
+// - db: a DbParameter reference to be able to use the DBManager methods.
+// - monit: a StatusManager reference to be able to use the status subsystem methods.
+type LifecycleManager struct {
+	db    *dbManager.DbParameter
+	monit *statusManager.StatusManager
+}
+
+// New is the function to create the struct LifecycleManager that grant
+// access to the methods to interact with the lifecycle endpoints.
+// Parameters:
+// - db: a reference to the DbParameter to be able to interact with the db methods.
+// - monit: a reference to the StatusManager to be able to interact with the
+// status subsystem.
+// Returns:
+// - LifecycleManager: struct to interact with the lifecycle endpoints functionalities.
+func New(db *dbManager.DbParameter, monit *statusManager.StatusManager) *LifecycleManager {
+
+	l.Trace.Printf("[LifecycleManager] Generating new LifecycleManager.\n")
+
+	monit.InitEndpoint("lifecycle")
+
+	return &LifecycleManager{
+		db:    db,
+		monit: monit,
+	}
+
+}
+
+// CreateLifecycle (Swagger func) is the function behind the (POST) endpoint
+// /lifecycle
+// Its job is to define the states and transitions allowed for a resource type.
+func (m *LifecycleManager) CreateLifecycle(ctx context.Context, params lifecycle_management.CreateLifecycleParams) middleware.Responder {
+
+	l.Trace.Printf("[LifecycleManager] CreateLifecycle endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("lifecycle", callTime)
+
+	_, e := m.db.CreateLifecycle(params.Lifecycle)
+
+	if e == nil {
+
+		createdReturn := models.ItemCreatedResponse{
+			Message: "New Lifecycle registered in the system.",
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "201", "method": "POST", "route": "/lifecycle"}).Inc()
+
+		m.monit.APIHitDone("lifecycle", callTime)
+
+		return lifecycle_management.NewCreateLifecycleCreated().WithPayload(&createdReturn)
+
+	}
+
+	if errors.Is(e, dbManager.ErrLifecycleInvalid) {
+
+		s := "The Lifecycle provided is not valid: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "400", "method": "POST", "route": "/lifecycle"}).Inc()
+
+		m.monit.APIHitDone("lifecycle", callTime)
+
+		return lifecycle_management.NewCreateLifecycleBadRequest().WithPayload(&errorReturn)
+
+	}
+
+	if errors.Is(e, dbManager.ErrLifecycleDuplicated) {
+
+		s := "The Lifecycle already exists in the system."
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "409", "method": "POST", "route": "/lifecycle"}).Inc()
+
+		m.monit.APIHitDone("lifecycle", callTime)
+
+		return lifecycle_management.NewCreateLifecycleConflict().WithPayload(&errorReturn)
+
+	}
+
+	s := "There was an error registering the Lifecycle in the system: " + e.Error()
+	errorReturn := models.ErrorResponse{
+		ErrorString: &s,
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "POST", "route": "/lifecycle"}).Inc()
+
+	m.monit.APIHitDone("lifecycle", callTime)
+
+	return lifecycle_management.NewCreateLifecycleInternalServerError().WithPayload(&errorReturn)
+
+}
+
+// GetLifecycle (Swagger func) is the function behind the (GET) endpoint
+// /lifecycle/{type}
+// Its job is to provide the lifecycle defined for the requested resource type.
+func (m *LifecycleManager) GetLifecycle(ctx context.Context, params lifecycle_management.GetLifecycleParams) middleware.Responder {
+
+	l.Trace.Printf("[LifecycleManager] GetLifecycle endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("lifecycle", callTime)
+
+	lifecycle, e := m.db.GetLifecycle(params.Type)
+
+	if e != nil {
+
+		s := "There was an error retrieving the Lifecycle from the system: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/lifecycle/" + params.Type}).Inc()
+
+		m.monit.APIHitDone("lifecycle", callTime)
+
+		return lifecycle_management.NewGetLifecycleInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	if lifecycle == nil {
+
+		s := "The Lifecycle doesn't exist in the system."
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "404", "method": "GET", "route": "/lifecycle/" + params.Type}).Inc()
+
+		m.monit.APIHitDone("lifecycle", callTime)
+
+		return lifecycle_management.NewGetLifecycleNotFound().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/lifecycle/" + params.Type}).Inc()
+
+	m.monit.APIHitDone("lifecycle", callTime)
+
+	return lifecycle_management.NewGetLifecycleOK().WithPayload(lifecycle)
+
+}
+
+// ListLifecycles (Swagger func) is the function behind the (GET) endpoint
+// /lifecycle
+// Its job is to provide the list of lifecycles defined in the system.
+func (m *LifecycleManager) ListLifecycles(ctx context.Context, params lifecycle_management.ListLifecyclesParams) middleware.Responder {
+
+	l.Trace.Printf("[LifecycleManager] ListLifecycles endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("lifecycle", callTime)
+
+	lifecycles, e := m.db.ListLifecycles()
+
+	if e == nil {
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/lifecycle"}).Inc()
+
+		m.monit.APIHitDone("lifecycle", callTime)
+
+		return lifecycle_management.NewListLifecyclesOK().WithPayload(lifecycles)
+
+	}
+
+	s := "There was an error retrieving the Lifecycles from the system: " + e.Error()
+	errorReturn := models.ErrorResponse{
+		ErrorString: &s,
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/lifecycle"}).Inc()
+
+	m.monit.APIHitDone("lifecycle", callTime)
+
+	return lifecycle_management.NewListLifecyclesInternalServerError().WithPayload(&errorReturn)
+
+}
+
+// UpdateLifecycle (Swagger func) is the function behind the (PUT) endpoint
+// /lifecycle/{type}
+// Its job is to update the lifecycle defined for the provided resource type.
+func (m *LifecycleManager) UpdateLifecycle(ctx context.Context, params lifecycle_management.UpdateLifecycleParams) middleware.Responder {
+
+	l.Trace.Printf("[LifecycleManager] UpdateLifecycle endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("lifecycle", callTime)
+
+	if params.Lifecycle.ResourceType != nil && *params.Lifecycle.ResourceType != params.Type {
+
+		s := "The resource type of the Lifecycle provided doesn't match the one in the path."
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "400", "method": "PUT", "route": "/lifecycle/" + params.Type}).Inc()
+
+		m.monit.APIHitDone("lifecycle", callTime)
+
+		return lifecycle_management.NewUpdateLifecycleBadRequest().WithPayload(&errorReturn)
+
+	}
+
+	lifecycle, e := m.db.UpdateLifecycle(params.Type, params.Lifecycle)
+
+	if errors.Is(e, dbManager.ErrLifecycleInvalid) {
+
+		s := "The Lifecycle provided is not valid: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "400", "method": "PUT", "route": "/lifecycle/" + params.Type}).Inc()
+
+		m.monit.APIHitDone("lifecycle", callTime)
+
+		return lifecycle_management.NewUpdateLifecycleBadRequest().WithPayload(&errorReturn)
+
+	}
+
+	if e != nil {
+
+		s := "There was an error updating the Lifecycle in the system: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "PUT", "route": "/lifecycle/" + params.Type}).Inc()
+
+		m.monit.APIHitDone("lifecycle", callTime)
+
+		return lifecycle_management.NewUpdateLifecycleInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	if lifecycle == nil {
+
+		s := "The Lifecycle doesn't exist in the system."
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "404", "method": "PUT", "route": "/lifecycle/" + params.Type}).Inc()
+
+		m.monit.APIHitDone("lifecycle", callTime)
+
+		return lifecycle_management.NewUpdateLifecycleNotFound().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "PUT", "route": "/lifecycle/" + params.Type}).Inc()
+
+	m.monit.APIHitDone("lifecycle", callTime)
+
+	return lifecycle_management.NewUpdateLifecycleOK().WithPayload(lifecycle)
+
+}
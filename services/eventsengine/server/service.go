@@ -1,15 +1,21 @@
 package main
 
 import (
+	"errors"
 	"net/http"
+	"regexp"
 	"strings"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/cors"
 	"github.com/GoDieNow/TFT_Code/services/eventsengine/models"
+	kafka "github.com/segmentio/kafka-go"
 	"github.com/GoDieNow/TFT_Code/services/eventsengine/restapi"
+	"github.com/GoDieNow/TFT_Code/services/eventsengine/server/cloudEventManager"
 	"github.com/GoDieNow/TFT_Code/services/eventsengine/server/dbManager"
 	"github.com/GoDieNow/TFT_Code/services/eventsengine/server/eventManager"
+	"github.com/GoDieNow/TFT_Code/services/eventsengine/server/filterManager"
+	"github.com/GoDieNow/TFT_Code/services/eventsengine/server/lifecycleManager"
 	"github.com/GoDieNow/TFT_Code/services/eventsengine/server/statusManager"
 	"github.com/GoDieNow/TFT_Code/services/eventsengine/server/usageManager"
 	l "gitlab.com/cyclops-utilities/logging"
@@ -26,33 +32,41 @@ func getService() (handler http.Handler, register *prometheus.Registry, e error)
 	l.Trace.Printf("[MAIN] Intializing service [ %v ] handler\n", strings.Title(service))
 
 	// TABLE0,...,N have to be customized
-	db := dbStart(&models.Event{}, &models.State{})
+	db := dbStart(&models.CloudEventMapping{}, &models.DiscreteEvent{}, &models.Event{}, &models.FilterRule{}, &models.Lifecycle{}, &models.QuarantinedEvent{}, &models.RawEvent{}, &models.Recomputation{}, &models.State{})
 	mon := statusManager.New(db)
 
 	// Prometheus Metrics linked to dbParameter
 	db.Metrics, register = prometheusStart()
 
+	filtersStart(db)
+
 	// In case of needed, here is where kafka support is started
 	// The functions needs to be customized accordingly to the needs of the service
 	// And the parts of the service that might need to send messages need to get
 	// the channel
 	_ = kafkaStart(db, mon)
 
-	//bp := getBasePath()
+	bp := getBasePath()
 
 	// Parts of the service HERE
-	v := eventManager.New(db, mon, cfg.Events.Filters)
+	c := cloudEventManager.New(db, mon, bp)
+	v := eventManager.New(db, mon)
+	f := filterManager.New(db, mon, bp)
 	u := usageManager.New(db, mon)
+	lc := lifecycleManager.New(db, mon)
 
 	// Initiate the http handler, with the objects that are implementing the business logic.
 	h, e := restapi.Handler(restapi.Config{
-		StatusManagementAPI: mon,
-		EventManagementAPI:  v,
-		UsageManagementAPI:  u,
-		Logger:              l.Info.Printf,
-		AuthKeycloak:        AuthKeycloak,
-		AuthAPIKeyHeader:    AuthAPIKey,
-		AuthAPIKeyParam:     AuthAPIKey,
+		StatusManagementAPI:     mon,
+		CloudEventManagementAPI: c,
+		EventManagementAPI:      v,
+		FilterManagementAPI:     f,
+		LifecycleManagementAPI:  lc,
+		UsageManagementAPI:      u,
+		Logger:                  l.Info.Printf,
+		AuthKeycloak:            AuthKeycloak,
+		AuthAPIKeyHeader:        AuthAPIKey,
+		AuthAPIKeyParam:         AuthAPIKey,
 	})
 
 	if e != nil {
@@ -97,7 +111,26 @@ func kafkaStart(db *dbManager.DbParameter, mon *statusManager.StatusManager) (ch
 
 	f := func(db *dbManager.DbParameter, model interface{}) (e error) {
 
-		if e = db.AddEvent(*model.(*models.Event)); e != nil {
+		// Quarantined events are kept for review, there's no point on retrying them
+		if e = db.AddEvent(*model.(*models.Event)); errors.Is(e, dbManager.ErrEventQuarantined) {
+
+			l.Warning.Printf("[KAFKA] The event was quarantined. Reason: %v\n", e)
+
+			e = nil
+
+		} else if errors.Is(e, dbManager.ErrEventFiltered) {
+
+			l.Debug.Printf("[KAFKA] The event was not registered, %v\n", e)
+
+			e = nil
+
+		} else if errors.Is(e, dbManager.ErrEventInvalid) {
+
+			l.Warning.Printf("[KAFKA] The event was dropped. Reason: %v\n", e)
+
+			e = nil
+
+		} else if e != nil {
 
 			l.Warning.Printf("[KAFKA] There was an error while apliying the linked function. Error: %v\n", e)
 
@@ -123,8 +156,92 @@ func kafkaStart(db *dbManager.DbParameter, mon *statusManager.StatusManager) (ch
 		},
 	}
 
+	// CloudEvents are decoded following the Kafka protocol binding
+	if len(cfg.Kafka.TopicsCloudEventsIn) > 0 {
+
+		fce := func(db *dbManager.DbParameter, model interface{}) (e error) {
+
+			if e = db.AddCloudEvent(model.(*dbManager.CloudEvent)); errors.Is(e, dbManager.ErrEventQuarantined) {
+
+				l.Warning.Printf("[KAFKA] The cloud event was quarantined. Reason: %v\n", e)
+
+				e = nil
+
+			} else if errors.Is(e, dbManager.ErrEventFiltered) {
+
+				l.Debug.Printf("[KAFKA] The cloud event was not registered, %v\n", e)
+
+				e = nil
+
+			} else if e != nil {
+
+				l.Warning.Printf("[KAFKA] The cloud event was rejected. Error: %v\n", e)
+
+			}
+
+			return
+
+		}
+
+		decoder := func(m kafka.Message) (interface{}, error) {
+
+			return cloudEventManager.ParseKafka(m)
+
+		}
+
+		handler.in = append(handler.in, kafkaPackage{
+			topic:    cfg.Kafka.TopicsCloudEventsIn[0],
+			decoder:  decoder,
+			function: fce,
+			saveDB:   false,
+		})
+
+	}
+
 	kafkaHandler(db, mon, handler)
 
 	return
 
 }
+
+// filtersStart handles the initialization of the filter rules for the events.
+// The substring filters of the configuration are kept as drop rules over the
+// resource name, added only if there is no rule with the same name already.
+// Parameters:
+// - db: DbPAramenter reference for interaction with the db.
+func filtersStart(db *dbManager.DbParameter) {
+
+	l.Trace.Printf("[MAIN] Intializing the filter rules\n")
+
+	for _, f := range cfg.Events.Filters {
+
+		if f == "" {
+
+			continue
+
+		}
+
+		name := "config-" + f
+		action := models.FilterRuleActionDrop
+
+		rule := models.FilterRule{
+			Action:      &action,
+			Name:        &name,
+			NamePattern: regexp.QuoteMeta(f),
+		}
+
+		if _, e := db.CreateFilterRule(&rule); e != nil && !errors.Is(e, dbManager.ErrFilterRuleDuplicated) {
+
+			l.Warning.Printf("[MAIN] The filter [ %v ] of the configuration couldn't be added as a rule. Error: %v\n", f, e)
+
+		}
+
+	}
+
+	if _, e := db.LoadFilterRules(); e != nil {
+
+		l.Warning.Printf("[MAIN] The filter rules couldn't be loaded, events won't be filtered. Error: %v\n", e)
+
+	}
+
+}
@@ -96,14 +96,26 @@ func NewCreateCycleBadRequest() *CreateCycleBadRequest {
 invalid input, object invalid
 */
 type CreateCycleBadRequest struct {
+	Payload *models.ErrorResponse
 }
 
 func (o *CreateCycleBadRequest) Error() string {
-	return fmt.Sprintf("[POST /cycle][%d] createCycleBadRequest ", 400)
+	return fmt.Sprintf("[POST /cycle][%d] createCycleBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CreateCycleBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
 }
 
 func (o *CreateCycleBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
 	return nil
 }
 
@@ -27,6 +27,11 @@ type API interface {
 
 	   get cycle with given id*/
 	GetCycle(ctx context.Context, params *GetCycleParams) (*GetCycleOK, error)
+	/*
+	   GetCycleCoverage coverages of the resource lifecycles by the cycles
+
+	   Checks the cycles against the lifecycles declared in the EventsEngine, reporting the states without a cycle to price them and the cycles of states not declared*/
+	GetCycleCoverage(ctx context.Context, params *GetCycleCoverageParams) (*GetCycleCoverageOK, error)
 	/*
 	   ListCycles lists all cycles
 
@@ -111,6 +116,33 @@ func (a *Client) GetCycle(ctx context.Context, params *GetCycleParams) (*GetCycl
 
 }
 
+/*
+GetCycleCoverage coverages of the resource lifecycles by the cycles
+
+Checks the cycles against the lifecycles declared in the EventsEngine, reporting the states without a cycle to price them and the cycles of states not declared
+*/
+func (a *Client) GetCycleCoverage(ctx context.Context, params *GetCycleCoverageParams) (*GetCycleCoverageOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getCycleCoverage",
+		Method:             "GET",
+		PathPattern:        "/cycle/coverage",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetCycleCoverageReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetCycleCoverageOK), nil
+
+}
+
 /*
 ListCycles lists all cycles
 
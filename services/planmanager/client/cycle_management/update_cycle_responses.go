@@ -29,6 +29,12 @@ func (o *UpdateCycleReader) ReadResponse(response runtime.ClientResponse, consum
 			return nil, err
 		}
 		return result, nil
+	case 400:
+		result := NewUpdateCycleBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 404:
 		result := NewUpdateCycleNotFound()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -80,6 +86,39 @@ func (o *UpdateCycleOK) readResponse(response runtime.ClientResponse, consumer r
 	return nil
 }
 
+// NewUpdateCycleBadRequest creates a UpdateCycleBadRequest with default headers values
+func NewUpdateCycleBadRequest() *UpdateCycleBadRequest {
+	return &UpdateCycleBadRequest{}
+}
+
+/*UpdateCycleBadRequest handles this case with default header values.
+
+invalid input, object invalid
+*/
+type UpdateCycleBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *UpdateCycleBadRequest) Error() string {
+	return fmt.Sprintf("[PUT /cycle/{id}][%d] updateCycleBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *UpdateCycleBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *UpdateCycleBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewUpdateCycleNotFound creates a UpdateCycleNotFound with default headers values
 func NewUpdateCycleNotFound() *UpdateCycleNotFound {
 	return &UpdateCycleNotFound{}
@@ -3,6 +3,7 @@ module github.com/GoDieNow/TFT_Code/services/planmanager
 go 1.23.9
 
 require (
+	github.com/GoDieNow/TFT_Code/services/eventsengine v0.0.0-20250624195138-25504b9603b7
 	github.com/Nerzal/gocloak/v7 v7.11.0
 	github.com/go-openapi/errors v0.22.1
 	github.com/go-openapi/loads v0.22.0
@@ -72,3 +73,5 @@ require (
 	google.golang.org/protobuf v1.36.5 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace github.com/GoDieNow/TFT_Code/services/eventsengine => ../eventsengine
@@ -58,6 +58,9 @@ type CycleManagementAPI interface {
 	/* GetCycle Get specific cycle */
 	GetCycle(ctx context.Context, params cycle_management.GetCycleParams) middleware.Responder
 
+	/* GetCycleCoverage Coverage of the resource lifecycles by the cycles */
+	GetCycleCoverage(ctx context.Context, params cycle_management.GetCycleCoverageParams) middleware.Responder
+
 	/* ListCycles List all cycles */
 	ListCycles(ctx context.Context, params cycle_management.ListCyclesParams) middleware.Responder
 
@@ -267,6 +270,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.PlanManagerManagementAPIAPI
 		ctx = storeAuth(ctx, principal)
 		return c.CycleManagementAPI.GetCycle(ctx, params)
 	})
+	api.CycleManagementGetCycleCoverageHandler = cycle_management.GetCycleCoverageHandlerFunc(func(params cycle_management.GetCycleCoverageParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.CycleManagementAPI.GetCycleCoverage(ctx, params)
+	})
 	api.PlanManagementGetPlanHandler = plan_management.GetPlanHandlerFunc(func(params plan_management.GetPlanParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
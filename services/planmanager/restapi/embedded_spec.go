@@ -109,7 +109,10 @@ func init() {
             }
           },
           "400": {
-            "description": "invalid input, object invalid"
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "409": {
             "description": "an existing item already exists",
@@ -126,6 +129,44 @@ func init() {
         }
       }
     },
+    "/cycle/coverage": {
+      "get": {
+        "description": "Checks the cycles against the lifecycles declared in the EventsEngine, reporting the states without a cycle to price them and the cycles of states not declared",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "cycleManagement"
+        ],
+        "summary": "Coverage of the resource lifecycles by the cycles",
+        "operationId": "getCycleCoverage",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "resource type to filter",
+            "name": "type",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "coverage of the lifecycles returned",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/CycleCoverage"
+              }
+            }
+          },
+          "500": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
     "/cycle/{id}": {
       "get": {
         "description": "get cycle with given id",
@@ -208,6 +249,12 @@ func init() {
               "$ref": "#/definitions/Cycle"
             }
           },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "404": {
             "description": "cycle with id not found",
             "schema": {
@@ -1184,6 +1231,32 @@ func init() {
         }
       }
     },
+    "CycleCoverage": {
+      "type": "object",
+      "properties": {
+        "Lifecycle": {
+          "description": "Whether the EventsEngine has a lifecycle declared for the resource type",
+          "type": "boolean"
+        },
+        "ResourceType": {
+          "type": "string"
+        },
+        "Undeclared": {
+          "description": "States with a cycle that are not declared in the lifecycle",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "Unpriced": {
+          "description": "Non-terminal states declared in the lifecycle without a cycle",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "ErrorResponse": {
       "type": "object",
       "required": [
@@ -1542,7 +1615,10 @@ func init() {
             }
           },
           "400": {
-            "description": "invalid input, object invalid"
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "409": {
             "description": "an existing item already exists",
@@ -1559,6 +1635,44 @@ func init() {
         }
       }
     },
+    "/cycle/coverage": {
+      "get": {
+        "description": "Checks the cycles against the lifecycles declared in the EventsEngine, reporting the states without a cycle to price them and the cycles of states not declared",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "cycleManagement"
+        ],
+        "summary": "Coverage of the resource lifecycles by the cycles",
+        "operationId": "getCycleCoverage",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "resource type to filter",
+            "name": "type",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "coverage of the lifecycles returned",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/CycleCoverage"
+              }
+            }
+          },
+          "500": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
     "/cycle/{id}": {
       "get": {
         "description": "get cycle with given id",
@@ -1641,6 +1755,12 @@ func init() {
               "$ref": "#/definitions/Cycle"
             }
           },
+          "400": {
+            "description": "invalid input, object invalid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "404": {
             "description": "cycle with id not found",
             "schema": {
@@ -2617,6 +2737,32 @@ func init() {
         }
       }
     },
+    "CycleCoverage": {
+      "type": "object",
+      "properties": {
+        "Lifecycle": {
+          "description": "Whether the EventsEngine has a lifecycle declared for the resource type",
+          "type": "boolean"
+        },
+        "ResourceType": {
+          "type": "string"
+        },
+        "Undeclared": {
+          "description": "States with a cycle that are not declared in the lifecycle",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "Unpriced": {
+          "description": "Non-terminal states declared in the lifecycle without a cycle",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "ErrorResponse": {
       "type": "object",
       "required": [
@@ -65,6 +65,11 @@ const CreateCycleBadRequestCode int = 400
 swagger:response createCycleBadRequest
 */
 type CreateCycleBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
 }
 
 // NewCreateCycleBadRequest creates CreateCycleBadRequest with default headers values
@@ -73,12 +78,27 @@ func NewCreateCycleBadRequest() *CreateCycleBadRequest {
 	return &CreateCycleBadRequest{}
 }
 
+// WithPayload adds the payload to the create cycle bad request response
+func (o *CreateCycleBadRequest) WithPayload(payload *models.ErrorResponse) *CreateCycleBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create cycle bad request response
+func (o *CreateCycleBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
 // WriteResponse to the client
 func (o *CreateCycleBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
-	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
-
 	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
 }
 
 // CreateCycleConflictCode is the HTTP code returned for type CreateCycleConflict
@@ -57,6 +57,50 @@ func (o *UpdateCycleOK) WriteResponse(rw http.ResponseWriter, producer runtime.P
 	}
 }
 
+// UpdateCycleBadRequestCode is the HTTP code returned for type UpdateCycleBadRequest
+const UpdateCycleBadRequestCode int = 400
+
+/*UpdateCycleBadRequest invalid input, object invalid
+
+swagger:response updateCycleBadRequest
+*/
+type UpdateCycleBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewUpdateCycleBadRequest creates UpdateCycleBadRequest with default headers values
+func NewUpdateCycleBadRequest() *UpdateCycleBadRequest {
+
+	return &UpdateCycleBadRequest{}
+}
+
+// WithPayload adds the payload to the update cycle bad request response
+func (o *UpdateCycleBadRequest) WithPayload(payload *models.ErrorResponse) *UpdateCycleBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update cycle bad request response
+func (o *UpdateCycleBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateCycleBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // UpdateCycleNotFoundCode is the HTTP code returned for type UpdateCycleNotFound
 const UpdateCycleNotFoundCode int = 404
 
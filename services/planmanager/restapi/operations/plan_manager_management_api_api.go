@@ -74,6 +74,9 @@ func NewPlanManagerManagementAPIAPI(spec *loads.Document) *PlanManagerManagement
 		CycleManagementGetCycleHandler: cycle_management.GetCycleHandlerFunc(func(params cycle_management.GetCycleParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation cycle_management.GetCycle has not yet been implemented")
 		}),
+		CycleManagementGetCycleCoverageHandler: cycle_management.GetCycleCoverageHandlerFunc(func(params cycle_management.GetCycleCoverageParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation cycle_management.GetCycleCoverage has not yet been implemented")
+		}),
 		PlanManagementGetPlanHandler: plan_management.GetPlanHandlerFunc(func(params plan_management.GetPlanParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation plan_management.GetPlan has not yet been implemented")
 		}),
@@ -207,6 +210,8 @@ type PlanManagerManagementAPIAPI struct {
 	PlanManagementGetCompletePlanHandler plan_management.GetCompletePlanHandler
 	// CycleManagementGetCycleHandler sets the operation handler for the get cycle operation
 	CycleManagementGetCycleHandler cycle_management.GetCycleHandler
+	// CycleManagementGetCycleCoverageHandler sets the operation handler for the get cycle coverage operation
+	CycleManagementGetCycleCoverageHandler cycle_management.GetCycleCoverageHandler
 	// PlanManagementGetPlanHandler sets the operation handler for the get plan operation
 	PlanManagementGetPlanHandler plan_management.GetPlanHandler
 	// SkuManagementGetSkuHandler sets the operation handler for the get sku operation
@@ -353,6 +358,9 @@ func (o *PlanManagerManagementAPIAPI) Validate() error {
 	if o.CycleManagementGetCycleHandler == nil {
 		unregistered = append(unregistered, "cycle_management.GetCycleHandler")
 	}
+	if o.CycleManagementGetCycleCoverageHandler == nil {
+		unregistered = append(unregistered, "cycle_management.GetCycleCoverageHandler")
+	}
 	if o.PlanManagementGetPlanHandler == nil {
 		unregistered = append(unregistered, "plan_management.GetPlanHandler")
 	}
@@ -546,6 +554,10 @@ func (o *PlanManagerManagementAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/cycle/coverage"] = cycle_management.NewGetCycleCoverage(o.context, o.CycleManagementGetCycleCoverageHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/plan/{id}"] = plan_management.NewGetPlan(o.context, o.PlanManagementGetPlanHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -2,10 +2,18 @@ package cycleManager
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/go-openapi/runtime/middleware"
+	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/prometheus/client_golang/prometheus"
+	eventsClient "github.com/GoDieNow/TFT_Code/services/eventsengine/client"
+	eventsLifecycle "github.com/GoDieNow/TFT_Code/services/eventsengine/client/lifecycle_management"
+	eventsModels "github.com/GoDieNow/TFT_Code/services/eventsengine/models"
 	"github.com/GoDieNow/TFT_Code/services/planmanager/models"
 	"github.com/GoDieNow/TFT_Code/services/planmanager/restapi/operations/cycle_management"
 	"github.com/GoDieNow/TFT_Code/services/planmanager/server/dbManager"
@@ -25,11 +33,13 @@ const (
 // Parameters:
 // - basePath: a string with the base path of the system.
 // - db: a DbParameter reference to be able to use the DBManager methods.
+// - eventsConfig: a EventsEngine client config reference to interact with it.
 // - s.monit. a StatusManager reference to be able to use the status subsystem methods.
 type CycleManager struct {
-	basePath string
-	db       *dbManager.DbParameter
-	monit    *statusManager.StatusManager
+	basePath     string
+	db           *dbManager.DbParameter
+	eventsConfig eventsClient.Config
+	monit        *statusManager.StatusManager
 }
 
 // New is the function to create the struct CycleManager.
@@ -39,22 +49,43 @@ type CycleManager struct {
 // - StatusParameter: reference poining to the StatusManager that allows the
 // interaction with the StatusManager methods.
 // - bp: a string containing the base path of the service.
+// - c: the config needed to start the EventsEngine client, used to check the
+// cycles against the lifecycles of the resource types.
 // Returns:
 // - CycleManager: struct to interact with CycleManager subsystem functionalities.
-func New(db *dbManager.DbParameter, monit *statusManager.StatusManager, bp string) *CycleManager {
+func New(db *dbManager.DbParameter, monit *statusManager.StatusManager, bp string, c eventsClient.Config) *CycleManager {
 
 	l.Trace.Printf("[CycleManager] Generating new cycleManager.\n")
 
 	monit.InitEndpoint("cycle")
 
 	return &CycleManager{
-		basePath: bp,
-		db:       db,
-		monit:    monit,
+		basePath:     bp,
+		db:           db,
+		eventsConfig: c,
+		monit:        monit,
 	}
 
 }
 
+func (m *CycleManager) getClient(param *http.Request) *eventsClient.EventEngineManagementAPI {
+
+	config := m.eventsConfig
+
+	if param != nil && len(param.Header.Get("Authorization")) > 0 {
+
+		token := strings.Fields(param.Header.Get("Authorization"))[1]
+
+		config.AuthInfo = httptransport.BearerToken(token)
+
+	}
+
+	r := eventsClient.New(config)
+
+	return r
+
+}
+
 // CreateCycle (Swagger func) is the function behind the (POST) endpoint /cycle
 // Its job is to add a new Cycle to the system.
 func (m *CycleManager) CreateCycle(ctx context.Context, params cycle_management.CreateCycleParams) middleware.Responder {
@@ -64,6 +95,21 @@ func (m *CycleManager) CreateCycle(ctx context.Context, params cycle_management.
 	callTime := time.Now()
 	m.monit.APIHit("cycle", callTime)
 
+	if reason := m.checkState(params.HTTPRequest, params.Cycle); reason != "" {
+
+		s := "The Cycle provided is not valid: " + reason
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "400", "method": "POST", "route": "/cycle"}).Inc()
+
+		m.monit.APIHitDone("cycle", callTime)
+
+		return cycle_management.NewCreateCycleBadRequest().WithPayload(&errorReturn)
+
+	}
+
 	id, status, e := m.db.CreateCycle(params.Cycle)
 
 	if e != nil {
@@ -160,6 +206,152 @@ func (m *CycleManager) GetCycle(ctx context.Context, params cycle_management.Get
 
 }
 
+// GetCycleCoverage (Swagger func) is the function behind the (GET) endpoint
+// /cycle/coverage
+// Its job is to check the Cycles in the system against the lifecycles declared
+// in the EventsEngine, reporting the states that would go unpriced and the
+// Cycles of states that are not declared.
+func (m *CycleManager) GetCycleCoverage(ctx context.Context, params cycle_management.GetCycleCoverageParams) middleware.Responder {
+
+	l.Trace.Printf("[CycleManager] GetCycleCoverage endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("cycle", callTime)
+
+	ty := ""
+
+	if params.Type != nil {
+
+		ty = *params.Type
+
+	}
+
+	lifecycles, e := m.getClient(params.HTTPRequest).LifecycleManagement.ListLifecycles(ctx, eventsLifecycle.NewListLifecyclesParams())
+
+	if e != nil {
+
+		s := "Problem retrieving the lifecycles from the EventsEngine: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/cycle/coverage"}).Inc()
+
+		m.monit.APIHitDone("cycle", callTime)
+
+		return cycle_management.NewGetCycleCoverageInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	cycles, e := m.db.ListCycles("", ty)
+
+	if e != nil {
+
+		s := "Problem retrieving the Cycles from the system: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/cycle/coverage"}).Inc()
+
+		m.monit.APIHitDone("cycle", callTime)
+
+		return cycle_management.NewGetCycleCoverageInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	priced := make(map[string]map[string]bool)
+
+	for _, c := range cycles {
+
+		if priced[*c.ResourceType] == nil {
+
+			priced[*c.ResourceType] = make(map[string]bool)
+
+		}
+
+		priced[*c.ResourceType][*c.State] = true
+
+	}
+
+	declared := make(map[string]*eventsModels.Lifecycle)
+
+	for _, lc := range lifecycles.Payload {
+
+		if ty == "" || *lc.ResourceType == ty {
+
+			declared[*lc.ResourceType] = lc
+
+		}
+
+	}
+
+	var coverage []*models.CycleCoverage
+
+	for _, lc := range declared {
+
+		cov := models.CycleCoverage{
+			Lifecycle:    true,
+			ResourceType: *lc.ResourceType,
+			Undeclared:   []string{},
+			Unpriced:     []string{},
+		}
+
+		for _, state := range lc.States {
+
+			if !priced[cov.ResourceType][state] && !inList(lc.Terminal, state) {
+
+				cov.Unpriced = append(cov.Unpriced, state)
+
+			}
+
+		}
+
+		for state := range priced[cov.ResourceType] {
+
+			if !inList(lc.States, state) {
+
+				cov.Undeclared = append(cov.Undeclared, state)
+
+			}
+
+		}
+
+		sort.Strings(cov.Undeclared)
+
+		coverage = append(coverage, &cov)
+
+	}
+
+	// Resource types without lifecycle can't be checked, but they are listed
+	for rt := range priced {
+
+		if _, exists := declared[rt]; !exists {
+
+			coverage = append(coverage, &models.CycleCoverage{
+				ResourceType: rt,
+				Undeclared:   []string{},
+				Unpriced:     []string{},
+			})
+
+		}
+
+	}
+
+	sort.Slice(coverage, func(i, j int) bool {
+
+		return coverage[i].ResourceType < coverage[j].ResourceType
+
+	})
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/cycle/coverage"}).Inc()
+
+	m.monit.APIHitDone("cycle", callTime)
+
+	return cycle_management.NewGetCycleCoverageOK().WithPayload(coverage)
+
+}
+
 // ListCycles (Swagger func) is the function behind the (GET) endpoint /cycle
 // Its job is to get the list of Cycles in the system.
 func (m *CycleManager) ListCycles(ctx context.Context, params cycle_management.ListCyclesParams) middleware.Responder {
@@ -219,6 +411,21 @@ func (m *CycleManager) UpdateCycle(ctx context.Context, params cycle_management.
 	callTime := time.Now()
 	m.monit.APIHit("cycle", callTime)
 
+	if reason := m.checkState(params.HTTPRequest, params.Cycle); reason != "" {
+
+		s := "The Cycle provided is not valid: " + reason
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "400", "method": "PUT", "route": "/cycle/" + params.ID}).Inc()
+
+		m.monit.APIHitDone("cycle", callTime)
+
+		return cycle_management.NewUpdateCycleBadRequest().WithPayload(&errorReturn)
+
+	}
+
 	state, e := m.db.UpdateCycle(params.ID, params.Cycle)
 
 	if e != nil {
@@ -258,3 +465,70 @@ func (m *CycleManager) UpdateCycle(ctx context.Context, params cycle_management.
 	return cycle_management.NewUpdateCycleOK().WithPayload(params.Cycle)
 
 }
+
+// checkState job is to check that the state of the cycle is declared in the
+// lifecycle of its resource type in the EventsEngine. Resource types without
+// lifecycle are not checked, and neither are the cycles when the EventsEngine
+// can't be reached, to not block the pricing on its availability.
+// Parameters:
+// - param: the http request to forward its authorization, if any.
+// - cycle: reference to the Cycle to be checked.
+// Returns:
+// - reason: string with the reason why the cycle is not valid, empty if it is.
+func (m *CycleManager) checkState(param *http.Request, cycle *models.Cycle) (reason string) {
+
+	if cycle == nil || cycle.ResourceType == nil || cycle.State == nil {
+
+		return
+
+	}
+
+	params := eventsLifecycle.NewGetLifecycleParams().WithType(*cycle.ResourceType)
+
+	r, e := m.getClient(param).LifecycleManagement.GetLifecycle(context.Background(), params)
+
+	if e != nil {
+
+		var missing *eventsLifecycle.GetLifecycleNotFound
+
+		if !errors.As(e, &missing) {
+
+			l.Warning.Printf("[CycleManager] There was a problem while retrieving the lifecycle of [ %v ] from EventsEngine, the cycle is not checked. Error: %v", *cycle.ResourceType, e)
+
+		}
+
+		return
+
+	}
+
+	if !inList(r.Payload.States, *cycle.State) {
+
+		reason = "state [ " + *cycle.State + " ] not declared in the lifecycle of [ " + *cycle.ResourceType + " ]"
+
+	}
+
+	return
+
+}
+
+// inList job is to check if the provided string is in the list.
+// Parameters:
+// - list: slice of strings to look into.
+// - s: string to look for.
+// Returns:
+// - bool with the result of the check.
+func inList(list []string, s string) bool {
+
+	for i := range list {
+
+		if list[i] == s {
+
+			return true
+
+		}
+
+	}
+
+	return false
+
+}
@@ -2,10 +2,13 @@ package main
 
 import (
 	"net/http"
+	"net/url"
 	"strings"
 
+	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/cors"
+	eventsClient "github.com/GoDieNow/TFT_Code/services/eventsengine/client"
 	"github.com/GoDieNow/TFT_Code/services/planmanager/models"
 	"github.com/GoDieNow/TFT_Code/services/planmanager/restapi"
 	"github.com/GoDieNow/TFT_Code/services/planmanager/server/bundleManager"
@@ -36,9 +39,18 @@ func getService() (handler http.Handler, register *prometheus.Registry, e error)
 
 	bp := getBasePath()
 
+	eec := eventsClient.Config{
+		URL: &url.URL{
+			Host:   cfg.General.Services["eventsengine"],
+			Path:   eventsClient.DefaultBasePath,
+			Scheme: "http",
+		},
+		AuthInfo: httptransport.APIKeyAuth(cfg.APIKey.Key, cfg.APIKey.Place, cfg.APIKey.Token),
+	}
+
 	// Parts of the service HERE
 	b := bundleManager.New(db, mon, bp)
-	c := cycleManager.New(db, mon, bp)
+	c := cycleManager.New(db, mon, bp, eec)
 	p := planManager.New(db, mon, bp)
 	s := skuManager.New(db, mon, bp)
 	sp := priceManager.New(db, mon, bp)
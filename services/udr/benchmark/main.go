@@ -0,0 +1,330 @@
+// Package main provides the benchmark harness of the UDR compaction.
+//
+// It seeds a synthetic metric in an isolated window of the configured database
+// and compacts it with both the legacy in-memory approach, which loads every
+// raw record and scans them account by account, and the streaming approach,
+// which is the compaction run by the scheduler through the TriggerManager,
+// aggregating the records in SQL and writing the UDR records in batches.
+// For each of them it reports the time spent and the heap used, removing the
+// synthetic data afterwards.
+//
+// The UDRMode of the synthetic metric can be set to measure the integration of
+// the samples (step, trapezoid), the legacy approach only knowing the default
+// time-weighted average.
+//
+// Usage:
+//
+//	go run ./benchmark -db "host=localhost port=5432 user=postgres password=pass dbname=udr sslmode=disable" -accounts 1000 -resources 10
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/prometheus/client_golang/prometheus"
+	"gitlab.com/cyclops-utilities/datamodels"
+	eventsClient "github.com/GoDieNow/TFT_Code/services/eventsengine/client"
+	"github.com/GoDieNow/TFT_Code/services/udr/models"
+	"github.com/GoDieNow/TFT_Code/services/udr/server/dbManager"
+	"github.com/GoDieNow/TFT_Code/services/udr/server/statusManager"
+	"github.com/GoDieNow/TFT_Code/services/udr/server/triggerManager"
+	l "gitlab.com/cyclops-utilities/logging"
+)
+
+const (
+	seedBatch = 5000
+)
+
+type result struct {
+	Duration time.Duration
+	Heap     uint64
+	Records  int
+}
+
+func main() {
+
+	conn := flag.String("db", "host=localhost port=5432 user=postgres password=pass dbname=udr sslmode=disable", "connection string of the database")
+	accounts := flag.Int("accounts", 100, "number of accounts seeded")
+	resources := flag.Int("resources", 10, "number of resources seeded per account")
+	window := flag.Duration("window", 8*time.Hour, "length of the window compacted")
+	step := flag.Duration("step", 15*time.Minute, "time between the samples of a resource")
+	legacy := flag.Bool("legacy", true, "run also the legacy in-memory compaction")
+	mode := flag.String("mode", "", "UDRMode of the synthetic metric (avg, sum, last, max, min, p95, step, trapezoid), empty for the default")
+	keep := flag.Bool("keep", false, "keep the synthetic data in the database")
+	logLevel := flag.String("loglevel", "warning", "log level of the harness")
+
+	flag.Parse()
+
+	if e := l.InitLogger("", *logLevel, true); e != nil {
+
+		fmt.Printf("Unable to initialize the logger: %v\n", e)
+
+		os.Exit(1)
+
+	}
+
+	db := dbManager.New(*conn, &models.Usage{}, &models.UDRRecord{})
+
+	db.Metrics = map[string]*prometheus.GaugeVec{
+		"count": prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "processed_count"}, []string{"type"}),
+	}
+
+	// A past window and a unique metric keep the synthetic data isolated
+	metric := fmt.Sprintf("benchmark-%v", time.Now().UnixNano())
+	start := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	from, to := strfmt.DateTime(start), strfmt.DateTime(start.Add(*window))
+
+	defer func() {
+
+		if *keep {
+
+			return
+
+		}
+
+		db.Db.Where(&models.Usage{ResourceType: metric}).Delete(&models.Usage{})
+		db.Db.Where(&models.UDRRecord{ResourceType: metric}).Delete(&models.UDRRecord{})
+
+	}()
+
+	seeded, e := seed(db, metric, start, *window, *step, *accounts, *resources)
+
+	if e != nil {
+
+		fmt.Printf("Unable to seed the synthetic usage: %v\n", e)
+
+		return
+
+	}
+
+	fmt.Printf("Seeded [ %v ] samples of metric [ %v ] for [ %v ] accounts with [ %v ] resources each.\n\n", seeded, metric, *accounts, *resources)
+
+	fmt.Printf("%-10v %15v %15v %15v\n", "approach", "duration", "heap (MiB)", "records")
+
+	if *legacy {
+
+		r := measure(func() int { return compactLegacy(db, metric, from, to) })
+
+		fmt.Printf("%-10v %15v %15.2f %15v\n", "legacy", r.Duration.Round(time.Millisecond), float64(r.Heap)/(1<<20), r.Records)
+
+	}
+
+	db.Db.Where(&models.UDRRecord{ResourceType: metric}).Delete(&models.UDRRecord{})
+
+	integration := triggerManager.IntegrationConfig{
+		Metrics: map[string]string{strings.ToLower(metric): strings.ToLower(*mode)},
+	}
+
+	trigger := triggerManager.New(db, statusManager.New(db), eventsClient.Config{}, nil, triggerManager.SchedulerConfig{Location: time.UTC}, integration)
+
+	r := measure(func() int {
+
+		count, errs := trigger.CompactMetric(metric, from, to)
+
+		for _, e := range errs {
+
+			fmt.Printf("The streaming compaction failed: %v\n", e)
+
+		}
+
+		return count
+
+	})
+
+	fmt.Printf("%-10v %15v %15.2f %15v\n", "streaming", r.Duration.Round(time.Millisecond), float64(r.Heap)/(1<<20), r.Records)
+
+}
+
+// compactLegacy reproduces the former compaction of a metric, loading all its
+// raw records in memory and scanning them for every account.
+func compactLegacy(db *dbManager.DbParameter, metric string, from, to strfmt.DateTime) (count int) {
+
+	interval := ((time.Time)(to)).Sub((time.Time)(from)).Seconds()
+
+	records := db.GetRecords(metric, from, to)
+	accounts := make(map[string]struct{})
+
+	for i := range records {
+
+		accounts[records[i].Account] = struct{}{}
+
+	}
+
+	for account := range accounts {
+
+		accum := make(map[string]float64)
+		counter := make(map[string]float64)
+		keys := make(map[string]*models.Usage)
+
+		for i := range records {
+
+			if records[i].Account != account {
+
+				continue
+
+			}
+
+			key := fmt.Sprintf("%v|%v|%v|%v", records[i].ResourceID, records[i].ResourceName, records[i].Unit, records[i].Metadata)
+
+			accum[key] += records[i].Usage
+			counter[key]++
+			keys[key] = records[i]
+
+		}
+
+		for key, u := range keys {
+
+			report := models.UDRRecord{
+				AccountID:    account,
+				UsageBreakup: datamodels.JSONdb{"used": accum[key] * interval / counter[key]},
+				Metadata:     u.Metadata,
+				TimeTo:       to,
+				TimeFrom:     from,
+				ResourceID:   u.ResourceID,
+				ResourceName: u.ResourceName,
+				ResourceType: metric,
+				Unit:         u.Unit + "(*period)",
+			}
+
+			if db.AddRecord(report) == nil {
+
+				count++
+
+			}
+
+		}
+
+	}
+
+	return
+
+}
+
+// measure runs the compaction provided, sampling the heap in use meanwhile to
+// report its peak over the heap in use before starting.
+func measure(f func() int) (r result) {
+
+	var base, peak uint64
+	var stats runtime.MemStats
+
+	runtime.GC()
+	runtime.ReadMemStats(&stats)
+
+	base = stats.HeapAlloc
+
+	done := make(chan struct{})
+	sampled := make(chan struct{})
+
+	go func() {
+
+		defer close(sampled)
+
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+
+		for {
+
+			var s runtime.MemStats
+
+			runtime.ReadMemStats(&s)
+
+			if s.HeapAlloc > atomic.LoadUint64(&peak) {
+
+				atomic.StoreUint64(&peak, s.HeapAlloc)
+
+			}
+
+			select {
+
+			case <-done:
+
+				return
+
+			case <-ticker.C:
+
+			}
+
+		}
+
+	}()
+
+	now := time.Now()
+
+	r.Records = f()
+	r.Duration = time.Since(now)
+
+	close(done)
+	<-sampled
+
+	if peak > base {
+
+		r.Heap = peak - base
+
+	}
+
+	return
+
+}
+
+// seed adds the synthetic samples of the metric to the window, one every step
+// for each resource of each account.
+func seed(db *dbManager.DbParameter, metric string, start time.Time, window, step time.Duration, accounts, resources int) (count int, e error) {
+
+	batch := make([]*models.Usage, 0, seedBatch)
+
+	for a := 0; a < accounts; a++ {
+
+		for r := 0; r < resources; r++ {
+
+			for t := start; t.Before(start.Add(window)); t = t.Add(step) {
+
+				batch = append(batch, &models.Usage{
+					Account:      fmt.Sprintf("account-%v", a),
+					Metadata:     datamodels.JSONdb{"flavor": fmt.Sprintf("m%v.small", r%4)},
+					ResourceID:   fmt.Sprintf("resource-%v-%v", a, r),
+					ResourceName: fmt.Sprintf("vm-%v", r),
+					ResourceType: metric,
+					Time:         t.Unix(),
+					Timedate:     strfmt.DateTime(t),
+					Unit:         "instance",
+					Usage:        float64(1 + (a+r)%8),
+				})
+
+				if len(batch) == seedBatch {
+
+					if e = db.Db.Create(&batch).Error; e != nil {
+
+						return
+
+					}
+
+					count += len(batch)
+					batch = batch[:0]
+
+				}
+
+			}
+
+		}
+
+	}
+
+	if len(batch) > 0 {
+
+		if e = db.Db.Create(&batch).Error; e == nil {
+
+			count += len(batch)
+
+		}
+
+	}
+
+	return
+
+}
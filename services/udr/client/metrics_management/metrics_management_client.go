@@ -17,9 +17,21 @@ import (
 
 // API is the interface of the metrics management client
 type API interface {
+	/*
+	   CreateMetric registers the definition of a new metric in the system*/
+	CreateMetric(ctx context.Context, params *CreateMetricParams) (*CreateMetricCreated, error)
+	/*
+	   GetMetric definitions of the metric with the provided id*/
+	GetMetric(ctx context.Context, params *GetMetricParams) (*GetMetricOK, error)
 	/*
 	   GetMetrics lists of all metric types processed by the service*/
 	GetMetrics(ctx context.Context, params *GetMetricsParams) (*GetMetricsOK, error)
+	/*
+	   ListQuarantine lists of the usage records held back on ingestion for unknown or invalid metrics*/
+	ListQuarantine(ctx context.Context, params *ListQuarantineParams) (*ListQuarantineOK, error)
+	/*
+	   UpdateMetric updates the definition of the metric with the provided id*/
+	UpdateMetric(ctx context.Context, params *UpdateMetricParams) (*UpdateMetricOK, error)
 }
 
 // New creates a new metrics management API client.
@@ -40,6 +52,56 @@ type Client struct {
 	authInfo  runtime.ClientAuthInfoWriter
 }
 
+/*
+CreateMetric registers the definition of a new metric in the system
+*/
+func (a *Client) CreateMetric(ctx context.Context, params *CreateMetricParams) (*CreateMetricCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "createMetric",
+		Method:             "POST",
+		PathPattern:        "/metrics",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CreateMetricReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*CreateMetricCreated), nil
+
+}
+
+/*
+GetMetric definitions of the metric with the provided id
+*/
+func (a *Client) GetMetric(ctx context.Context, params *GetMetricParams) (*GetMetricOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getMetric",
+		Method:             "GET",
+		PathPattern:        "/metrics/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetMetricReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetMetricOK), nil
+
+}
+
 /*
 GetMetrics lists of all metric types processed by the service
 */
@@ -64,3 +126,53 @@ func (a *Client) GetMetrics(ctx context.Context, params *GetMetricsParams) (*Get
 	return result.(*GetMetricsOK), nil
 
 }
+
+/*
+ListQuarantine lists of the usage records held back on ingestion for unknown or invalid metrics
+*/
+func (a *Client) ListQuarantine(ctx context.Context, params *ListQuarantineParams) (*ListQuarantineOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listQuarantine",
+		Method:             "GET",
+		PathPattern:        "/quarantine",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListQuarantineReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListQuarantineOK), nil
+
+}
+
+/*
+UpdateMetric updates the definition of the metric with the provided id
+*/
+func (a *Client) UpdateMetric(ctx context.Context, params *UpdateMetricParams) (*UpdateMetricOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "updateMetric",
+		Method:             "PUT",
+		PathPattern:        "/metrics/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpdateMetricReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UpdateMetricOK), nil
+
+}
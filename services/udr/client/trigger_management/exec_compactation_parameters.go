@@ -71,6 +71,11 @@ type ExecCompactationParams struct {
 
 	*/
 	From *strfmt.DateTime
+	/*Schedule
+	  Compaction schedule to run, restricting the metrics compacted to the ones in it and using its windows when no period is provided
+
+	*/
+	Schedule *string
 	/*To
 	  Datetime until which to get the usage report
 
@@ -137,6 +142,17 @@ func (o *ExecCompactationParams) SetFrom(from *strfmt.DateTime) {
 	o.From = from
 }
 
+// WithSchedule adds the schedule to the exec compactation params
+func (o *ExecCompactationParams) WithSchedule(schedule *string) *ExecCompactationParams {
+	o.SetSchedule(schedule)
+	return o
+}
+
+// SetSchedule adds the schedule to the exec compactation params
+func (o *ExecCompactationParams) SetSchedule(schedule *string) {
+	o.Schedule = schedule
+}
+
 // WithTo adds the to to the exec compactation params
 func (o *ExecCompactationParams) WithTo(to *strfmt.DateTime) *ExecCompactationParams {
 	o.SetTo(to)
@@ -188,6 +204,22 @@ func (o *ExecCompactationParams) WriteToRequest(r runtime.ClientRequest, reg str
 
 	}
 
+	if o.Schedule != nil {
+
+		// query param schedule
+		var qrSchedule string
+		if o.Schedule != nil {
+			qrSchedule = *o.Schedule
+		}
+		qSchedule := qrSchedule
+		if qSchedule != "" {
+			if err := r.SetQueryParam("schedule", qSchedule); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if o.To != nil {
 
 		// query param to
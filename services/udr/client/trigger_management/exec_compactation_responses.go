@@ -29,6 +29,12 @@ func (o *ExecCompactationReader) ReadResponse(response runtime.ClientResponse, c
 			return nil, err
 		}
 		return result, nil
+	case 400:
+		result := NewExecCompactationBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewExecCompactationInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -62,6 +68,39 @@ func (o *ExecCompactationOK) readResponse(response runtime.ClientResponse, consu
 	return nil
 }
 
+// NewExecCompactationBadRequest creates a ExecCompactationBadRequest with default headers values
+func NewExecCompactationBadRequest() *ExecCompactationBadRequest {
+	return &ExecCompactationBadRequest{}
+}
+
+/*ExecCompactationBadRequest handles this case with default header values.
+
+The schedule provided doesn't exist
+*/
+type ExecCompactationBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *ExecCompactationBadRequest) Error() string {
+	return fmt.Sprintf("[GET /trigger/compact][%d] execCompactationBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ExecCompactationBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ExecCompactationBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewExecCompactationInternalServerError creates a ExecCompactationInternalServerError with default headers values
 func NewExecCompactationInternalServerError() *ExecCompactationInternalServerError {
 	return &ExecCompactationInternalServerError{}
@@ -20,6 +20,12 @@ type API interface {
 	/*
 	   ExecCompactation compactations task trigger*/
 	ExecCompactation(ctx context.Context, params *ExecCompactationParams) (*ExecCompactationOK, error)
+	/*
+	   ListCompactions lists of the windows already compacted by the schedules*/
+	ListCompactions(ctx context.Context, params *ListCompactionsParams) (*ListCompactionsOK, error)
+	/*
+	   ListWatermarks lists of the compaction watermarks of the metrics*/
+	ListWatermarks(ctx context.Context, params *ListWatermarksParams) (*ListWatermarksOK, error)
 }
 
 // New creates a new trigger management API client.
@@ -64,3 +70,53 @@ func (a *Client) ExecCompactation(ctx context.Context, params *ExecCompactationP
 	return result.(*ExecCompactationOK), nil
 
 }
+
+/*
+ListCompactions lists of the windows already compacted by the schedules
+*/
+func (a *Client) ListCompactions(ctx context.Context, params *ListCompactionsParams) (*ListCompactionsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listCompactions",
+		Method:             "GET",
+		PathPattern:        "/trigger/compactions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListCompactionsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListCompactionsOK), nil
+
+}
+
+/*
+ListWatermarks lists of the compaction watermarks of the metrics
+*/
+func (a *Client) ListWatermarks(ctx context.Context, params *ListWatermarksParams) (*ListWatermarksOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listWatermarks",
+		Method:             "GET",
+		PathPattern:        "/trigger/watermarks",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListWatermarksReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListWatermarksOK), nil
+
+}
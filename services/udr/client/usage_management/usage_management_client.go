@@ -17,12 +17,18 @@ import (
 
 // API is the interface of the usage management client
 type API interface {
+	/*
+	   GetIngestionJob status and results of an ingestion job*/
+	GetIngestionJob(ctx context.Context, params *GetIngestionJobParams) (*GetIngestionJobOK, error)
 	/*
 	   GetSystemUsage detaileds report covering all accounts within the specified time window*/
 	GetSystemUsage(ctx context.Context, params *GetSystemUsageParams) (*GetSystemUsageOK, error)
 	/*
 	   GetUsage detaileds report covering of the account associated with the id within the specified time window*/
 	GetUsage(ctx context.Context, params *GetUsageParams) (*GetUsageOK, error)
+	/*
+	   IngestUsage ingestions of a batch of usage records as JSON n d JSON or c s v*/
+	IngestUsage(ctx context.Context, params *IngestUsageParams) (*IngestUsageOK, error)
 }
 
 // New creates a new usage management API client.
@@ -43,6 +49,31 @@ type Client struct {
 	authInfo  runtime.ClientAuthInfoWriter
 }
 
+/*
+GetIngestionJob status and results of an ingestion job
+*/
+func (a *Client) GetIngestionJob(ctx context.Context, params *GetIngestionJobParams) (*GetIngestionJobOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getIngestionJob",
+		Method:             "GET",
+		PathPattern:        "/usage/ingest/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetIngestionJobReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetIngestionJobOK), nil
+
+}
+
 /*
 GetSystemUsage detaileds report covering all accounts within the specified time window
 */
@@ -92,3 +123,28 @@ func (a *Client) GetUsage(ctx context.Context, params *GetUsageParams) (*GetUsag
 	return result.(*GetUsageOK), nil
 
 }
+
+/*
+IngestUsage ingestions of a batch of usage records as JSON n d JSON or c s v
+*/
+func (a *Client) IngestUsage(ctx context.Context, params *IngestUsageParams) (*IngestUsageOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ingestUsage",
+		Method:             "POST",
+		PathPattern:        "/usage/ingest",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/x-ndjson", "text/csv"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &IngestUsageReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*IngestUsageOK), nil
+
+}
@@ -82,3 +82,10 @@ require (
 	google.golang.org/protobuf v1.36.5 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace (
+	github.com/GoDieNow/TFT_Code/services/cdr => ../cdr
+	github.com/GoDieNow/TFT_Code/services/customerdb => ../customerdb
+	github.com/GoDieNow/TFT_Code/services/eventsengine => ../eventsengine
+	github.com/GoDieNow/TFT_Code/services/planmanager => ../planmanager
+)
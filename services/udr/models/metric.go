@@ -6,8 +6,12 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // Metric metric
@@ -15,12 +19,155 @@ import (
 // swagger:model Metric
 type Metric struct {
 
+	// Function used to compact the samples, period being the average over the window
+	// Enum: [avg last max min p95 period step sum trapezoid]
+	Aggregation string `json:"Aggregation,omitempty"`
+
+	// description
+	Description string `json:"Description,omitempty"`
+
+	// Seconds without samples after which there is a gap for the step and trapezoid aggregations, the configured ones if 0
+	GapHold int64 `json:"GapHold,omitempty"`
+
+	// Gaps hold the last value (hold) or hold it up to GapHold and count as zero afterwards (zero), the configured one if empty
+	// Enum: [hold zero]
+	GapMode string `json:"GapMode,omitempty"`
+
+	// Metadata keys allowed in the samples, any if empty
+	MetadataKeys []string `json:"MetadataKeys" gorm:"serializer:json"`
+
 	// metric
 	Metric string `json:"Metric,omitempty" gorm:"primary_key"`
+
+	// Days the raw samples are kept in the system, forever if 0
+	RetentionDays int64 `json:"RetentionDays,omitempty"`
+
+	// Canonical unit of the samples
+	Unit string `json:"Unit,omitempty"`
 }
 
 // Validate validates this metric
 func (m *Metric) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAggregation(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateGapMode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var metricTypeAggregationPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["avg","last","max","min","p95","period","step","sum","trapezoid"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		metricTypeAggregationPropEnum = append(metricTypeAggregationPropEnum, v)
+	}
+}
+
+const (
+
+	// MetricAggregationAvg captures enum value "avg"
+	MetricAggregationAvg string = "avg"
+
+	// MetricAggregationLast captures enum value "last"
+	MetricAggregationLast string = "last"
+
+	// MetricAggregationMax captures enum value "max"
+	MetricAggregationMax string = "max"
+
+	// MetricAggregationMin captures enum value "min"
+	MetricAggregationMin string = "min"
+
+	// MetricAggregationP95 captures enum value "p95"
+	MetricAggregationP95 string = "p95"
+
+	// MetricAggregationPeriod captures enum value "period"
+	MetricAggregationPeriod string = "period"
+
+	// MetricAggregationStep captures enum value "step"
+	MetricAggregationStep string = "step"
+
+	// MetricAggregationSum captures enum value "sum"
+	MetricAggregationSum string = "sum"
+
+	// MetricAggregationTrapezoid captures enum value "trapezoid"
+	MetricAggregationTrapezoid string = "trapezoid"
+)
+
+// prop value enum
+func (m *Metric) validateAggregationEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, metricTypeAggregationPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Metric) validateAggregation(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Aggregation) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateAggregationEnum("Aggregation", "body", m.Aggregation); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var metricTypeGapModePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["hold","zero"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		metricTypeGapModePropEnum = append(metricTypeGapModePropEnum, v)
+	}
+}
+
+const (
+
+	// MetricGapModeHold captures enum value "hold"
+	MetricGapModeHold string = "hold"
+
+	// MetricGapModeZero captures enum value "zero"
+	MetricGapModeZero string = "zero"
+)
+
+// prop value enum
+func (m *Metric) validateGapModeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, metricTypeGapModePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Metric) validateGapMode(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.GapMode) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateGapModeEnum("GapMode", "body", m.GapMode); err != nil {
+		return err
+	}
+
 	return nil
 }
 
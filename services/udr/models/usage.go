@@ -21,6 +21,9 @@ type Usage struct {
 	// account
 	Account string `json:"Account,omitempty" gorm:"index"`
 
+	// Deduplication key of the sample, derived from metric, account, resource and time when not provided
+	IdempotencyKey string `json:"IdempotencyKey,omitempty" gorm:"index:idx_usage_idempotency,unique,priority:1,where:idempotency_key <> ''"`
+
 	// metadata
 	Metadata datamodels.JSONdb `json:"Metadata,omitempty" gorm:"type:jsonb"`
 
@@ -38,7 +41,7 @@ type Usage struct {
 
 	// timedate
 	// Format: datetime
-	Timedate strfmt.DateTime `json:"Timedate,omitempty" gorm:"index;type:timestamptz"`
+	Timedate strfmt.DateTime `json:"Timedate,omitempty" gorm:"index;index:idx_usage_idempotency,unique,priority:2;type:timestamptz"`
 
 	// unit
 	Unit string `json:"Unit,omitempty"`
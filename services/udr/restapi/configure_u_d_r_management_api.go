@@ -29,8 +29,20 @@ const AuthKey contextKey = "Auth"
 
 /* MetricsManagementAPI  */
 type MetricsManagementAPI interface {
+	/* CreateMetric Registers the definition of a new metric in the system */
+	CreateMetric(ctx context.Context, params metrics_management.CreateMetricParams) middleware.Responder
+
+	/* GetMetric Definition of the metric with the provided id */
+	GetMetric(ctx context.Context, params metrics_management.GetMetricParams) middleware.Responder
+
 	/* GetMetrics List of all metric types processed by the service */
 	GetMetrics(ctx context.Context, params metrics_management.GetMetricsParams) middleware.Responder
+
+	/* ListQuarantine List of the usage records held back on ingestion for unknown or invalid metrics */
+	ListQuarantine(ctx context.Context, params metrics_management.ListQuarantineParams) middleware.Responder
+
+	/* UpdateMetric Updates the definition of the metric with the provided id */
+	UpdateMetric(ctx context.Context, params metrics_management.UpdateMetricParams) middleware.Responder
 }
 
 //go:generate mockery -name StatusManagementAPI -inpkg
@@ -50,17 +62,29 @@ type StatusManagementAPI interface {
 type TriggerManagementAPI interface {
 	/* ExecCompactation Compactation task trigger */
 	ExecCompactation(ctx context.Context, params trigger_management.ExecCompactationParams) middleware.Responder
+
+	/* ListCompactions List of the windows already compacted by the schedules */
+	ListCompactions(ctx context.Context, params trigger_management.ListCompactionsParams) middleware.Responder
+
+	/* ListWatermarks List of the compaction watermarks of the metrics */
+	ListWatermarks(ctx context.Context, params trigger_management.ListWatermarksParams) middleware.Responder
 }
 
 //go:generate mockery -name UsageManagementAPI -inpkg
 
 /* UsageManagementAPI  */
 type UsageManagementAPI interface {
+	/* GetIngestionJob Status and results of an ingestion job */
+	GetIngestionJob(ctx context.Context, params usage_management.GetIngestionJobParams) middleware.Responder
+
 	/* GetSystemUsage Detailed report covering all accounts within the specified time window */
 	GetSystemUsage(ctx context.Context, params usage_management.GetSystemUsageParams) middleware.Responder
 
 	/* GetUsage Detailed report covering of the account associated with the id within the specified time window */
 	GetUsage(ctx context.Context, params usage_management.GetUsageParams) middleware.Responder
+
+	/* IngestUsage Ingestion of a batch of usage records as JSON, NDJSON or CSV */
+	IngestUsage(ctx context.Context, params usage_management.IngestUsageParams) middleware.Responder
 }
 
 // Config is configuration for Handler
@@ -123,6 +147,7 @@ func HandlerAPI(c Config) (http.Handler, *operations.UDRManagementAPIAPI, error)
 		api.BearerAuthenticator = c.BearerAuthenticator
 	}
 
+	api.CsvConsumer = runtime.CSVConsumer()
 	api.JSONConsumer = runtime.JSONConsumer()
 	api.JSONProducer = runtime.JSONProducer()
 	api.APIKeyHeaderAuth = func(token string) (interface{}, error) {
@@ -146,11 +171,26 @@ func HandlerAPI(c Config) (http.Handler, *operations.UDRManagementAPIAPI, error)
 		return c.AuthKeycloak(token, scopes)
 	}
 	api.APIAuthorizer = authorizer(c.Authorizer)
+	api.MetricsManagementCreateMetricHandler = metrics_management.CreateMetricHandlerFunc(func(params metrics_management.CreateMetricParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.MetricsManagementAPI.CreateMetric(ctx, params)
+	})
 	api.TriggerManagementExecCompactationHandler = trigger_management.ExecCompactationHandlerFunc(func(params trigger_management.ExecCompactationParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.TriggerManagementAPI.ExecCompactation(ctx, params)
 	})
+	api.UsageManagementGetIngestionJobHandler = usage_management.GetIngestionJobHandlerFunc(func(params usage_management.GetIngestionJobParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.UsageManagementAPI.GetIngestionJob(ctx, params)
+	})
+	api.MetricsManagementGetMetricHandler = metrics_management.GetMetricHandlerFunc(func(params metrics_management.GetMetricParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.MetricsManagementAPI.GetMetric(ctx, params)
+	})
 	api.MetricsManagementGetMetricsHandler = metrics_management.GetMetricsHandlerFunc(func(params metrics_management.GetMetricsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -171,11 +211,36 @@ func HandlerAPI(c Config) (http.Handler, *operations.UDRManagementAPIAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.UsageManagementAPI.GetUsage(ctx, params)
 	})
+	api.UsageManagementIngestUsageHandler = usage_management.IngestUsageHandlerFunc(func(params usage_management.IngestUsageParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.UsageManagementAPI.IngestUsage(ctx, params)
+	})
+	api.TriggerManagementListCompactionsHandler = trigger_management.ListCompactionsHandlerFunc(func(params trigger_management.ListCompactionsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.TriggerManagementAPI.ListCompactions(ctx, params)
+	})
+	api.MetricsManagementListQuarantineHandler = metrics_management.ListQuarantineHandlerFunc(func(params metrics_management.ListQuarantineParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.MetricsManagementAPI.ListQuarantine(ctx, params)
+	})
+	api.TriggerManagementListWatermarksHandler = trigger_management.ListWatermarksHandlerFunc(func(params trigger_management.ListWatermarksParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.TriggerManagementAPI.ListWatermarks(ctx, params)
+	})
 	api.StatusManagementShowStatusHandler = status_management.ShowStatusHandlerFunc(func(params status_management.ShowStatusParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.StatusManagementAPI.ShowStatus(ctx, params)
 	})
+	api.MetricsManagementUpdateMetricHandler = metrics_management.UpdateMetricHandlerFunc(func(params metrics_management.UpdateMetricParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.MetricsManagementAPI.UpdateMetric(ctx, params)
+	})
 	api.ServerShutdown = func() {}
 	return api.Serve(c.InnerMiddleware), api, nil
 }
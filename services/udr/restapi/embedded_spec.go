@@ -78,6 +78,243 @@ func init() {
             }
           }
         }
+      },
+      "post": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "metricsManagement"
+        ],
+        "summary": "Registers the definition of a new metric in the system",
+        "operationId": "createMetric",
+        "parameters": [
+          {
+            "description": "Definition of the metric to be registered",
+            "name": "metric",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Metric"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Metric registered in the system",
+            "schema": {
+              "$ref": "#/definitions/Metric"
+            }
+          },
+          "400": {
+            "description": "The definition of the metric is not valid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The metric already exists in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/metrics/{id}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "metricsManagement"
+        ],
+        "summary": "Definition of the metric with the provided id",
+        "operationId": "getMetric",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Name of the metric",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/Metric"
+            }
+          },
+          "404": {
+            "description": "The metric doesn't exist in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "metricsManagement"
+        ],
+        "summary": "Updates the definition of the metric with the provided id",
+        "operationId": "updateMetric",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Name of the metric",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Definition of the metric to be updated",
+            "name": "metric",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Metric"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Metric updated in the system",
+            "schema": {
+              "$ref": "#/definitions/Metric"
+            }
+          },
+          "400": {
+            "description": "The definition of the metric is not valid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The metric doesn't exist in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/quarantine": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "metricsManagement"
+        ],
+        "summary": "List of the usage records held back on ingestion for unknown or invalid metrics",
+        "operationId": "listQuarantine",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Metric to filter by",
+            "name": "metric",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/QuarantinedUsage"
+              }
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
       }
     },
     "/status": {
@@ -212,12 +449,24 @@ func init() {
             "description": "Switch for using 15m boundaries instead of 8h",
             "name": "fast_mode",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Compaction schedule to run, restricting the metrics compacted to the ones in it and using its windows when no period is provided",
+            "name": "schedule",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Compactation task executed successfully."
           },
+          "400": {
+            "description": "The schedule provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "Something unexpected happend, error raised",
             "schema": {
@@ -227,7 +476,7 @@ func init() {
         }
       }
     },
-    "/usage": {
+    "/trigger/compactions": {
       "get": {
         "security": [
           {
@@ -246,35 +495,29 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "usageManagement"
+          "triggerManagement"
         ],
-        "summary": "Detailed report covering all accounts within the specified time window",
-        "operationId": "getSystemUsage",
+        "summary": "List of the windows already compacted by the schedules",
+        "operationId": "listCompactions",
         "parameters": [
           {
             "type": "string",
-            "format": "datetime",
-            "description": "Datetime from which to get the usage report",
-            "name": "from",
+            "description": "Compaction schedule to filter by",
+            "name": "schedule",
             "in": "query"
           },
           {
             "type": "string",
             "format": "datetime",
-            "description": "Datetime until which to get the usage report",
-            "name": "to",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Metric(s) to get the usage report",
-            "name": "metric",
+            "description": "Datetime from which to get the compacted windows",
+            "name": "from",
             "in": "query"
           },
           {
             "type": "string",
-            "description": "List of ids to be queried",
-            "name": "idlist",
+            "format": "datetime",
+            "description": "Datetime until which to get the compacted windows",
+            "name": "to",
             "in": "query"
           }
         ],
@@ -284,7 +527,7 @@ func init() {
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/UReport"
+                "$ref": "#/definitions/CompactionWindow"
               }
             }
           },
@@ -297,7 +540,7 @@ func init() {
         }
       }
     },
-    "/usage/{id}": {
+    "/trigger/watermarks": {
       "get": {
         "security": [
           {
@@ -316,35 +559,14 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "usageManagement"
+          "triggerManagement"
         ],
-        "summary": "Detailed report covering of the account associated with the id within the specified time window",
-        "operationId": "getUsage",
+        "summary": "List of the compaction watermarks of the metrics",
+        "operationId": "listWatermarks",
         "parameters": [
           {
             "type": "string",
-            "description": "Id of the account to be checked",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "datetime",
-            "description": "Datetime from which to get the usage report",
-            "name": "from",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "datetime",
-            "description": "Datetime until which to get the usage report",
-            "name": "to",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Metric(s) to get the usage report",
+            "description": "Metric to filter by",
             "name": "metric",
             "in": "query"
           }
@@ -355,7 +577,7 @@ func init() {
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/UReport"
+                "$ref": "#/definitions/Watermark"
               }
             }
           },
@@ -367,9 +589,333 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
+    },
+    "/usage": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "usageManagement"
+        ],
+        "summary": "Detailed report covering all accounts within the specified time window",
+        "operationId": "getSystemUsage",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime from which to get the usage report",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime until which to get the usage report",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Metric(s) to get the usage report",
+            "name": "metric",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "List of ids to be queried",
+            "name": "idlist",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/UReport"
+              }
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/usage/ingest": {
+      "post": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json",
+          "application/x-ndjson",
+          "text/csv"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "usageManagement"
+        ],
+        "summary": "Ingestion of a batch of usage records as JSON, NDJSON or CSV",
+        "operationId": "ingestUsage",
+        "parameters": [
+          {
+            "description": "Usage records to be ingested",
+            "name": "records",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          {
+            "enum": [
+              "csv",
+              "json",
+              "ndjson"
+            ],
+            "type": "string",
+            "description": "Format of the batch, taken from the Content-Type if not provided",
+            "name": "format",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Process the batch in the background regardless of its size",
+            "name": "async",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Batch processed, with the result of each record not accepted",
+            "schema": {
+              "$ref": "#/definitions/IngestionJob"
+            }
+          },
+          "202": {
+            "description": "Batch accepted to be processed in the background",
+            "schema": {
+              "$ref": "#/definitions/IngestionJob"
+            }
+          },
+          "400": {
+            "description": "The batch couldn't be parsed or exceeds the max size allowed",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/usage/ingest/{id}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "usageManagement"
+        ],
+        "summary": "Status and results of an ingestion job",
+        "operationId": "getIngestionJob",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Id of the ingestion job",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/IngestionJob"
+            }
+          },
+          "404": {
+            "description": "The ingestion job doesn't exist in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/usage/{id}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "usageManagement"
+        ],
+        "summary": "Detailed report covering of the account associated with the id within the specified time window",
+        "operationId": "getUsage",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Id of the account to be checked",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime from which to get the usage report",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime until which to get the usage report",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Metric(s) to get the usage report",
+            "name": "metric",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/UReport"
+              }
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "CompactionWindow": {
+      "type": "object",
+      "properties": {
+        "CompactedAt": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+        },
+        "Dirty": {
+          "description": "Late usage landed in the window after its compaction",
+          "type": "boolean",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "Errors": {
+          "type": "integer"
+        },
+        "LateRecords": {
+          "type": "integer"
+        },
+        "Schedule": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "Status": {
+          "type": "string",
+          "enum": [
+            "COMPLETED",
+            "FAILED",
+            "RUNNING"
+          ]
+        },
+        "TimeFrom": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"primary_key;type:timestamptz\""
+        },
+        "TimeTo": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+        }
+      }
+    },
     "ErrorResponse": {
       "type": "object",
       "required": [
@@ -381,6 +927,87 @@ func init() {
         }
       }
     },
+    "IngestionJob": {
+      "type": "object",
+      "properties": {
+        "Accepted": {
+          "type": "integer"
+        },
+        "CreatedAt": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+        },
+        "Duplicated": {
+          "type": "integer"
+        },
+        "Error": {
+          "type": "string"
+        },
+        "FinishedAt": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+        },
+        "Format": {
+          "type": "string"
+        },
+        "ID": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"type:uuid;primary_key;unique;default:md5(random()::text || clock_timestamp()::text)::uuid\""
+        },
+        "Quarantined": {
+          "type": "integer"
+        },
+        "Rejected": {
+          "type": "integer"
+        },
+        "Results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/IngestionResult"
+          },
+          "x-go-custom-tag": "gorm:\"serializer:json\""
+        },
+        "Status": {
+          "type": "string",
+          "enum": [
+            "COMPLETED",
+            "FAILED",
+            "PENDING",
+            "RUNNING"
+          ]
+        },
+        "Total": {
+          "type": "integer"
+        }
+      }
+    },
+    "IngestionResult": {
+      "type": "object",
+      "properties": {
+        "Error": {
+          "type": "string"
+        },
+        "IdempotencyKey": {
+          "type": "string"
+        },
+        "Index": {
+          "description": "Position of the record in the batch, starting at 1",
+          "type": "integer"
+        },
+        "Status": {
+          "type": "string",
+          "enum": [
+            "ACCEPTED",
+            "DUPLICATED",
+            "QUARANTINED",
+            "REJECTED"
+          ]
+        }
+      }
+    },
     "Metadata": {
       "type": "object",
       "x-go-type": {
@@ -393,9 +1020,80 @@ func init() {
     "Metric": {
       "type": "object",
       "properties": {
+        "Aggregation": {
+          "description": "Function used to compact the samples, period being the average over the window",
+          "type": "string",
+          "enum": [
+            "avg",
+            "last",
+            "max",
+            "min",
+            "p95",
+            "period",
+            "step",
+            "sum",
+            "trapezoid"
+          ]
+        },
+        "Description": {
+          "type": "string"
+        },
+        "GapHold": {
+          "description": "Seconds without samples after which there is a gap for the step and trapezoid aggregations, the configured ones if 0",
+          "type": "integer"
+        },
+        "GapMode": {
+          "description": "Gaps hold the last value (hold) or hold it up to GapHold and count as zero afterwards (zero), the configured one if empty",
+          "type": "string",
+          "enum": [
+            "hold",
+            "zero"
+          ]
+        },
+        "MetadataKeys": {
+          "description": "Metadata keys allowed in the samples, any if empty",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"serializer:json\""
+        },
         "Metric": {
           "type": "string",
-          "x-go-custom-tag": "gorm:\"primary_key\""
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "RetentionDays": {
+          "description": "Days the raw samples are kept in the system, forever if 0",
+          "type": "integer"
+        },
+        "Unit": {
+          "description": "Canonical unit of the samples",
+          "type": "string"
+        }
+      }
+    },
+    "QuarantinedUsage": {
+      "type": "object",
+      "properties": {
+        "ID": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "Metric": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "Reason": {
+          "type": "string"
+        },
+        "ReceivedAt": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+        },
+        "Record": {
+          "x-go-custom-tag": "gorm:\"serializer:json\"",
+          "$ref": "#/definitions/Usage"
         }
       }
     },
@@ -526,6 +1224,11 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"index\""
         },
+        "IdempotencyKey": {
+          "description": "Deduplication key of the sample, derived from metric, account, resource and time when not provided",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index:idx_usage_idempotency,unique,priority:1,where:idempotency_key \u003c\u003e ''\""
+        },
         "Metadata": {
           "x-go-custom-tag": "gorm:\"type:jsonb\"",
           "$ref": "#/definitions/Metadata"
@@ -545,7 +1248,7 @@ func init() {
         "Timedate": {
           "type": "string",
           "format": "datetime",
-          "x-go-custom-tag": "gorm:\"index;type:timestamptz\""
+          "x-go-custom-tag": "gorm:\"index;index:idx_usage_idempotency,unique,priority:2;type:timestamptz\""
         },
         "Unit": {
           "type": "string"
@@ -557,6 +1260,27 @@ func init() {
           "x-go-custom-tag": "gorm:\"type:numeric(23,13);default:0.0\""
         }
       }
+    },
+    "Watermark": {
+      "type": "object",
+      "properties": {
+        "LateRecords": {
+          "type": "integer"
+        },
+        "Metric": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "Schedule": {
+          "type": "string"
+        },
+        "Watermark": {
+          "description": "End of the last window compacted for the metric",
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+        }
+      }
     }
   },
   "securityDefinitions": {
@@ -579,63 +1303,292 @@ func init() {
         "admin": "Admin scope",
         "user": "User scope"
       }
-    }
-  },
-  "security": [
-    {
-      "Keycloak": [
-        "user",
-        "admin"
-      ]
-    },
-    {
-      "APIKeyHeader": []
-    },
-    {
-      "APIKeyParam": []
-    }
-  ],
-  "tags": [
-    {
-      "description": "Actions relating to the reporting of the state of the service",
-      "name": "statusManagement"
-    },
-    {
-      "description": "Actions relating to the periodics actions to be triggered in the system",
-      "name": "triggerManagement"
-    },
-    {
-      "description": "Actions relating to the metrics used and controlled by the system",
-      "name": "metricsManagement"
-    },
-    {
-      "description": "Actions relating to the usage reporting of the accounts of the system",
-      "name": "usageManagement"
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "schemes": [
-    "http",
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "An API which supports creation, deletion, listing etc of UDR",
-    "title": "UDR Management API",
-    "contact": {
-      "email": "diego@cyclops-labs.io"
-    },
-    "license": {
-      "name": "Apache 2.0",
-      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+    }
+  },
+  "security": [
+    {
+      "Keycloak": [
+        "user",
+        "admin"
+      ]
+    },
+    {
+      "APIKeyHeader": []
+    },
+    {
+      "APIKeyParam": []
+    }
+  ],
+  "tags": [
+    {
+      "description": "Actions relating to the reporting of the state of the service",
+      "name": "statusManagement"
+    },
+    {
+      "description": "Actions relating to the periodics actions to be triggered in the system",
+      "name": "triggerManagement"
+    },
+    {
+      "description": "Actions relating to the metrics used and controlled by the system",
+      "name": "metricsManagement"
+    },
+    {
+      "description": "Actions relating to the usage reporting of the accounts of the system",
+      "name": "usageManagement"
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "An API which supports creation, deletion, listing etc of UDR",
+    "title": "UDR Management API",
+    "contact": {
+      "email": "diego@cyclops-labs.io"
+    },
+    "license": {
+      "name": "Apache 2.0",
+      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+    },
+    "version": "1.0.0"
+  },
+  "host": "localhost:8000",
+  "basePath": "/api/v1.0",
+  "paths": {
+    "/metrics": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "metricsManagement"
+        ],
+        "summary": "List of all metric types processed by the service",
+        "operationId": "getMetrics",
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Metric"
+              }
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "metricsManagement"
+        ],
+        "summary": "Registers the definition of a new metric in the system",
+        "operationId": "createMetric",
+        "parameters": [
+          {
+            "description": "Definition of the metric to be registered",
+            "name": "metric",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Metric"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Metric registered in the system",
+            "schema": {
+              "$ref": "#/definitions/Metric"
+            }
+          },
+          "400": {
+            "description": "The definition of the metric is not valid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The metric already exists in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/metrics/{id}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "metricsManagement"
+        ],
+        "summary": "Definition of the metric with the provided id",
+        "operationId": "getMetric",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Name of the metric",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "$ref": "#/definitions/Metric"
+            }
+          },
+          "404": {
+            "description": "The metric doesn't exist in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "metricsManagement"
+        ],
+        "summary": "Updates the definition of the metric with the provided id",
+        "operationId": "updateMetric",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Name of the metric",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Definition of the metric to be updated",
+            "name": "metric",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Metric"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Metric updated in the system",
+            "schema": {
+              "$ref": "#/definitions/Metric"
+            }
+          },
+          "400": {
+            "description": "The definition of the metric is not valid",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The metric doesn't exist in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
     },
-    "version": "1.0.0"
-  },
-  "host": "localhost:8000",
-  "basePath": "/api/v1.0",
-  "paths": {
-    "/metrics": {
+    "/quarantine": {
       "get": {
         "security": [
           {
@@ -656,15 +1609,23 @@ func init() {
         "tags": [
           "metricsManagement"
         ],
-        "summary": "List of all metric types processed by the service",
-        "operationId": "getMetrics",
+        "summary": "List of the usage records held back on ingestion for unknown or invalid metrics",
+        "operationId": "listQuarantine",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Metric to filter by",
+            "name": "metric",
+            "in": "query"
+          }
+        ],
         "responses": {
           "200": {
             "description": "Description of a successfully operation",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/Metric"
+                "$ref": "#/definitions/QuarantinedUsage"
               }
             }
           },
@@ -729,36 +1690,220 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "statusManagement"
+          "statusManagement"
+        ],
+        "summary": "Basic status of the system",
+        "operationId": "getStatus",
+        "parameters": [
+          {
+            "enum": [
+              "kafka-receiver",
+              "kafka-sender",
+              "status",
+              "trigger",
+              "metrics",
+              "usage"
+            ],
+            "type": "string",
+            "description": "Id of the endpoint to be checked",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Status information of the system",
+            "schema": {
+              "$ref": "#/definitions/Status"
+            }
+          },
+          "404": {
+            "description": "The endpoint provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/trigger/compact": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "triggerManagement"
+        ],
+        "summary": "Compactation task trigger",
+        "operationId": "execCompactation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime from which to get the usage report",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime until which to get the usage report",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Switch for using 15m boundaries instead of 8h",
+            "name": "fast_mode",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Compaction schedule to run, restricting the metrics compacted to the ones in it and using its windows when no period is provided",
+            "name": "schedule",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Compactation task executed successfully."
+          },
+          "400": {
+            "description": "The schedule provided doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/trigger/compactions": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "triggerManagement"
+        ],
+        "summary": "List of the windows already compacted by the schedules",
+        "operationId": "listCompactions",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Compaction schedule to filter by",
+            "name": "schedule",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime from which to get the compacted windows",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "datetime",
+            "description": "Datetime until which to get the compacted windows",
+            "name": "to",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Description of a successfully operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/CompactionWindow"
+              }
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/trigger/watermarks": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
+          },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "triggerManagement"
         ],
-        "summary": "Basic status of the system",
-        "operationId": "getStatus",
+        "summary": "List of the compaction watermarks of the metrics",
+        "operationId": "listWatermarks",
         "parameters": [
           {
-            "enum": [
-              "kafka-receiver",
-              "kafka-sender",
-              "status",
-              "trigger",
-              "metrics",
-              "usage"
-            ],
             "type": "string",
-            "description": "Id of the endpoint to be checked",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "description": "Metric to filter by",
+            "name": "metric",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Status information of the system",
+            "description": "Description of a successfully operation",
             "schema": {
-              "$ref": "#/definitions/Status"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Watermark"
+              }
             }
           },
-          "404": {
-            "description": "The endpoint provided doesn't exist",
+          "500": {
+            "description": "Something unexpected happend, error raised",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -766,7 +1911,7 @@ func init() {
         }
       }
     },
-    "/trigger/compact": {
+    "/usage": {
       "get": {
         "security": [
           {
@@ -785,10 +1930,10 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "triggerManagement"
+          "usageManagement"
         ],
-        "summary": "Compactation task trigger",
-        "operationId": "execCompactation",
+        "summary": "Detailed report covering all accounts within the specified time window",
+        "operationId": "getSystemUsage",
         "parameters": [
           {
             "type": "string",
@@ -805,15 +1950,27 @@ func init() {
             "in": "query"
           },
           {
-            "type": "boolean",
-            "description": "Switch for using 15m boundaries instead of 8h",
-            "name": "fast_mode",
+            "type": "string",
+            "description": "Metric(s) to get the usage report",
+            "name": "metric",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "List of ids to be queried",
+            "name": "idlist",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Compactation task executed successfully."
+            "description": "Description of a successfully operation",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/UReport"
+              }
+            }
           },
           "500": {
             "description": "Something unexpected happend, error raised",
@@ -824,8 +1981,8 @@ func init() {
         }
       }
     },
-    "/usage": {
-      "get": {
+    "/usage/ingest": {
+      "post": {
         "security": [
           {
             "Keycloak": [
@@ -839,50 +1996,120 @@ func init() {
             "APIKeyParam": []
           }
         ],
+        "consumes": [
+          "application/json",
+          "application/x-ndjson",
+          "text/csv"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
           "usageManagement"
         ],
-        "summary": "Detailed report covering all accounts within the specified time window",
-        "operationId": "getSystemUsage",
+        "summary": "Ingestion of a batch of usage records as JSON, NDJSON or CSV",
+        "operationId": "ingestUsage",
         "parameters": [
           {
-            "type": "string",
-            "format": "datetime",
-            "description": "Datetime from which to get the usage report",
-            "name": "from",
-            "in": "query"
+            "description": "Usage records to be ingested",
+            "name": "records",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
           },
           {
+            "enum": [
+              "csv",
+              "json",
+              "ndjson"
+            ],
             "type": "string",
-            "format": "datetime",
-            "description": "Datetime until which to get the usage report",
-            "name": "to",
+            "description": "Format of the batch, taken from the Content-Type if not provided",
+            "name": "format",
             "in": "query"
           },
           {
-            "type": "string",
-            "description": "Metric(s) to get the usage report",
-            "name": "metric",
+            "type": "boolean",
+            "description": "Process the batch in the background regardless of its size",
+            "name": "async",
             "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Batch processed, with the result of each record not accepted",
+            "schema": {
+              "$ref": "#/definitions/IngestionJob"
+            }
+          },
+          "202": {
+            "description": "Batch accepted to be processed in the background",
+            "schema": {
+              "$ref": "#/definitions/IngestionJob"
+            }
+          },
+          "400": {
+            "description": "The batch couldn't be parsed or exceeds the max size allowed",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Something unexpected happend, error raised",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/usage/ingest/{id}": {
+      "get": {
+        "security": [
+          {
+            "Keycloak": [
+              "user"
+            ]
+          },
+          {
+            "APIKeyHeader": []
           },
+          {
+            "APIKeyParam": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "usageManagement"
+        ],
+        "summary": "Status and results of an ingestion job",
+        "operationId": "getIngestionJob",
+        "parameters": [
           {
             "type": "string",
-            "description": "List of ids to be queried",
-            "name": "idlist",
-            "in": "query"
+            "format": "uuid",
+            "description": "Id of the ingestion job",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Description of a successfully operation",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/UReport"
-              }
+              "$ref": "#/definitions/IngestionJob"
+            }
+          },
+          "404": {
+            "description": "The ingestion job doesn't exist in the system",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "500": {
@@ -967,6 +2194,49 @@ func init() {
     }
   },
   "definitions": {
+    "CompactionWindow": {
+      "type": "object",
+      "properties": {
+        "CompactedAt": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+        },
+        "Dirty": {
+          "description": "Late usage landed in the window after its compaction",
+          "type": "boolean",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "Errors": {
+          "type": "integer"
+        },
+        "LateRecords": {
+          "type": "integer"
+        },
+        "Schedule": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "Status": {
+          "type": "string",
+          "enum": [
+            "COMPLETED",
+            "FAILED",
+            "RUNNING"
+          ]
+        },
+        "TimeFrom": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"primary_key;type:timestamptz\""
+        },
+        "TimeTo": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+        }
+      }
+    },
     "ErrorResponse": {
       "type": "object",
       "required": [
@@ -978,6 +2248,87 @@ func init() {
         }
       }
     },
+    "IngestionJob": {
+      "type": "object",
+      "properties": {
+        "Accepted": {
+          "type": "integer"
+        },
+        "CreatedAt": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+        },
+        "Duplicated": {
+          "type": "integer"
+        },
+        "Error": {
+          "type": "string"
+        },
+        "FinishedAt": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+        },
+        "Format": {
+          "type": "string"
+        },
+        "ID": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"type:uuid;primary_key;unique;default:md5(random()::text || clock_timestamp()::text)::uuid\""
+        },
+        "Quarantined": {
+          "type": "integer"
+        },
+        "Rejected": {
+          "type": "integer"
+        },
+        "Results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/IngestionResult"
+          },
+          "x-go-custom-tag": "gorm:\"serializer:json\""
+        },
+        "Status": {
+          "type": "string",
+          "enum": [
+            "COMPLETED",
+            "FAILED",
+            "PENDING",
+            "RUNNING"
+          ]
+        },
+        "Total": {
+          "type": "integer"
+        }
+      }
+    },
+    "IngestionResult": {
+      "type": "object",
+      "properties": {
+        "Error": {
+          "type": "string"
+        },
+        "IdempotencyKey": {
+          "type": "string"
+        },
+        "Index": {
+          "description": "Position of the record in the batch, starting at 1",
+          "type": "integer"
+        },
+        "Status": {
+          "type": "string",
+          "enum": [
+            "ACCEPTED",
+            "DUPLICATED",
+            "QUARANTINED",
+            "REJECTED"
+          ]
+        }
+      }
+    },
     "Metadata": {
       "type": "object",
       "x-go-type": {
@@ -990,9 +2341,80 @@ func init() {
     "Metric": {
       "type": "object",
       "properties": {
+        "Aggregation": {
+          "description": "Function used to compact the samples, period being the average over the window",
+          "type": "string",
+          "enum": [
+            "avg",
+            "last",
+            "max",
+            "min",
+            "p95",
+            "period",
+            "step",
+            "sum",
+            "trapezoid"
+          ]
+        },
+        "Description": {
+          "type": "string"
+        },
+        "GapHold": {
+          "description": "Seconds without samples after which there is a gap for the step and trapezoid aggregations, the configured ones if 0",
+          "type": "integer"
+        },
+        "GapMode": {
+          "description": "Gaps hold the last value (hold) or hold it up to GapHold and count as zero afterwards (zero), the configured one if empty",
+          "type": "string",
+          "enum": [
+            "hold",
+            "zero"
+          ]
+        },
+        "MetadataKeys": {
+          "description": "Metadata keys allowed in the samples, any if empty",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"serializer:json\""
+        },
         "Metric": {
           "type": "string",
           "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "RetentionDays": {
+          "description": "Days the raw samples are kept in the system, forever if 0",
+          "type": "integer"
+        },
+        "Unit": {
+          "description": "Canonical unit of the samples",
+          "type": "string"
+        }
+      }
+    },
+    "QuarantinedUsage": {
+      "type": "object",
+      "properties": {
+        "ID": {
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "Metric": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "Reason": {
+          "type": "string"
+        },
+        "ReceivedAt": {
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+        },
+        "Record": {
+          "x-go-custom-tag": "gorm:\"serializer:json\"",
+          "$ref": "#/definitions/Usage"
         }
       }
     },
@@ -1123,6 +2545,11 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"index\""
         },
+        "IdempotencyKey": {
+          "description": "Deduplication key of the sample, derived from metric, account, resource and time when not provided",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index:idx_usage_idempotency,unique,priority:1,where:idempotency_key \u003c\u003e ''\""
+        },
         "Metadata": {
           "x-go-custom-tag": "gorm:\"type:jsonb\"",
           "$ref": "#/definitions/Metadata"
@@ -1142,7 +2569,7 @@ func init() {
         "Timedate": {
           "type": "string",
           "format": "datetime",
-          "x-go-custom-tag": "gorm:\"index;type:timestamptz\""
+          "x-go-custom-tag": "gorm:\"index;index:idx_usage_idempotency,unique,priority:2;type:timestamptz\""
         },
         "Unit": {
           "type": "string"
@@ -1154,6 +2581,27 @@ func init() {
           "x-go-custom-tag": "gorm:\"type:numeric(23,13);default:0.0\""
         }
       }
+    },
+    "Watermark": {
+      "type": "object",
+      "properties": {
+        "LateRecords": {
+          "type": "integer"
+        },
+        "Metric": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "Schedule": {
+          "type": "string"
+        },
+        "Watermark": {
+          "description": "End of the last window compacted for the metric",
+          "type": "string",
+          "format": "datetime",
+          "x-go-custom-tag": "gorm:\"type:timestamptz\""
+        }
+      }
     }
   },
   "securityDefinitions": {
@@ -40,6 +40,10 @@ type ExecCompactationParams struct {
 	  In: query
 	*/
 	From *strfmt.DateTime
+	/*Compaction schedule to run, restricting the metrics compacted to the ones in it and using its windows when no period is provided
+	  In: query
+	*/
+	Schedule *string
 	/*Datetime until which to get the usage report
 	  In: query
 	*/
@@ -67,6 +71,11 @@ func (o *ExecCompactationParams) BindRequest(r *http.Request, route *middleware.
 		res = append(res, err)
 	}
 
+	qSchedule, qhkSchedule, _ := qs.GetOK("schedule")
+	if err := o.bindSchedule(qSchedule, qhkSchedule, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qTo, qhkTo, _ := qs.GetOK("to")
 	if err := o.bindTo(qTo, qhkTo, route.Formats); err != nil {
 		res = append(res, err)
@@ -136,6 +145,24 @@ func (o *ExecCompactationParams) validateFrom(formats strfmt.Registry) error {
 	return nil
 }
 
+// bindSchedule binds and validates parameter Schedule from query.
+func (o *ExecCompactationParams) bindSchedule(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Schedule = &raw
+
+	return nil
+}
+
 // bindTo binds and validates parameter To from query.
 func (o *ExecCompactationParams) bindTo(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
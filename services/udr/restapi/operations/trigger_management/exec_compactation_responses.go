@@ -37,6 +37,50 @@ func (o *ExecCompactationOK) WriteResponse(rw http.ResponseWriter, producer runt
 	rw.WriteHeader(200)
 }
 
+// ExecCompactationBadRequestCode is the HTTP code returned for type ExecCompactationBadRequest
+const ExecCompactationBadRequestCode int = 400
+
+/*ExecCompactationBadRequest The schedule provided doesn't exist
+
+swagger:response execCompactationBadRequest
+*/
+type ExecCompactationBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewExecCompactationBadRequest creates ExecCompactationBadRequest with default headers values
+func NewExecCompactationBadRequest() *ExecCompactationBadRequest {
+
+	return &ExecCompactationBadRequest{}
+}
+
+// WithPayload adds the payload to the exec compactation bad request response
+func (o *ExecCompactationBadRequest) WithPayload(payload *models.ErrorResponse) *ExecCompactationBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the exec compactation bad request response
+func (o *ExecCompactationBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ExecCompactationBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ExecCompactationInternalServerErrorCode is the HTTP code returned for type ExecCompactationInternalServerError
 const ExecCompactationInternalServerErrorCode int = 500
 
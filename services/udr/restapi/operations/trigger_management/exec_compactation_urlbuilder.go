@@ -18,6 +18,7 @@ import (
 type ExecCompactationURL struct {
 	FastMode *bool
 	From     *strfmt.DateTime
+	Schedule *string
 	To       *strfmt.DateTime
 
 	_basePath string
@@ -70,6 +71,14 @@ func (o *ExecCompactationURL) Build() (*url.URL, error) {
 		qs.Set("from", fromQ)
 	}
 
+	var scheduleQ string
+	if o.Schedule != nil {
+		scheduleQ = *o.Schedule
+	}
+	if scheduleQ != "" {
+		qs.Set("schedule", scheduleQ)
+	}
+
 	var toQ string
 	if o.To != nil {
 		toQ = o.To.String()
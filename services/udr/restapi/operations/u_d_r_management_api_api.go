@@ -43,13 +43,23 @@ func NewUDRManagementAPIAPI(spec *loads.Document) *UDRManagementAPIAPI {
 		APIKeyAuthenticator: security.APIKeyAuth,
 		BearerAuthenticator: security.BearerAuth,
 
+		CsvConsumer:  runtime.CSVConsumer(),
 		JSONConsumer: runtime.JSONConsumer(),
 
 		JSONProducer: runtime.JSONProducer(),
 
+		MetricsManagementCreateMetricHandler: metrics_management.CreateMetricHandlerFunc(func(params metrics_management.CreateMetricParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation metrics_management.CreateMetric has not yet been implemented")
+		}),
 		TriggerManagementExecCompactationHandler: trigger_management.ExecCompactationHandlerFunc(func(params trigger_management.ExecCompactationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation trigger_management.ExecCompactation has not yet been implemented")
 		}),
+		UsageManagementGetIngestionJobHandler: usage_management.GetIngestionJobHandlerFunc(func(params usage_management.GetIngestionJobParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation usage_management.GetIngestionJob has not yet been implemented")
+		}),
+		MetricsManagementGetMetricHandler: metrics_management.GetMetricHandlerFunc(func(params metrics_management.GetMetricParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation metrics_management.GetMetric has not yet been implemented")
+		}),
 		MetricsManagementGetMetricsHandler: metrics_management.GetMetricsHandlerFunc(func(params metrics_management.GetMetricsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation metrics_management.GetMetrics has not yet been implemented")
 		}),
@@ -62,9 +72,24 @@ func NewUDRManagementAPIAPI(spec *loads.Document) *UDRManagementAPIAPI {
 		UsageManagementGetUsageHandler: usage_management.GetUsageHandlerFunc(func(params usage_management.GetUsageParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation usage_management.GetUsage has not yet been implemented")
 		}),
+		UsageManagementIngestUsageHandler: usage_management.IngestUsageHandlerFunc(func(params usage_management.IngestUsageParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation usage_management.IngestUsage has not yet been implemented")
+		}),
+		TriggerManagementListCompactionsHandler: trigger_management.ListCompactionsHandlerFunc(func(params trigger_management.ListCompactionsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation trigger_management.ListCompactions has not yet been implemented")
+		}),
+		MetricsManagementListQuarantineHandler: metrics_management.ListQuarantineHandlerFunc(func(params metrics_management.ListQuarantineParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation metrics_management.ListQuarantine has not yet been implemented")
+		}),
+		TriggerManagementListWatermarksHandler: trigger_management.ListWatermarksHandlerFunc(func(params trigger_management.ListWatermarksParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation trigger_management.ListWatermarks has not yet been implemented")
+		}),
 		StatusManagementShowStatusHandler: status_management.ShowStatusHandlerFunc(func(params status_management.ShowStatusParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation status_management.ShowStatus has not yet been implemented")
 		}),
+		MetricsManagementUpdateMetricHandler: metrics_management.UpdateMetricHandlerFunc(func(params metrics_management.UpdateMetricParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation metrics_management.UpdateMetric has not yet been implemented")
+		}),
 
 		// Applies when the "X-API-KEY" header is set
 		APIKeyHeaderAuth: func(token string) (interface{}, error) {
@@ -105,8 +130,12 @@ type UDRManagementAPIAPI struct {
 	// It has a default implementation in the security package, however you can replace it for your particular usage.
 	BearerAuthenticator func(string, security.ScopedTokenAuthentication) runtime.Authenticator
 
+	// CsvConsumer registers a consumer for the following mime types:
+	//   - text/csv
+	CsvConsumer runtime.Consumer
 	// JSONConsumer registers a consumer for the following mime types:
 	//   - application/json
+	//   - application/x-ndjson
 	JSONConsumer runtime.Consumer
 
 	// JSONProducer registers a producer for the following mime types:
@@ -128,8 +157,14 @@ type UDRManagementAPIAPI struct {
 	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
 	APIAuthorizer runtime.Authorizer
 
+	// MetricsManagementCreateMetricHandler sets the operation handler for the create metric operation
+	MetricsManagementCreateMetricHandler metrics_management.CreateMetricHandler
 	// TriggerManagementExecCompactationHandler sets the operation handler for the exec compactation operation
 	TriggerManagementExecCompactationHandler trigger_management.ExecCompactationHandler
+	// UsageManagementGetIngestionJobHandler sets the operation handler for the get ingestion job operation
+	UsageManagementGetIngestionJobHandler usage_management.GetIngestionJobHandler
+	// MetricsManagementGetMetricHandler sets the operation handler for the get metric operation
+	MetricsManagementGetMetricHandler metrics_management.GetMetricHandler
 	// MetricsManagementGetMetricsHandler sets the operation handler for the get metrics operation
 	MetricsManagementGetMetricsHandler metrics_management.GetMetricsHandler
 	// StatusManagementGetStatusHandler sets the operation handler for the get status operation
@@ -138,8 +173,18 @@ type UDRManagementAPIAPI struct {
 	UsageManagementGetSystemUsageHandler usage_management.GetSystemUsageHandler
 	// UsageManagementGetUsageHandler sets the operation handler for the get usage operation
 	UsageManagementGetUsageHandler usage_management.GetUsageHandler
+	// UsageManagementIngestUsageHandler sets the operation handler for the ingest usage operation
+	UsageManagementIngestUsageHandler usage_management.IngestUsageHandler
+	// TriggerManagementListCompactionsHandler sets the operation handler for the list compactions operation
+	TriggerManagementListCompactionsHandler trigger_management.ListCompactionsHandler
+	// MetricsManagementListQuarantineHandler sets the operation handler for the list quarantine operation
+	MetricsManagementListQuarantineHandler metrics_management.ListQuarantineHandler
+	// TriggerManagementListWatermarksHandler sets the operation handler for the list watermarks operation
+	TriggerManagementListWatermarksHandler trigger_management.ListWatermarksHandler
 	// StatusManagementShowStatusHandler sets the operation handler for the show status operation
 	StatusManagementShowStatusHandler status_management.ShowStatusHandler
+	// MetricsManagementUpdateMetricHandler sets the operation handler for the update metric operation
+	MetricsManagementUpdateMetricHandler metrics_management.UpdateMetricHandler
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
 	ServeError func(http.ResponseWriter, *http.Request, error)
@@ -208,6 +253,9 @@ func (o *UDRManagementAPIAPI) RegisterFormat(name string, format strfmt.Format,
 func (o *UDRManagementAPIAPI) Validate() error {
 	var unregistered []string
 
+	if o.CsvConsumer == nil {
+		unregistered = append(unregistered, "CsvConsumer")
+	}
 	if o.JSONConsumer == nil {
 		unregistered = append(unregistered, "JSONConsumer")
 	}
@@ -226,9 +274,18 @@ func (o *UDRManagementAPIAPI) Validate() error {
 		unregistered = append(unregistered, "KeycloakAuth")
 	}
 
+	if o.MetricsManagementCreateMetricHandler == nil {
+		unregistered = append(unregistered, "metrics_management.CreateMetricHandler")
+	}
 	if o.TriggerManagementExecCompactationHandler == nil {
 		unregistered = append(unregistered, "trigger_management.ExecCompactationHandler")
 	}
+	if o.UsageManagementGetIngestionJobHandler == nil {
+		unregistered = append(unregistered, "usage_management.GetIngestionJobHandler")
+	}
+	if o.MetricsManagementGetMetricHandler == nil {
+		unregistered = append(unregistered, "metrics_management.GetMetricHandler")
+	}
 	if o.MetricsManagementGetMetricsHandler == nil {
 		unregistered = append(unregistered, "metrics_management.GetMetricsHandler")
 	}
@@ -241,9 +298,24 @@ func (o *UDRManagementAPIAPI) Validate() error {
 	if o.UsageManagementGetUsageHandler == nil {
 		unregistered = append(unregistered, "usage_management.GetUsageHandler")
 	}
+	if o.UsageManagementIngestUsageHandler == nil {
+		unregistered = append(unregistered, "usage_management.IngestUsageHandler")
+	}
+	if o.TriggerManagementListCompactionsHandler == nil {
+		unregistered = append(unregistered, "trigger_management.ListCompactionsHandler")
+	}
+	if o.MetricsManagementListQuarantineHandler == nil {
+		unregistered = append(unregistered, "metrics_management.ListQuarantineHandler")
+	}
+	if o.TriggerManagementListWatermarksHandler == nil {
+		unregistered = append(unregistered, "trigger_management.ListWatermarksHandler")
+	}
 	if o.StatusManagementShowStatusHandler == nil {
 		unregistered = append(unregistered, "status_management.ShowStatusHandler")
 	}
+	if o.MetricsManagementUpdateMetricHandler == nil {
+		unregistered = append(unregistered, "metrics_management.UpdateMetricHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -291,6 +363,10 @@ func (o *UDRManagementAPIAPI) ConsumersFor(mediaTypes []string) map[string]runti
 		switch mt {
 		case "application/json":
 			result["application/json"] = o.JSONConsumer
+		case "application/x-ndjson":
+			result["application/x-ndjson"] = o.JSONConsumer
+		case "text/csv":
+			result["text/csv"] = o.CsvConsumer
 		}
 
 		if c, ok := o.customConsumers[mt]; ok {
@@ -348,6 +424,10 @@ func (o *UDRManagementAPIAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/metrics"] = metrics_management.NewCreateMetric(o.context, o.MetricsManagementCreateMetricHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -355,6 +435,14 @@ func (o *UDRManagementAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/usage/ingest/{id}"] = usage_management.NewGetIngestionJob(o.context, o.UsageManagementGetIngestionJobHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/metrics/{id}"] = metrics_management.NewGetMetric(o.context, o.MetricsManagementGetMetricHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/metrics"] = metrics_management.NewGetMetrics(o.context, o.MetricsManagementGetMetricsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -368,10 +456,30 @@ func (o *UDRManagementAPIAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/usage/{id}"] = usage_management.NewGetUsage(o.context, o.UsageManagementGetUsageHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/usage/ingest"] = usage_management.NewIngestUsage(o.context, o.UsageManagementIngestUsageHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/trigger/compactions"] = trigger_management.NewListCompactions(o.context, o.TriggerManagementListCompactionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/quarantine"] = metrics_management.NewListQuarantine(o.context, o.MetricsManagementListQuarantineHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/trigger/watermarks"] = trigger_management.NewListWatermarks(o.context, o.TriggerManagementListWatermarksHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/status"] = status_management.NewShowStatus(o.context, o.StatusManagementShowStatusHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/metrics/{id}"] = metrics_management.NewUpdateMetric(o.context, o.MetricsManagementUpdateMetricHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -8,8 +8,8 @@ import (
 	l "gitlab.com/cyclops-utilities/logging"
 )
 
-// The following structs: apikey, dbConfig, eventsConfig, generalConfig,
-// kafkaConfig, and keycloakConfig are part of the configuration struct which
+// The following structs: apikey, compactionConfig, dbConfig, eventsConfig,
+// generalConfig, ingestionConfig, kafkaConfig, keycloakConfig, and timescaleConfig are part of the configuration struct which
 // acts as the main reference for configuration parameters in the system.
 type apiKey struct {
 	Enabled bool `json:"enabled"`
@@ -18,15 +18,34 @@ type apiKey struct {
 	Token   string `json:"token"`
 }
 
+type compactionConfig struct {
+	CheckInterval    string
+	Default          string
+	Delay            string
+	GapHold          string
+	GapMode          string
+	Gaps             map[string]string
+	Integration      map[string]string
+	LatenessHorizon  string
+	MaxCatchUp       int
+	Metrics          map[string]string
+	SchedulerEnabled bool
+	Schedules        map[string]string
+	Timezone         string
+}
+
 type configuration struct {
 	APIKey       apiKey
+	Compaction   compactionConfig
 	DB           dbConfig
 	Events       eventsConfig
 	General      generalConfig
+	Ingestion    ingestionConfig
 	Kafka        kafkaConfig
 	Keycloak     keycloakConfig `json:"keycloak"`
 	DefaultPlans map[string]string
 	Prometheus   prometheusConfig
+	Timescale    timescaleConfig
 }
 
 type dbConfig struct {
@@ -59,6 +78,13 @@ type generalConfig struct {
 	Services           map[string]string
 }
 
+type ingestionConfig struct {
+	AsyncThreshold int
+	InvalidRecords string
+	MaxBatchSize   int
+	UnknownMetrics string
+}
+
 type kafkaConfig struct {
 	Brokers    []string
 	MaxBytes   int
@@ -91,6 +117,14 @@ type prometheusConfig struct {
 	MetricsRoute  string
 }
 
+type timescaleConfig struct {
+	ChunkInterval string
+	Compression   map[string]string
+	Enabled       bool
+	Retention     map[string]string
+	Rollups       bool
+}
+
 // dumpConfig 's job is to dumps the configuration in JSON format to the log
 // system. It makes use of the masking function to keep some secrecy in the log.
 // Parameters:
@@ -156,6 +190,22 @@ func parseConfig() (c configuration) {
 			Token:   viper.GetString("apikey.token"),
 		},
 
+		Compaction: compactionConfig{
+			CheckInterval:    viper.GetString("compaction.checkinterval"),
+			Default:          viper.GetString("compaction.default"),
+			Delay:            viper.GetString("compaction.delay"),
+			GapHold:          viper.GetString("compaction.gaphold"),
+			GapMode:          viper.GetString("compaction.gapmode"),
+			Gaps:             viper.GetStringMapString("compaction.gaps"),
+			Integration:      viper.GetStringMapString("compaction.integration"),
+			LatenessHorizon:  viper.GetString("compaction.latenesshorizon"),
+			MaxCatchUp:       viper.GetInt("compaction.maxcatchup"),
+			Metrics:          viper.GetStringMapString("compaction.metrics"),
+			SchedulerEnabled: viper.GetBool("compaction.schedulerenabled"),
+			Schedules:        viper.GetStringMapString("compaction.schedules"),
+			Timezone:         viper.GetString("compaction.timezone"),
+		},
+
 		DB: dbConfig{
 			CacheRetention: viper.GetString("database.cacheretention"),
 			DbName:         viper.GetString("database.dbname"),
@@ -186,6 +236,13 @@ func parseConfig() (c configuration) {
 			Services:           viper.GetStringMapString("general.services"),
 		},
 
+		Ingestion: ingestionConfig{
+			AsyncThreshold: viper.GetInt("ingestion.asyncthreshold"),
+			InvalidRecords: viper.GetString("ingestion.invalidrecords"),
+			MaxBatchSize:   viper.GetInt("ingestion.maxbatchsize"),
+			UnknownMetrics: viper.GetString("ingestion.unknownmetrics"),
+		},
+
 		Kafka: kafkaConfig{
 			Brokers:    viper.GetStringSlice("kafka.brokers"),
 			MaxBytes:   viper.GetInt("kafka.sizemax"),
@@ -216,6 +273,14 @@ func parseConfig() (c configuration) {
 			MetricsPort:   viper.GetString("prometheus.metricsport"),
 			MetricsRoute:  viper.GetString("prometheus.metricsroute"),
 		},
+
+		Timescale: timescaleConfig{
+			ChunkInterval: viper.GetString("timescale.chunkinterval"),
+			Compression:   viper.GetStringMapString("timescale.compression"),
+			Enabled:       viper.GetBool("timescale.enabled"),
+			Retention:     viper.GetStringMapString("timescale.retention"),
+			Rollups:       viper.GetBool("timescale.rollups"),
+		},
 	}
 
 	return
@@ -1,18 +1,22 @@
 package dbManager
 
 import (
+	"crypto/sha256"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/prometheus/client_golang/prometheus"
+	"gitlab.com/cyclops-utilities/datamodels"
 	"github.com/GoDieNow/TFT_Code/services/udr/models"
 	"github.com/GoDieNow/TFT_Code/services/udr/server/cacheManager"
 	l "gitlab.com/cyclops-utilities/logging"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 const (
@@ -22,17 +26,109 @@ const (
 	statusOK
 )
 
+var (
+	// ErrMetricDuplicated is raised when registering a metric already in the
+	// system.
+	ErrMetricDuplicated = errors.New("metric already exists in the system")
+
+	// ErrMetricInvalid is raised when a metric definition or a usage sample
+	// is not valid.
+	ErrMetricInvalid = errors.New("invalid metric")
+
+	// ErrMetricUnknown is raised when a usage sample refers to a metric not
+	// registered in the system.
+	ErrMetricUnknown = errors.New("unknown metric")
+
+	// ErrUsageDuplicated is raised when a usage sample with the same
+	// idempotency key was already ingested.
+	ErrUsageDuplicated = errors.New("usage sample already ingested")
+
+	// ErrUsageInvalid is raised when a usage sample lacks the metric, the
+	// account or the time it refers to.
+	ErrUsageInvalid = errors.New("invalid usage sample")
+
+	// ErrUsageQuarantined is raised when a usage sample is held back in
+	// quarantine instead of being ingested.
+	ErrUsageQuarantined = errors.New("usage sample quarantined")
+)
+
+// IngestionConfig is the struct defined to group the policies applied to the
+// usage samples received that can't be ingested or arrive late.
+// On it there is the following parameters:
+// - Async: int with the number of records from which the batches received
+// through the API are processed in the background.
+// - Horizon: time.Duration after which late usage doesn't trigger the
+// re-compaction of its window anymore, 0 for no limit.
+// - Invalid: string with the policy for samples not matching the definition
+// of their metric: reject or quarantine.
+// - MaxSize: int64 with the max number of bytes of the batches received
+// through the API.
+// - Unknown: string with the policy for samples of unknown metrics: register,
+// reject or quarantine.
+type IngestionConfig struct {
+	Async   int
+	Horizon time.Duration
+	Invalid string
+	MaxSize int64
+	Unknown string
+}
+
+// UsageAggregate is the struct defined to hold the aggregation of the usage
+// samples of a resource within a window, as computed by the database.
+// On it there is the following parameters:
+// - Account, ResourceID, ResourceName, Unit and Metadata: the matching-key of
+// the samples aggregated.
+// - Count: float64 with the number of samples.
+// - Last: float64 with the usage of the latest sample.
+// - Max, Min and Sum: float64 with the max, min and sum of the usage.
+// - P95: float64 with the nearest-rank 95th percentile of the usage.
+type UsageAggregate struct {
+	Account      string
+	Count        float64
+	Last         float64
+	Max          float64
+	Metadata     datamodels.JSONdb
+	Min          float64
+	P95          float64
+	ResourceID   string
+	ResourceName string
+	Sum          float64
+	Unit         string
+}
+
+// TimescaleConfig is the struct defined to group the parameters of the
+// optional TimescaleDB support.
+// On it there is the following parameters:
+// - ChunkInterval: time.Duration covered by each chunk of the hypertables.
+// - Compression: map with the table and the age from which its chunks are
+// compressed.
+// - Enabled: bool to use TimescaleDB when it's available in the database.
+// - Retention: map with the table and the age from which its chunks are
+// dropped.
+// - Rollups: bool to keep the hourly rollups of the raw usage.
+type TimescaleConfig struct {
+	ChunkInterval time.Duration
+	Compression   map[string]time.Duration
+	Enabled       bool
+	Retention     map[string]time.Duration
+	Rollups       bool
+}
+
 // DbParameter is the struct defined to group and contain all the methods
 // that interact with the database.
 // On it there is the following parameters:
 // - Cache: CacheManager pointer for the cache mechanism.
 // - connStr: strings with the connection information to the database
 // - Db: a gorm.DB pointer to the db to invoke all the db methods
+// - Ingestion: IngestionConfig with the policies for the samples received.
+// - Timescale: bool reporting if the TimescaleDB support is active.
 type DbParameter struct {
-	Cache   *cacheManager.CacheManager
-	connStr string
-	Db      *gorm.DB
-	Metrics map[string]*prometheus.GaugeVec
+	Cache     *cacheManager.CacheManager
+	connStr   string
+	Db        *gorm.DB
+	Ingestion IngestionConfig
+	Metrics   map[string]*prometheus.GaugeVec
+	Timescale bool
 }
 
 // New is the function to create the struct DbParameter.
@@ -68,12 +164,65 @@ func New(dbConn string, tables ...interface{}) *DbParameter {
 	//Database migration, it handles everything
 	dp.Db.AutoMigrate(tables...)
 
-	l.Trace.Printf("[DB] Generating hypertables.\n")
+	// Hypertables are created by EnableTimescale when TimescaleDB is available
+	return &dp
 
-	// Hypertables creation for timescaledb in case of needed
-	dp.Db.Exec("SELECT create_hypertable('" + dp.Db.NamingStrategy.TableName("Usage") + "', 'timedate');")
+}
 
-	return &dp
+// AddCompaction job is to keep track of a window compacted by one of the
+// compaction schedules, replacing any previous run of the same window.
+// Parameters:
+// - c: a CompactionWindow with the result of the compaction.
+// Returns:
+// - e: error raised in case of problems.
+func (d *DbParameter) AddCompaction(c models.CompactionWindow) (e error) {
+
+	l.Trace.Printf("[DB] Attempting to save the compaction of schedule [ %v ] for window [ %v ] - [ %v ].\n", c.Schedule, c.TimeFrom, c.TimeTo)
+
+	// The late usage tracking of the window is kept between runs
+	conflict := clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: d.Db.NamingStrategy.ColumnName("", "Schedule")},
+			{Name: d.Db.NamingStrategy.ColumnName("", "TimeFrom")},
+		},
+		DoUpdates: clause.AssignmentColumns([]string{
+			d.Db.NamingStrategy.ColumnName("", "CompactedAt"),
+			d.Db.NamingStrategy.ColumnName("", "Errors"),
+			d.Db.NamingStrategy.ColumnName("", "Status"),
+			d.Db.NamingStrategy.ColumnName("", "TimeTo"),
+		}),
+	}
+
+	if e = d.Db.Clauses(conflict).Create(&c).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while saving the compaction of schedule [ %v ]. Error: %v\n", c.Schedule, e)
+
+		return
+
+	}
+
+	d.Metrics["count"].With(prometheus.Labels{"type": "Compaction windows " + strings.ToLower(c.Status)}).Inc()
+
+	return
+
+}
+
+// AddIngestionJob job is to register a new batch ingestion job in the system.
+// Parameters:
+// - job: reference to the IngestionJob to be saved, its ID is filled.
+// Returns:
+// - e: error raised in case of problems.
+func (d *DbParameter) AddIngestionJob(job *models.IngestionJob) (e error) {
+
+	l.Trace.Printf("[DB] Attempting to register a new ingestion job with [ %v ] records.\n", job.Total)
+
+	if e = d.Db.Create(job).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while registering the ingestion job. Error: %v\n", e)
+
+	}
+
+	return
 
 }
 
@@ -178,6 +327,39 @@ func (d *DbParameter) AddRecord(report models.UDRRecord) (e error) {
 
 }
 
+// AddRecords job is to add a batch of compacted records in the system with a
+// single insert, the previous records of the window are expected to be already
+// cleaned up.
+// Parameters:
+// - reports: slice of UDRRecords containing the Usage compacted.
+// Returns:
+// - e: error in case of failure in the task.
+func (d *DbParameter) AddRecords(reports []*models.UDRRecord) (e error) {
+
+	l.Trace.Printf("[DB] Attempting to add a batch of [ %v ] compacted UDR Records in the system.\n", len(reports))
+
+	if len(reports) == 0 {
+
+		return
+
+	}
+
+	if e = d.Db.Create(&reports).Error; e != nil {
+
+		l.Trace.Printf("[DB] Something went wrong when adding the batch of UDR Records to the system. Error: %v\n", e)
+
+		return
+
+	}
+
+	d.Metrics["count"].With(prometheus.Labels{"type": "UDR Records added"}).Add(float64(len(reports)))
+
+	l.Trace.Printf("[DB] Batch of UDR Records added in the system.\n")
+
+	return
+
+}
+
 // AddReport job is to add a new report of compacted usage to the system.
 // Parameters:
 // - report: a Report containing the data to be added to the system.
@@ -211,6 +393,254 @@ func (d *DbParameter) AddReport(report models.UReport) (e error) {
 
 }
 
+// AggregateRecords job is to aggregate in the database the non-compacted usage
+// records of the metric within the time-window by resource, streaming the
+// aggregates to the provided function so only one of them is held in memory
+// at a time.
+// Parameters:
+// - metric: a string with the metric of the records.
+// - from: a datatime reference for the initial border of the time-window.
+// - to: a datatime reference for the final border of the time-window.
+// - f: function invoked with every aggregate, sorted by account.
+// Returns:
+// - e: error raised in case of problems with the query or by the function.
+func (d *DbParameter) AggregateRecords(metric string, from, to strfmt.DateTime, f func(*UsageAggregate) error) (e error) {
+
+	l.Trace.Printf("[DB] Attempting to aggregate the non-compacted usage records of metric [ %v ].\n", metric)
+
+	key := "account, resource_id, resource_name, unit, metadata"
+
+	query := "SELECT " + key + ", count(*)::float8 AS count, sum(usage)::float8 AS sum, " +
+		"min(usage)::float8 AS min, max(usage)::float8 AS max, " +
+		"((array_agg(usage ORDER BY \"time\" DESC))[1])::float8 AS last, " +
+		"(percentile_disc(0.95) WITHIN GROUP (ORDER BY usage))::float8 AS p95 " +
+		"FROM " + d.Db.NamingStrategy.TableName("Usage") +
+		" WHERE " + d.GetInterval("timedate", from, to) + " AND resource_type = ?" +
+		" GROUP BY " + key + " ORDER BY account"
+
+	rows, e := d.Db.Raw(query, metric).Rows()
+
+	if e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while aggregating the non-compacted usage records. Error: %v\n", e)
+
+		return
+
+	}
+
+	defer rows.Close()
+
+	var count int
+
+	for rows.Next() {
+
+		var a UsageAggregate
+
+		if e = d.Db.ScanRows(rows, &a); e != nil {
+
+			l.Warning.Printf("[DB] Something went wrong while reading the aggregated usage records. Error: %v\n", e)
+
+			return
+
+		}
+
+		if e = f(&a); e != nil {
+
+			return
+
+		}
+
+		count++
+
+	}
+
+	if e = rows.Err(); e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while reading the aggregated usage records. Error: %v\n", e)
+
+		return
+
+	}
+
+	l.Debug.Printf("[DB] [ %v ] aggregated usage records of metric [ %v ] retrieved from the system.\n", count, metric)
+
+	return
+
+}
+
+// CreateMetric job is to register the definition of a new metric in the
+// system.
+// Parameters:
+// - m: reference to the Metric to be registered.
+// Returns:
+// - reference to the Metric registered.
+// - error raised in case of problems, ErrMetricDuplicated if it already exists.
+func (d *DbParameter) CreateMetric(m *models.Metric) (*models.Metric, error) {
+
+	l.Trace.Printf("[DB] Attempting to register the metric [ %v ] in the system.\n", m.Metric)
+
+	if m.Metric == "" {
+
+		return nil, fmt.Errorf("%w: the name of the metric is missing", ErrMetricInvalid)
+
+	}
+
+	if e := d.Db.Where(&models.Metric{Metric: m.Metric}).First(&models.Metric{}).Error; !errors.Is(e, gorm.ErrRecordNotFound) {
+
+		if e != nil {
+
+			return nil, e
+
+		}
+
+		l.Trace.Printf("[DB] The metric [ %v ] already exists in the system.\n", m.Metric)
+
+		return nil, ErrMetricDuplicated
+
+	}
+
+	if e := d.Db.Create(m).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while registering the metric [ %v ]. Error: %v\n", m.Metric, e)
+
+		return nil, e
+
+	}
+
+	d.Metrics["count"].With(prometheus.Labels{"type": "Usage metrics added"}).Inc()
+
+	return m, nil
+
+}
+
+// EnableTimescale job is to turn the time-series tables into TimescaleDB
+// hypertables, with their compression and retention policies and the hourly
+// rollups of the raw usage, as long as the extension is available in the
+// database. Otherwise the system keeps working on plain PostgreSQL.
+// Parameters:
+// - c: TimescaleConfig with the configuration of the support.
+func (d *DbParameter) EnableTimescale(c TimescaleConfig) {
+
+	if !c.Enabled {
+
+		return
+
+	}
+
+	l.Trace.Printf("[DB] Checking the TimescaleDB capabilities of the database.\n")
+
+	if e := d.Db.Exec("CREATE EXTENSION IF NOT EXISTS timescaledb").Error; e != nil {
+
+		l.Warning.Printf("[DB] TimescaleDB is not available, running on plain PostgreSQL. Error: %v\n", e)
+
+		return
+
+	}
+
+	var version string
+
+	if e := d.Db.Raw("SELECT extversion FROM pg_extension WHERE extname = 'timescaledb'").Scan(&version).Error; e != nil || version == "" {
+
+		l.Warning.Printf("[DB] TimescaleDB is not available, running on plain PostgreSQL. Error: %v\n", e)
+
+		return
+
+	}
+
+	l.Info.Printf("[DB] TimescaleDB [ %v ] detected, generating hypertables.\n", version)
+
+	// Table, time column and columns to segment the compressed chunks by
+	hypertables := [][3]string{
+		{d.Db.NamingStrategy.TableName("Usage"), "Timedate", "ResourceType, Account"},
+		{d.Db.NamingStrategy.TableName("UDRRecord"), "TimeFrom", "ResourceType, AccountID"},
+	}
+
+	for _, h := range hypertables {
+
+		table, column := h[0], d.Db.NamingStrategy.ColumnName("", h[1])
+
+		var segments []string
+
+		for _, s := range strings.Split(h[2], ",") {
+
+			segments = append(segments, d.Db.NamingStrategy.ColumnName("", strings.TrimSpace(s)))
+
+		}
+
+		if e := d.Db.Exec("SELECT create_hypertable(?::regclass, ?, chunk_time_interval => make_interval(secs => ?), if_not_exists => TRUE, migrate_data => TRUE)", table, column, c.ChunkInterval.Seconds()).Error; e != nil {
+
+			l.Warning.Printf("[DB] The hypertable for [ %v ] couldn't be generated. Error: %v\n", table, e)
+
+			continue
+
+		}
+
+		if after, exists := c.Compression[table]; exists && after > 0 {
+
+			if e := d.Db.Exec(fmt.Sprintf("ALTER TABLE %v SET (timescaledb.compress, timescaledb.compress_segmentby = '%v')", table, strings.Join(segments, ", "))).Error; e != nil {
+
+				l.Warning.Printf("[DB] The compression of [ %v ] couldn't be enabled. Error: %v\n", table, e)
+
+			} else if e := d.Db.Exec("SELECT add_compression_policy(?::regclass, make_interval(secs => ?), if_not_exists => TRUE)", table, after.Seconds()).Error; e != nil {
+
+				l.Warning.Printf("[DB] The compression policy of [ %v ] couldn't be added. Error: %v\n", table, e)
+
+			}
+
+		}
+
+		if after, exists := c.Retention[table]; exists && after > 0 {
+
+			if e := d.Db.Exec("SELECT add_retention_policy(?::regclass, make_interval(secs => ?), if_not_exists => TRUE)", table, after.Seconds()).Error; e != nil {
+
+				l.Warning.Printf("[DB] The retention policy of [ %v ] couldn't be added. Error: %v\n", table, e)
+
+			}
+
+		}
+
+	}
+
+	d.Timescale = true
+
+	if !c.Rollups {
+
+		return
+
+	}
+
+	col := func(name string) string {
+
+		return d.Db.NamingStrategy.ColumnName("", name)
+
+	}
+
+	view := "usage_hourly"
+
+	rollup := fmt.Sprintf("CREATE MATERIALIZED VIEW IF NOT EXISTS %v WITH (timescaledb.continuous) AS "+
+		"SELECT time_bucket(INTERVAL '1 hour', %v) AS bucket, %v, %v, %v, %v, "+
+		"avg(%v) AS avg_usage, max(%v) AS max_usage, min(%v) AS min_usage, sum(%v) AS sum_usage, count(*) AS samples "+
+		"FROM %v GROUP BY bucket, %v, %v, %v, %v WITH NO DATA",
+		view, col("Timedate"), col("Account"), col("ResourceType"), col("ResourceID"), col("Unit"),
+		col("Usage"), col("Usage"), col("Usage"), col("Usage"),
+		d.Db.NamingStrategy.TableName("Usage"), col("Account"), col("ResourceType"), col("ResourceID"), col("Unit"))
+
+	if e := d.Db.Exec(rollup).Error; e != nil {
+
+		l.Warning.Printf("[DB] The hourly rollups of the usage couldn't be generated. Error: %v\n", e)
+
+		return
+
+	}
+
+	if e := d.Db.Exec("SELECT add_continuous_aggregate_policy(?::regclass, start_offset => INTERVAL '3 days', end_offset => INTERVAL '1 hour', schedule_interval => INTERVAL '1 hour', if_not_exists => TRUE)", view).Error; e != nil {
+
+		l.Warning.Printf("[DB] The refresh policy of the hourly rollups couldn't be added. Error: %v\n", e)
+
+	}
+
+}
+
 // GetAccounts job is to retrieve a list of the accounts in the system.
 // Returns:
 // - Slice of strings containing the accounts in the system with usage data.
@@ -280,6 +710,36 @@ func (d *DbParameter) GetUDRAccounts() []string {
 
 }
 
+// GetIngestionJob job is to retrieve the provided ingestion job.
+// Parameters:
+// - id: string with the id of the job.
+// Returns:
+// - reference to the IngestionJob, nil if it doesn't exist.
+// - error raised in case of problems.
+func (d *DbParameter) GetIngestionJob(id string) (*models.IngestionJob, error) {
+
+	l.Trace.Printf("[DB] Attempting to retrieve the ingestion job [ %v ].\n", id)
+
+	var job models.IngestionJob
+
+	if e := d.Db.Where(&models.IngestionJob{ID: strfmt.UUID(id)}).First(&job).Error; e != nil {
+
+		if errors.Is(e, gorm.ErrRecordNotFound) {
+
+			return nil, nil
+
+		}
+
+		l.Warning.Printf("[DB] Something went wrong while retrieving the ingestion job [ %v ]. Error: %v\n", id, e)
+
+		return nil, e
+
+	}
+
+	return &job, nil
+
+}
+
 // GetInterval job is to provide a proper query string to use in GORM to indicate
 // a range of time.
 // Parameters:
@@ -298,43 +758,106 @@ func (d *DbParameter) GetInterval(field string, from strfmt.DateTime, to strfmt.
 
 }
 
-// GetMetrics job is to retrieve the list of metrics registered in the system.
+// GetLastCompaction job is to retrieve the latest window tracked for the
+// provided schedule, whatever its status.
+// Parameters:
+// - schedule: string with the name of the compaction schedule.
 // Returns:
-// - a slice of references with the metrics in the system.
-// - error in case of duplication or failure in the task.
-func (d *DbParameter) GetMetrics() ([]*models.Metric, error) {
+// - reference to the CompactionWindow, nil if the schedule never ran.
+// - error raised in case of problems.
+func (d *DbParameter) GetLastCompaction(schedule string) (*models.CompactionWindow, error) {
 
-	l.Trace.Printf("[DB] Attempting to retrieve the metrics in the system.\n")
+	l.Trace.Printf("[DB] Attempting to retrieve the last compaction of schedule [ %v ].\n", schedule)
 
-	var m []*models.Metric
-	var e error
+	var c models.CompactionWindow
 
-	if e = d.Db.Debug().Find(&m).Error; e == nil {
+	q := d.Db.Where(&models.CompactionWindow{Schedule: schedule})
 
-		l.Debug.Printf("[DB] [%v] metrics retrieved from the system.\n", len(m))
+	if e := q.Order(d.Db.NamingStrategy.ColumnName("", "TimeFrom") + " DESC").First(&c).Error; e != nil {
 
-	} else {
+		if errors.Is(e, gorm.ErrRecordNotFound) {
 
-		l.Warning.Printf("[DB] Something went wrong while retrieving metrics from the system. Error: %v\n", e)
+			return nil, nil
+
+		}
+
+		l.Warning.Printf("[DB] Something went wrong while retrieving the last compaction of schedule [ %v ]. Error: %v\n", schedule, e)
+
+		return nil, e
 
 	}
 
-	return m, e
+	return &c, nil
 
 }
 
-// GetRecords job is to retrieve the non-compacted usage records from the system
-// in the requested time-window with the posibility of filter by metric.
+// GetMetric job is to retrieve the definition of the provided metric.
 // Parameters:
-// - metric: a string with the metric to filter the records.
-// - from: a datatime reference for the initial border of the time-window.
-// - to: a datatime reference for the final border of the time-window.
+// - metric: string with the name of the metric.
 // Returns:
-// - a slice of references with the usage contained in the system within the
-// requested time-window.
-func (d *DbParameter) GetRecords(metric string, from strfmt.DateTime, to strfmt.DateTime) []*models.Usage {
+// - reference to the Metric, nil if it doesn't exist.
+// - error raised in case of problems.
+func (d *DbParameter) GetMetric(metric string) (*models.Metric, error) {
 
-	l.Trace.Printf("[DB] Attempting to retrieve the non-compacted usage records.\n")
+	l.Trace.Printf("[DB] Attempting to retrieve the metric [ %v ].\n", metric)
+
+	var m models.Metric
+
+	if e := d.Db.Where(&models.Metric{Metric: metric}).First(&m).Error; e != nil {
+
+		if errors.Is(e, gorm.ErrRecordNotFound) {
+
+			return nil, nil
+
+		}
+
+		l.Warning.Printf("[DB] Something went wrong while retrieving the metric [ %v ]. Error: %v\n", metric, e)
+
+		return nil, e
+
+	}
+
+	return &m, nil
+
+}
+
+// GetMetrics job is to retrieve the list of metrics registered in the system.
+// Returns:
+// - a slice of references with the metrics in the system.
+// - error in case of duplication or failure in the task.
+func (d *DbParameter) GetMetrics() ([]*models.Metric, error) {
+
+	l.Trace.Printf("[DB] Attempting to retrieve the metrics in the system.\n")
+
+	var m []*models.Metric
+	var e error
+
+	if e = d.Db.Debug().Find(&m).Error; e == nil {
+
+		l.Debug.Printf("[DB] [%v] metrics retrieved from the system.\n", len(m))
+
+	} else {
+
+		l.Warning.Printf("[DB] Something went wrong while retrieving metrics from the system. Error: %v\n", e)
+
+	}
+
+	return m, e
+
+}
+
+// GetRecords job is to retrieve the non-compacted usage records from the system
+// in the requested time-window with the posibility of filter by metric.
+// Parameters:
+// - metric: a string with the metric to filter the records.
+// - from: a datatime reference for the initial border of the time-window.
+// - to: a datatime reference for the final border of the time-window.
+// Returns:
+// - a slice of references with the usage contained in the system within the
+// requested time-window.
+func (d *DbParameter) GetRecords(metric string, from strfmt.DateTime, to strfmt.DateTime) []*models.Usage {
+
+	l.Trace.Printf("[DB] Attempting to retrieve the non-compacted usage records.\n")
 
 	var u []*models.Usage
 
@@ -354,6 +877,42 @@ func (d *DbParameter) GetRecords(metric string, from strfmt.DateTime, to strfmt.
 
 }
 
+// GetSamples job is to retrieve the non-compacted usage records of the
+// resource aggregated within the time-window, sorted by time, for the
+// compactions needing every sample.
+// Parameters:
+// - metric: a string with the metric of the records.
+// - a: reference to the UsageAggregate with the matching-key of the resource.
+// - from: a datatime reference for the initial border of the time-window.
+// - to: a datatime reference for the final border of the time-window.
+// Returns:
+// - u: slice of references with the usage samples of the resource.
+// - e: error raised in case of problems.
+func (d *DbParameter) GetSamples(metric string, a *UsageAggregate, from, to strfmt.DateTime) (u []*models.Usage, e error) {
+
+	l.Trace.Printf("[DB] Attempting to retrieve the usage samples of resource [ %v ].\n", a.ResourceID)
+
+	// A map is used so the empty fields are also part of the matching-key
+	key := map[string]interface{}{
+		"account":       a.Account,
+		"resource_id":   a.ResourceID,
+		"resource_name": a.ResourceName,
+		"resource_type": metric,
+		"unit":          a.Unit,
+	}
+
+	q := d.Db.Where(d.GetInterval("timedate", from, to)).Where(key).Where("metadata = ?", a.Metadata)
+
+	if e = q.Order("\"time\"").Find(&u).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while retrieving the usage samples of the resource. Error: %v\n", e)
+
+	}
+
+	return
+
+}
+
 // GetReport job is to retrieve the compacted usage records from the system for
 // the provided account in the requested time-window with the posibility of
 // filter by metric.
@@ -400,6 +959,152 @@ func (d *DbParameter) GetReport(id, metric string, from, to strfmt.DateTime) []*
 
 }
 
+// IngestUsage job is to save a usage sample received in the system once it's
+// checked against the definition of its metric. Samples of unknown metrics or
+// not matching their definition are rejected or quarantined as configured.
+// The samples are deduplicated by their idempotency key, assigned in case of
+// not carrying one, so retries and redeliveries are ingested only once.
+// Parameters:
+// - u: reference to the Usage received.
+// Returns:
+// - e: error raised in case of problems or rejection, ErrUsageDuplicated in
+// case of being already ingested and ErrUsageQuarantined in case of being held
+// back.
+func (d *DbParameter) IngestUsage(u *models.Usage) (e error) {
+
+	l.Trace.Printf("[DB] Attempting to ingest a usage sample of metric [ %v ].\n", u.ResourceType)
+
+	// The time of the samples is kept as epoch, with the datetime derived
+	switch {
+
+	case u.ResourceType == "" || u.Account == "":
+
+		e = fmt.Errorf("%w: the metric or the account is missing", ErrUsageInvalid)
+
+	case u.Time != 0:
+
+		u.Timedate = strfmt.DateTime(time.Unix(u.Time, 0))
+
+	case !(time.Time)(u.Timedate).IsZero():
+
+		u.Time = (time.Time)(u.Timedate).Unix()
+
+	default:
+
+		e = fmt.Errorf("%w: the time is missing", ErrUsageInvalid)
+
+	}
+
+	if e != nil {
+
+		l.Warning.Printf("[DB] Usage sample of metric [ %v ] rejected. Reason: %v\n", u.ResourceType, e)
+
+		d.Metrics["count"].With(prometheus.Labels{"type": "Usage samples rejected"}).Inc()
+
+		return
+
+	}
+
+	if u.IdempotencyKey == "" {
+
+		u.IdempotencyKey = d.getUsageKey(u)
+
+	}
+
+	metric, e := d.GetMetric(u.ResourceType)
+
+	if e != nil {
+
+		return
+
+	}
+
+	policy := d.Ingestion.Invalid
+
+	if metric == nil {
+
+		e = fmt.Errorf("%w: [ %v ]", ErrMetricUnknown, u.ResourceType)
+		policy = d.Ingestion.Unknown
+
+	} else {
+
+		e = d.checkUsage(metric, u)
+
+	}
+
+	if e != nil {
+
+		switch policy {
+
+		case "register":
+
+			if e = d.AddMetric(u.ResourceType); e != nil {
+
+				return
+
+			}
+
+		case "quarantine":
+
+			l.Warning.Printf("[DB] Usage sample of metric [ %v ] quarantined. Reason: %v\n", u.ResourceType, e)
+
+			if e = d.quarantineUsage(u, e.Error()); e == nil {
+
+				e = ErrUsageQuarantined
+
+			}
+
+			return
+
+		default:
+
+			l.Warning.Printf("[DB] Usage sample of metric [ %v ] rejected. Reason: %v\n", u.ResourceType, e)
+
+			d.Metrics["count"].With(prometheus.Labels{"type": "Usage samples rejected"}).Inc()
+
+			return
+
+		}
+
+	}
+
+	key := d.Db.NamingStrategy.ColumnName("", "IdempotencyKey")
+
+	// The conflict target has to match the partial unique index
+	conflict := clause.OnConflict{
+		Columns:     []clause.Column{{Name: key}, {Name: d.Db.NamingStrategy.ColumnName("", "Timedate")}},
+		TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: key + " <> ''"}}},
+		DoNothing:   true,
+	}
+
+	r := d.Db.Clauses(conflict).Create(u)
+
+	if e = r.Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while saving the usage sample. Error: %v\n", e)
+
+		return
+
+	}
+
+	if r.RowsAffected == 0 {
+
+		l.Debug.Printf("[DB] Usage sample with key [ %v ] already in the system, skipping it.\n", u.IdempotencyKey)
+
+		d.Metrics["count"].With(prometheus.Labels{"type": "Usage samples duplicated"}).Inc()
+
+		return ErrUsageDuplicated
+
+	}
+
+	d.Metrics["count"].With(prometheus.Labels{"type": "Usage samples ingested"}).Inc()
+
+	d.checkLateness(u)
+
+	return
+
+}
+
 // GetUsage job is to retrieve the usage report of the system or the specified
 // account within the requested time-window with the posibility to filter by
 // metric.
@@ -502,6 +1207,580 @@ func (d *DbParameter) GetUsages(ids, metric string, from, to strfmt.DateTime) ([
 
 }
 
+// ListCompactions job is to retrieve the windows compacted by the schedules
+// with the posibility of filtering by schedule and time-window.
+// Parameters:
+// - schedule: string with the name of the compaction schedule.
+// - from: a datatime reference for the initial border of the time-window.
+// - to: a datatime reference for the final border of the time-window.
+// Returns:
+// - a slice of references with the compacted windows.
+// - error raised in case of problems.
+func (d *DbParameter) ListCompactions(schedule string, from, to strfmt.DateTime) ([]*models.CompactionWindow, error) {
+
+	l.Trace.Printf("[DB] Attempting to list the compacted windows in the system.\n")
+
+	var c []*models.CompactionWindow
+
+	q := d.Db.Where(&models.CompactionWindow{Schedule: schedule})
+
+	if window := d.getWindow(from, to); window != "" {
+
+		q = q.Where(window)
+
+	}
+
+	if e := q.Order(d.Db.NamingStrategy.ColumnName("", "TimeFrom") + " DESC").Find(&c).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while listing the compacted windows. Error: %v\n", e)
+
+		return nil, e
+
+	}
+
+	l.Debug.Printf("[DB] [ %v ] compacted windows retrieved from the system.\n", len(c))
+
+	return c, nil
+
+}
+
+// ListPendingCompactions job is to retrieve the windows of the provided
+// schedule whose compaction didn't complete, either because it failed or
+// because it was interrupted while running.
+// Parameters:
+// - schedule: string with the name of the compaction schedule.
+// - since: time from which the beginning of the windows has to be, zero for all.
+// Returns:
+// - a slice of references with the pending windows, oldest first.
+// - error raised in case of problems.
+func (d *DbParameter) ListPendingCompactions(schedule string, since time.Time) ([]*models.CompactionWindow, error) {
+
+	l.Trace.Printf("[DB] Attempting to list the pending compactions of schedule [ %v ].\n", schedule)
+
+	var c []*models.CompactionWindow
+
+	q := d.Db.Where(&models.CompactionWindow{Schedule: schedule}).Where(d.Db.NamingStrategy.ColumnName("", "Status")+" <> ?", models.CompactionWindowStatusCOMPLETED)
+
+	if !since.IsZero() {
+
+		q = q.Where(d.Db.NamingStrategy.ColumnName("", "TimeFrom")+" >= ?", since)
+
+	}
+
+	if e := q.Order(d.Db.NamingStrategy.ColumnName("", "TimeFrom") + " ASC").Find(&c).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while listing the pending compactions of schedule [ %v ]. Error: %v\n", schedule, e)
+
+		return nil, e
+
+	}
+
+	return c, nil
+
+}
+
+// ListDirtyCompactions job is to retrieve the compacted windows where late
+// usage landed after their compaction.
+// Parameters:
+// - since: time from which the end of the windows has to be, zero for all.
+// Returns:
+// - a slice of references with the dirty windows.
+// - error raised in case of problems.
+func (d *DbParameter) ListDirtyCompactions(since time.Time) ([]*models.CompactionWindow, error) {
+
+	l.Trace.Printf("[DB] Attempting to list the dirty compacted windows in the system.\n")
+
+	var c []*models.CompactionWindow
+
+	q := d.Db.Where(d.Db.NamingStrategy.ColumnName("", "Dirty")+" = ?", true)
+
+	if !since.IsZero() {
+
+		q = q.Where(d.Db.NamingStrategy.ColumnName("", "TimeTo")+" >= ?", since)
+
+	}
+
+	if e := q.Order(d.Db.NamingStrategy.ColumnName("", "TimeFrom") + " ASC").Find(&c).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while listing the dirty compacted windows. Error: %v\n", e)
+
+		return nil, e
+
+	}
+
+	return c, nil
+
+}
+
+// ListQuarantine job is to retrieve the usage samples held back on ingestion
+// with the posibility of filtering by metric.
+// Parameters:
+// - metric: string with the metric to filter by.
+// Returns:
+// - a slice of references with the quarantined samples.
+// - error raised in case of problems.
+func (d *DbParameter) ListQuarantine(metric string) ([]*models.QuarantinedUsage, error) {
+
+	l.Trace.Printf("[DB] Attempting to list the quarantined usage samples.\n")
+
+	var q []*models.QuarantinedUsage
+
+	if e := d.Db.Where(&models.QuarantinedUsage{Metric: metric}).Order(d.Db.NamingStrategy.ColumnName("", "ReceivedAt") + " DESC").Find(&q).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while listing the quarantined usage samples. Error: %v\n", e)
+
+		return nil, e
+
+	}
+
+	return q, nil
+
+}
+
+// ListWatermarks job is to retrieve the compaction watermarks of the metrics
+// with the posibility of filtering by metric.
+// Parameters:
+// - metric: string with the metric to filter by.
+// Returns:
+// - a slice of references with the watermarks.
+// - error raised in case of problems.
+func (d *DbParameter) ListWatermarks(metric string) ([]*models.Watermark, error) {
+
+	l.Trace.Printf("[DB] Attempting to list the compaction watermarks.\n")
+
+	var w []*models.Watermark
+
+	if e := d.Db.Where(&models.Watermark{Metric: metric}).Order(d.Db.NamingStrategy.ColumnName("", "Metric")).Find(&w).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while listing the compaction watermarks. Error: %v\n", e)
+
+		return nil, e
+
+	}
+
+	return w, nil
+
+}
+
+// MarkDirty job is to flag as dirty the compacted windows of the schedule
+// overlapping the provided time-window, so they get re-compacted.
+// Parameters:
+// - schedule: string with the name of the compaction schedule.
+// - from: time with the beginning of the time-window.
+// - to: time with the end of the time-window.
+// Returns:
+// - n: int64 with the number of windows flagged.
+// - e: error raised in case of problems.
+func (d *DbParameter) MarkDirty(schedule string, from, to time.Time) (n int64, e error) {
+
+	l.Trace.Printf("[DB] Attempting to flag as dirty the windows of schedule [ %v ] within [ %v ] - [ %v ].\n", schedule, from, to)
+
+	r := d.Db.Model(&models.CompactionWindow{}).Where(&models.CompactionWindow{Schedule: schedule}).
+		Where(fmt.Sprintf("%v < ? AND %v > ?", d.Db.NamingStrategy.ColumnName("", "TimeFrom"), d.Db.NamingStrategy.ColumnName("", "TimeTo")), to, from).
+		Update(d.Db.NamingStrategy.ColumnName("", "Dirty"), true)
+
+	if e = r.Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while flagging as dirty the windows of schedule [ %v ]. Error: %v\n", schedule, e)
+
+		return
+
+	}
+
+	n = r.RowsAffected
+
+	return
+
+}
+
+// PurgeUsage job is to remove the raw usage samples older than the retention
+// set in the definition of their metrics.
+// Returns:
+// - e: error raised in case of problems.
+func (d *DbParameter) PurgeUsage() (e error) {
+
+	l.Trace.Printf("[DB] Attempting to purge the usage samples out of retention.\n")
+
+	metrics, e := d.GetMetrics()
+
+	if e != nil {
+
+		return
+
+	}
+
+	for _, m := range metrics {
+
+		if m.RetentionDays <= 0 {
+
+			continue
+
+		}
+
+		limit := time.Now().AddDate(0, 0, -int(m.RetentionDays))
+
+		r := d.Db.Where(&models.Usage{ResourceType: m.Metric}).Where(fmt.Sprintf("%v < ?", d.Db.NamingStrategy.ColumnName("", "Timedate")), limit).Delete(&models.Usage{})
+
+		if e = r.Error; e != nil {
+
+			l.Warning.Printf("[DB] Something went wrong while purging the samples of metric [ %v ]. Error: %v\n", m.Metric, e)
+
+			return
+
+		}
+
+		if r.RowsAffected > 0 {
+
+			l.Debug.Printf("[DB] [ %v ] samples of metric [ %v ] purged from the system.\n", r.RowsAffected, m.Metric)
+
+		}
+
+	}
+
+	return
+
+}
+
+// RunIngestionJob job is to ingest the records of a batch through the same
+// path as the ones received from Kafka, keeping in the job the result of each
+// of them not accepted.
+// Parameters:
+// - job: reference to the IngestionJob, with the results of the records that
+// couldn't be parsed already in it.
+// - records: slice with the records of the batch, nil for the ones that
+// couldn't be parsed.
+func (d *DbParameter) RunIngestionJob(job *models.IngestionJob, records []*models.Usage) {
+
+	l.Trace.Printf("[DB] Attempting to run the ingestion job [ %v ].\n", job.ID)
+
+	var failed int
+
+	now := time.Now()
+
+	job.Status = models.IngestionJobStatusRUNNING
+
+	if e := d.Db.Save(job).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while updating the ingestion job [ %v ]. Error: %v\n", job.ID, e)
+
+	}
+
+	for i := range records {
+
+		if records[i] == nil {
+
+			continue
+
+		}
+
+		r := models.IngestionResult{
+			Index:  int64(i + 1),
+			Status: models.IngestionResultStatusACCEPTED,
+		}
+
+		e := d.IngestUsage(records[i])
+
+		r.IdempotencyKey = records[i].IdempotencyKey
+
+		switch {
+
+		case e == nil:
+
+			job.Accepted++
+
+		case errors.Is(e, ErrUsageDuplicated):
+
+			r.Status = models.IngestionResultStatusDUPLICATED
+			job.Duplicated++
+
+		case errors.Is(e, ErrUsageQuarantined):
+
+			r.Status = models.IngestionResultStatusQUARANTINED
+			job.Quarantined++
+
+		default:
+
+			if !errors.Is(e, ErrUsageInvalid) && !errors.Is(e, ErrMetricInvalid) && !errors.Is(e, ErrMetricUnknown) {
+
+				failed++
+
+			}
+
+			r.Status = models.IngestionResultStatusREJECTED
+			r.Error = e.Error()
+			job.Rejected++
+
+		}
+
+		if r.Status != models.IngestionResultStatusACCEPTED {
+
+			job.Results = append(job.Results, &r)
+
+		}
+
+	}
+
+	sort.Slice(job.Results, func(i, j int) bool { return job.Results[i].Index < job.Results[j].Index })
+
+	job.FinishedAt = strfmt.DateTime(time.Now())
+	job.Status = models.IngestionJobStatusCOMPLETED
+
+	if failed > 0 {
+
+		job.Error = fmt.Sprintf("%v records couldn't be ingested due to internal errors", failed)
+		job.Status = models.IngestionJobStatusFAILED
+
+	}
+
+	if e := d.Db.Save(job).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while saving the results of the ingestion job [ %v ]. Error: %v\n", job.ID, e)
+
+	}
+
+	d.Metrics["count"].With(prometheus.Labels{"type": "Ingestion jobs " + strings.ToLower(job.Status)}).Inc()
+
+	d.Metrics["time"].With(prometheus.Labels{"type": "Ingestion job time"}).Set(float64(time.Now().UnixNano()-now.UnixNano()) / float64(time.Millisecond))
+
+	l.Debug.Printf("[DB] Ingestion job [ %v ] finished: [ %v ] accepted, [ %v ] duplicated, [ %v ] quarantined, [ %v ] rejected.\n", job.ID, job.Accepted, job.Duplicated, job.Quarantined, job.Rejected)
+
+}
+
+// StartCompaction job is to flag the window as being compacted by the
+// schedule, clearing its dirty mark and its late records, and to move the
+// watermark of the compacted metrics up to the end of the window, so the usage
+// arriving from now on for it is detected as late.
+// Parameters:
+// - schedule: string with the schedule compacting.
+// - from: a datatime reference for the initial border of the window.
+// - to: a datatime reference for the final border of the window.
+// - metrics: slice with the metrics compacted in the window.
+// Returns:
+// - e: error raised in case of problems.
+func (d *DbParameter) StartCompaction(schedule string, from, to strfmt.DateTime, metrics []string) (e error) {
+
+	l.Trace.Printf("[DB] Attempting to start the compaction of schedule [ %v ] for window [ %v ] - [ %v ].\n", schedule, from, to)
+
+	c := models.CompactionWindow{
+		Schedule: schedule,
+		Status:   models.CompactionWindowStatusRUNNING,
+		TimeFrom: from,
+		TimeTo:   to,
+	}
+
+	conflict := clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: d.Db.NamingStrategy.ColumnName("", "Schedule")},
+			{Name: d.Db.NamingStrategy.ColumnName("", "TimeFrom")},
+		},
+		DoUpdates: clause.AssignmentColumns([]string{
+			d.Db.NamingStrategy.ColumnName("", "Dirty"),
+			d.Db.NamingStrategy.ColumnName("", "LateRecords"),
+			d.Db.NamingStrategy.ColumnName("", "Status"),
+		}),
+	}
+
+	if e = d.Db.Clauses(conflict).Create(&c).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while starting the compaction of schedule [ %v ]. Error: %v\n", schedule, e)
+
+		return
+
+	}
+
+	table := d.Db.NamingStrategy.TableName("Watermark")
+	column := d.Db.NamingStrategy.ColumnName("", "Watermark")
+
+	for _, metric := range metrics {
+
+		w := models.Watermark{
+			Metric:    metric,
+			Schedule:  schedule,
+			Watermark: to,
+		}
+
+		conflict := clause.OnConflict{
+			Columns: []clause.Column{{Name: d.Db.NamingStrategy.ColumnName("", "Metric")}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				d.Db.NamingStrategy.ColumnName("", "Schedule"): schedule,
+				column: gorm.Expr(fmt.Sprintf("GREATEST(%v.%v, EXCLUDED.%v)", table, column, column)),
+			}),
+		}
+
+		if e = d.Db.Clauses(conflict).Create(&w).Error; e != nil {
+
+			l.Warning.Printf("[DB] Something went wrong while moving the watermark of metric [ %v ]. Error: %v\n", metric, e)
+
+			return
+
+		}
+
+	}
+
+	return
+
+}
+
+// UpdateMetric job is to update the definition of the provided metric.
+// Parameters:
+// - metric: string with the name of the metric.
+// - m: reference to the Metric with the new definition.
+// Returns:
+// - reference to the Metric updated, nil if it doesn't exist.
+// - error raised in case of problems.
+func (d *DbParameter) UpdateMetric(metric string, m *models.Metric) (*models.Metric, error) {
+
+	l.Trace.Printf("[DB] Attempting to update the metric [ %v ].\n", metric)
+
+	if previous, e := d.GetMetric(metric); previous == nil {
+
+		return nil, e
+
+	}
+
+	m.Metric = metric
+
+	if e := d.Db.Save(m).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while updating the metric [ %v ]. Error: %v\n", metric, e)
+
+		return nil, e
+
+	}
+
+	return m, nil
+
+}
+
+// checkLateness job is to detect if the usage sample landed in a window
+// already compacted, marking it as dirty to be re-compacted as long as it's
+// within the lateness horizon.
+// Parameters:
+// - u: reference to the Usage saved.
+func (d *DbParameter) checkLateness(u *models.Usage) {
+
+	var w models.Watermark
+
+	if e := d.Db.Where(&models.Watermark{Metric: u.ResourceType}).First(&w).Error; e != nil {
+
+		if !errors.Is(e, gorm.ErrRecordNotFound) {
+
+			l.Warning.Printf("[DB] Something went wrong while retrieving the watermark of metric [ %v ]. Error: %v\n", u.ResourceType, e)
+
+		}
+
+		return
+
+	}
+
+	t := (time.Time)(u.Timedate)
+
+	if !t.Before((time.Time)(w.Watermark)) {
+
+		return
+
+	}
+
+	if d.Ingestion.Horizon > 0 && time.Since(t) > d.Ingestion.Horizon {
+
+		l.Warning.Printf("[DB] Usage sample of metric [ %v ] at [ %v ] arrived beyond the lateness horizon, it won't be re-compacted.\n", u.ResourceType, t)
+
+		d.Metrics["late"].With(prometheus.Labels{"metric": u.ResourceType, "state": "beyond horizon"}).Inc()
+
+		return
+
+	}
+
+	late := d.Db.NamingStrategy.ColumnName("", "LateRecords")
+
+	r := d.Db.Model(&models.CompactionWindow{}).Where(&models.CompactionWindow{Schedule: w.Schedule}).
+		Where(fmt.Sprintf("%v <= ? AND %v > ?", d.Db.NamingStrategy.ColumnName("", "TimeFrom"), d.Db.NamingStrategy.ColumnName("", "TimeTo")), t, t).
+		Updates(map[string]interface{}{
+			d.Db.NamingStrategy.ColumnName("", "Dirty"): true,
+			late: gorm.Expr(late + " + 1"),
+		})
+
+	if r.Error != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while marking as dirty the window of the late usage of metric [ %v ]. Error: %v\n", u.ResourceType, r.Error)
+
+		return
+
+	}
+
+	if e := d.Db.Model(&w).UpdateColumn(late, gorm.Expr(late+" + 1")).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while counting the late usage of metric [ %v ]. Error: %v\n", u.ResourceType, e)
+
+	}
+
+	if r.RowsAffected > 0 {
+
+		l.Debug.Printf("[DB] Late usage sample of metric [ %v ] at [ %v ], window of schedule [ %v ] marked as dirty.\n", u.ResourceType, t, w.Schedule)
+
+	}
+
+	d.Metrics["late"].With(prometheus.Labels{"metric": u.ResourceType, "state": "accepted"}).Inc()
+
+}
+
+// checkUsage job is to validate a usage sample against the definition of its
+// metric.
+// Parameters:
+// - metric: reference to the Metric of the sample.
+// - u: reference to the Usage to be checked.
+// Returns:
+// - e: ErrMetricInvalid with the reason in case of not matching the definition.
+func (d *DbParameter) checkUsage(metric *models.Metric, u *models.Usage) (e error) {
+
+	if metric.Unit != "" && u.Unit != metric.Unit {
+
+		return fmt.Errorf("%w: unit [ %v ] instead of [ %v ]", ErrMetricInvalid, u.Unit, metric.Unit)
+
+	}
+
+	if len(metric.MetadataKeys) == 0 {
+
+		return
+
+	}
+
+	allowed := make(map[string]bool)
+
+	for _, k := range metric.MetadataKeys {
+
+		allowed[k] = true
+
+	}
+
+	for k := range u.Metadata {
+
+		if !allowed[k] {
+
+			return fmt.Errorf("%w: metadata key [ %v ] not allowed", ErrMetricInvalid, k)
+
+		}
+
+	}
+
+	return
+
+}
+
+// getUsageKey job is to derive the idempotency key of a usage sample from the
+// metric, account, resource and time it refers to.
+// Parameters:
+// - u: reference to the Usage.
+// Returns:
+// - string with the hexadecimal hash used as key.
+func (d *DbParameter) getUsageKey(u *models.Usage) string {
+
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%v|%v|%v|%v", u.ResourceType, u.Account, u.ResourceID, u.Time)))
+
+	return fmt.Sprintf("%x", sum)
+
+}
+
 // getWindow job is to select the timeframe for the usage retrievals according
 // to the data providad from<window, window<to, or from<window<to.
 // Parameters:
@@ -538,3 +1817,32 @@ func (d *DbParameter) getWindow(from, to strfmt.DateTime) (s string) {
 	return s
 
 }
+
+// quarantineUsage job is to hold back a usage sample that can't be ingested.
+// Parameters:
+// - u: reference to the Usage held back.
+// - reason: string with the reason of the quarantine.
+// Returns:
+// - e: error raised in case of problems.
+func (d *DbParameter) quarantineUsage(u *models.Usage, reason string) (e error) {
+
+	q := models.QuarantinedUsage{
+		Metric:     u.ResourceType,
+		Reason:     reason,
+		ReceivedAt: strfmt.DateTime(time.Now()),
+		Record:     u,
+	}
+
+	if e = d.Db.Create(&q).Error; e != nil {
+
+		l.Warning.Printf("[DB] Something went wrong while quarantining the usage sample. Error: %v\n", e)
+
+		return
+
+	}
+
+	d.Metrics["count"].With(prometheus.Labels{"type": "Usage samples quarantined"}).Inc()
+
+	return
+
+}
@@ -72,6 +72,19 @@ func prometheusStart() (metricsMap map[string]*prometheus.GaugeVec, register *pr
 		},
 	)
 
+	metricLate := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "CYCLOPS",
+			Subsystem: strings.Split(service, "-")[0] + "_Service",
+			Name:      "late_usage",
+			Help:      "Usage samples arriving after their window was compacted",
+		},
+		[]string{
+			"metric",
+			"state",
+		},
+	)
+
 	metricSecurity = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: "CYCLOPS",
@@ -98,12 +111,13 @@ func prometheusStart() (metricsMap map[string]*prometheus.GaugeVec, register *pr
 	)
 
 	register.MustRegister(metricCache, metricCount, metricEndpoint, metricKafka,
-		metricSecurity, metricTime)
+		metricLate, metricSecurity, metricTime)
 
 	metricsMap["cache"] = metricCache
 	metricsMap["count"] = metricCount
 	metricsMap["api"] = metricEndpoint
 	metricsMap["kafka"] = metricKafka
+	metricsMap["late"] = metricLate
 	metricsMap["security"] = metricSecurity
 	metricsMap["time"] = metricTime
 
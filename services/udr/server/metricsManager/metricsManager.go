@@ -2,6 +2,7 @@ package metricsManager
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/go-openapi/runtime/middleware"
@@ -44,6 +45,121 @@ func New(db *dbManager.DbParameter, monit *statusManager.StatusManager) *Metrics
 
 }
 
+// CreateMetric (Swagger func) is the function behind the (POST) endpoint
+// /metrics
+// Its job is to register the definition of a new metric in the system.
+func (m *MetricsManager) CreateMetric(ctx context.Context, params metrics_management.CreateMetricParams) middleware.Responder {
+
+	l.Trace.Printf("[MetricsManager] CreateMetric endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("metrics", callTime)
+
+	metric, e := m.db.CreateMetric(params.Metric)
+
+	if e == nil {
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "201", "method": "POST", "route": "/metrics"}).Inc()
+
+		m.monit.APIHitDone("metrics", callTime)
+
+		return metrics_management.NewCreateMetricCreated().WithPayload(metric)
+
+	}
+
+	if errors.Is(e, dbManager.ErrMetricInvalid) {
+
+		s := "The Metric provided is not valid: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "400", "method": "POST", "route": "/metrics"}).Inc()
+
+		m.monit.APIHitDone("metrics", callTime)
+
+		return metrics_management.NewCreateMetricBadRequest().WithPayload(&errorReturn)
+
+	}
+
+	if errors.Is(e, dbManager.ErrMetricDuplicated) {
+
+		s := "The Metric already exists in the system."
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "409", "method": "POST", "route": "/metrics"}).Inc()
+
+		m.monit.APIHitDone("metrics", callTime)
+
+		return metrics_management.NewCreateMetricConflict().WithPayload(&errorReturn)
+
+	}
+
+	s := "There was an error registering the Metric in the system: " + e.Error()
+	errorReturn := models.ErrorResponse{
+		ErrorString: &s,
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "POST", "route": "/metrics"}).Inc()
+
+	m.monit.APIHitDone("metrics", callTime)
+
+	return metrics_management.NewCreateMetricInternalServerError().WithPayload(&errorReturn)
+
+}
+
+// GetMetric (Swagger func) is the function behind the (GET) endpoint
+// /metrics/{id}
+// Its job is to provide the definition of the requested metric.
+func (m *MetricsManager) GetMetric(ctx context.Context, params metrics_management.GetMetricParams) middleware.Responder {
+
+	l.Trace.Printf("[MetricsManager] GetMetric endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("metrics", callTime)
+
+	metric, e := m.db.GetMetric(params.ID)
+
+	if e != nil {
+
+		s := "There was an error retrieving the Metric from the system: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/metrics/" + params.ID}).Inc()
+
+		m.monit.APIHitDone("metrics", callTime)
+
+		return metrics_management.NewGetMetricInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	if metric == nil {
+
+		s := "The Metric doesn't exist in the system."
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "404", "method": "GET", "route": "/metrics/" + params.ID}).Inc()
+
+		m.monit.APIHitDone("metrics", callTime)
+
+		return metrics_management.NewGetMetricNotFound().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/metrics/" + params.ID}).Inc()
+
+	m.monit.APIHitDone("metrics", callTime)
+
+	return metrics_management.NewGetMetricOK().WithPayload(metric)
+
+}
+
 // GetMetrics (Swagger func) is the function behind the (GET) endpoint
 // /metrics
 // Its job is to provide a list of the metrics saved in the system that can be
@@ -79,3 +195,112 @@ func (m *MetricsManager) GetMetrics(ctx context.Context, params metrics_manageme
 	return metrics_management.NewGetMetricsInternalServerError().WithPayload(&errorReturn)
 
 }
+
+// ListQuarantine (Swagger func) is the function behind the (GET) endpoint
+// /quarantine
+// Its job is to provide the usage samples held back on ingestion for not
+// matching the definition of their metric.
+func (m *MetricsManager) ListQuarantine(ctx context.Context, params metrics_management.ListQuarantineParams) middleware.Responder {
+
+	l.Trace.Printf("[MetricsManager] ListQuarantine endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("metrics", callTime)
+
+	var metric string
+
+	if params.Metric != nil {
+
+		metric = *params.Metric
+
+	}
+
+	samples, e := m.db.ListQuarantine(metric)
+
+	if e == nil {
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/quarantine"}).Inc()
+
+		m.monit.APIHitDone("metrics", callTime)
+
+		return metrics_management.NewListQuarantineOK().WithPayload(samples)
+
+	}
+
+	s := "There was an error retrieving the quarantined samples from the system: " + e.Error()
+	errorReturn := models.ErrorResponse{
+		ErrorString: &s,
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/quarantine"}).Inc()
+
+	m.monit.APIHitDone("metrics", callTime)
+
+	return metrics_management.NewListQuarantineInternalServerError().WithPayload(&errorReturn)
+
+}
+
+// UpdateMetric (Swagger func) is the function behind the (PUT) endpoint
+// /metrics/{id}
+// Its job is to update the definition of the provided metric.
+func (m *MetricsManager) UpdateMetric(ctx context.Context, params metrics_management.UpdateMetricParams) middleware.Responder {
+
+	l.Trace.Printf("[MetricsManager] UpdateMetric endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("metrics", callTime)
+
+	if params.Metric.Metric != "" && params.Metric.Metric != params.ID {
+
+		s := "The name of the Metric provided doesn't match the one in the path."
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "400", "method": "PUT", "route": "/metrics/" + params.ID}).Inc()
+
+		m.monit.APIHitDone("metrics", callTime)
+
+		return metrics_management.NewUpdateMetricBadRequest().WithPayload(&errorReturn)
+
+	}
+
+	metric, e := m.db.UpdateMetric(params.ID, params.Metric)
+
+	if e != nil {
+
+		s := "There was an error updating the Metric in the system: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "PUT", "route": "/metrics/" + params.ID}).Inc()
+
+		m.monit.APIHitDone("metrics", callTime)
+
+		return metrics_management.NewUpdateMetricInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	if metric == nil {
+
+		s := "The Metric doesn't exist in the system."
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "404", "method": "PUT", "route": "/metrics/" + params.ID}).Inc()
+
+		m.monit.APIHitDone("metrics", callTime)
+
+		return metrics_management.NewUpdateMetricNotFound().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "PUT", "route": "/metrics/" + params.ID}).Inc()
+
+	m.monit.APIHitDone("metrics", callTime)
+
+	return metrics_management.NewUpdateMetricOK().WithPayload(metric)
+
+}
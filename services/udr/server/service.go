@@ -1,13 +1,13 @@
 package main
 
 import (
+	"errors"
 	"net/http"
 	"net/url"
 	"strings"
 	"time"
 
 	httptransport "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/cors"
 	eventsClient "github.com/GoDieNow/TFT_Code/services/eventsengine/client"
@@ -32,9 +32,13 @@ func getService() (handler http.Handler, register *prometheus.Registry, e error)
 	l.Trace.Printf("[MAIN] Intializing service [ %v ] handler\n", strings.Title(service))
 
 	// TABLE0,...,N have to be customized
-	db := dbStart(&models.UReport{}, &models.UDRRecord{}, &models.Usage{}, &models.Metric{})
+	db := dbStart(&models.UReport{}, &models.UDRRecord{}, &models.Usage{}, &models.Metric{}, &models.CompactionWindow{}, &models.QuarantinedUsage{}, &models.Watermark{})
 	mon := statusManager.New(db)
 
+	db.EnableTimescale(timescaleStart())
+
+	db.Ingestion = ingestionStart()
+
 	// Prometheus Metrics linked to dbParameter
 	db.Metrics, register = prometheusStart()
 
@@ -61,7 +65,13 @@ func getService() (handler http.Handler, register *prometheus.Registry, e error)
 	// Parts of the service HERE
 	m := metricsManager.New(db, mon)
 	u := usageManager.New(db, mon)
-	t := triggerManager.New(db, mon, eec, ch)
+	t := triggerManager.New(db, mon, eec, ch, schedulerStart(), integrationStart())
+
+	if cfg.Compaction.SchedulerEnabled {
+
+		t.StartScheduler()
+
+	}
 
 	// Initiate the http handler, with the objects that are implementing the business logic.
 	h, e := restapi.Handler(restapi.Config{
@@ -119,9 +129,12 @@ func kafkaStart(db *dbManager.DbParameter, mon *statusManager.StatusManager) (ch
 
 		u := model.(*models.Usage)
 
-		u.Timedate = strfmt.DateTime(time.Unix(u.Time, 0))
+		// Redelivered and quarantined samples are expected and not a failure
+		if e = db.IngestUsage(u); errors.Is(e, dbManager.ErrUsageDuplicated) || errors.Is(e, dbManager.ErrUsageQuarantined) {
+
+			e = nil
 
-		e = db.AddMetric(u.ResourceType)
+		}
 
 		return
 
@@ -135,7 +148,7 @@ func kafkaStart(db *dbManager.DbParameter, mon *statusManager.StatusManager) (ch
 				topic:    cfg.Kafka.TopicsIn[0],
 				model:    models.Usage{},
 				function: f,
-				saveDB:   true,
+				saveDB:   false,
 			},
 		},
 		out: []kafkaPackage{
@@ -151,3 +164,264 @@ func kafkaStart(db *dbManager.DbParameter, mon *statusManager.StatusManager) (ch
 	return
 
 }
+
+// ingestionStart handles the configuration of the policies applied to the
+// usage samples that can't be ingested or arrive late, keeping by default the
+// legacy behaviour of registering the unknown metrics.
+// Returns:
+// - c: the IngestionConfig to be used by the dbManager.
+func ingestionStart() (c dbManager.IngestionConfig) {
+
+	l.Trace.Printf("[MAIN] Intializing the usage ingestion policies\n")
+
+	c = dbManager.IngestionConfig{
+		Async:   cfg.Ingestion.AsyncThreshold,
+		Invalid: strings.ToLower(cfg.Ingestion.InvalidRecords),
+		MaxSize: int64(cfg.Ingestion.MaxBatchSize) << 20,
+		Unknown: strings.ToLower(cfg.Ingestion.UnknownMetrics),
+	}
+
+	if c.Async <= 0 {
+
+		c.Async = 1000
+
+	}
+
+	if c.MaxSize <= 0 {
+
+		c.MaxSize = 64 << 20
+
+	}
+
+	if c.Invalid != "reject" {
+
+		c.Invalid = "quarantine"
+
+	}
+
+	if c.Unknown != "reject" && c.Unknown != "quarantine" {
+
+		c.Unknown = "register"
+
+	}
+
+	if c.Horizon, _ = time.ParseDuration(cfg.Compaction.LatenessHorizon); c.Horizon < 0 {
+
+		c.Horizon = 0
+
+	}
+
+	return
+
+}
+
+// integrationStart handles the configuration of the time-weighted integration
+// of the usage samples.
+// Returns:
+// - c: the IntegrationConfig to be used by the triggerManager.
+func integrationStart() (c triggerManager.IntegrationConfig) {
+
+	l.Trace.Printf("[MAIN] Intializing the usage integration modes\n")
+
+	c = triggerManager.IntegrationConfig{
+		Gap: triggerManager.GapPolicy{
+			Zero: strings.ToLower(cfg.Compaction.GapMode) == "zero",
+		},
+		Gaps:    make(map[string]triggerManager.GapPolicy),
+		Metrics: make(map[string]string),
+	}
+
+	for metric, mode := range cfg.Compaction.Integration {
+
+		c.Metrics[strings.ToLower(metric)] = strings.ToLower(mode)
+
+	}
+
+	if c.Gap.Hold, _ = time.ParseDuration(cfg.Compaction.GapHold); c.Gap.Hold < 0 {
+
+		c.Gap.Hold = 0
+
+	}
+
+	// The policy of a metric is its mode, optionally followed by its hold
+	for metric, policy := range cfg.Compaction.Gaps {
+
+		fields := strings.Fields(strings.ToLower(policy))
+
+		if len(fields) == 0 {
+
+			continue
+
+		}
+
+		p := triggerManager.GapPolicy{
+			Hold: c.Gap.Hold,
+			Zero: fields[0] == "zero",
+		}
+
+		if len(fields) > 1 {
+
+			hold, e := time.ParseDuration(fields[1])
+
+			if e != nil || hold < 0 {
+
+				l.Warning.Printf("[MAIN] The gap hold [ %v ] of metric [ %v ] is not valid, using the general one.\n", fields[1], metric)
+
+			} else {
+
+				p.Hold = hold
+
+			}
+
+		}
+
+		c.Gaps[strings.ToLower(metric)] = p
+
+	}
+
+	return
+
+}
+
+// schedulerStart handles the configuration of the compaction schedules,
+// filling the gaps with the legacy behaviour of 8h windows in UTC.
+// Returns:
+// - c: the SchedulerConfig to be used by the triggerManager.
+func schedulerStart() (c triggerManager.SchedulerConfig) {
+
+	l.Trace.Printf("[MAIN] Intializing the compaction schedules\n")
+
+	c = triggerManager.SchedulerConfig{
+		Default:    cfg.Compaction.Default,
+		Location:   time.UTC,
+		MaxCatchUp: cfg.Compaction.MaxCatchUp,
+		Metrics:    make(map[string]string),
+		Schedules:  cfg.Compaction.Schedules,
+	}
+
+	if c.Default == "" {
+
+		c.Default = "default"
+
+	}
+
+	if c.Schedules == nil {
+
+		c.Schedules = make(map[string]string)
+
+	}
+
+	if _, exists := c.Schedules[c.Default]; !exists {
+
+		c.Schedules[c.Default] = "8h"
+
+	}
+
+	for metric, schedule := range cfg.Compaction.Metrics {
+
+		if _, exists := c.Schedules[schedule]; !exists {
+
+			l.Warning.Printf("[MAIN] The metric [ %v ] is linked to the unknown schedule [ %v ], using the default one.\n", metric, schedule)
+
+			continue
+
+		}
+
+		c.Metrics[strings.ToLower(metric)] = schedule
+
+	}
+
+	if cfg.Compaction.Timezone != "" {
+
+		if loc, e := time.LoadLocation(cfg.Compaction.Timezone); e == nil {
+
+			c.Location = loc
+
+		} else {
+
+			l.Warning.Printf("[MAIN] The timezone [ %v ] is not valid, using UTC. Error: %v\n", cfg.Compaction.Timezone, e)
+
+		}
+
+	}
+
+	if c.CheckInterval, _ = time.ParseDuration(cfg.Compaction.CheckInterval); c.CheckInterval <= 0 {
+
+		c.CheckInterval = time.Minute
+
+	}
+
+	if c.Delay, _ = time.ParseDuration(cfg.Compaction.Delay); c.Delay < 0 {
+
+		c.Delay = 0
+
+	}
+
+	if c.Horizon, _ = time.ParseDuration(cfg.Compaction.LatenessHorizon); c.Horizon < 0 {
+
+		c.Horizon = 0
+
+	}
+
+	if c.MaxCatchUp <= 0 {
+
+		c.MaxCatchUp = 96
+
+	}
+
+	return
+
+}
+
+// timescaleStart handles the configuration of the optional TimescaleDB
+// support, filling the gaps with daily chunks and no compression or retention.
+// Returns:
+// - c: the TimescaleConfig to be used by the dbManager.
+func timescaleStart() (c dbManager.TimescaleConfig) {
+
+	l.Trace.Printf("[MAIN] Intializing the TimescaleDB support\n")
+
+	c = dbManager.TimescaleConfig{
+		Compression: make(map[string]time.Duration),
+		Enabled:     cfg.Timescale.Enabled,
+		Retention:   make(map[string]time.Duration),
+		Rollups:     cfg.Timescale.Rollups,
+	}
+
+	if c.ChunkInterval, _ = time.ParseDuration(cfg.Timescale.ChunkInterval); c.ChunkInterval <= 0 {
+
+		c.ChunkInterval = 24 * time.Hour
+
+	}
+
+	for table, after := range cfg.Timescale.Compression {
+
+		if d, e := time.ParseDuration(after); e == nil && d > 0 {
+
+			c.Compression[strings.ToLower(table)] = d
+
+		} else {
+
+			l.Warning.Printf("[MAIN] The compression age [ %v ] of table [ %v ] is not valid, skipping it.\n", after, table)
+
+		}
+
+	}
+
+	for table, after := range cfg.Timescale.Retention {
+
+		if d, e := time.ParseDuration(after); e == nil && d > 0 {
+
+			c.Retention[strings.ToLower(table)] = d
+
+		} else {
+
+			l.Warning.Printf("[MAIN] The retention age [ %v ] of table [ %v ] is not valid, skipping it.\n", after, table)
+
+		}
+
+	}
+
+	return
+
+}
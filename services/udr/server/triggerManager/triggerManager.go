@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"math"
 	"net/http"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	httptransport "github.com/go-openapi/runtime/client"
@@ -16,6 +18,7 @@ import (
 	"github.com/remeh/sizedwaitgroup"
 	"gitlab.com/cyclops-utilities/datamodels"
 	eventsClient "github.com/GoDieNow/TFT_Code/services/eventsengine/client"
+	eventsEvent "github.com/GoDieNow/TFT_Code/services/eventsengine/client/event_management"
 	eventsUsage "github.com/GoDieNow/TFT_Code/services/eventsengine/client/usage_management"
 	eeModels "github.com/GoDieNow/TFT_Code/services/eventsengine/models"
 	"github.com/GoDieNow/TFT_Code/services/udr/models"
@@ -31,11 +34,69 @@ import (
 // - db: a DbParameter reference to be able to use the DBManager methods.
 // - monit: a StatusManager reference to be able to use the status subsystem methods.
 // - eventsConfig: a EventsEngine client config reference to interact with EventsEngine
+// - integration: IntegrationConfig with the time-weighted integration setup.
+// - lock: mutex to avoid overlapping compactions.
+// - recomputed: int64 with the time from which the recomputations flagged by
+// the EventsEngine are pending to be checked.
+// - scheduler: SchedulerConfig with the compaction schedules.
 type TriggerManager struct {
 	pipe         chan interface{}
 	db           *dbManager.DbParameter
 	monit        *statusManager.StatusManager
 	eventsConfig eventsClient.Config
+	integration  IntegrationConfig
+	lock         sync.Mutex
+	recomputed   int64
+	scheduler    SchedulerConfig
+}
+
+// GapPolicy is the struct defined to group how the gaps between the samples
+// of a metric are integrated.
+// Parameters:
+// - Hold: time.Duration beyond which the time between samples is a gap.
+// - Zero: bool to count the gaps as zero once the last value has been held for
+// Hold, instead of holding it for the whole gap.
+type GapPolicy struct {
+	Hold time.Duration
+	Zero bool
+}
+
+// IntegrationConfig is the struct defined to group the configuration of the
+// time-weighted integration of the usage samples.
+// Parameters:
+// - Gap: GapPolicy of the metrics without one of their own.
+// - Gaps: map with the lowercased metric and its GapPolicy, used when the
+// definition of the metric doesn't set one.
+// - Metrics: map with the lowercased metric and its UDRMode, used when the
+// samples don't carry one in their metadata.
+type IntegrationConfig struct {
+	Gap     GapPolicy
+	Gaps    map[string]GapPolicy
+	Metrics map[string]string
+}
+
+// SchedulerConfig is the struct defined to group the configuration of the
+// compaction schedules and the internal scheduler running them.
+// Parameters:
+// - CheckInterval: time.Duration between the checks for closed windows.
+// - Default: string with the schedule of the metrics not assigned to any.
+// - Delay: time.Duration to wait after a window closes before compacting it.
+// - Horizon: time.Duration after which the dirty windows are not re-compacted,
+// 0 for no limit.
+// - Location: time.Location the windows are aligned to.
+// - MaxCatchUp: int with the max number of missed windows compacted per schedule.
+// - Metrics: map with the lowercased metric and the schedule compacting it.
+// - Schedules: map with the schedule and its granularity, which can be hourly,
+// daily, weekly, monthly or a duration dividing a day (15m, 8h...).
+type SchedulerConfig struct {
+	CheckInterval time.Duration
+	Default       string
+	Delay         time.Duration
+	Horizon       time.Duration
+	Location      *time.Location
+	MaxCatchUp    int
+	Metrics       map[string]string
+	Schedules     map[string]string
 }
 
 type CompactionKey struct {
@@ -48,7 +109,8 @@ type CompactionKey struct {
 }
 
 const (
-	scaler = 1e7
+	batchSize = 500
+	scaler    = 1e7
 )
 
 // New is the function to create the struct TriggerManager.
@@ -59,19 +121,34 @@ const (
 // interaction with the TriggerManager methods.
 // - eventsClient.Config: a reference to the config needed to start the client
 // interface to be able to interact with the EventsEngine service.
+// - ch: the channel where the compacted usages are sent through kafka.
+// - s: SchedulerConfig with the compaction schedules.
+// - i: IntegrationConfig with the time-weighted integration setup.
 // Returns:
 // - TriggerManager: struct to interact with triggerManager subsystem functionalities.
-func New(db *dbManager.DbParameter, monit *statusManager.StatusManager, c eventsClient.Config, ch chan interface{}) *TriggerManager {
+func New(db *dbManager.DbParameter, monit *statusManager.StatusManager, c eventsClient.Config, ch chan interface{}, s SchedulerConfig, i IntegrationConfig) *TriggerManager {
 
 	l.Trace.Printf("[TriggerManager] Generating new TriggerManager.\n")
 
 	monit.InitEndpoint("trigger")
 
+	// On start, the recomputations within the lateness horizon are checked
+	recomputed := time.Now()
+
+	if s.Horizon > 0 {
+
+		recomputed = recomputed.Add(-s.Horizon)
+
+	}
+
 	return &TriggerManager{
 		pipe:         ch,
 		db:           db,
 		monit:        monit,
 		eventsConfig: c,
+		integration:  i,
+		recomputed:   recomputed.Unix(),
+		scheduler:    s,
 	}
 
 }
@@ -80,7 +157,7 @@ func (m *TriggerManager) getClient(param *http.Request) *eventsClient.EventEngin
 
 	config := m.eventsConfig
 
-	if len(param.Header.Get("Authorization")) > 0 {
+	if param != nil && len(param.Header.Get("Authorization")) > 0 {
 
 		token := strings.Fields(param.Header.Get("Authorization"))[1]
 
@@ -97,6 +174,47 @@ func (m *TriggerManager) getClient(param *http.Request) *eventsClient.EventEngin
 func (m *TriggerManager) getNiceFloat(i float64) (o float64) {
 
 	return float64(math.Round(i*scaler) / scaler)
+}
+
+// CompactMetric job is to compact the usage of a single metric within the
+// window exactly as the compactions do, without cleaning the window or
+// tracking it, so the compaction can be measured on its own.
+// Parameters:
+// - metric: string with the metric to be compacted.
+// - from: a datatime reference for the initial border of the window.
+// - to: a datatime reference for the final border of the window.
+// Returns:
+// - count: int with the number of records added to the system.
+// - errs: slice with the errors found while compacting.
+func (m *TriggerManager) CompactMetric(metric string, from, to strfmt.DateTime) (count int, errs []string) {
+
+	queue := make(chan string, 1)
+	done := make(chan struct{})
+
+	go func() {
+
+		for t := range queue {
+			errs = append(errs, t)
+		}
+
+		close(done)
+
+	}()
+
+	def, e := m.db.GetMetric(metric)
+
+	if e != nil {
+
+		queue <- "Metric: " + metric + ". Error: " + e.Error()
+
+	}
+
+	count = m.compactMetric(def, metric, from, to, queue)
+
+	close(queue)
+	<-done
+
+	return
 
 }
 
@@ -114,14 +232,39 @@ func (m *TriggerManager) ExecCompactation(ctx context.Context, params trigger_ma
 	m.monit.APIHit("trigger", callTime)
 
 	var from, to strfmt.DateTime
-	var accumErr []string
-	var udrCount int64
-	var interval float64
+	var schedule string
 
-	queue := make(chan string, 1)
+	granularity := "8h"
 
-	now := time.Now()
-	records := make(map[string][]*models.Usage)
+	if params.FastMode != nil && *params.FastMode {
+
+		granularity = "15m"
+
+	}
+
+	if params.Schedule != nil && *params.Schedule != "" {
+
+		g, exists := m.scheduler.Schedules[*params.Schedule]
+
+		if !exists {
+
+			s := "The compaction schedule doesn't exist in the system."
+			errorReturn := models.ErrorResponse{
+				ErrorString: &s,
+			}
+
+			m.db.Metrics["api"].With(prometheus.Labels{"code": "400", "method": "GET", "route": "/trigger/compact"}).Inc()
+
+			m.monit.APIHitDone("trigger", callTime)
+
+			return trigger_management.NewExecCompactationBadRequest().WithPayload(&errorReturn)
+
+		}
+
+		schedule = *params.Schedule
+		granularity = g
+
+	}
 
 	if params.From != nil && params.To != nil {
 
@@ -132,74 +275,208 @@ func (m *TriggerManager) ExecCompactation(ctx context.Context, params trigger_ma
 
 	} else {
 
-		if params.FastMode != nil && *params.FastMode {
+		f, t, e := m.getLastWindow(granularity, time.Now())
 
-			switch now.Minute() {
+		if e != nil {
 
-			case 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15:
+			s := "The granularity of the compaction is not valid: " + e.Error()
+			errorReturn := models.ErrorResponse{
+				ErrorString: &s,
+			}
 
-				f := time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), 45, 0, 0, time.UTC)
-				to = strfmt.DateTime(time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), 0, 0, 0, time.UTC))
+			m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/trigger/compact"}).Inc()
 
-				from = strfmt.DateTime(f.Add(time.Minute * -60))
+			m.monit.APIHitDone("trigger", callTime)
 
-				l.Trace.Printf("[TriggerManager] Preriod for compactation: HH(-1):45-HH:00 in day(-1?).\n")
+			return trigger_management.NewExecCompactationInternalServerError().WithPayload(&errorReturn)
 
-			case 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30:
+		}
 
-				from = strfmt.DateTime(time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), 0, 0, 0, time.UTC))
-				to = strfmt.DateTime(time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), 15, 0, 0, time.UTC))
+		l.Trace.Printf("[TriggerManager] Preriod for compactation: [ %v ] windows, [ %v ] - [ %v ].\n", granularity, f, t)
 
-				l.Trace.Printf("[TriggerManager] Preriod for compactation: HH:00-HH:15.\n")
+		from = strfmt.DateTime(f)
+		to = strfmt.DateTime(t)
 
-			case 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45:
+	}
 
-				from = strfmt.DateTime(time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), 15, 0, 0, time.UTC))
-				to = strfmt.DateTime(time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), 30, 0, 0, time.UTC))
+	accumErr := m.compact(ctx, m.getClient(params.HTTPRequest), schedule, from, to)
 
-				l.Trace.Printf("[TriggerManager] Preriod for compactation: HH:15-HH:30.\n")
+	if schedule != "" {
 
-			case 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 0:
+		m.recordCompaction(schedule, from, to, accumErr)
 
-				from = strfmt.DateTime(time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), 30, 0, 0, time.UTC))
-				to = strfmt.DateTime(time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), 45, 0, 0, time.UTC))
+	}
 
-				l.Trace.Printf("[TriggerManager] Preriod for compactation: HH:30-HH:45.\n")
+	if len(accumErr) > 0 {
 
-			}
+		s := "This are the accumulative errors: " + strings.Join(accumErr, ",\n")
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
 
-		} else {
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/trigger/compact"}).Inc()
 
-			switch now.Hour() {
+		m.monit.APIHitDone("trigger", callTime)
 
-			case 0, 1, 2, 3, 4, 5, 6, 7:
+		return trigger_management.NewExecCompactationInternalServerError().WithPayload(&errorReturn)
 
-				from = strfmt.DateTime(time.Date(now.Year(), now.Month(), now.Day()-1, 16, 0, 0, 0, time.UTC))
-				to = strfmt.DateTime(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC))
+	}
 
-				l.Trace.Printf("[TriggerManager] Preriod for compactation: 16:00-24:00 in day -1.\n")
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/trigger/compact"}).Inc()
 
-			case 8, 9, 10, 11, 12, 13, 14, 15:
+	m.monit.APIHitDone("trigger", callTime)
 
-				from = strfmt.DateTime(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC))
-				to = strfmt.DateTime(time.Date(now.Year(), now.Month(), now.Day(), 8, 0, 0, 0, time.UTC))
+	return trigger_management.NewExecCompactationOK()
 
-				l.Trace.Printf("[TriggerManager] Preriod for compactation: 00:00-08:00.\n")
+}
 
-			case 16, 17, 18, 19, 20, 21, 22, 23:
+// ListCompactions (Swagger func) is the function behind the (GET) endpoint
+// /trigger/compactions
+// Its job is to list the windows already compacted by the schedules.
+func (m *TriggerManager) ListCompactions(ctx context.Context, params trigger_management.ListCompactionsParams) middleware.Responder {
 
-				from = strfmt.DateTime(time.Date(now.Year(), now.Month(), now.Day(), 8, 0, 0, 0, time.UTC))
-				to = strfmt.DateTime(time.Date(now.Year(), now.Month(), now.Day(), 16, 0, 0, 0, time.UTC))
+	l.Trace.Printf("[TriggerManager] ListCompactions endpoint invoked.\n")
 
-				l.Trace.Printf("[TriggerManager] Preriod for compactation: 08:00-16:00.\n")
+	callTime := time.Now()
+	m.monit.APIHit("trigger", callTime)
 
-			}
+	var schedule string
+	var from, to strfmt.DateTime
+
+	if params.Schedule != nil {
+
+		schedule = *params.Schedule
+
+	}
+
+	if params.From != nil {
+
+		from = *params.From
+
+	}
+
+	if params.To != nil {
+
+		to = *params.To
+
+	}
+
+	compactions, e := m.db.ListCompactions(schedule, from, to)
+
+	if e != nil {
+
+		s := "There was an error in the DB operation: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/trigger/compactions"}).Inc()
+
+		m.monit.APIHitDone("trigger", callTime)
+
+		return trigger_management.NewListCompactionsInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/trigger/compactions"}).Inc()
+
+	m.monit.APIHitDone("trigger", callTime)
+
+	return trigger_management.NewListCompactionsOK().WithPayload(compactions)
+
+}
+
+// ListWatermarks (Swagger func) is the function behind the (GET) endpoint
+// /trigger/watermarks
+// Its job is to list the watermarks of the metrics, after which the usage
+// received is considered late.
+func (m *TriggerManager) ListWatermarks(ctx context.Context, params trigger_management.ListWatermarksParams) middleware.Responder {
+
+	l.Trace.Printf("[TriggerManager] ListWatermarks endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("trigger", callTime)
+
+	var metric string
+
+	if params.Metric != nil {
 
+		metric = *params.Metric
+
+	}
+
+	watermarks, e := m.db.ListWatermarks(metric)
+
+	if e != nil {
+
+		s := "There was an error in the DB operation: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
 		}
 
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/trigger/watermarks"}).Inc()
+
+		m.monit.APIHitDone("trigger", callTime)
+
+		return trigger_management.NewListWatermarksInternalServerError().WithPayload(&errorReturn)
+
 	}
 
-	interval = float64(((time.Time)(to)).Sub((time.Time)(from)).Seconds())
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/trigger/watermarks"}).Inc()
+
+	m.monit.APIHitDone("trigger", callTime)
+
+	return trigger_management.NewListWatermarksOK().WithPayload(watermarks)
+
+}
+
+// StartScheduler job is to launch the internal scheduler that compacts the
+// windows of each schedule once they are closed, catching up with the ones
+// missed while the service was down.
+func (m *TriggerManager) StartScheduler() {
+
+	l.Info.Printf("[TriggerManager] Starting the compaction scheduler with [ %v ] schedules.\n", len(m.scheduler.Schedules))
+
+	go func() {
+
+		ticker := time.NewTicker(m.scheduler.CheckInterval)
+		defer ticker.Stop()
+
+		for {
+
+			m.runSchedules()
+
+			<-ticker.C
+
+		}
+
+	}()
+
+}
+
+// compact job is to compact the usage of the metrics of the provided schedule
+// within the window, importing as well the usage from the EventsEngine, and
+// to send the resulting reports through kafka.
+// Parameters:
+// - ctx: context of the operation.
+// - client: EventsEngine client to import the usage from.
+// - schedule: string with the schedule compacting, empty for all the metrics.
+// - from: a datatime reference for the initial border of the window.
+// - to: a datatime reference for the final border of the window.
+// Returns:
+// - accumErr: slice with the errors found during the compaction.
+func (m *TriggerManager) compact(ctx context.Context, client *eventsClient.EventEngineManagementAPI, schedule string, from, to strfmt.DateTime) (accumErr []string) {
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	var udrCount int64
+
+	queue := make(chan string, 1)
+	done := make(chan struct{})
+
+	now := time.Now()
+	registry := make(map[string]*models.Metric)
 
 	// First we clean all the records so we always have a clean UDR generation
 	templateRecord := models.UDRRecord{
@@ -209,7 +486,15 @@ func (m *TriggerManager) ExecCompactation(ctx context.Context, params trigger_ma
 
 	l.Info.Printf("[TriggerManager] Clean up of previous UDRRecords started for period [ %v ] - [ %v ].\n", from, to)
 
-	if e := m.db.Db.Where(&templateRecord).Delete(&models.UDRRecord{}).Error; e != nil {
+	q := m.db.Db.Where(&templateRecord)
+
+	if query, metrics := m.getMetricsFilter(schedule); query != "" {
+
+		q = q.Where(query, metrics)
+
+	}
+
+	if e := q.Delete(&models.UDRRecord{}).Error; e != nil {
 
 		l.Warning.Printf("[TriggerManager] The clean up of previous UDRecords failed. Error: %v\n", e)
 
@@ -222,7 +507,19 @@ func (m *TriggerManager) ExecCompactation(ctx context.Context, params trigger_ma
 
 	l.Info.Printf("[TriggerManager] Clean up of previous UReports started for period [ %v ] - [ %v ].\n", from, to)
 
-	if e := m.db.Db.Where(&templateReport).Delete(&models.UReport{}).Error; e != nil {
+	q = m.db.Db.Where(&templateReport)
+
+	// The reports of the accounts with records of other schedules left in the
+	// window still belong to those schedules
+	if schedule != "" {
+
+		accountID := m.db.Db.NamingStrategy.ColumnName("", "AccountID")
+
+		q = q.Where(fmt.Sprintf("%v NOT IN (?)", accountID), m.db.Db.Model(&models.UDRRecord{}).Distinct(accountID).Where(&templateRecord))
+
+	}
+
+	if e := q.Delete(&models.UReport{}).Error; e != nil {
 
 		l.Warning.Printf("[TriggerManager] The clean up of previous UReports failed. Error: %v\n", e)
 
@@ -235,153 +532,96 @@ func (m *TriggerManager) ExecCompactation(ctx context.Context, params trigger_ma
 			accumErr = append(accumErr, t)
 		}
 
+		close(done)
+
 	}()
 
 	// First we get the metric and accounts in the system.
 	metrics, _ := m.db.GetMetrics()
 	accounts := m.db.GetAccounts()
 
-	// Then, we get all the records by metrics
 	for _, metric := range metrics {
 
-		records[metric.Metric] = m.db.GetRecords(metric.Metric, from, to)
-
-	}
-
-	swg := sizedwaitgroup.New(8)
-	// Goroutines start
-	swg.Add()
-	go func() {
-
-		defer swg.Done()
-
-		// And finally, we compact the records by account
-		// Using the metadata as matching-key
-		for i := range accounts {
-
-			swg.Add()
-			go func(acc string) {
-
-				account := acc
-				defer swg.Done()
-
-				for _, record := range records {
-
-					accum := make(map[CompactionKey]float64)
-					counter := make(map[CompactionKey]float64)
-					metas := make(map[CompactionKey]datamodels.JSONdb)
-
-					// In the first part we calculate the usage by metadata
-					// And create a map of metadatas/matching keys
-					for id := range record {
-
-						if record[id].Account == account {
-
-							key := CompactionKey{
-								Account:  record[id].Account,
-								ID:       record[id].ResourceID,
-								Name:     record[id].ResourceName,
-								Metadata: fmt.Sprintf("%v", record[id].Metadata),
-								Metric:   record[id].ResourceType,
-								Unit:     record[id].Unit,
-							}
+		if m.inSchedule(metric.Metric, schedule) {
 
-							accum[key] += record[id].Usage
-							metas[key] = record[id].Metadata
-							counter[key]++
+			registry[metric.Metric] = metric
 
-						}
-
-					}
-
-					// In the second part we add the compacted records in the system
-					for key := range accum {
-
-						use := datamodels.JSONdb{"used": accum[key] * interval / counter[key]}
-						unit := key.Unit + "(*period)"
+		}
 
-						if v, exists := metas[key]["UDRMode"].(string); exists {
+	}
 
-							switch v {
+	// The watermarks are moved before reading the records, so the ones
+	// arriving meanwhile are detected as late and the window re-compacted
+	if schedule != "" {
 
-							case "avg":
+		var names []string
 
-								use = datamodels.JSONdb{"used": accum[key] / counter[key]}
-								unit = key.Unit
+		for metric := range registry {
 
-							case "sum":
+			names = append(names, metric)
 
-								use = datamodels.JSONdb{"used": accum[key]}
-								unit = key.Unit
+		}
 
-							default:
+		if e := m.db.StartCompaction(schedule, from, to, names); e != nil {
 
-								use = datamodels.JSONdb{"used": accum[key] * interval / counter[key]}
-								unit = key.Unit + "(*period)"
+			l.Warning.Printf("[TriggerManager] The late usage tracking of the window couldn't be started. Error: %v\n", e)
 
-							}
+		}
 
-						}
+	}
 
-						report := models.UDRRecord{
-							AccountID:    key.Account,
-							UsageBreakup: use,
-							Metadata:     metas[key],
-							TimeTo:       to,
-							TimeFrom:     from,
-							ResourceID:   key.ID,
-							ResourceName: key.Name,
-							ResourceType: key.Metric,
-							Unit:         unit,
-						}
+	swg := sizedwaitgroup.New(8)
 
-						if e := m.db.AddRecord(report); e != nil {
+	// Then, we compact the records by metric, aggregated in the database by
+	// resource, so the raw usage is never loaded as a whole in memory
+	for metric := range registry {
 
-							err := "Acc: " + account + "-" + report.ResourceID + ". Error: " + e.Error()
-							queue <- err
+		swg.Add()
+		go func(metric string) {
 
-							l.Trace.Printf("[TriggerManager] There was a problem adding the record in the system. Error: %v.\n", e)
+			defer swg.Done()
 
-						} else {
+			m.compactMetric(registry[metric], metric, from, to, queue)
 
-							l.Trace.Printf("[TriggerManager] Compacted record for account [ %v ] added to the system.\n", account)
+		}(metric)
 
-						}
+	}
 
-					}
+	// Goroutines start
+	swg.Add()
+	go func() {
 
-				}
+		defer swg.Done()
 
-				r := models.UReport{
-					AccountID: account,
-					TimeFrom:  from,
-					TimeTo:    to,
-				}
+		// And finally, we add the reports by account
+		for _, account := range accounts {
 
-				if e := m.db.AddReport(r); e != nil {
+			r := models.UReport{
+				AccountID: account,
+				TimeFrom:  from,
+				TimeTo:    to,
+			}
 
-					err := "Acc(r): " + account + ". Error: " + e.Error()
-					queue <- err
+			if e := m.db.AddReport(r); e != nil {
 
-					l.Trace.Printf("[TriggerManager] There was a problem adding the Report in the system. Error: %v.\n", e)
+				err := "Acc(r): " + account + ". Error: " + e.Error()
+				queue <- err
 
-				} else {
+				l.Trace.Printf("[TriggerManager] There was a problem adding the Report in the system. Error: %v.\n", e)
 
-					udrCount++
+			} else {
 
-					l.Trace.Printf("[TriggerManager] Compacted report for account [ %v ] added to the system.\n", account)
+				udrCount++
 
-				}
+				l.Trace.Printf("[TriggerManager] Compacted report for account [ %v ] added to the system.\n", account)
 
-			}(accounts[i])
+			}
 
 		}
 
 	}()
 
 	// EE import and compactation
-	client := m.getClient(params.HTTPRequest)
-
 	fu := ((time.Time)(from)).Unix()
 	tu := ((time.Time)(to)).Unix()
 
@@ -414,6 +654,12 @@ func (m *TriggerManager) ExecCompactation(ctx context.Context, params trigger_ma
 				// And create a map of metadatas/matching keys
 				for id := range acc.Usage {
 
+					if !m.inSchedule(acc.Usage[id].ResourceType, schedule) {
+
+						continue
+
+					}
+
 					key := CompactionKey{
 						Account:  acc.AccountID,
 						ID:       acc.Usage[id].ResourceID,
@@ -426,17 +672,19 @@ func (m *TriggerManager) ExecCompactation(ctx context.Context, params trigger_ma
 					accum[key] = make(datamodels.JSONdb)
 					metas[key] = acc.Usage[id].MetaData
 
+					// The seconds by state are integers, but the count of the
+					// discrete events might have a fractional quantity
 					for i := range acc.Usage[id].UsageBreakup {
 
 						if _, exists := accum[key][i]; !exists {
 
-							accum[key][i] = int64(0)
+							accum[key][i] = float64(0)
 
 						}
 
-						addition, _ := acc.Usage[id].UsageBreakup[i].(json.Number).Int64()
+						addition, _ := acc.Usage[id].UsageBreakup[i].(json.Number).Float64()
 
-						accum[key][i] = accum[key][i].(int64) + addition
+						accum[key][i] = accum[key][i].(float64) + addition
 
 					}
 
@@ -514,6 +762,40 @@ func (m *TriggerManager) ExecCompactation(ctx context.Context, params trigger_ma
 
 			for _, usage := range usages {
 
+				// Only the reports of this very window are sent, the ones of
+				// the shorter windows within it belong to other compactions
+				if !((time.Time)(usage.TimeFrom)).Equal((time.Time)(from)) || !((time.Time)(usage.TimeTo)).Equal((time.Time)(to)) {
+
+					continue
+
+				}
+
+				// Neither the usages of the metrics of other schedules sharing
+				// the window, which are sent by their own compactions
+				if schedule != "" && len(usage.Usage) > 0 {
+
+					var scoped []*models.UDRReport
+
+					for _, u := range usage.Usage {
+
+						if m.inSchedule(u.ResourceType, schedule) {
+
+							scoped = append(scoped, u)
+
+						}
+
+					}
+
+					if len(scoped) == 0 {
+
+						continue
+
+					}
+
+					usage.Usage = scoped
+
+				}
+
 				m.pipe <- *usage
 
 			}
@@ -531,31 +813,806 @@ func (m *TriggerManager) ExecCompactation(ctx context.Context, params trigger_ma
 
 	close(queue)
 
+	<-done
+
 	m.db.Metrics["time"].With(prometheus.Labels{"type": "UDRs generation time"}).Set(float64(time.Now().UnixNano()-now.UnixNano()) / float64(time.Millisecond))
 
 	m.db.Metrics["count"].With(prometheus.Labels{"type": "UDRs generated"}).Set(float64(udrCount))
 
 	m.db.Metrics["count"].With(prometheus.Labels{"type": "Errors during UDR generation"}).Set(float64(len(accumErr)))
 
-	if len(accumErr) > 0 {
+	return
 
-		s := "This are the accumulative errors: " + strings.Join(accumErr, ",\n")
-		errorReturn := models.ErrorResponse{
-			ErrorString: &s,
-		}
+}
 
-		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/trigger/compact"}).Inc()
+// checkRecomputations job is to flag as dirty the compacted windows whose
+// usage changed in the EventsEngine due to out-of-order events, since the
+// last check.
+func (m *TriggerManager) checkRecomputations() {
 
-		m.monit.APIHitDone("trigger", callTime)
+	since := m.recomputed
 
-		return trigger_management.NewExecCompactationInternalServerError().WithPayload(&errorReturn)
+	params := eventsEvent.NewListRecomputationsParams().WithSince(&since)
+
+	r, e := m.getClient(nil).EventManagement.ListRecomputations(context.Background(), params)
+
+	if e != nil {
+
+		l.Warning.Printf("[TriggerManager] There was a problem while retrieving the recomputations from EventsEngine. Error: %v", e)
+
+		return
 
 	}
 
-	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/trigger/compact"}).Inc()
+	for _, rc := range r.Payload {
 
-	m.monit.APIHitDone("trigger", callTime)
+		for schedule := range m.scheduler.Schedules {
 
-	return trigger_management.NewExecCompactationOK()
+			if !m.inSchedule(rc.ResourceType, schedule) {
+
+				continue
+
+			}
+
+			if n, e := m.db.MarkDirty(schedule, time.Unix(rc.TimeFrom, 0), time.Unix(rc.TimeTo, 0)); e == nil && n > 0 {
+
+				l.Debug.Printf("[TriggerManager] [ %v ] windows of schedule [ %v ] flagged as dirty by the events of resource [ %v ].\n", n, schedule, rc.ResourceID)
+
+			}
+
+		}
+
+		// Recomputations flagged within the same second are checked again,
+		// which is harmless as flagging is idempotent
+		if rc.CreatedAt > m.recomputed {
+
+			m.recomputed = rc.CreatedAt
+
+		}
+
+	}
+
+}
+
+// compactMetric job is to compact the usage of the metric within the window
+// by resource, streaming the aggregates computed by the database and adding
+// the resulting records to the system in batches.
+// Parameters:
+// - def: reference to the definition of the metric, if any.
+// - metric: string with the metric to be compacted.
+// - from: a datatime reference for the initial border of the window.
+// - to: a datatime reference for the final border of the window.
+// - queue: channel where the errors found are reported.
+// Returns:
+// - count: int with the number of records added to the system.
+func (m *TriggerManager) compactMetric(def *models.Metric, metric string, from, to strfmt.DateTime, queue chan string) (count int) {
+
+	interval := float64(((time.Time)(to)).Sub((time.Time)(from)).Seconds())
+
+	base := ""
+
+	if def != nil {
+
+		base = def.Unit
+
+	}
+
+	batch := make([]*models.UDRRecord, 0, batchSize)
+
+	flush := func() {
+
+		if len(batch) == 0 {
+
+			return
+
+		}
+
+		if e := m.db.AddRecords(batch); e != nil {
+
+			err := "Metric: " + metric + ". Error: " + e.Error()
+			queue <- err
+
+			l.Trace.Printf("[TriggerManager] There was a problem adding the records in the system. Error: %v.\n", e)
+
+		} else {
+
+			count += len(batch)
+
+			l.Trace.Printf("[TriggerManager] [ %v ] compacted records of metric [ %v ] added to the system.\n", len(batch), metric)
+
+		}
+
+		batch = make([]*models.UDRRecord, 0, batchSize)
+
+	}
+
+	e := m.db.AggregateRecords(metric, from, to, func(a *dbManager.UsageAggregate) error {
+
+		unit := a.Unit
+
+		if base != "" {
+
+			unit = base
+
+		}
+
+		var used float64
+
+		mode := m.getUDRMode(def, metric, a.Metadata)
+
+		switch mode {
+
+		case "avg":
+
+			used = a.Sum / a.Count
+
+		case "sum":
+
+			used = a.Sum
+
+		case "last":
+
+			used = a.Last
+
+		case "max":
+
+			used = a.Max
+
+		case "min":
+
+			used = a.Min
+
+		case "p95":
+
+			used = a.P95
+
+		case "step", "trapezoid":
+
+			samples, e := m.db.GetSamples(metric, a, from, to)
+
+			if e != nil {
+
+				err := "Acc: " + a.Account + "-" + a.ResourceID + ". Error: " + e.Error()
+				queue <- err
+
+				return nil
+
+			}
+
+			used = m.integrate(samples, mode, m.getGapPolicy(def, metric), from, to)
+			unit += "(*period)"
+
+		default:
+
+			used = a.Sum * interval / a.Count
+			unit += "(*period)"
+
+		}
+
+		batch = append(batch, &models.UDRRecord{
+			AccountID:    a.Account,
+			UsageBreakup: datamodels.JSONdb{"used": used},
+			Metadata:     a.Metadata,
+			TimeTo:       to,
+			TimeFrom:     from,
+			ResourceID:   a.ResourceID,
+			ResourceName: a.ResourceName,
+			ResourceType: metric,
+			Unit:         unit,
+		})
+
+		if len(batch) >= batchSize {
+
+			flush()
+
+		}
+
+		return nil
+
+	})
+
+	if e != nil {
+
+		err := "Metric: " + metric + ". Error: " + e.Error()
+		queue <- err
+
+		l.Warning.Printf("[TriggerManager] There was a problem while aggregating the usage of metric [ %v ]. Error: %v", metric, e)
+
+	}
+
+	flush()
+
+	return
+
+}
+
+// getCatchUpStart job is to provide the beginning of the oldest window a
+// schedule still compacts, bounded by the max number of windows caught up and
+// by the lateness horizon.
+// Parameters:
+// - granularity: string with the granularity of the windows.
+// - lastFrom: time with the beginning of the latest closed window.
+// - now: time of reference.
+// Returns:
+// - since: time with the beginning of the oldest window, zero when unbounded.
+func (m *TriggerManager) getCatchUpStart(granularity string, lastFrom, now time.Time) (since time.Time) {
+
+	if m.scheduler.MaxCatchUp > 0 {
+
+		since = lastFrom
+
+		for i := 1; i < m.scheduler.MaxCatchUp; i++ {
+
+			since, _, _ = m.getWindow(granularity, since.Add(-time.Nanosecond))
+
+		}
+
+	}
+
+	if m.scheduler.Horizon > 0 {
+
+		if horizon := now.Add(-m.scheduler.Horizon); horizon.After(since) {
+
+			since = horizon
+
+		}
+
+	}
+
+	return
+
+}
+
+// getCatchUpWindows job is to provide the windows a schedule has to compact:
+// the tracked ones that didn't complete, oldest first, followed by the ones
+// closed after the last tracked window, limited to the max number of windows
+// caught up.
+// Parameters:
+// - granularity: string with the granularity of the windows.
+// - previous: reference to the last window tracked, nil if none.
+// - pending: slice with the tracked windows to be compacted again.
+// - lastFrom: time with the beginning of the latest closed window.
+// - lastTo: time with the end of the latest closed window.
+// Returns:
+// - windows: slice with the borders of the windows to be compacted.
+// - missed: int with the number of windows left out by the limit.
+func (m *TriggerManager) getCatchUpWindows(granularity string, previous *models.CompactionWindow, pending []*models.CompactionWindow, lastFrom, lastTo time.Time) (windows [][2]time.Time, missed int) {
+
+	var next [][2]time.Time
+
+	retried := make(map[int64]bool)
+
+	for _, c := range pending {
+
+		from, to := (time.Time)(c.TimeFrom), (time.Time)(c.TimeTo)
+
+		windows = append(windows, [2]time.Time{from, to})
+		retried[from.Unix()] = true
+
+	}
+
+	if previous == nil {
+
+		next = append(next, [2]time.Time{lastFrom, lastTo})
+
+	} else {
+
+		for t := (time.Time)(previous.TimeTo); t.Before(lastTo); {
+
+			from, to, _ := m.getWindow(granularity, t)
+
+			next = append(next, [2]time.Time{from, to})
+
+			t = to
+
+		}
+
+	}
+
+	if len(next) > m.scheduler.MaxCatchUp && m.scheduler.MaxCatchUp > 0 {
+
+		missed = len(next) - m.scheduler.MaxCatchUp
+		next = next[missed:]
+
+	}
+
+	for _, w := range next {
+
+		if !retried[w[0].Unix()] {
+
+			windows = append(windows, w)
+
+		}
+
+	}
+
+	return
+
+}
+
+// getGapPolicy job is to provide how the gaps between the samples of a metric
+// are integrated, as set in the definition of the metric or, failing that, in
+// the configuration.
+// Parameters:
+// - def: reference to the definition of the metric, if any.
+// - metric: string with the metric of the samples.
+// Returns:
+// - p: GapPolicy of the metric.
+func (m *TriggerManager) getGapPolicy(def *models.Metric, metric string) (p GapPolicy) {
+
+	p = m.integration.Gap
+
+	if g, exists := m.integration.Gaps[strings.ToLower(metric)]; exists {
+
+		p = g
+
+	}
+
+	if def == nil {
+
+		return
+
+	}
+
+	if def.GapHold > 0 {
+
+		p.Hold = time.Duration(def.GapHold) * time.Second
+
+	}
+
+	if def.GapMode != "" {
+
+		p.Zero = def.GapMode == models.MetricGapModeZero
+
+	}
+
+	return
+
+}
+
+// getLastWindow job is to provide the latest window of the granularity that is
+// already closed at the provided time.
+// Parameters:
+// - granularity: string with the granularity of the windows.
+// - t: time of reference.
+// Returns:
+// - from: time with the beginning of the window.
+// - to: time with the end of the window.
+// - e: error raised in case of an invalid granularity.
+func (m *TriggerManager) getLastWindow(granularity string, t time.Time) (from, to time.Time, e error) {
+
+	if from, _, e = m.getWindow(granularity, t); e != nil {
+
+		return
+
+	}
+
+	return m.getWindow(granularity, from.Add(-time.Nanosecond))
+
+}
+
+// getMetricsFilter job is to provide the condition restricting the compacted
+// records to the metrics of the provided schedule.
+// Parameters:
+// - schedule: string with the schedule compacting, empty for all the metrics.
+// Returns:
+// - query: string with the condition to be used with GORM, empty if none.
+// - metrics: slice with the lowercased metrics of the condition.
+func (m *TriggerManager) getMetricsFilter(schedule string) (query string, metrics []string) {
+
+	if schedule == "" {
+
+		return
+
+	}
+
+	column := m.db.Db.NamingStrategy.ColumnName("", "ResourceType")
+
+	// The default schedule takes every metric not claimed by another one
+	for metric, s := range m.scheduler.Metrics {
+
+		if (schedule == m.scheduler.Default && s != schedule) || (schedule != m.scheduler.Default && s == schedule) {
+
+			metrics = append(metrics, metric)
+
+		}
+
+	}
+
+	if schedule == m.scheduler.Default {
+
+		if len(metrics) > 0 {
+
+			query = fmt.Sprintf("LOWER(%v) NOT IN ?", column)
+
+		}
+
+		return
+
+	}
+
+	if len(metrics) == 0 {
+
+		metrics = []string{""}
+
+	}
+
+	query = fmt.Sprintf("LOWER(%v) IN ?", column)
+
+	return
+
+}
+
+// getUDRMode job is to provide the way the samples of a metric are compacted,
+// as set in the definition of the metric, in their metadata or, failing that,
+// in the configuration.
+// Parameters:
+// - def: reference to the definition of the metric, if any.
+// - metric: string with the metric of the samples.
+// - meta: the metadata of the samples.
+// Returns:
+// - mode: string with the UDRMode, empty for the default behaviour.
+func (m *TriggerManager) getUDRMode(def *models.Metric, metric string, meta datamodels.JSONdb) (mode string) {
+
+	if def != nil && def.Aggregation != "" {
+
+		return def.Aggregation
+
+	}
+
+	if v, exists := meta["UDRMode"].(string); exists {
+
+		return v
+
+	}
+
+	return m.integration.Metrics[strings.ToLower(metric)]
+
+}
+
+// getWindow job is to provide the window of the granularity containing the
+// provided time, aligned in the configured timezone.
+// Parameters:
+// - granularity: string with the granularity of the windows.
+// - t: time contained in the window.
+// Returns:
+// - from: time with the beginning of the window.
+// - to: time with the end of the window.
+// - e: error raised in case of an invalid granularity.
+func (m *TriggerManager) getWindow(granularity string, t time.Time) (from, to time.Time, e error) {
+
+	loc := m.scheduler.Location
+
+	if loc == nil {
+
+		loc = time.UTC
+
+	}
+
+	t = t.In(loc)
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+
+	switch strings.ToLower(granularity) {
+
+	case "daily":
+
+		return day, day.AddDate(0, 0, 1), nil
+
+	case "weekly":
+
+		from = day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+
+		return from, from.AddDate(0, 0, 7), nil
+
+	case "monthly":
+
+		from = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+
+		return from, from.AddDate(0, 1, 0), nil
+
+	case "hourly":
+
+		granularity = "1h"
+
+	}
+
+	d, e := time.ParseDuration(granularity)
+
+	if e != nil {
+
+		return
+
+	}
+
+	if d <= 0 || (24*time.Hour)%d != 0 {
+
+		e = fmt.Errorf("the granularity [ %v ] doesn't divide a day", granularity)
+
+		return
+
+	}
+
+	// Windows are aligned to the start of the day, so the last one of the days
+	// with a timezone change gets shortened to end at midnight
+	from = day.Add(t.Sub(day).Truncate(d))
+	to = from.Add(d)
+
+	if next := day.AddDate(0, 0, 1); to.After(next) {
+
+		to = next
+
+	}
+
+	return
+
+}
+
+// inSchedule job is to check if the provided metric is compacted by the
+// provided schedule.
+// Parameters:
+// - metric: string with the metric to check.
+// - schedule: string with the schedule compacting, empty for all the metrics.
+// Returns:
+// - bool with the result of the check.
+func (m *TriggerManager) inSchedule(metric, schedule string) bool {
+
+	if schedule == "" {
+
+		return true
+
+	}
+
+	if s, exists := m.scheduler.Metrics[strings.ToLower(metric)]; exists {
+
+		return s == schedule
+
+	}
+
+	return schedule == m.scheduler.Default
+
+}
+
+// integrate job is to compute the time-weighted integral of the samples of a
+// resource within the window, each sample holding its value until the next one
+// (step) or varying linearly towards it (trapezoid). The first sample is taken
+// as valid since the beginning of the window and the last one until its end.
+// Stretches without samples longer than the Hold of the policy are gaps, not
+// interpolated, where the last value is held for the whole gap or, with the
+// zero policy, held for the Hold and counted as zero for the rest.
+// Parameters:
+// - samples: slice with the usage samples of the resource.
+// - mode: string with the integration mode, step or trapezoid.
+// - gap: GapPolicy of the metric of the samples.
+// - from: a datatime reference for the initial border of the window.
+// - to: a datatime reference for the final border of the window.
+// Returns:
+// - total: the integral of the samples in usage per second.
+func (m *TriggerManager) integrate(samples []*models.Usage, mode string, gap GapPolicy, from, to strfmt.DateTime) (total float64) {
+
+	if len(samples) == 0 {
+
+		return
+
+	}
+
+	sort.Slice(samples, func(i, j int) bool { return samples[i].Time < samples[j].Time })
+
+	hold := gap.Hold.Seconds()
+
+	held := func(v, dt float64) float64 {
+
+		if dt <= 0 {
+
+			return 0
+
+		}
+
+		if gap.Zero && hold > 0 && dt > hold {
+
+			return v * hold
+
+		}
+
+		return v * dt
+
+	}
+
+	first, last := samples[0], samples[len(samples)-1]
+
+	total += held(first.Usage, float64(first.Time-((time.Time)(from)).Unix()))
+
+	for i := 1; i < len(samples); i++ {
+
+		dt := float64(samples[i].Time - samples[i-1].Time)
+
+		if mode == "trapezoid" && (hold <= 0 || dt <= hold) {
+
+			total += (samples[i-1].Usage + samples[i].Usage) / 2 * dt
+
+		} else {
+
+			total += held(samples[i-1].Usage, dt)
+
+		}
+
+	}
+
+	total += held(last.Usage, float64(((time.Time)(to)).Unix()-last.Time))
+
+	return
+
+}
+
+// recordCompaction job is to keep track of the result of the compaction of a
+// window by a schedule.
+// Parameters:
+// - schedule: string with the schedule compacting.
+// - from: a datatime reference for the initial border of the window.
+// - to: a datatime reference for the final border of the window.
+// - accumErr: slice with the errors found during the compaction.
+func (m *TriggerManager) recordCompaction(schedule string, from, to strfmt.DateTime, accumErr []string) {
+
+	c := models.CompactionWindow{
+		CompactedAt: strfmt.DateTime(time.Now()),
+		Errors:      int64(len(accumErr)),
+		Schedule:    schedule,
+		Status:      models.CompactionWindowStatusCOMPLETED,
+		TimeFrom:    from,
+		TimeTo:      to,
+	}
+
+	if len(accumErr) > 0 {
+
+		c.Status = models.CompactionWindowStatusFAILED
+
+	}
+
+	if e := m.db.AddCompaction(c); e != nil {
+
+		l.Warning.Printf("[TriggerManager] The compaction of schedule [ %v ] couldn't be tracked. Error: %v\n", schedule, e)
+
+	}
+
+}
+
+// recompactDirty job is to compact again the windows where late usage landed
+// after their compaction, within the lateness horizon, so the corrected
+// reports are sent downstream.
+func (m *TriggerManager) recompactDirty() {
+
+	m.checkRecomputations()
+
+	var since time.Time
+
+	if m.scheduler.Horizon > 0 {
+
+		since = time.Now().Add(-m.scheduler.Horizon)
+
+	}
+
+	dirty, e := m.db.ListDirtyCompactions(since)
+
+	if e != nil {
+
+		return
+
+	}
+
+	for _, c := range dirty {
+
+		if _, exists := m.scheduler.Schedules[c.Schedule]; !exists {
+
+			continue
+
+		}
+
+		l.Info.Printf("[TriggerManager] Schedule [ %v ] re-compacting window [ %v ] - [ %v ] with [ %v ] late records.\n", c.Schedule, c.TimeFrom, c.TimeTo, c.LateRecords)
+
+		m.recordCompaction(c.Schedule, c.TimeFrom, c.TimeTo, m.compact(context.Background(), m.getClient(nil), c.Schedule, c.TimeFrom, c.TimeTo))
+
+		m.db.Metrics["count"].With(prometheus.Labels{"type": "Dirty windows re-compacted"}).Inc()
+
+	}
+
+}
+
+// runSchedules job is to compact, for each schedule, every closed window not
+// compacted yet: the tracked ones that failed or were interrupted, within the
+// catch-up limits, and the ones closed after the last one tracked.
+func (m *TriggerManager) runSchedules() {
+
+	var names []string
+
+	now := time.Now().Add(-m.scheduler.Delay)
+
+	for name := range m.scheduler.Schedules {
+
+		names = append(names, name)
+
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+
+		granularity := m.scheduler.Schedules[name]
+
+		lastFrom, lastTo, e := m.getLastWindow(granularity, now)
+
+		if e != nil {
+
+			l.Warning.Printf("[TriggerManager] The schedule [ %v ] has an invalid granularity. Error: %v\n", name, e)
+
+			continue
+
+		}
+
+		previous, e := m.db.GetLastCompaction(name)
+
+		if e != nil {
+
+			continue
+
+		}
+
+		pending, e := m.db.ListPendingCompactions(name, m.getCatchUpStart(granularity, lastFrom, now))
+
+		if e != nil {
+
+			continue
+
+		}
+
+		// The windows left RUNNING are only taken as interrupted when no
+		// compaction is in progress
+		if !m.lock.TryLock() {
+
+			var failed []*models.CompactionWindow
+
+			for _, c := range pending {
+
+				if c.Status != models.CompactionWindowStatusRUNNING {
+
+					failed = append(failed, c)
+
+				}
+
+			}
+
+			pending = failed
+
+		} else {
+
+			m.lock.Unlock()
+
+		}
+
+		windows, missed := m.getCatchUpWindows(granularity, previous, pending, lastFrom, lastTo)
+
+		if missed > 0 {
+
+			l.Warning.Printf("[TriggerManager] The schedule [ %v ] missed [ %v ] windows, only the last [ %v ] are going to be compacted.\n", name, missed+m.scheduler.MaxCatchUp, m.scheduler.MaxCatchUp)
+
+		}
+
+		for _, w := range windows {
+
+			l.Info.Printf("[TriggerManager] Schedule [ %v ] compacting window [ %v ] - [ %v ].\n", name, w[0], w[1])
+
+			from, to := strfmt.DateTime(w[0]), strfmt.DateTime(w[1])
+
+			m.recordCompaction(name, from, to, m.compact(context.Background(), m.getClient(nil), name, from, to))
+
+		}
+
+	}
+
+	m.recompactDirty()
+
+	if e := m.db.PurgeUsage(); e != nil {
+
+		l.Warning.Printf("[TriggerManager] The purge of the usage samples out of retention failed. Error: %v\n", e)
+
+	}
 
 }
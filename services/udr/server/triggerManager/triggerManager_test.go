@@ -0,0 +1,159 @@
+package triggerManager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/GoDieNow/TFT_Code/services/udr/models"
+)
+
+// window builds the tracked daily window starting the provided day of
+// January 2024 with the provided status.
+func window(day int, status string) *models.CompactionWindow {
+
+	from := time.Date(2024, time.January, day, 0, 0, 0, 0, time.UTC)
+
+	return &models.CompactionWindow{
+		Schedule: "daily",
+		Status:   status,
+		TimeFrom: strfmt.DateTime(from),
+		TimeTo:   strfmt.DateTime(from.AddDate(0, 0, 1)),
+	}
+
+}
+
+func TestGetCatchUpWindows(t *testing.T) {
+
+	day := func(d int) time.Time {
+
+		return time.Date(2024, time.January, d, 0, 0, 0, 0, time.UTC)
+
+	}
+
+	cases := []struct {
+		name       string
+		maxCatchUp int
+		previous   *models.CompactionWindow
+		pending    []*models.CompactionWindow
+		lastFrom   int
+		want       []int
+		missed     int
+	}{
+		{
+			name:     "failed window before a completed one is retried",
+			previous: window(3, models.CompactionWindowStatusCOMPLETED),
+			pending:  []*models.CompactionWindow{window(1, models.CompactionWindowStatusFAILED)},
+			lastFrom: 4,
+			want:     []int{1, 4},
+		},
+		{
+			name:     "interrupted last window is compacted once",
+			previous: window(4, models.CompactionWindowStatusRUNNING),
+			pending:  []*models.CompactionWindow{window(2, models.CompactionWindowStatusFAILED), window(4, models.CompactionWindowStatusRUNNING)},
+			lastFrom: 5,
+			want:     []int{2, 4, 5},
+		},
+		{
+			name:       "new windows are limited by the catch up",
+			maxCatchUp: 2,
+			previous:   window(1, models.CompactionWindowStatusCOMPLETED),
+			lastFrom:   6,
+			want:       []int{5, 6},
+			missed:     3,
+		},
+		{
+			name:     "first run compacts the last closed window",
+			lastFrom: 6,
+			want:     []int{6},
+		},
+	}
+
+	for _, c := range cases {
+
+		m := &TriggerManager{
+			scheduler: SchedulerConfig{
+				Location:   time.UTC,
+				MaxCatchUp: c.maxCatchUp,
+			},
+		}
+
+		windows, missed := m.getCatchUpWindows("daily", c.previous, c.pending, day(c.lastFrom), day(c.lastFrom+1))
+
+		if missed != c.missed {
+
+			t.Errorf("%v: missed [ %v ] windows, expected [ %v ]", c.name, missed, c.missed)
+
+		}
+
+		if len(windows) != len(c.want) {
+
+			t.Errorf("%v: got [ %v ] windows, expected [ %v ]: %v", c.name, len(windows), len(c.want), windows)
+
+			continue
+
+		}
+
+		for i, w := range windows {
+
+			if !w[0].Equal(day(c.want[i])) || !w[1].Equal(day(c.want[i]+1)) {
+
+				t.Errorf("%v: window [ %v ] is [ %v - %v ], expected the one starting [ %v ]", c.name, i, w[0], w[1], day(c.want[i]))
+
+			}
+
+		}
+
+	}
+
+}
+
+func TestIntegrateGaps(t *testing.T) {
+
+	from := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	// A value of 2 for the first 10 minutes and no samples for the next 50
+	samples := func() []*models.Usage {
+
+		return []*models.Usage{
+			{Time: from.Unix(), Usage: 2},
+			{Time: from.Add(10 * time.Minute).Unix(), Usage: 2},
+		}
+
+	}
+
+	cases := []struct {
+		name string
+		gap  GapPolicy
+		want float64
+	}{
+		{
+			name: "hold keeps the value for the whole gap",
+			gap:  GapPolicy{Hold: 15 * time.Minute},
+			want: 2 * 3600,
+		},
+		{
+			name: "zero holds the value up to the hold",
+			gap:  GapPolicy{Hold: 15 * time.Minute, Zero: true},
+			want: 2 * (600 + 900),
+		},
+	}
+
+	m := &TriggerManager{}
+
+	for _, c := range cases {
+
+		for _, mode := range []string{"step", "trapezoid"} {
+
+			if got := m.integrate(samples(), mode, c.gap, strfmt.DateTime(from), strfmt.DateTime(to)); got != c.want {
+
+				t.Errorf("%v (%v): got [ %v ], expected [ %v ]", c.name, mode, got, c.want)
+
+			}
+
+		}
+
+	}
+
+}
@@ -1,7 +1,17 @@
 package usageManager
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-openapi/runtime/middleware"
@@ -14,6 +24,54 @@ import (
 	l "gitlab.com/cyclops-utilities/logging"
 )
 
+// batchReader is the struct defined to read the batches received up to the
+// max size allowed.
+// Parameters:
+// - r: io.Reader with the batch.
+// - left: int64 with the number of bytes that can still be read.
+// - exceeded: bool flagging the batch as bigger than the max size allowed.
+type batchReader struct {
+	r        io.Reader
+	left     int64
+	exceeded bool
+}
+
+// errBatchTooLarge is raised when the batch received goes over the max size
+// allowed.
+var errBatchTooLarge = errors.New("the batch exceeds the max size allowed")
+
+// Read (io.Reader func) reads from the batch until the max size allowed is
+// exceeded, failing from then on.
+func (b *batchReader) Read(p []byte) (n int, e error) {
+
+	if b.exceeded {
+
+		return 0, errBatchTooLarge
+
+	}
+
+	if int64(len(p)) > b.left+1 {
+
+		p = p[:b.left+1]
+
+	}
+
+	n, e = b.r.Read(p)
+
+	b.left -= int64(n)
+
+	if b.left < 0 {
+
+		b.exceeded = true
+
+		return n + int(b.left), errBatchTooLarge
+
+	}
+
+	return
+
+}
+
 // UsageManager is the struct defined to group and contain all the methods
 // that interact with the usage endpoint.
 // Parameters:
@@ -45,6 +103,56 @@ func New(db *dbManager.DbParameter, monit *statusManager.StatusManager) *UsageMa
 
 }
 
+// GetIngestionJob (Swagger func) is the function behind the (GET) endpoint
+// /usage/ingest/{id}
+// Its job is to provide the status and the results of an ingestion job.
+func (m *UsageManager) GetIngestionJob(ctx context.Context, params usage_management.GetIngestionJobParams) middleware.Responder {
+
+	l.Trace.Printf("[UsageManager] GetIngestionJob endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("usage", callTime)
+
+	job, e := m.db.GetIngestionJob(params.ID.String())
+
+	if e != nil {
+
+		s := "There was an error retrieving the ingestion job from the system: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "GET", "route": "/usage/ingest/" + params.ID.String()}).Inc()
+
+		m.monit.APIHitDone("usage", callTime)
+
+		return usage_management.NewGetIngestionJobInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	if job == nil {
+
+		s := "The ingestion job doesn't exist in the system."
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "404", "method": "GET", "route": "/usage/ingest/" + params.ID.String()}).Inc()
+
+		m.monit.APIHitDone("usage", callTime)
+
+		return usage_management.NewGetIngestionJobNotFound().WithPayload(&errorReturn)
+
+	}
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "GET", "route": "/usage/ingest/" + params.ID.String()}).Inc()
+
+	m.monit.APIHitDone("usage", callTime)
+
+	return usage_management.NewGetIngestionJobOK().WithPayload(job)
+
+}
+
 // GetSystemUsage (Swagger func) is the function behind the (GET) endpoint
 // /usage
 // Its job is to retrieve the usage report given a certain time-window and with
@@ -167,3 +275,407 @@ func (m *UsageManager) GetUsage(ctx context.Context, params usage_management.Get
 	return usage_management.NewGetUsageOK().WithPayload(usage)
 
 }
+
+// IngestUsage (Swagger func) is the function behind the (POST) endpoint
+// /usage/ingest
+// Its job is to ingest a batch of usage records sent as JSON, NDJSON or CSV
+// through the same path as the ones received from Kafka. Batches over the max
+// size configured are rejected and big ones processed in the background, the
+// results of the records not accepted available in the job returned.
+func (m *UsageManager) IngestUsage(ctx context.Context, params usage_management.IngestUsageParams) middleware.Responder {
+
+	l.Trace.Printf("[UsageManager] IngestUsage endpoint invoked.\n")
+
+	callTime := time.Now()
+	m.monit.APIHit("usage", callTime)
+
+	defer params.Records.Close()
+
+	format := m.getFormat(params)
+
+	batch := &batchReader{
+		r:    params.Records,
+		left: m.db.Ingestion.MaxSize,
+	}
+
+	var records []*models.Usage
+	var rejects []*models.IngestionResult
+	var e error
+
+	switch format {
+
+	case "csv":
+
+		records, rejects, e = m.parseCSV(batch)
+
+	case "ndjson":
+
+		records, rejects, e = m.parseNDJSON(batch)
+
+	default:
+
+		records, rejects, e = m.parseJSON(batch)
+
+	}
+
+	if batch.exceeded {
+
+		e = fmt.Errorf("%w: [ %v ] bytes", errBatchTooLarge, m.db.Ingestion.MaxSize)
+
+	}
+
+	if e != nil {
+
+		s := "The batch couldn't be parsed as " + format + ": " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "400", "method": "POST", "route": "/usage/ingest"}).Inc()
+
+		m.monit.APIHitDone("usage", callTime)
+
+		return usage_management.NewIngestUsageBadRequest().WithPayload(&errorReturn)
+
+	}
+
+	job := models.IngestionJob{
+		CreatedAt: strfmt.DateTime(time.Now()),
+		Format:    format,
+		Rejected:  int64(len(rejects)),
+		Results:   rejects,
+		Status:    models.IngestionJobStatusPENDING,
+		Total:     int64(len(records)),
+	}
+
+	if e := m.db.AddIngestionJob(&job); e != nil {
+
+		s := "There was an error registering the ingestion job in the system: " + e.Error()
+		errorReturn := models.ErrorResponse{
+			ErrorString: &s,
+		}
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "500", "method": "POST", "route": "/usage/ingest"}).Inc()
+
+		m.monit.APIHitDone("usage", callTime)
+
+		return usage_management.NewIngestUsageInternalServerError().WithPayload(&errorReturn)
+
+	}
+
+	if (params.Async != nil && *params.Async) || len(records) > m.db.Ingestion.Async {
+
+		// The job keeps being updated in the background
+		accepted := job
+		accepted.Results = append([]*models.IngestionResult(nil), job.Results...)
+
+		go m.db.RunIngestionJob(&job, records)
+
+		m.db.Metrics["api"].With(prometheus.Labels{"code": "202", "method": "POST", "route": "/usage/ingest"}).Inc()
+
+		m.monit.APIHitDone("usage", callTime)
+
+		return usage_management.NewIngestUsageAccepted().WithPayload(&accepted)
+
+	}
+
+	m.db.RunIngestionJob(&job, records)
+
+	m.db.Metrics["api"].With(prometheus.Labels{"code": "200", "method": "POST", "route": "/usage/ingest"}).Inc()
+
+	m.monit.APIHitDone("usage", callTime)
+
+	return usage_management.NewIngestUsageOK().WithPayload(&job)
+
+}
+
+// getFormat job is to provide the format of the batch received, as set in the
+// params or, failing that, in its Content-Type.
+// Parameters:
+// - params: the IngestUsageParams of the request.
+// Returns:
+// - string with the format: csv, json or ndjson.
+func (m *UsageManager) getFormat(params usage_management.IngestUsageParams) string {
+
+	if params.Format != nil {
+
+		return *params.Format
+
+	}
+
+	mt, _, _ := mime.ParseMediaType(params.HTTPRequest.Header.Get("Content-Type"))
+
+	switch mt {
+
+	case "text/csv":
+
+		return "csv"
+
+	case "application/x-ndjson":
+
+		return "ndjson"
+
+	}
+
+	return "json"
+
+}
+
+// getReject job is to provide the result of a record of the batch that
+// couldn't be parsed.
+// Parameters:
+// - index: int with the position of the record in the batch, starting at 1.
+// - e: error raised while parsing the record.
+// Returns:
+// - reference to the IngestionResult of the record.
+func (m *UsageManager) getReject(index int, e error) *models.IngestionResult {
+
+	return &models.IngestionResult{
+		Error:  "the record couldn't be parsed: " + e.Error(),
+		Index:  int64(index),
+		Status: models.IngestionResultStatusREJECTED,
+	}
+
+}
+
+// parseCSV job is to parse a batch of usage records in CSV, with a header
+// row naming the fields of the Usage model in each column and the Metadata
+// column, if any, in JSON.
+// Parameters:
+// - r: io.Reader with the batch.
+// Returns:
+// - records: slice with the records, nil for the ones that couldn't be parsed.
+// - rejects: slice with the results of the records that couldn't be parsed.
+// - e: error raised in case of the batch not being valid CSV.
+func (m *UsageManager) parseCSV(r io.Reader) (records []*models.Usage, rejects []*models.IngestionResult, e error) {
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, e := reader.Read()
+
+	if e != nil {
+
+		return
+
+	}
+
+	columns := make(map[string]int)
+
+	for i, name := range header {
+
+		name = strings.ToLower(strings.TrimSpace(name))
+
+		switch name {
+
+		case "account", "idempotencykey", "metadata", "resourceid", "resourcename", "resourcetype", "time", "timedate", "unit", "usage":
+
+			columns[name] = i
+
+		default:
+
+			e = fmt.Errorf("unknown column [ %v ]", name)
+
+			return
+
+		}
+
+	}
+
+	for {
+
+		row, err := reader.Read()
+
+		if err == io.EOF {
+
+			break
+
+		}
+
+		records = append(records, nil)
+
+		if err != nil {
+
+			var pe *csv.ParseError
+
+			if !errors.As(err, &pe) {
+
+				e = err
+
+				return
+
+			}
+
+			rejects = append(rejects, m.getReject(len(records), err))
+
+			continue
+
+		}
+
+		if len(row) != len(header) {
+
+			rejects = append(rejects, m.getReject(len(records), fmt.Errorf("[ %v ] fields instead of [ %v ]", len(row), len(header))))
+
+			continue
+
+		}
+
+		var u models.Usage
+
+		get := func(name string) (v string) {
+
+			if i, exists := columns[name]; exists {
+
+				v = row[i]
+
+			}
+
+			return
+
+		}
+
+		u.Account = get("account")
+		u.IdempotencyKey = get("idempotencykey")
+		u.ResourceID = get("resourceid")
+		u.ResourceName = get("resourcename")
+		u.ResourceType = get("resourcetype")
+		u.Unit = get("unit")
+
+		if v := get("metadata"); v != "" {
+
+			err = json.Unmarshal([]byte(v), &u.Metadata)
+
+		}
+
+		if v := get("time"); v != "" && err == nil {
+
+			u.Time, err = strconv.ParseInt(v, 10, 64)
+
+		}
+
+		if v := get("timedate"); v != "" && err == nil {
+
+			u.Timedate, err = strfmt.ParseDateTime(v)
+
+		}
+
+		if v := get("usage"); v != "" && err == nil {
+
+			u.Usage, err = strconv.ParseFloat(v, 64)
+
+		}
+
+		if err != nil {
+
+			rejects = append(rejects, m.getReject(len(records), err))
+
+			continue
+
+		}
+
+		records[len(records)-1] = &u
+
+	}
+
+	return
+
+}
+
+// parseJSON job is to parse a batch of usage records in JSON, either a list
+// of them or a single one.
+// Parameters:
+// - r: io.Reader with the batch.
+// Returns:
+// - records: slice with the records, nil for the ones that couldn't be parsed.
+// - rejects: slice with the results of the records that couldn't be parsed.
+// - e: error raised in case of the batch not being valid JSON.
+func (m *UsageManager) parseJSON(r io.Reader) (records []*models.Usage, rejects []*models.IngestionResult, e error) {
+
+	var raw json.RawMessage
+	var list []json.RawMessage
+
+	if e = json.NewDecoder(r).Decode(&raw); e != nil {
+
+		return
+
+	}
+
+	if trimmed := bytes.TrimSpace(raw); len(trimmed) > 0 && trimmed[0] == '[' {
+
+		if e = json.Unmarshal(raw, &list); e != nil {
+
+			return
+
+		}
+
+	} else {
+
+		list = append(list, raw)
+
+	}
+
+	for i := range list {
+
+		var u models.Usage
+
+		if err := json.Unmarshal(list[i], &u); err != nil {
+
+			records = append(records, nil)
+			rejects = append(rejects, m.getReject(i+1, err))
+
+			continue
+
+		}
+
+		records = append(records, &u)
+
+	}
+
+	return
+
+}
+
+// parseNDJSON job is to parse a batch of usage records in NDJSON, a record in
+// JSON per line, skipping the empty ones.
+// Parameters:
+// - r: io.Reader with the batch.
+// Returns:
+// - records: slice with the records, nil for the ones that couldn't be parsed.
+// - rejects: slice with the results of the records that couldn't be parsed.
+// - e: error raised in case of problems reading the batch.
+func (m *UsageManager) parseNDJSON(r io.Reader) (records []*models.Usage, rejects []*models.IngestionResult, e error) {
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+
+		line := bytes.TrimSpace(scanner.Bytes())
+
+		if len(line) == 0 {
+
+			continue
+
+		}
+
+		var u models.Usage
+
+		if err := json.Unmarshal(line, &u); err != nil {
+
+			records = append(records, nil)
+			rejects = append(rejects, m.getReject(len(records), err))
+
+			continue
+
+		}
+
+		records = append(records, &u)
+
+	}
+
+	e = scanner.Err()
+
+	return
+
+}
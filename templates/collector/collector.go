@@ -1,52 +1,47 @@
-package main
+package template
 
 import (
 	"context"
-	"os"
 	"strings"
 	"time"
 
-	"github.com/gophercloud/gophercloud"
-	"github.com/gophercloud/gophercloud/openstack"
-	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
-	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
-	"github.com/gophercloud/gophercloud/pagination"
-	"github.com/prometheus/client_golang/prometheus"
 	datamodels "gitlab.com/cyclops-utilities/datamodels"
-	eeEvent "github.com/GoDieNow/TFT_Code/services/eventsengine/client/event_management"
 	eeModels "github.com/GoDieNow/TFT_Code/services/eventsengine/models"
 	l "gitlab.com/cyclops-utilities/logging"
 )
 
 var (
-	collector       = "Network"
-	objects         = "ip"
-	remotelist      []*eeModels.MinimalState
 	collectionStart int64
 )
 
 // collect handles the process of retrieving the information from the system.
-func collect() {
+// Parameters:
+// - ctx: context of the collection.
+// Returns:
+// - e: error raised in case of something goes wrong, the collection is then
+// retried and nothing of it is reported.
+func collect(ctx context.Context) (e error) {
 
 	l.Trace.Printf("[COLLECTION] The collection process has been started.\n")
 
 	collectionStart = time.Now().UnixNano()
 
-	metricTime.With(prometheus.Labels{"type": "Collection Start Time"}).Set(float64(collectionStart))
-
+	// Here comes the logic to retrieve the information from the system, through
+	// httpClient so the responses can be recorded and replayed.
 	l.Trace.Printf("[COLLECTION] Found ...")
 
 	// Here comes the transformation of the information retrieved into either
 	md := make(datamodels.JSONdb)
-	evTime := int64(time.Now().Unix())
+	evTime := int64(fixtures.Now().Unix())
 	// events or usage reports to be sent.
-	event := ...
+	event := eeModels.Event{...}
 	report(event)
 
+	l.Warning.Printf("[COLLECTION] Completed.\n - Processing Time: %v[ms]\n", float64(time.Now().UnixNano()-collectionStart)/float64(time.Millisecond))
 
 	l.Trace.Printf("[COLLECTION] The collection process has been finished.\n")
 
-	return
+	return nil
 
 }
 
@@ -1,3 +0,0 @@
-module github.com/GoDieNow/TFT_Code/templates/collector
-
-go 1.23.9
@@ -0,0 +1,75 @@
+package template
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/GoDieNow/TFT_Code/collectors/collector"
+)
+
+var (
+	cfg          *collector.Configuration
+	fixtures     *collector.Fixtures
+	httpClient   http.Client
+	metricCount  *prometheus.GaugeVec
+	reports      []interface{}
+	reportsMutex sync.Mutex
+)
+
+// Source collects the resources of the system as events or usages.
+// The state of the collection is kept by the package, so the source can only
+// be run once per collector.
+type Source struct{}
+
+// New creates the source to be run by the collector runtime, it has to be
+// registered in collectors/cmd/collector with the name used in the [SOURCES]
+// section of the configuration.
+// Parameters:
+// - env: Env reference with the configuration and clients of the runtime.
+// Returns:
+// - s: the Source.
+// - e: error raised in case of something goes wrong.
+func New(env *collector.Env) (s collector.Source, e error) {
+
+	cfg = env.Config
+	fixtures = env.Fixtures
+	httpClient = env.HTTPClient
+	metricCount = env.MetricCount
+
+	s = &Source{}
+
+	return
+
+}
+
+// Collect retrieves the resources from the system.
+// Parameters:
+// - ctx: context of the collection.
+// Returns:
+// - r: slice with the events/usages to be reported.
+// - e: error raised in case of something goes wrong.
+func (s *Source) Collect(ctx context.Context) (r []interface{}, e error) {
+
+	reports = nil
+
+	e = collect(ctx)
+
+	r = reports
+
+	return
+
+}
+
+// report queues the event/usage to be sent once the collection has finished.
+// Parameters:
+// - object: an interface{} reference with the event/usage to be sent.
+func report(object interface{}) {
+
+	reportsMutex.Lock()
+	defer reportsMutex.Unlock()
+
+	reports = append(reports, object)
+
+}